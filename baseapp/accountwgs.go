@@ -4,34 +4,63 @@ import (
 	"sync"
 
 	sdk "github.com/line/lbm-sdk/types"
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
 )
 
 type AccountWGs struct {
 	mtx sync.Mutex
 	wgs map[string]*sync.WaitGroup
+
+	// number of txs being checked per signer, and its limit (0 means unlimited)
+	pending    map[string]int
+	maxPending int
 }
 
 func NewAccountWGs() *AccountWGs {
 	return &AccountWGs{
-		wgs: make(map[string]*sync.WaitGroup),
+		wgs:     make(map[string]*sync.WaitGroup),
+		pending: make(map[string]int),
 	}
 }
 
-func (aw *AccountWGs) Register(tx sdk.Tx) (waits []*sync.WaitGroup, signals []*AccountWG) {
+// SetMaxPending sets the maximum number of txs of a signer which can be
+// checked at the same time. Zero means unlimited.
+func (aw *AccountWGs) SetMaxPending(maxPending int) {
+	aw.mtx.Lock()
+	defer aw.mtx.Unlock()
+
+	aw.maxPending = maxPending
+}
+
+// Register registers the signers of the tx, returning the wait groups of the
+// txs of the same signers which must be checked before it. The number of
+// pending txs is limited only for new txs, as rechecked txs are already in the
+// mempool.
+func (aw *AccountWGs) Register(tx sdk.Tx, recheck bool) (waits []*sync.WaitGroup, signals []*AccountWG, err error) {
 	signers := getUniqSigners(tx)
 
 	aw.mtx.Lock()
 	defer aw.mtx.Unlock()
+
+	if !recheck && aw.maxPending > 0 {
+		for _, signer := range signers {
+			if aw.pending[signer] >= aw.maxPending {
+				return nil, nil, sdkerrors.Wrapf(sdkerrors.ErrMempoolIsFull, "too many pending txs of %s; limit: %d", signer, aw.maxPending)
+			}
+		}
+	}
+
 	for _, signer := range signers {
 		if wg := aw.wgs[signer]; wg != nil {
 			waits = append(waits, wg)
 		}
 		sig := waitGroup1()
 		aw.wgs[signer] = sig
+		aw.pending[signer]++
 		signals = append(signals, NewAccountWG(signer, sig))
 	}
 
-	return waits, signals
+	return waits, signals, nil
 }
 
 func (aw *AccountWGs) Wait(waits []*sync.WaitGroup) {
@@ -49,6 +78,9 @@ func (aw *AccountWGs) Done(signals []*AccountWG) {
 		if aw.wgs[signal.acc] == signal.wg {
 			delete(aw.wgs, signal.acc)
 		}
+		if aw.pending[signal.acc]--; aw.pending[signal.acc] <= 0 {
+			delete(aw.pending, signal.acc)
+		}
 	}
 }
 
@@ -8,6 +8,7 @@ import (
 	"github.com/line/lbm-sdk/crypto/keys/secp256k1"
 	"github.com/line/lbm-sdk/testutil/testdata"
 	sdk "github.com/line/lbm-sdk/types"
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
 )
 
 func TestConvertByteSliceToString(t *testing.T) {
@@ -23,7 +24,8 @@ func TestRegister(t *testing.T) {
 	privs := newTestPrivKeys(3)
 	tx := newTestTx(privs)
 
-	waits, signals := app.checkAccountWGs.Register(tx)
+	waits, signals, err := app.checkAccountWGs.Register(tx, false)
+	require.NoError(t, err)
 
 	require.Equal(t, 0, len(waits))
 	require.Equal(t, 3, len(signals))
@@ -33,6 +35,36 @@ func TestRegister(t *testing.T) {
 	}
 }
 
+func TestRegisterMaxPending(t *testing.T) {
+	app := setupBaseApp(t, SetMaxPendingTxsPerSigner(2))
+
+	privs := newTestPrivKeys(2)
+	tx := newTestTx(privs)
+	other := newTestTx(privs[1:])
+
+	_, signals1, err := app.checkAccountWGs.Register(tx, false)
+	require.NoError(t, err)
+	_, signals2, err := app.checkAccountWGs.Register(other, false)
+	require.NoError(t, err)
+
+	// the second signer has reached the limit
+	_, _, err = app.checkAccountWGs.Register(tx, false)
+	require.ErrorIs(t, err, sdkerrors.ErrMempoolIsFull)
+
+	// rechecked txs are not limited
+	waits, signals3, err := app.checkAccountWGs.Register(other, true)
+	require.NoError(t, err)
+	require.Len(t, waits, 1)
+
+	app.checkAccountWGs.Done(signals1)
+	app.checkAccountWGs.Done(signals2)
+	app.checkAccountWGs.Done(signals3)
+	require.Empty(t, app.checkAccountWGs.pending)
+
+	_, _, err = app.checkAccountWGs.Register(tx, false)
+	require.NoError(t, err)
+}
+
 func TestDontPanicWithNil(t *testing.T) {
 	app := setupBaseApp(t)
 
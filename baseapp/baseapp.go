@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"strconv"
 	"strings"
 	"sync"
 
@@ -34,6 +35,11 @@ type (
 	// an older version of the software. In particular, if a module changed the substore key name
 	// (or removed a substore) between two versions of the software.
 	StoreLoader func(ms sdk.CommitMultiStore) error
+
+	// TxPriority defines a function which computes the priority of a tx which
+	// passed the AnteHandler in CheckTx. The priority is returned to the mempool,
+	// which prefers txs with a higher priority.
+	TxPriority func(ctx sdk.Context, tx sdk.Tx) int64
 )
 
 // BaseApp reflects the ABCI application implementation.
@@ -43,6 +49,7 @@ type BaseApp struct { // nolint: maligned
 	name              string               // application name from abci.Info
 	db                dbm.DB               // common DB backend
 	cms               sdk.CommitMultiStore // Main (uncached) state
+	archive           sdk.ArchiveStore     // history of the state, closed with the app
 	storeLoader       StoreLoader          // function to handle store loading, may be overridden with SetStoreLoader()
 	router            sdk.Router           // handle any kind of message
 	queryRouter       sdk.QueryRouter      // router for redirecting query calls
@@ -52,6 +59,7 @@ type BaseApp struct { // nolint: maligned
 	txDecoder         sdk.TxDecoder // unmarshal []byte into sdk.Tx
 
 	anteHandler    sdk.AnteHandler  // ante handler for fee and auth
+	txPriority     TxPriority       // priority of txs in the mempool
 	initChainer    sdk.InitChainer  // initialize state with validators and state blob
 	beginBlocker   sdk.BeginBlocker // logic to run before any txs
 	endBlocker     sdk.EndBlocker   // logic to run after all txs, and to determine valset changes
@@ -59,6 +67,12 @@ type BaseApp struct { // nolint: maligned
 	idPeerFilter   sdk.PeerFilter   // filter peers by node ID
 	fauxMerkleMode bool             // if true, IAVL MountStores uses MountStoresDB for simulation speed.
 
+	// records the store operations of the delivered txs if set
+	storeProfiler *StoreProfiler
+
+	// records the gas prices of the txs delivered in the recent blocks if set
+	gasPriceHistory *GasPriceHistory
+
 	// manages snapshots, i.e. dumps of app state at certain intervals
 	snapshotManager    *snapshots.Manager
 	snapshotInterval   uint64 // block interval between state sync snapshots
@@ -300,6 +314,17 @@ func (app *BaseApp) LastBlockHeight() int64 {
 	return app.cms.LastCommitID().Version
 }
 
+// Close closes the DB backends of the BaseApp.
+func (app *BaseApp) Close() error {
+	if app.archive != nil {
+		if err := app.archive.Close(); err != nil {
+			return err
+		}
+	}
+
+	return app.db.Close()
+}
+
 func (app *BaseApp) init() error {
 	if app.sealed {
 		panic("cannot call initFromMainStore: baseapp already sealed")
@@ -597,7 +622,7 @@ func (app *BaseApp) PreCheckTx(txBytes []byte) (sdk.Tx, error) {
 	return app.preCheckTx(txBytes)
 }
 
-func (app *BaseApp) checkTx(txBytes []byte, tx sdk.Tx, recheck bool) (gInfo sdk.GasInfo, err error) {
+func (app *BaseApp) checkTx(txBytes []byte, tx sdk.Tx, recheck bool) (gInfo sdk.GasInfo, priority int64, err error) {
 	ctx := app.getCheckContextForTx(txBytes, recheck)
 	gasCtx := &ctx
 
@@ -615,7 +640,30 @@ func (app *BaseApp) checkTx(txBytes []byte, tx sdk.Tx, recheck bool) (gInfo sdk.
 		gasCtx = &anteCtx
 	}
 
-	return gInfo, err
+	if err == nil && app.txPriority != nil {
+		priority = app.txPriority(*gasCtx, tx)
+	}
+
+	return gInfo, priority, err
+}
+
+// checkTxResponse returns the ResponseCheckTx of a tx which passed CheckTx.
+// As ResponseCheckTx has no dedicated field for it, the priority of the tx is
+// returned as an attribute of a tx event if a TxPriority is set.
+func (app *BaseApp) checkTxResponse(gInfo sdk.GasInfo, priority int64) abci.ResponseCheckTx {
+	res := abci.ResponseCheckTx{
+		GasWanted: int64(gInfo.GasWanted), // TODO: Should type accept unsigned ints?
+		GasUsed:   int64(gInfo.GasUsed),   // TODO: Should type accept unsigned ints?
+	}
+
+	if app.txPriority != nil {
+		event := sdk.NewEvent(sdk.EventTypeTx,
+			sdk.NewAttribute(sdk.AttributeKeyPriority, strconv.FormatInt(priority, 10)),
+		)
+		res.Events = sdk.MarkEventsToIndex(sdk.Events{event}.ToABCIEvents(), app.indexEvents)
+	}
+
+	return res
 }
 
 func (app *BaseApp) anteTx(ctx sdk.Context, txBytes []byte, tx sdk.Tx, simulate bool) (sdk.Context, error) {
@@ -693,8 +741,15 @@ func (app *BaseApp) runTx(txBytes []byte, tx sdk.Tx, simulate bool) (gInfo sdk.G
 		return sdk.GasInfo{}, nil, nil, err
 	}
 
+	// store operations are profiled only in DeliverTx
+	profiler := app.storeProfiler
+	if simulate {
+		profiler = nil
+	}
+
+	anteProfile := profiler.start(ProfileAnteHandler)
 	var newCtx sdk.Context
-	newCtx, err = app.anteTx(ctx, txBytes, tx, simulate)
+	newCtx, err = app.anteTx(anteProfile.wrap(ctx), txBytes, tx, simulate)
 	if !newCtx.IsZero() {
 		// At this point, newCtx.MultiStore() is a store branch, or something else
 		// replaced by the AnteHandler. We want the original multistore.
@@ -704,6 +759,7 @@ func (app *BaseApp) runTx(txBytes []byte, tx sdk.Tx, simulate bool) (gInfo sdk.G
 		// prior to returning.
 		ctx = newCtx.WithMultiStore(ms)
 	}
+	anteProfile.done(ctx.GasMeter().GasConsumed())
 
 	if err != nil {
 		return gInfo, nil, nil, err
@@ -720,7 +776,7 @@ func (app *BaseApp) runTx(txBytes []byte, tx sdk.Tx, simulate bool) (gInfo sdk.G
 	// Attempt to execute all messages and only update state if all messages pass
 	// and we're in DeliverTx. Note, runMsgs will never return a reference to a
 	// Result if any single message fails or does not have a registered Handler.
-	result, err = app.runMsgs(runMsgCtx, msgs)
+	result, err = app.runMsgs(runMsgCtx, msgs, profiler)
 	if err == nil && !simulate {
 		// When block gas exceeds, it'll panic and won't commit the cached store.
 		consumeBlockGas()
@@ -741,7 +797,8 @@ func (app *BaseApp) runTx(txBytes []byte, tx sdk.Tx, simulate bool) (gInfo sdk.G
 // and DeliverTx. An error is returned if any single message fails or if a
 // Handler does not exist for a given message route. Otherwise, a reference to a
 // Result is returned. The caller must not commit state if an error is returned.
-func (app *BaseApp) runMsgs(ctx sdk.Context, msgs []sdk.Msg) (*sdk.Result, error) {
+// If a profiler is given, the store operations of each message are recorded.
+func (app *BaseApp) runMsgs(ctx sdk.Context, msgs []sdk.Msg, profiler *StoreProfiler) (*sdk.Result, error) {
 	msgLogs := make(sdk.ABCIMessageLogs, 0, len(msgs))
 	events := sdk.EmptyEvents()
 	txMsgData := &sdk.TxMsgData{
@@ -756,9 +813,13 @@ func (app *BaseApp) runMsgs(ctx sdk.Context, msgs []sdk.Msg) (*sdk.Result, error
 			err          error
 		)
 
+		msgProfile := profiler.start(sdk.MsgTypeURL(msg))
+		msgCtx := msgProfile.wrap(ctx)
+		gasBefore := ctx.GasMeter().GasConsumed()
+
 		if handler := app.msgServiceRouter.Handler(msg); handler != nil {
 			// ADR 031 request type routing
-			msgResult, err = handler(ctx, msg)
+			msgResult, err = handler(msgCtx, msg)
 			eventMsgName = sdk.MsgTypeURL(msg)
 		} else if legacyMsg, ok := msg.(legacytx.LegacyMsg); ok {
 			// legacy sdk.Msg routing
@@ -773,11 +834,13 @@ func (app *BaseApp) runMsgs(ctx sdk.Context, msgs []sdk.Msg) (*sdk.Result, error
 				return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized message route: %s; message index: %d", msgRoute, i)
 			}
 
-			msgResult, err = handler(ctx, msg)
+			msgResult, err = handler(msgCtx, msg)
 		} else {
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "can't route message %+v", msg)
 		}
 
+		msgProfile.done(ctx.GasMeter().GasConsumed() - gasBefore)
+
 		if err != nil {
 			return nil, sdkerrors.Wrapf(err, "failed to execute message; message index: %d", i)
 		}
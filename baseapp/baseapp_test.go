@@ -2,6 +2,7 @@ package baseapp
 
 import (
 	"bytes"
+	"context"
 	"encoding/binary"
 	"encoding/json"
 	"fmt"
@@ -21,6 +22,7 @@ import (
 	"github.com/stretchr/testify/require"
 	dbm "github.com/tendermint/tm-db"
 
+	"github.com/line/lbm-sdk/client/grpc/profiler"
 	"github.com/line/lbm-sdk/codec"
 	"github.com/line/lbm-sdk/snapshots"
 	snapshottypes "github.com/line/lbm-sdk/snapshots/types"
@@ -967,6 +969,45 @@ func TestCheckTx(t *testing.T) {
 	require.Nil(t, storedBytes)
 }
 
+func TestCheckTxPriority(t *testing.T) {
+	counterKey := []byte("counter-key")
+
+	anteOpt := func(bapp *BaseApp) {
+		bapp.SetAnteHandler(anteHandlerTxTest(t, capKey1, counterKey))
+		bapp.SetTxPriority(func(ctx sdk.Context, tx sdk.Tx) int64 {
+			return tx.(txTest).Counter * 10
+		})
+	}
+	app := setupBaseApp(t, anteOpt)
+	app.InitChain(abci.RequestInitChain{})
+
+	codec := codec.NewLegacyAmino()
+	registerTestCodec(codec)
+
+	for i := int64(0); i < 3; i++ {
+		txBytes, err := codec.Marshal(newTxCounter(i, 0))
+		require.NoError(t, err)
+
+		expected := []abci.Event{{
+			Type:       sdk.EventTypeTx,
+			Attributes: []abci.EventAttribute{{Key: []byte(sdk.AttributeKeyPriority), Value: []byte(fmt.Sprintf("%d", i*10)), Index: true}},
+		}}
+
+		r := app.CheckTxSync(abci.RequestCheckTx{Tx: txBytes})
+		require.True(t, r.IsOK(), fmt.Sprintf("%v", r))
+		require.Equal(t, expected, r.GetEvents())
+	}
+
+	// failed txs have no priority
+	tx := newTxCounter(3, 0)
+	tx.setFailOnAnte(true)
+	txBytes, err := codec.Marshal(tx)
+	require.NoError(t, err)
+	r := app.CheckTxSync(abci.RequestCheckTx{Tx: txBytes})
+	require.False(t, r.IsOK())
+	require.Empty(t, r.GetEvents())
+}
+
 // Test that successive DeliverTx can see each others' effects
 // on the store, both within and across blocks.
 func TestDeliverTx(t *testing.T) {
@@ -1015,6 +1056,101 @@ func TestDeliverTx(t *testing.T) {
 	}
 }
 
+func TestStoreProfiler(t *testing.T) {
+	anteKey := []byte("ante-key")
+	anteOpt := func(bapp *BaseApp) { bapp.SetAnteHandler(anteHandlerTxTest(t, capKey1, anteKey)) }
+
+	deliverKey := []byte("deliver-key")
+	routerOpt := func(bapp *BaseApp) {
+		r := sdk.NewRoute(routeMsgCounter, handlerMsgCounter(t, capKey1, deliverKey))
+		bapp.Router().AddRoute(r)
+	}
+
+	storeProfiler := NewStoreProfiler()
+	profilerOpt := func(bapp *BaseApp) { bapp.SetStoreProfiler(storeProfiler) }
+
+	app := setupBaseApp(t, anteOpt, routerOpt, profilerOpt)
+	app.InitChain(abci.RequestInitChain{})
+
+	codec := codec.NewLegacyAmino()
+	registerTestCodec(codec)
+
+	header := ocproto.Header{Height: 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	for i := int64(0); i < 2; i++ {
+		txBytes, err := codec.Marshal(newTxCounter(i, i))
+		require.NoError(t, err)
+
+		// txs are not profiled in CheckTx
+		r := app.CheckTxSync(abci.RequestCheckTx{Tx: txBytes})
+		require.True(t, r.IsOK(), fmt.Sprintf("%v", r))
+
+		res := app.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
+		require.True(t, res.IsOK(), fmt.Sprintf("%v", res))
+	}
+
+	msgs := storeProfiler.StoreProfile()
+	require.Len(t, msgs, 2)
+	require.Equal(t, ProfileAnteHandler, msgs[1].MsgTypeUrl)
+	require.Equal(t, sdk.MsgTypeURL(msgCounter{}), msgs[0].MsgTypeUrl)
+
+	for _, msg := range msgs {
+		require.Equal(t, uint64(2), msg.Count)
+		require.Len(t, msg.Stores, 1)
+		require.Equal(t, capKey1.Name(), msg.Stores[0].StoreKey)
+
+		// each execution reads and writes a counter
+		stats := msg.Stores[0].Stats
+		require.Equal(t, uint64(2), stats.Reads)
+		require.Equal(t, uint64(2), stats.Writes)
+		require.NotZero(t, stats.Gas)
+		require.GreaterOrEqual(t, msg.GasUsed, stats.Gas)
+	}
+
+	// the profile is served by the gRPC query service
+	res, err := profiler.NewQueryServer(storeProfiler).StoreProfile(context.Background(), &profiler.StoreProfileRequest{})
+	require.NoError(t, err)
+	require.Equal(t, msgs, res.Msgs)
+}
+
+func TestGasPriceHistory(t *testing.T) {
+	// no gas prices without the history
+	app := setupBaseApp(t, SetMinGasPrices("0.001stake"))
+	prices, blocks := app.RecentGasPrices()
+	require.Empty(t, prices)
+	require.Zero(t, blocks)
+	require.Equal(t, sdk.NewDecCoins(sdk.NewDecCoinFromDec("stake", sdk.NewDecWithPrec(1, 3))), app.MinGasPrices())
+
+	history := NewGasPriceHistory(2)
+	app = setupBaseApp(t, SetGasPriceHistory(history))
+	app.InitChain(abci.RequestInitChain{})
+
+	newFeeTx := func(gas uint64, fee sdk.Coins) sdk.Tx {
+		return legacytx.NewStdTx(nil, legacytx.NewStdFee(gas, fee), nil, "")
+	}
+	for height := int64(1); height <= 3; height++ {
+		app.BeginBlock(abci.RequestBeginBlock{Header: ocproto.Header{Height: height}})
+		history.record(newFeeTx(100000, sdk.NewCoins(sdk.NewInt64Coin("stake", 100*height))))
+		// the txs without gas or fee are ignored
+		history.record(newFeeTx(0, sdk.NewCoins(sdk.NewInt64Coin("stake", 100))))
+		history.record(newFeeTx(100000, nil))
+		if height == 3 {
+			history.record(newFeeTx(200000, sdk.NewCoins(sdk.NewInt64Coin("stake", 200), sdk.NewInt64Coin("atom", 20))))
+		}
+		app.EndBlock(abci.RequestEndBlock{Height: height})
+		app.Commit()
+	}
+
+	// the prices of the first block are out of the history
+	prices, blocks = app.RecentGasPrices()
+	require.Equal(t, uint64(2), blocks)
+	require.Equal(t, map[string][]sdk.Dec{
+		"stake": {sdk.NewDecWithPrec(2, 3), sdk.NewDecWithPrec(3, 3), sdk.NewDecWithPrec(1, 3)},
+		"atom":  {sdk.NewDecWithPrec(1, 4)},
+	}, prices)
+}
+
 // Number of messages doesn't matter to CheckTx.
 func TestMultiMsgCheckTx(t *testing.T) {
 	// TODO: ensure we get the same results
@@ -0,0 +1,90 @@
+package baseapp
+
+import (
+	"sync"
+
+	"github.com/line/lbm-sdk/client/grpc/feeestimate"
+	sdk "github.com/line/lbm-sdk/types"
+)
+
+var _ feeestimate.GasPriceHistory = (*BaseApp)(nil)
+
+// GasPriceHistory records the effective gas prices of the txs delivered in the
+// recent blocks by denom, for the fee estimation service.
+type GasPriceHistory struct {
+	mtx     sync.Mutex
+	blocks  int
+	history []map[string][]sdk.Dec
+	current map[string][]sdk.Dec
+}
+
+// NewGasPriceHistory returns a reference to a new GasPriceHistory keeping the
+// gas prices of the given number of recent blocks.
+func NewGasPriceHistory(blocks uint64) *GasPriceHistory {
+	return &GasPriceHistory{
+		blocks:  int(blocks),
+		current: make(map[string][]sdk.Dec),
+	}
+}
+
+// RecentGasPrices returns the gas prices of the recent blocks by denom, and
+// the number of blocks they were recorded over.
+func (h *GasPriceHistory) RecentGasPrices() (map[string][]sdk.Dec, uint64) {
+	prices := make(map[string][]sdk.Dec)
+	if h == nil {
+		return prices, 0
+	}
+
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
+
+	for _, block := range h.history {
+		for denom, blockPrices := range block {
+			prices[denom] = append(prices[denom], blockPrices...)
+		}
+	}
+	return prices, uint64(len(h.history))
+}
+
+// record adds the gas prices of a delivered tx to the current block. It is
+// no-op if the history is nil or the tx has no fee.
+func (h *GasPriceHistory) record(tx sdk.Tx) {
+	if h == nil {
+		return
+	}
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return
+	}
+
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
+	feeestimate.AddGasPrices(h.current, feeTx)
+}
+
+// commit closes the current block, dropping the blocks beyond the history.
+func (h *GasPriceHistory) commit() {
+	if h == nil {
+		return
+	}
+
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
+
+	h.history = append(h.history, h.current)
+	if len(h.history) > h.blocks {
+		h.history = h.history[len(h.history)-h.blocks:]
+	}
+	h.current = make(map[string][]sdk.Dec)
+}
+
+// RecentGasPrices implements feeestimate.GasPriceHistory. It returns no gas
+// prices if the gas price history is not set.
+func (app *BaseApp) RecentGasPrices() (map[string][]sdk.Dec, uint64) {
+	return app.gasPriceHistory.RecentGasPrices()
+}
+
+// MinGasPrices implements feeestimate.GasPriceHistory.
+func (app *BaseApp) MinGasPrices() sdk.DecCoins {
+	return app.minGasPrices
+}
@@ -6,11 +6,13 @@ import (
 
 	dbm "github.com/tendermint/tm-db"
 
+	"github.com/line/lbm-sdk/client/grpc/profiler"
 	"github.com/line/lbm-sdk/store/cache"
 
 	"github.com/line/lbm-sdk/codec/types"
 	"github.com/line/lbm-sdk/snapshots"
 	"github.com/line/lbm-sdk/store"
+	"github.com/line/lbm-sdk/store/rootmulti"
 	sdk "github.com/line/lbm-sdk/types"
 )
 
@@ -64,12 +66,36 @@ func SetIAVLCacheSize(size int) func(*BaseApp) {
 	return func(bapp *BaseApp) { bapp.cms.SetIAVLCacheSize(size) }
 }
 
+// SetMaxPendingTxsPerSigner provides a BaseApp option function that limits the
+// number of txs of a single signer which can be checked at the same time.
+func SetMaxPendingTxsPerSigner(maxPending uint64) func(*BaseApp) {
+	return func(app *BaseApp) { app.checkAccountWGs.SetMaxPending(int(maxPending)) }
+}
+
 // SetInterBlockCache provides a BaseApp option function that sets the
 // inter-block cache.
 func SetInterBlockCache(cache sdk.MultiStorePersistentCache) func(*BaseApp) {
 	return func(app *BaseApp) { app.setInterBlockCache(cache) }
 }
 
+// SetArchiveStore provides a BaseApp option function that sets the archive
+// used to serve queries at heights removed by pruning.
+func SetArchiveStore(archive sdk.ArchiveStore) func(*BaseApp) {
+	return func(app *BaseApp) { app.SetArchiveStore(archive) }
+}
+
+// SetStoreProfiler provides a BaseApp option function that sets the store
+// profiler.
+func SetStoreProfiler(profiler *StoreProfiler) func(*BaseApp) {
+	return func(app *BaseApp) { app.SetStoreProfiler(profiler) }
+}
+
+// SetGasPriceHistory provides a BaseApp option function that sets the gas
+// price history.
+func SetGasPriceHistory(history *GasPriceHistory) func(*BaseApp) {
+	return func(app *BaseApp) { app.SetGasPriceHistory(history) }
+}
+
 // SetSnapshotInterval sets the snapshot interval.
 func SetSnapshotInterval(interval uint64) func(*BaseApp) {
 	return func(app *BaseApp) { app.SetSnapshotInterval(interval) }
@@ -163,6 +189,15 @@ func (app *BaseApp) SetAnteHandler(ah sdk.AnteHandler) {
 	app.anteHandler = ah
 }
 
+// SetTxPriority sets the function computing the priority of txs in CheckTx.
+func (app *BaseApp) SetTxPriority(txPriority TxPriority) {
+	if app.sealed {
+		panic("SetTxPriority() on sealed BaseApp")
+	}
+
+	app.txPriority = txPriority
+}
+
 func (app *BaseApp) SetAddrPeerFilter(pf sdk.PeerFilter) {
 	if app.sealed {
 		panic("SetAddrPeerFilter() on sealed BaseApp")
@@ -238,6 +273,40 @@ func (app *BaseApp) SetSnapshotKeepRecent(snapshotKeepRecent uint32) {
 	app.snapshotKeepRecent = snapshotKeepRecent
 }
 
+// SetArchiveStore sets the archive store of the state, which is closed with
+// the BaseApp.
+func (app *BaseApp) SetArchiveStore(archive sdk.ArchiveStore) {
+	if app.sealed {
+		panic("SetArchiveStore() on sealed BaseApp")
+	}
+	if rms, ok := app.cms.(*rootmulti.Store); ok {
+		rms.SetLogger(app.logger.With("module", "archive"))
+	}
+	app.cms.SetArchive(archive)
+	app.archive = archive
+}
+
+// SetStoreProfiler sets the store profiler, which records the store
+// operations of the delivered txs, and registers its gRPC query service.
+func (app *BaseApp) SetStoreProfiler(storeProfiler *StoreProfiler) {
+	if app.sealed {
+		panic("SetStoreProfiler() on sealed BaseApp")
+	}
+	if app.storeProfiler == nil {
+		profiler.RegisterProfilerService(app.grpcQueryRouter, storeProfiler)
+	}
+	app.storeProfiler = storeProfiler
+}
+
+// SetGasPriceHistory sets the gas price history, which records the gas prices
+// of the txs delivered in the recent blocks for the fee estimation service.
+func (app *BaseApp) SetGasPriceHistory(history *GasPriceHistory) {
+	if app.sealed {
+		panic("SetGasPriceHistory() on sealed BaseApp")
+	}
+	app.gasPriceHistory = history
+}
+
 // SetInterfaceRegistry sets the InterfaceRegistry.
 func (app *BaseApp) SetInterfaceRegistry(registry types.InterfaceRegistry) {
 	app.interfaceRegistry = registry
@@ -0,0 +1,202 @@
+package baseapp
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/armon/go-metrics"
+
+	"github.com/line/lbm-sdk/client/grpc/profiler"
+	"github.com/line/lbm-sdk/store/profilekv"
+	storetypes "github.com/line/lbm-sdk/store/types"
+	"github.com/line/lbm-sdk/telemetry"
+	sdk "github.com/line/lbm-sdk/types"
+)
+
+// ProfileAnteHandler is the label of the store operations made by the ante
+// handler in the store profile.
+const ProfileAnteHandler = "ante"
+
+var _ profiler.Profiler = (*StoreProfiler)(nil)
+
+// StoreProfiler aggregates the store operations made while delivering txs by
+// the type URL of the executing Msg and the store key. The operations are also
+// reported to telemetry after each Msg.
+type StoreProfiler struct {
+	mtx  sync.Mutex
+	msgs map[string]*msgProfile
+}
+
+type msgProfile struct {
+	count   uint64
+	gasUsed uint64
+	stores  map[string]*profilekv.Stats
+}
+
+// NewStoreProfiler returns a reference to a new StoreProfiler.
+func NewStoreProfiler() *StoreProfiler {
+	return &StoreProfiler{
+		msgs: make(map[string]*msgProfile),
+	}
+}
+
+// StoreProfile implements profiler.Profiler.
+func (p *StoreProfiler) StoreProfile() []profiler.MsgProfile {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	msgs := make([]profiler.MsgProfile, 0, len(p.msgs))
+	for typeURL, mp := range p.msgs {
+		stores := make([]profiler.StoreProfile, 0, len(mp.stores))
+		for key, stats := range mp.stores {
+			stores = append(stores, profiler.StoreProfile{
+				StoreKey: key,
+				Stats: profiler.KVStats{
+					Reads:      stats.Reads,
+					ReadBytes:  stats.ReadBytes,
+					Writes:     stats.Writes,
+					WriteBytes: stats.WriteBytes,
+					Deletes:    stats.Deletes,
+					IterSteps:  stats.IterSteps,
+					IterBytes:  stats.IterBytes,
+					Gas:        stats.Gas,
+				},
+			})
+		}
+		sort.Slice(stores, func(i, j int) bool { return stores[i].StoreKey < stores[j].StoreKey })
+
+		msgs = append(msgs, profiler.MsgProfile{
+			MsgTypeUrl: typeURL,
+			Count:      mp.count,
+			GasUsed:    mp.gasUsed,
+			Stores:     stores,
+		})
+	}
+	sort.Slice(msgs, func(i, j int) bool { return msgs[i].MsgTypeUrl < msgs[j].MsgTypeUrl })
+
+	return msgs
+}
+
+// start returns a recorder of the store operations of a Msg execution. It
+// returns nil if the profiler is nil, and the methods of a nil recorder are
+// no-op.
+func (p *StoreProfiler) start(label string) *profileRecorder {
+	if p == nil {
+		return nil
+	}
+
+	return &profileRecorder{
+		profiler: p,
+		label:    label,
+		stores:   make(map[string]*profilekv.Stats),
+	}
+}
+
+func (p *StoreProfiler) add(label string, gasUsed uint64, stores map[string]*profilekv.Stats) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	mp, ok := p.msgs[label]
+	if !ok {
+		mp = &msgProfile{stores: make(map[string]*profilekv.Stats)}
+		p.msgs[label] = mp
+	}
+	mp.count++
+	mp.gasUsed += gasUsed
+
+	for key, stats := range stores {
+		total, ok := mp.stores[key]
+		if !ok {
+			total = &profilekv.Stats{}
+			mp.stores[key] = total
+		}
+		total.Add(*stats)
+	}
+}
+
+type profileRecorder struct {
+	profiler *StoreProfiler
+	label    string
+	stores   map[string]*profilekv.Stats
+}
+
+// wrap returns the context whose multistore records the store operations.
+func (r *profileRecorder) wrap(ctx sdk.Context) sdk.Context {
+	if r == nil {
+		return ctx
+	}
+
+	return ctx.WithMultiStore(profileMultiStore{MultiStore: ctx.MultiStore(), recorder: r})
+}
+
+// done adds the recorded store operations to the profiler and telemetry.
+func (r *profileRecorder) done(gasUsed uint64) {
+	if r == nil {
+		return
+	}
+
+	r.profiler.add(r.label, gasUsed, r.stores)
+
+	msgLabel := telemetry.NewLabel("msg", r.label)
+	telemetry.IncrCounterWithLabels([]string{"store", "profile", "gas_used"}, float32(gasUsed), []metrics.Label{msgLabel})
+	for key, stats := range r.stores {
+		labels := []metrics.Label{msgLabel, telemetry.NewLabel("store", key)}
+		telemetry.IncrCounterWithLabels([]string{"store", "profile", "reads"}, float32(stats.Reads), labels)
+		telemetry.IncrCounterWithLabels([]string{"store", "profile", "writes"}, float32(stats.Writes), labels)
+		telemetry.IncrCounterWithLabels([]string{"store", "profile", "deletes"}, float32(stats.Deletes), labels)
+		telemetry.IncrCounterWithLabels([]string{"store", "profile", "iter_steps"}, float32(stats.IterSteps), labels)
+		telemetry.IncrCounterWithLabels([]string{"store", "profile", "gas"}, float32(stats.Gas), labels)
+	}
+}
+
+func (r *profileRecorder) kvStore(key sdk.StoreKey, parent sdk.KVStore) sdk.KVStore {
+	stats, ok := r.stores[key.Name()]
+	if !ok {
+		stats = &profilekv.Stats{}
+		r.stores[key.Name()] = stats
+	}
+
+	return profilekv.NewStore(parent, stats, storetypes.KVGasConfig())
+}
+
+// profileMultiStore records the operations made to its KVStores and to the
+// KVStores of its branches.
+type profileMultiStore struct {
+	sdk.MultiStore
+	recorder *profileRecorder
+}
+
+// GetKVStore implements MultiStore.
+func (ms profileMultiStore) GetKVStore(key sdk.StoreKey) sdk.KVStore {
+	return ms.recorder.kvStore(key, ms.MultiStore.GetKVStore(key))
+}
+
+// CacheMultiStore implements MultiStore.
+func (ms profileMultiStore) CacheMultiStore() sdk.CacheMultiStore {
+	return profileCacheMultiStore{branch: ms.MultiStore.CacheMultiStore(), recorder: ms.recorder}
+}
+
+// branch is embedded by profileCacheMultiStore under a name which does not
+// collide with the CacheMultiStore method.
+type branch = sdk.CacheMultiStore
+
+type profileCacheMultiStore struct {
+	branch
+	recorder *profileRecorder
+}
+
+// GetKVStore implements MultiStore.
+func (ms profileCacheMultiStore) GetKVStore(key sdk.StoreKey) sdk.KVStore {
+	return ms.recorder.kvStore(key, ms.branch.GetKVStore(key))
+}
+
+// CacheMultiStore implements MultiStore.
+func (ms profileCacheMultiStore) CacheMultiStore() sdk.CacheMultiStore {
+	return profileCacheMultiStore{branch: ms.branch.CacheMultiStore(), recorder: ms.recorder}
+}
+
+// SetTracingContext implements MultiStore.
+func (ms profileCacheMultiStore) SetTracingContext(tc sdk.TraceContext) sdk.MultiStore {
+	ms.branch = ms.branch.SetTracingContext(tc).(sdk.CacheMultiStore)
+	return ms
+}
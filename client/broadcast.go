@@ -32,8 +32,14 @@ func (ctx Context) BroadcastTx(txBytes []byte) (res *sdk.TxResponse, err error)
 	case flags.BroadcastBlock:
 		res, err = ctx.BroadcastTxCommit(txBytes)
 
+	case flags.BroadcastWait:
+		var result *TxResult
+		if result, err = ctx.BroadcastTxWait(txBytes); result != nil {
+			res = result.TxResponse
+		}
+
 	default:
-		return nil, fmt.Errorf("unsupported return type %s; supported types: sync, async, block", ctx.BroadcastMode)
+		return nil, fmt.Errorf("unsupported return type %s; supported types: sync, async, block, wait", ctx.BroadcastMode)
 	}
 
 	return res, err
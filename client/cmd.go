@@ -190,6 +190,14 @@ func readTxCommandFlags(clientCtx Context, flagSet *pflag.FlagSet) (Context, err
 		return clientCtx, err
 	}
 
+	// the fees are derived from the estimated gas prices, they cannot be given as well
+	if gasPrices, _ := flagSet.GetString(flags.FlagGasPrices); gasPrices == flags.GasFlagAuto {
+		if fees, _ := flagSet.GetString(flags.FlagFees); fees != "" {
+			return clientCtx, errors.Errorf("cannot provide both --%s and --%s=%s, the fees are derived from the estimated gas prices",
+				flags.FlagFees, flags.FlagGasPrices, flags.GasFlagAuto)
+		}
+	}
+
 	if !clientCtx.GenerateOnly || flagSet.Changed(flags.FlagGenerateOnly) {
 		genOnly, _ := flagSet.GetBool(flags.FlagGenerateOnly)
 		clientCtx = clientCtx.WithGenerateOnly(genOnly)
@@ -210,6 +218,11 @@ func readTxCommandFlags(clientCtx Context, flagSet *pflag.FlagSet) (Context, err
 		clientCtx = clientCtx.WithBroadcastMode(bMode)
 	}
 
+	if clientCtx.WaitTimeout == 0 || flagSet.Changed(flags.FlagWaitTimeout) {
+		waitTimeout, _ := flagSet.GetDuration(flags.FlagWaitTimeout)
+		clientCtx = clientCtx.WithWaitTimeout(waitTimeout)
+	}
+
 	if !clientCtx.SkipConfirm || flagSet.Changed(flags.FlagSkipConfirmation) {
 		skipConfirm, _ := flagSet.GetBool(flags.FlagSkipConfirmation)
 		clientCtx = clientCtx.WithSkipConfirmation(skipConfirm)
@@ -244,8 +257,10 @@ func readTxCommandFlags(clientCtx Context, flagSet *pflag.FlagSet) (Context, err
 
 		// If the `from` signer account is a ledger key, we need to use
 		// SIGN_MODE_AMINO_JSON, because ledger doesn't support proto yet.
+		// SIGN_MODE_TEXTUAL is kept when chosen explicitly, as it renders no proto.
 		// ref: https://github.com/cosmos/cosmos-sdk/issues/8109
-		if keyType == keyring.TypeLedger && clientCtx.SignModeStr != flags.SignModeLegacyAminoJSON {
+		if keyType == keyring.TypeLedger && clientCtx.SignModeStr != flags.SignModeLegacyAminoJSON &&
+			clientCtx.SignModeStr != flags.SignModeTextual {
 			fmt.Println("Default sign-mode 'direct' not supported by Ledger, using sign-mode 'amino-json'.")
 			clientCtx = clientCtx.WithSignModeStr(flags.SignModeLegacyAminoJSON)
 		}
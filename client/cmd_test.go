@@ -110,3 +110,45 @@ func TestSetCmdClientContextHandler(t *testing.T) {
 		})
 	}
 }
+
+func TestGetClientTxContextAutoGasPricesWithFees(t *testing.T) {
+	testCases := []struct {
+		name      string
+		args      []string
+		expectErr bool
+	}{
+		{"auto gas prices", []string{fmt.Sprintf("--%s=%s", flags.FlagGasPrices, flags.GasFlagAuto)}, false},
+		{"fees", []string{fmt.Sprintf("--%s=10stake", flags.FlagFees)}, false},
+		{
+			"auto gas prices and fees",
+			[]string{fmt.Sprintf("--%s=%s", flags.FlagGasPrices, flags.GasFlagAuto), fmt.Sprintf("--%s=10stake", flags.FlagFees)},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			cmd := &cobra.Command{
+				RunE: func(cmd *cobra.Command, _ []string) error {
+					_, err := client.GetClientTxContext(cmd)
+					return err
+				},
+			}
+			cmd.Flags().String(flags.FlagFees, "", "fees")
+			cmd.Flags().String(flags.FlagGasPrices, "", "gas prices")
+			_ = testutil.ApplyMockIODiscardOutErr(cmd)
+			cmd.SetArgs(tc.args)
+
+			ctx := context.WithValue(context.Background(), client.ClientContextKey, &client.Context{})
+			err := cmd.ExecuteContext(ctx)
+			if tc.expectErr {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "cannot provide both")
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"io"
 	"os"
+	"time"
 
 	"github.com/spf13/viper"
 
@@ -40,6 +41,7 @@ type Context struct {
 	KeyringDir        string
 	From              string
 	BroadcastMode     string
+	WaitTimeout       time.Duration
 	FromName          string
 	SignModeStr       string
 	UseLedger         bool
@@ -211,6 +213,13 @@ func (ctx Context) WithBroadcastMode(mode string) Context {
 	return ctx
 }
 
+// WithWaitTimeout returns a copy of the context with an updated timeout of
+// waiting for a tx to be included in a block.
+func (ctx Context) WithWaitTimeout(timeout time.Duration) Context {
+	ctx.WaitTimeout = timeout
+	return ctx
+}
+
 // WithSignModeStr returns a copy of the context with an updated SignMode
 // value.
 func (ctx Context) WithSignModeStr(signModeStr string) Context {
@@ -0,0 +1,175 @@
+package feeestimate
+
+import (
+	"context"
+	"sort"
+
+	gogogrpc "github.com/gogo/protobuf/grpc"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/line/lbm-sdk/client"
+	sdk "github.com/line/lbm-sdk/types"
+)
+
+// maxMempoolTxs is the number of mempool txs the suggestions are based on at most.
+const maxMempoolTxs = 1000
+
+// GasPriceHistory provides the gas prices of the txs delivered in the recent
+// blocks, and the minimum gas prices of the node.
+type GasPriceHistory interface {
+	// RecentGasPrices returns the effective gas prices of the txs delivered in
+	// the recent blocks by denom, and the number of blocks they were recorded over.
+	RecentGasPrices() (map[string][]sdk.Dec, uint64)
+	// MinGasPrices returns the minimum gas prices of the node.
+	MinGasPrices() sdk.DecCoins
+}
+
+type queryServer struct {
+	clientCtx client.Context
+	history   GasPriceHistory
+}
+
+var _ ServiceServer = queryServer{}
+
+// NewQueryServer creates a new fee estimation query server.
+func NewQueryServer(clientCtx client.Context, history GasPriceHistory) ServiceServer {
+	return queryServer{
+		clientCtx: clientCtx,
+		history:   history,
+	}
+}
+
+// EstimateFee implements ServiceServer.EstimateFee
+func (s queryServer) EstimateFee(ctx context.Context, req *EstimateFeeRequest) (*EstimateFeeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.Denom != "" {
+		if err := sdk.ValidateDenom(req.Denom); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	blockPrices, blocks := s.history.RecentGasPrices()
+	mempoolPrices, err := s.mempoolGasPrices(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
+
+	return &EstimateFeeResponse{
+		Suggestions: Suggest(blockPrices, mempoolPrices, s.history.MinGasPrices(), req.Denom),
+		Blocks:      blocks,
+	}, nil
+}
+
+// mempoolGasPrices returns the effective gas prices of the txs in the mempool by denom.
+func (s queryServer) mempoolGasPrices(ctx context.Context) (map[string][]sdk.Dec, error) {
+	prices := make(map[string][]sdk.Dec)
+	if s.clientCtx.Client == nil {
+		return prices, nil
+	}
+
+	limit := maxMempoolTxs
+	res, err := s.clientCtx.Client.UnconfirmedTxs(ctx, &limit)
+	if err != nil {
+		return nil, err
+	}
+	for _, txBytes := range res.Txs {
+		tx, err := s.clientCtx.TxConfig.TxDecoder()(txBytes)
+		if err != nil {
+			continue
+		}
+		if feeTx, ok := tx.(sdk.FeeTx); ok {
+			AddGasPrices(prices, feeTx)
+		}
+	}
+	return prices, nil
+}
+
+// AddGasPrices adds the effective gas prices of the tx, its fee divided by
+// its gas limit, to the prices by denom.
+func AddGasPrices(prices map[string][]sdk.Dec, tx sdk.FeeTx) {
+	gas := tx.GetGas()
+	if gas == 0 {
+		return
+	}
+	gasDec := sdk.NewDecFromInt(sdk.NewIntFromUint64(gas))
+	for _, fee := range tx.GetFee() {
+		prices[fee.Denom] = append(prices[fee.Denom], fee.Amount.ToDec().Quo(gasDec))
+	}
+}
+
+// Suggest returns the gas price suggestions by denom from the gas prices of
+// the recent blocks and of the mempool, raised to the minimum gas prices. The
+// denoms of the minimum gas prices are suggested even without txs. The
+// suggestions are sorted by the number of txs, then by denom. If denom is not
+// empty, only the suggestion in this denom is returned.
+func Suggest(blockPrices, mempoolPrices map[string][]sdk.Dec, minGasPrices sdk.DecCoins, denom string) []GasPriceSuggestion {
+	denoms := make(map[string]bool)
+	for d := range blockPrices {
+		denoms[d] = true
+	}
+	for d := range mempoolPrices {
+		denoms[d] = true
+	}
+	for _, gp := range minGasPrices {
+		denoms[gp.Denom] = true
+	}
+
+	suggestions := []GasPriceSuggestion{}
+	for d := range denoms {
+		if denom != "" && d != denom {
+			continue
+		}
+
+		prices := make([]sdk.Dec, 0, len(blockPrices[d])+len(mempoolPrices[d]))
+		prices = append(prices, blockPrices[d]...)
+		prices = append(prices, mempoolPrices[d]...)
+		sort.Slice(prices, func(i, j int) bool { return prices[i].LT(prices[j]) })
+
+		min := minGasPrices.AmountOf(d)
+		suggestions = append(suggestions, GasPriceSuggestion{
+			Denom:      d,
+			Low:        sdk.MaxDec(percentile(prices, 25), min),
+			Median:     sdk.MaxDec(percentile(prices, 50), min),
+			High:       sdk.MaxDec(percentile(prices, 90), min),
+			BlockTxs:   uint64(len(blockPrices[d])),
+			MempoolTxs: uint64(len(mempoolPrices[d])),
+		})
+	}
+
+	sort.Slice(suggestions, func(i, j int) bool {
+		ti := suggestions[i].BlockTxs + suggestions[i].MempoolTxs
+		tj := suggestions[j].BlockTxs + suggestions[j].MempoolTxs
+		if ti != tj {
+			return ti > tj
+		}
+		return suggestions[i].Denom < suggestions[j].Denom
+	})
+	return suggestions
+}
+
+// percentile returns the nearest-rank percentile of the sorted prices, zero if there are none.
+func percentile(sorted []sdk.Dec, p int) sdk.Dec {
+	if len(sorted) == 0 {
+		return sdk.ZeroDec()
+	}
+	rank := (p*len(sorted) + 99) / 100
+	if rank < 1 {
+		rank = 1
+	}
+	return sorted[rank-1]
+}
+
+// RegisterFeeEstimateService registers the fee estimation service on the gRPC router.
+func RegisterFeeEstimateService(qrt gogogrpc.Server, clientCtx client.Context, history GasPriceHistory) {
+	RegisterServiceServer(qrt, NewQueryServer(clientCtx, history))
+}
+
+// RegisterGRPCGatewayRoutes mounts the fee estimation service's GRPC-gateway routes on the
+// given Mux.
+func RegisterGRPCGatewayRoutes(clientConn gogogrpc.ClientConn, mux *runtime.ServeMux) {
+	RegisterServiceHandlerClient(context.Background(), mux, NewServiceClient(clientConn))
+}
@@ -0,0 +1,161 @@
+package feeestimate_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/line/lbm-sdk/client/flags"
+	"github.com/line/lbm-sdk/client/grpc/feeestimate"
+	"github.com/line/lbm-sdk/testutil/network"
+	sdk "github.com/line/lbm-sdk/types"
+	"github.com/line/lbm-sdk/types/rest"
+	banktestutil "github.com/line/lbm-sdk/x/bank/client/testutil"
+)
+
+type IntegrationTestSuite struct {
+	suite.Suite
+
+	cfg     network.Config
+	network *network.Network
+
+	queryClient feeestimate.ServiceClient
+}
+
+func (s *IntegrationTestSuite) SetupSuite() {
+	s.T().Log("setting up integration test suite")
+
+	cfg := network.DefaultConfig()
+	cfg.NumValidators = 1
+
+	s.cfg = cfg
+	s.network = network.New(s.T(), cfg)
+
+	s.Require().NotNil(s.network)
+
+	_, err := s.network.WaitForHeight(1)
+	s.Require().NoError(err)
+
+	s.queryClient = feeestimate.NewServiceClient(s.network.Validators[0].ClientCtx)
+}
+
+func (s *IntegrationTestSuite) TearDownSuite() {
+	s.T().Log("tearing down integration test suite")
+	s.network.Cleanup()
+}
+
+func (s *IntegrationTestSuite) TestEstimateFee() {
+	val := s.network.Validators[0]
+
+	// without txs, the minimum gas prices are suggested
+	res, err := s.queryClient.EstimateFee(context.Background(), &feeestimate.EstimateFeeRequest{})
+	s.Require().NoError(err)
+	minGasPrice := sdk.NewDecWithPrec(6, 6)
+	s.Require().Len(res.Suggestions, 1)
+	s.Require().Equal(s.cfg.BondDenom, res.Suggestions[0].Denom)
+	s.Require().True(minGasPrice.Equal(res.Suggestions[0].Median))
+
+	// 10stake for the default gas limit of 200000
+	sendArgs := []string{
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 10))),
+	}
+	amount := sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 1))
+	_, err = banktestutil.MsgSendExec(val.ClientCtx, val.Address, val.Address, amount, sendArgs...)
+	s.Require().NoError(err)
+	s.Require().NoError(s.network.WaitForNextBlock())
+
+	gasPrice := sdk.NewDecWithPrec(5, 5)
+	res, err = s.queryClient.EstimateFee(context.Background(), &feeestimate.EstimateFeeRequest{Denom: s.cfg.BondDenom})
+	s.Require().NoError(err)
+	s.Require().Len(res.Suggestions, 1)
+	s.Require().Equal(uint64(1), res.Suggestions[0].BlockTxs)
+	s.Require().True(gasPrice.Equal(res.Suggestions[0].Median), res.Suggestions[0].Median)
+	s.Require().NotZero(res.Blocks)
+
+	restRes, err := rest.GetRequest(fmt.Sprintf("%s/lbm/base/feeestimate/v1/estimate_fee", val.APIAddress))
+	s.Require().NoError(err)
+	var restEstimate feeestimate.EstimateFeeResponse
+	s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(restRes, &restEstimate))
+	s.Require().Len(restEstimate.Suggestions, 1)
+	s.Require().True(gasPrice.Equal(restEstimate.Suggestions[0].Median))
+
+	// the fee of a tx with --gas-prices=auto is derived from the median gas price
+	autoArgs := []string{
+		fmt.Sprintf("--%s=true", flags.FlagGenerateOnly),
+		fmt.Sprintf("--%s=%s", flags.FlagGasPrices, flags.GasFlagAuto),
+	}
+	bz, err := banktestutil.MsgSendExec(val.ClientCtx, val.Address, val.Address, amount, autoArgs...)
+	s.Require().NoError(err)
+	tx, err := s.cfg.TxConfig.TxJSONDecoder()(bz.Bytes())
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 10)), tx.(sdk.FeeTx).GetFee())
+
+	_, err = s.queryClient.EstimateFee(context.Background(), &feeestimate.EstimateFeeRequest{Denom: "!"})
+	s.Require().Error(err)
+}
+
+func TestIntegrationTestSuite(t *testing.T) {
+	suite.Run(t, new(IntegrationTestSuite))
+}
+
+func decs(amounts ...int64) []sdk.Dec {
+	res := make([]sdk.Dec, len(amounts))
+	for i, amount := range amounts {
+		res[i] = sdk.NewDecWithPrec(amount, 3)
+	}
+	return res
+}
+
+func TestSuggest(t *testing.T) {
+	blockPrices := map[string][]sdk.Dec{
+		"stake": decs(10, 1, 9, 2, 8, 3, 7, 4, 6),
+		"atom":  decs(5),
+	}
+	mempoolPrices := map[string][]sdk.Dec{
+		"stake": decs(5),
+		"atom":  decs(50, 60),
+	}
+	minGasPrices := sdk.NewDecCoins(
+		sdk.NewDecCoinFromDec("atom", sdk.NewDecWithPrec(55, 3)),
+		sdk.NewDecCoinFromDec("photon", sdk.NewDecWithPrec(1, 1)),
+	)
+
+	suggestions := feeestimate.Suggest(blockPrices, mempoolPrices, minGasPrices, "")
+	require.Equal(t, []feeestimate.GasPriceSuggestion{
+		{
+			Denom:      "stake",
+			Low:        sdk.NewDecWithPrec(3, 3),
+			Median:     sdk.NewDecWithPrec(5, 3),
+			High:       sdk.NewDecWithPrec(9, 3),
+			BlockTxs:   9,
+			MempoolTxs: 1,
+		},
+		// raised to the minimum gas price
+		{
+			Denom:      "atom",
+			Low:        sdk.NewDecWithPrec(55, 3),
+			Median:     sdk.NewDecWithPrec(55, 3),
+			High:       sdk.NewDecWithPrec(60, 3),
+			BlockTxs:   1,
+			MempoolTxs: 2,
+		},
+		// suggested without txs
+		{
+			Denom:  "photon",
+			Low:    sdk.NewDecWithPrec(1, 1),
+			Median: sdk.NewDecWithPrec(1, 1),
+			High:   sdk.NewDecWithPrec(1, 1),
+		},
+	}, suggestions)
+
+	suggestions = feeestimate.Suggest(blockPrices, mempoolPrices, minGasPrices, "atom")
+	require.Len(t, suggestions, 1)
+	require.Equal(t, "atom", suggestions[0].Denom)
+
+	require.Empty(t, feeestimate.Suggest(nil, nil, nil, ""))
+}
@@ -0,0 +1,1505 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: lbm/base/profiler/v1/query.proto
+
+package profiler
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// StoreProfileRequest is the request type for the Query/StoreProfile RPC method.
+type StoreProfileRequest struct {
+}
+
+func (m *StoreProfileRequest) Reset()         { *m = StoreProfileRequest{} }
+func (m *StoreProfileRequest) String() string { return proto.CompactTextString(m) }
+func (*StoreProfileRequest) ProtoMessage()    {}
+func (*StoreProfileRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ec9994f465878b8e, []int{0}
+}
+func (m *StoreProfileRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StoreProfileRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StoreProfileRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StoreProfileRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StoreProfileRequest.Merge(m, src)
+}
+func (m *StoreProfileRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *StoreProfileRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StoreProfileRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StoreProfileRequest proto.InternalMessageInfo
+
+// StoreProfileResponse is the response type for the Query/StoreProfile RPC method.
+type StoreProfileResponse struct {
+	// msgs is the profile of each Msg type, sorted by the type URL.
+	Msgs []MsgProfile `protobuf:"bytes,1,rep,name=msgs,proto3" json:"msgs"`
+}
+
+func (m *StoreProfileResponse) Reset()         { *m = StoreProfileResponse{} }
+func (m *StoreProfileResponse) String() string { return proto.CompactTextString(m) }
+func (*StoreProfileResponse) ProtoMessage()    {}
+func (*StoreProfileResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ec9994f465878b8e, []int{1}
+}
+func (m *StoreProfileResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StoreProfileResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StoreProfileResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StoreProfileResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StoreProfileResponse.Merge(m, src)
+}
+func (m *StoreProfileResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *StoreProfileResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_StoreProfileResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StoreProfileResponse proto.InternalMessageInfo
+
+func (m *StoreProfileResponse) GetMsgs() []MsgProfile {
+	if m != nil {
+		return m.Msgs
+	}
+	return nil
+}
+
+// MsgProfile is the profile of the store operations made by a Msg type.
+type MsgProfile struct {
+	// msg_type_url is the type URL of the Msg, or "ante" for the ante handler.
+	MsgTypeUrl string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	// count is the number of executions.
+	Count uint64 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	// gas_used is the total gas used by the executions.
+	GasUsed uint64 `protobuf:"varint,3,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	// stores is the profile of each store accessed, sorted by the store key.
+	Stores []StoreProfile `protobuf:"bytes,4,rep,name=stores,proto3" json:"stores"`
+}
+
+func (m *MsgProfile) Reset()         { *m = MsgProfile{} }
+func (m *MsgProfile) String() string { return proto.CompactTextString(m) }
+func (*MsgProfile) ProtoMessage()    {}
+func (*MsgProfile) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ec9994f465878b8e, []int{2}
+}
+func (m *MsgProfile) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgProfile) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgProfile.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgProfile) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgProfile.Merge(m, src)
+}
+func (m *MsgProfile) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgProfile) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgProfile.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgProfile proto.InternalMessageInfo
+
+func (m *MsgProfile) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+func (m *MsgProfile) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *MsgProfile) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+func (m *MsgProfile) GetStores() []StoreProfile {
+	if m != nil {
+		return m.Stores
+	}
+	return nil
+}
+
+// StoreProfile is the profile of the operations made to a store.
+type StoreProfile struct {
+	// store_key is the name of the store key.
+	StoreKey string `protobuf:"bytes,1,opt,name=store_key,json=storeKey,proto3" json:"store_key,omitempty"`
+	// stats is the aggregated operations.
+	Stats KVStats `protobuf:"bytes,2,opt,name=stats,proto3" json:"stats"`
+}
+
+func (m *StoreProfile) Reset()         { *m = StoreProfile{} }
+func (m *StoreProfile) String() string { return proto.CompactTextString(m) }
+func (*StoreProfile) ProtoMessage()    {}
+func (*StoreProfile) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ec9994f465878b8e, []int{3}
+}
+func (m *StoreProfile) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StoreProfile) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StoreProfile.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StoreProfile) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StoreProfile.Merge(m, src)
+}
+func (m *StoreProfile) XXX_Size() int {
+	return m.Size()
+}
+func (m *StoreProfile) XXX_DiscardUnknown() {
+	xxx_messageInfo_StoreProfile.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StoreProfile proto.InternalMessageInfo
+
+func (m *StoreProfile) GetStoreKey() string {
+	if m != nil {
+		return m.StoreKey
+	}
+	return ""
+}
+
+func (m *StoreProfile) GetStats() KVStats {
+	if m != nil {
+		return m.Stats
+	}
+	return KVStats{}
+}
+
+// KVStats holds the numbers of the operations made to a KVStore.
+type KVStats struct {
+	// reads is the number of Get and Has calls.
+	Reads uint64 `protobuf:"varint,1,opt,name=reads,proto3" json:"reads,omitempty"`
+	// read_bytes is the number of bytes of the keys and values read.
+	ReadBytes uint64 `protobuf:"varint,2,opt,name=read_bytes,json=readBytes,proto3" json:"read_bytes,omitempty"`
+	// writes is the number of Set calls.
+	Writes uint64 `protobuf:"varint,3,opt,name=writes,proto3" json:"writes,omitempty"`
+	// write_bytes is the number of bytes of the keys and values written.
+	WriteBytes uint64 `protobuf:"varint,4,opt,name=write_bytes,json=writeBytes,proto3" json:"write_bytes,omitempty"`
+	// deletes is the number of Delete calls.
+	Deletes uint64 `protobuf:"varint,5,opt,name=deletes,proto3" json:"deletes,omitempty"`
+	// iter_steps is the number of entries visited by iterators.
+	IterSteps uint64 `protobuf:"varint,6,opt,name=iter_steps,json=iterSteps,proto3" json:"iter_steps,omitempty"`
+	// iter_bytes is the number of bytes of the keys and values visited by iterators.
+	IterBytes uint64 `protobuf:"varint,7,opt,name=iter_bytes,json=iterBytes,proto3" json:"iter_bytes,omitempty"`
+	// gas is the gas charged for the operations by the KVStore gas config.
+	Gas uint64 `protobuf:"varint,8,opt,name=gas,proto3" json:"gas,omitempty"`
+}
+
+func (m *KVStats) Reset()         { *m = KVStats{} }
+func (m *KVStats) String() string { return proto.CompactTextString(m) }
+func (*KVStats) ProtoMessage()    {}
+func (*KVStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ec9994f465878b8e, []int{4}
+}
+func (m *KVStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *KVStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_KVStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *KVStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KVStats.Merge(m, src)
+}
+func (m *KVStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *KVStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_KVStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KVStats proto.InternalMessageInfo
+
+func (m *KVStats) GetReads() uint64 {
+	if m != nil {
+		return m.Reads
+	}
+	return 0
+}
+
+func (m *KVStats) GetReadBytes() uint64 {
+	if m != nil {
+		return m.ReadBytes
+	}
+	return 0
+}
+
+func (m *KVStats) GetWrites() uint64 {
+	if m != nil {
+		return m.Writes
+	}
+	return 0
+}
+
+func (m *KVStats) GetWriteBytes() uint64 {
+	if m != nil {
+		return m.WriteBytes
+	}
+	return 0
+}
+
+func (m *KVStats) GetDeletes() uint64 {
+	if m != nil {
+		return m.Deletes
+	}
+	return 0
+}
+
+func (m *KVStats) GetIterSteps() uint64 {
+	if m != nil {
+		return m.IterSteps
+	}
+	return 0
+}
+
+func (m *KVStats) GetIterBytes() uint64 {
+	if m != nil {
+		return m.IterBytes
+	}
+	return 0
+}
+
+func (m *KVStats) GetGas() uint64 {
+	if m != nil {
+		return m.Gas
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*StoreProfileRequest)(nil), "lbm.base.profiler.v1.StoreProfileRequest")
+	proto.RegisterType((*StoreProfileResponse)(nil), "lbm.base.profiler.v1.StoreProfileResponse")
+	proto.RegisterType((*MsgProfile)(nil), "lbm.base.profiler.v1.MsgProfile")
+	proto.RegisterType((*StoreProfile)(nil), "lbm.base.profiler.v1.StoreProfile")
+	proto.RegisterType((*KVStats)(nil), "lbm.base.profiler.v1.KVStats")
+}
+
+func init() { proto.RegisterFile("lbm/base/profiler/v1/query.proto", fileDescriptor_ec9994f465878b8e) }
+
+var fileDescriptor_ec9994f465878b8e = []byte{
+	// 531 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0x3d, 0x6f, 0xd4, 0x40,
+	0x10, 0x3d, 0x27, 0xce, 0x5d, 0xb2, 0x49, 0x81, 0x96, 0x03, 0x99, 0x40, 0x1c, 0xcb, 0x08, 0x29,
+	0x7c, 0xd9, 0x4a, 0xa8, 0xa0, 0x42, 0x29, 0x68, 0x22, 0x24, 0xe4, 0x23, 0x14, 0x34, 0x96, 0x7d,
+	0x37, 0x59, 0xac, 0xd8, 0x5e, 0x67, 0x67, 0x7d, 0xc8, 0x2d, 0xbf, 0x00, 0x41, 0x8f, 0xc4, 0xbf,
+	0x49, 0x19, 0x89, 0x86, 0x0a, 0xc1, 0x1d, 0x3f, 0x04, 0xed, 0x07, 0x49, 0x40, 0x27, 0x41, 0x37,
+	0xf3, 0xde, 0xcc, 0x9b, 0xb7, 0xb3, 0xbb, 0x24, 0x28, 0xf3, 0x2a, 0xce, 0x33, 0x84, 0xb8, 0x11,
+	0xfc, 0xa8, 0x28, 0x41, 0xc4, 0xd3, 0xdd, 0xf8, 0xa4, 0x05, 0xd1, 0x45, 0x8d, 0xe0, 0x92, 0xd3,
+	0x61, 0x99, 0x57, 0x91, 0xaa, 0x88, 0x7e, 0x57, 0x44, 0xd3, 0xdd, 0xcd, 0x21, 0xe3, 0x8c, 0xeb,
+	0x82, 0x58, 0x45, 0xa6, 0x76, 0xf3, 0x16, 0xe3, 0x9c, 0x95, 0x10, 0x67, 0x4d, 0x11, 0x67, 0x75,
+	0xcd, 0x65, 0x26, 0x0b, 0x5e, 0xa3, 0x61, 0xc3, 0x6b, 0xe4, 0xea, 0x48, 0x72, 0x01, 0x2f, 0x8c,
+	0x4e, 0x02, 0x27, 0x2d, 0xa0, 0x0c, 0x13, 0x32, 0xfc, 0x13, 0xc6, 0x86, 0xd7, 0x08, 0xf4, 0x09,
+	0x71, 0x2b, 0x64, 0xe8, 0x39, 0xc1, 0xf2, 0xce, 0xfa, 0x5e, 0x10, 0x2d, 0xf2, 0x11, 0x3d, 0x47,
+	0x66, 0xfb, 0xf6, 0xdd, 0xd3, 0x6f, 0xdb, 0xbd, 0x44, 0xf7, 0x84, 0x9f, 0x1d, 0x42, 0x2e, 0x28,
+	0x1a, 0x90, 0x8d, 0x0a, 0x59, 0x2a, 0xbb, 0x06, 0xd2, 0x56, 0x94, 0x9e, 0x13, 0x38, 0x3b, 0x6b,
+	0x09, 0xa9, 0x90, 0xbd, 0xec, 0x1a, 0x38, 0x14, 0x25, 0x1d, 0x92, 0x95, 0x31, 0x6f, 0x6b, 0xe9,
+	0x2d, 0x05, 0xce, 0x8e, 0x9b, 0x98, 0x84, 0xde, 0x20, 0xab, 0x2c, 0xc3, 0xb4, 0x45, 0x98, 0x78,
+	0xcb, 0x9a, 0x18, 0xb0, 0x0c, 0x0f, 0x11, 0x26, 0xf4, 0x29, 0xe9, 0xa3, 0x72, 0x8d, 0x9e, 0xab,
+	0xfd, 0x85, 0x8b, 0xfd, 0x5d, 0x3e, 0x99, 0x75, 0x68, 0xfb, 0xc2, 0x23, 0xb2, 0x71, 0x99, 0xa5,
+	0x37, 0xc9, 0x9a, 0x66, 0xd2, 0x63, 0xe8, 0xac, 0xc3, 0x55, 0x0d, 0x1c, 0x40, 0x47, 0x1f, 0x93,
+	0x15, 0x94, 0x99, 0x44, 0xed, 0x6f, 0x7d, 0x6f, 0x6b, 0xf1, 0xb4, 0x83, 0x57, 0x23, 0x55, 0x64,
+	0x07, 0x99, 0x8e, 0xf0, 0x87, 0x43, 0x06, 0x96, 0x50, 0xc7, 0x14, 0x90, 0x4d, 0x50, 0xeb, 0xbb,
+	0x89, 0x49, 0xe8, 0x16, 0x21, 0x2a, 0x48, 0xf3, 0x4e, 0x02, 0xda, 0x0d, 0xac, 0x29, 0x64, 0x5f,
+	0x01, 0xf4, 0x3a, 0xe9, 0xbf, 0x15, 0x85, 0xa2, 0xcc, 0x0e, 0x6c, 0x46, 0xb7, 0xc9, 0xba, 0x8e,
+	0x6c, 0x9f, 0xab, 0x49, 0xa2, 0x21, 0xd3, 0xe8, 0x91, 0xc1, 0x04, 0x4a, 0x50, 0xe4, 0x8a, 0xd9,
+	0x9e, 0x4d, 0xd5, 0xc4, 0x42, 0x82, 0x48, 0x51, 0x42, 0x83, 0x5e, 0xdf, 0x4c, 0x54, 0xc8, 0x48,
+	0x01, 0xe7, 0xb4, 0x11, 0x1e, 0x5c, 0xd0, 0x46, 0xf7, 0x0a, 0x59, 0x66, 0x19, 0x7a, 0xab, 0x1a,
+	0x57, 0xe1, 0xde, 0x27, 0x87, 0x0c, 0x46, 0x20, 0xa6, 0xc5, 0x18, 0xe8, 0x07, 0xe7, 0xaf, 0xc5,
+	0xde, 0xfd, 0xf7, 0xd5, 0xd8, 0xb7, 0xb8, 0x79, 0xef, 0x7f, 0x4a, 0xcd, 0xfb, 0x0c, 0xef, 0xbf,
+	0xfb, 0xf2, 0xf3, 0xe3, 0xd2, 0x1d, 0x7a, 0x3b, 0x5e, 0xf8, 0x87, 0xcc, 0x5d, 0x5a, 0x64, 0xff,
+	0xd9, 0xe9, 0xcc, 0x77, 0xce, 0x66, 0xbe, 0xf3, 0x7d, 0xe6, 0x3b, 0xef, 0xe7, 0x7e, 0xef, 0x6c,
+	0xee, 0xf7, 0xbe, 0xce, 0xfd, 0xde, 0xeb, 0x07, 0xac, 0x90, 0x6f, 0xda, 0x3c, 0x1a, 0xf3, 0x2a,
+	0x2e, 0x8b, 0x1a, 0x94, 0xda, 0x43, 0x9c, 0x1c, 0xc7, 0xe3, 0xb2, 0x80, 0x5a, 0xc6, 0x4c, 0x34,
+	0xe3, 0x73, 0xe1, 0xbc, 0xaf, 0xbf, 0xd2, 0xa3, 0x5f, 0x03, 0x00, 0x46, 0xd1, 0x14, 0x24, 0xb8,
+	0x03, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// ServiceClient is the client API for Service service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ServiceClient interface {
+	// StoreProfile queries the store operations made by the node since it started,
+	// aggregated by the type URL of the executing Msg and the store key. It is
+	// available only if the store profiling is enabled.
+	StoreProfile(ctx context.Context, in *StoreProfileRequest, opts ...grpc.CallOption) (*StoreProfileResponse, error)
+}
+
+type serviceClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewServiceClient(cc grpc1.ClientConn) ServiceClient {
+	return &serviceClient{cc}
+}
+
+func (c *serviceClient) StoreProfile(ctx context.Context, in *StoreProfileRequest, opts ...grpc.CallOption) (*StoreProfileResponse, error) {
+	out := new(StoreProfileResponse)
+	err := c.cc.Invoke(ctx, "/lbm.base.profiler.v1.Service/StoreProfile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ServiceServer is the server API for Service service.
+type ServiceServer interface {
+	// StoreProfile queries the store operations made by the node since it started,
+	// aggregated by the type URL of the executing Msg and the store key. It is
+	// available only if the store profiling is enabled.
+	StoreProfile(context.Context, *StoreProfileRequest) (*StoreProfileResponse, error)
+}
+
+// UnimplementedServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedServiceServer struct {
+}
+
+func (*UnimplementedServiceServer) StoreProfile(ctx context.Context, req *StoreProfileRequest) (*StoreProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StoreProfile not implemented")
+}
+
+func RegisterServiceServer(s grpc1.Server, srv ServiceServer) {
+	s.RegisterService(&_Service_serviceDesc, srv)
+}
+
+func _Service_StoreProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StoreProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).StoreProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.base.profiler.v1.Service/StoreProfile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).StoreProfile(ctx, req.(*StoreProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Service_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "lbm.base.profiler.v1.Service",
+	HandlerType: (*ServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "StoreProfile",
+			Handler:    _Service_StoreProfile_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "lbm/base/profiler/v1/query.proto",
+}
+
+func (m *StoreProfileRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StoreProfileRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StoreProfileRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *StoreProfileResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StoreProfileResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StoreProfileResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Msgs) > 0 {
+		for iNdEx := len(m.Msgs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Msgs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgProfile) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgProfile) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProfile) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Stores) > 0 {
+		for iNdEx := len(m.Stores) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Stores[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.GasUsed != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Count != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StoreProfile) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StoreProfile) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StoreProfile) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Stats.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.StoreKey) > 0 {
+		i -= len(m.StoreKey)
+		copy(dAtA[i:], m.StoreKey)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StoreKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *KVStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *KVStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *KVStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Gas != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Gas))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.IterBytes != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.IterBytes))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.IterSteps != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.IterSteps))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Deletes != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Deletes))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.WriteBytes != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.WriteBytes))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Writes != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Writes))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ReadBytes != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ReadBytes))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Reads != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Reads))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *StoreProfileRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *StoreProfileResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Msgs) > 0 {
+		for _, e := range m.Msgs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgProfile) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Count != 0 {
+		n += 1 + sovQuery(uint64(m.Count))
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovQuery(uint64(m.GasUsed))
+	}
+	if len(m.Stores) > 0 {
+		for _, e := range m.Stores {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *StoreProfile) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StoreKey)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Stats.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *KVStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Reads != 0 {
+		n += 1 + sovQuery(uint64(m.Reads))
+	}
+	if m.ReadBytes != 0 {
+		n += 1 + sovQuery(uint64(m.ReadBytes))
+	}
+	if m.Writes != 0 {
+		n += 1 + sovQuery(uint64(m.Writes))
+	}
+	if m.WriteBytes != 0 {
+		n += 1 + sovQuery(uint64(m.WriteBytes))
+	}
+	if m.Deletes != 0 {
+		n += 1 + sovQuery(uint64(m.Deletes))
+	}
+	if m.IterSteps != 0 {
+		n += 1 + sovQuery(uint64(m.IterSteps))
+	}
+	if m.IterBytes != 0 {
+		n += 1 + sovQuery(uint64(m.IterBytes))
+	}
+	if m.Gas != 0 {
+		n += 1 + sovQuery(uint64(m.Gas))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *StoreProfileRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StoreProfileRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StoreProfileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StoreProfileResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StoreProfileResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StoreProfileResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msgs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msgs = append(m.Msgs, MsgProfile{})
+			if err := m.Msgs[len(m.Msgs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgProfile) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgProfile: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgProfile: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stores", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stores = append(m.Stores, StoreProfile{})
+			if err := m.Stores[len(m.Stores)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StoreProfile) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StoreProfile: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StoreProfile: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StoreKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StoreKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KVStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KVStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KVStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reads", wireType)
+			}
+			m.Reads = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Reads |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadBytes", wireType)
+			}
+			m.ReadBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReadBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Writes", wireType)
+			}
+			m.Writes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Writes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WriteBytes", wireType)
+			}
+			m.WriteBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WriteBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deletes", wireType)
+			}
+			m.Deletes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Deletes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IterSteps", wireType)
+			}
+			m.IterSteps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IterSteps |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IterBytes", wireType)
+			}
+			m.IterBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IterBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gas", wireType)
+			}
+			m.Gas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Gas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,41 @@
+package profiler
+
+import (
+	"context"
+
+	gogogrpc "github.com/gogo/protobuf/grpc"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+)
+
+// Profiler provides the store profile of the node.
+type Profiler interface {
+	// StoreProfile returns the profile of each Msg type, sorted by the type URL.
+	StoreProfile() []MsgProfile
+}
+
+type queryServer struct {
+	profiler Profiler
+}
+
+var _ ServiceServer = queryServer{}
+
+// NewQueryServer creates a new store profile query server.
+func NewQueryServer(profiler Profiler) ServiceServer {
+	return queryServer{profiler: profiler}
+}
+
+// StoreProfile implements ServiceServer.StoreProfile
+func (s queryServer) StoreProfile(_ context.Context, _ *StoreProfileRequest) (*StoreProfileResponse, error) {
+	return &StoreProfileResponse{Msgs: s.profiler.StoreProfile()}, nil
+}
+
+// RegisterProfilerService registers the store profile service on the gRPC router.
+func RegisterProfilerService(qrt gogogrpc.Server, profiler Profiler) {
+	RegisterServiceServer(qrt, NewQueryServer(profiler))
+}
+
+// RegisterGRPCGatewayRoutes mounts the store profile service's GRPC-gateway routes on the
+// given Mux.
+func RegisterGRPCGatewayRoutes(clientConn gogogrpc.ClientConn, mux *runtime.ServeMux) {
+	RegisterServiceHandlerClient(context.Background(), mux, NewServiceClient(clientConn))
+}
@@ -6,16 +6,21 @@ package client_test
 import (
 	"context"
 	"fmt"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
 
+	"github.com/line/lbm-sdk/client"
+	"github.com/line/lbm-sdk/client/flags"
 	"github.com/line/lbm-sdk/testutil/network"
 	"github.com/line/lbm-sdk/testutil/testdata"
 	sdk "github.com/line/lbm-sdk/types"
 	grpctypes "github.com/line/lbm-sdk/types/grpc"
+	banktestutil "github.com/line/lbm-sdk/x/bank/client/testutil"
 	banktypes "github.com/line/lbm-sdk/x/bank/types"
 )
 
@@ -78,6 +83,66 @@ func (s *IntegrationTestSuite) TestGRPCQuery() {
 	s.Require().Equal([]string{"1"}, blockHeight)
 }
 
+func (s *IntegrationTestSuite) TestBroadcastWait() {
+	val0 := s.network.Validators[0]
+
+	// the tx is returned once it is included in a block
+	amount := sdk.NewCoins(sdk.NewInt64Coin(s.network.Config.BondDenom, 1))
+	out, err := banktestutil.MsgSendExec(val0.ClientCtx, val0.Address, val0.Address, amount,
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastWait),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewInt64Coin(s.network.Config.BondDenom, 10))),
+	)
+	s.Require().NoError(err)
+
+	var res sdk.TxResponse
+	s.Require().NoError(val0.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &res), out.String())
+	s.Require().Zero(res.Code, res.RawLog)
+	s.Require().Positive(res.Height)
+
+	result, err := val0.ClientCtx.WaitTx(context.Background(), res.TxHash)
+	s.Require().NoError(err)
+	s.Require().Equal(res.Height, result.TxResponse.Height)
+
+	// waiting for an unknown tx times out
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	_, err = val0.ClientCtx.WaitTx(ctx, strings.Repeat("AB", 32))
+	s.Require().ErrorIs(err, context.DeadlineExceeded)
+}
+
+func (s *IntegrationTestSuite) TestWaitTxHTTPClient() {
+	val0 := s.network.Validators[0]
+	httpClient, err := client.NewClientFromNode(val0.RPCAddress)
+	s.Require().NoError(err)
+	// TestGRPCQuery leaves the context of the validator at height 1
+	clientCtx := val0.ClientCtx.WithHeight(0).WithClient(httpClient)
+
+	amount := sdk.NewCoins(sdk.NewInt64Coin(s.network.Config.BondDenom, 2))
+	out, err := banktestutil.MsgSendExec(clientCtx, val0.Address, val0.Address, amount,
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewInt64Coin(s.network.Config.BondDenom, 10))),
+	)
+	s.Require().NoError(err)
+
+	var res sdk.TxResponse
+	s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &res), out.String())
+	s.Require().Zero(res.Code, res.RawLog)
+
+	// waiting twice through the same client, which is left as it was
+	for i := 0; i < 2; i++ {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		result, err := clientCtx.WaitTx(ctx, res.TxHash)
+		cancel()
+		s.Require().NoError(err)
+		s.Require().Positive(result.TxResponse.Height)
+		s.Require().False(httpClient.IsRunning())
+	}
+	s.Require().NoError(httpClient.Start())
+	s.Require().NoError(httpClient.Stop())
+}
+
 func TestIntegrationTestSuite(t *testing.T) {
 	suite.Run(t, new(IntegrationTestSuite))
 }
@@ -128,6 +128,53 @@ func Test_runAddCmdLedger(t *testing.T) {
 		key1.GetPubKey().String())
 }
 
+func Test_runAddCmdLedgerSecp256r1(t *testing.T) {
+	cmd := AddKeyCommand()
+	cmd.Flags().AddFlagSet(Commands("home").PersistentFlags())
+
+	mockIn := testutil.ApplyMockIODiscardOutErr(cmd)
+	kbHome := t.TempDir()
+
+	clientCtx := client.Context{}.WithKeyringDir(kbHome)
+	ctx := context.WithValue(context.Background(), client.ClientContextKey, &clientCtx)
+
+	cmd.SetArgs([]string{
+		"keyname1",
+		fmt.Sprintf("--%s=true", flags.FlagUseLedger),
+		fmt.Sprintf("--%s=%s", cli.OutputFlag, OutputFormatText),
+		fmt.Sprintf("--%s=%s", flags.FlagKeyAlgorithm, string(hd.Secp256r1Type)),
+		fmt.Sprintf("--%s=%d", flagCoinType, sdk.CoinType),
+		fmt.Sprintf("--%s=%s", flags.FlagKeyringBackend, keyring.BackendTest),
+	})
+	mockIn.Reset("test1234\ntest1234\n")
+
+	require.NoError(t, cmd.ExecuteContext(ctx))
+
+	// Now check that it has been stored properly
+	kb, err := keyring.New(sdk.KeyringServiceName(), keyring.BackendTest, kbHome, mockIn)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = kb.Delete("keyname1")
+	})
+
+	key1, err := kb.Key("keyname1")
+	require.NoError(t, err)
+	require.Equal(t, keyring.TypeLedger, key1.GetType())
+	require.Equal(t, hd.Secp256r1Type, key1.GetAlgo())
+	require.Equal(t, string(hd.Secp256r1Type), key1.GetPubKey().Type())
+
+	// secp256r1 keys are not derived locally
+	cmd = AddKeyCommand()
+	cmd.Flags().AddFlagSet(Commands("home").PersistentFlags())
+	testutil.ApplyMockIODiscardOutErr(cmd)
+	cmd.SetArgs([]string{
+		"keyname2",
+		fmt.Sprintf("--%s=%s", flags.FlagKeyAlgorithm, string(hd.Secp256r1Type)),
+		fmt.Sprintf("--%s=%s", flags.FlagKeyringBackend, keyring.BackendTest),
+	})
+	require.EqualError(t, cmd.ExecuteContext(ctx), `provided algorithm "secp256r1" is not supported`)
+}
+
 func Test_runAddCmdLedgerDryRun(t *testing.T) {
 	testData := []struct {
 		name  string
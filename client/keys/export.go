@@ -14,6 +14,15 @@ import (
 const (
 	flagUnarmoredHex = "unarmored-hex"
 	flagUnsafe       = "unsafe"
+	flagFormat       = "format"
+)
+
+// Formats of the private keys exported and imported.
+const (
+	formatArmor    = "armor"
+	formatPKCS8    = "pkcs8"
+	formatKeystore = "keystore"
+	formatJWK      = "jwk"
 )
 
 // ExportKeyCommand exports private keys from the key store.
@@ -23,6 +32,13 @@ func ExportKeyCommand() *cobra.Command {
 		Short: "Export private keys",
 		Long: `Export a private key from the local keyring in ASCII-armored encrypted format.
 
+The --format flag selects a standard format instead, to use the key in other
+wallets and tools:
+  pkcs8     passphrase-encrypted PKCS#8 PEM
+  keystore  Ethereum style Web3 JSON keystore encrypted by a scrypt key
+  jwk       unencrypted JSON Web Key, which requires --unsafe
+The secp256k1, secp256r1 and ed25519 keys are supported in these formats.
+
 When both the --unarmored-hex and --unsafe flags are selected, cryptographic
 private key material is exported in an INSECURE fashion that is designed to
 allow users to import their keys in hot wallets. This feature is for advanced
@@ -38,10 +54,25 @@ and export your keys in ASCII-armored encrypted format.`,
 			buf := bufio.NewReader(clientCtx.Input)
 			unarmored, _ := cmd.Flags().GetBool(flagUnarmoredHex)
 			unsafe, _ := cmd.Flags().GetBool(flagUnsafe)
+			format, _ := cmd.Flags().GetString(flagFormat)
 
-			if unarmored && unsafe {
+			switch format {
+			case formatArmor, formatPKCS8, formatKeystore, formatJWK:
+			default:
+				return fmt.Errorf("invalid key format %s", format)
+			}
+			if unarmored && format != formatArmor {
+				return fmt.Errorf("the flags %s and %s cannot be used together", flagUnarmoredHex, flagFormat)
+			}
+
+			switch {
+			case unarmored && unsafe:
 				return exportUnsafeUnarmored(cmd, args[0], buf, clientCtx.Keyring)
-			} else if unarmored || unsafe {
+			case format == formatJWK && unsafe:
+				return exportUnsafeJWK(cmd, args[0], buf, clientCtx.Keyring)
+			case format == formatJWK:
+				return fmt.Errorf("the %s format must be used with the flag %s", formatJWK, flagUnsafe)
+			case unarmored || unsafe:
 				return fmt.Errorf("the flags %s and %s must be used together", flagUnsafe, flagUnarmoredHex)
 			}
 
@@ -50,18 +81,29 @@ and export your keys in ASCII-armored encrypted format.`,
 				return err
 			}
 
-			armored, err := clientCtx.Keyring.ExportPrivKeyArmor(args[0], encryptPassword)
+			var exported string
+			switch format {
+			case formatPKCS8:
+				exported, err = clientCtx.Keyring.ExportPrivKeyPKCS8(args[0], encryptPassword)
+			case formatKeystore:
+				var bz []byte
+				bz, err = clientCtx.Keyring.ExportPrivKeyKeystore(args[0], encryptPassword)
+				exported = string(bz)
+			default:
+				exported, err = clientCtx.Keyring.ExportPrivKeyArmor(args[0], encryptPassword)
+			}
 			if err != nil {
 				return err
 			}
 
-			cmd.Println(armored)
+			cmd.Println(exported)
 
 			return nil
 		},
 	}
 
 	cmd.Flags().Bool(flagUnarmoredHex, false, "Export unarmored hex privkey. Requires --unsafe.")
+	cmd.Flags().String(flagFormat, formatArmor, "Key format: armor|pkcs8|keystore|jwk. The jwk format requires --unsafe.")
 	cmd.Flags().Bool(flagUnsafe, false, "Enable unsafe operations. This flag must be switched on along with all unsafe operation-specific options.")
 
 	return cmd
@@ -84,3 +126,20 @@ func exportUnsafeUnarmored(cmd *cobra.Command, uid string, buf *bufio.Reader, kr
 
 	return nil
 }
+
+func exportUnsafeJWK(cmd *cobra.Command, uid string, buf *bufio.Reader, kr keyring.Keyring) error {
+	if yes, err := input.GetConfirmation("WARNING: The private key will be exported as an unencrypted JSON Web Key. USE AT YOUR OWN RISK. Continue?", buf, cmd.ErrOrStderr()); err != nil {
+		return err
+	} else if !yes {
+		return nil
+	}
+
+	jwk, err := keyring.NewUnsafe(kr).UnsafeExportPrivKeyJWK(uid)
+	if err != nil {
+		return err
+	}
+
+	cmd.Println(string(jwk))
+
+	return nil
+}
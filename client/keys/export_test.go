@@ -39,6 +39,24 @@ func Test_runExportCmd(t *testing.T) {
 			extraArgs:      []string{"--unarmored-hex"},
 			mustFail:       true,
 		},
+		{
+			name:           "--format=jwk without --unsafe must fail",
+			keyringBackend: keyring.BackendTest,
+			extraArgs:      []string{"--format=jwk"},
+			mustFail:       true,
+		},
+		{
+			name:           "--format=pkcs8 --unarmored-hex --unsafe must fail",
+			keyringBackend: keyring.BackendTest,
+			extraArgs:      []string{"--format=pkcs8", "--unarmored-hex", "--unsafe"},
+			mustFail:       true,
+		},
+		{
+			name:           "unknown --format must fail",
+			keyringBackend: keyring.BackendTest,
+			extraArgs:      []string{"--format=der"},
+			mustFail:       true,
+		},
 		{
 			name:           "--unsafe --unarmored-hex fail with no user confirmation",
 			keyringBackend: keyring.BackendTest,
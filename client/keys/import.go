@@ -2,6 +2,7 @@ package keys
 
 import (
 	"bufio"
+	"fmt"
 	"io/ioutil"
 
 	"github.com/spf13/cobra"
@@ -12,29 +13,55 @@ import (
 
 // ImportKeyCommand imports private keys from a keyfile.
 func ImportKeyCommand() *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "import <name> <keyfile>",
 		Short: "Import private keys into the local keybase",
-		Long:  "Import a ASCII armored private key into the local keybase.",
-		Args:  cobra.ExactArgs(2),
+		Long: `Import a ASCII armored private key into the local keybase.
+
+The --format flag selects a standard format instead:
+  pkcs8     passphrase-encrypted PKCS#8 PEM
+  keystore  Web3 JSON keystore encrypted by a scrypt or PBKDF2 key
+  jwk       unencrypted JSON Web Key
+The secp256k1, secp256r1 and ed25519 keys are supported in these formats.`,
+		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
 			if err != nil {
 				return err
 			}
 			buf := bufio.NewReader(clientCtx.Input)
+			format, _ := cmd.Flags().GetString(flagFormat)
 
 			bz, err := ioutil.ReadFile(args[1])
 			if err != nil {
 				return err
 			}
 
+			switch format {
+			case formatArmor, formatPKCS8, formatKeystore:
+			case formatJWK:
+				return clientCtx.Keyring.ImportPrivKeyJWK(args[0], bz)
+			default:
+				return fmt.Errorf("invalid key format %s", format)
+			}
+
 			passphrase, err := input.GetPassword("Enter passphrase to decrypt your key:", buf)
 			if err != nil {
 				return err
 			}
 
-			return clientCtx.Keyring.ImportPrivKey(args[0], string(bz), passphrase)
+			switch format {
+			case formatPKCS8:
+				return clientCtx.Keyring.ImportPrivKeyPKCS8(args[0], string(bz), passphrase)
+			case formatKeystore:
+				return clientCtx.Keyring.ImportPrivKeyKeystore(args[0], bz, passphrase)
+			default:
+				return clientCtx.Keyring.ImportPrivKey(args[0], string(bz), passphrase)
+			}
 		},
 	}
+
+	cmd.Flags().String(flagFormat, formatArmor, "Key format: armor|pkcs8|keystore|jwk")
+
+	return cmd
 }
@@ -1,6 +1,7 @@
 package keys
 
 import (
+	"bufio"
 	"context"
 	"fmt"
 	"io/ioutil"
@@ -12,8 +13,11 @@ import (
 
 	"github.com/line/lbm-sdk/client"
 	"github.com/line/lbm-sdk/client/flags"
+	"github.com/line/lbm-sdk/crypto"
+	"github.com/line/lbm-sdk/crypto/hd"
 	"github.com/line/lbm-sdk/crypto/keyring"
 	"github.com/line/lbm-sdk/testutil"
+	"github.com/line/lbm-sdk/testutil/testdata"
 	sdk "github.com/line/lbm-sdk/types"
 )
 
@@ -116,3 +120,60 @@ Ax9IQsSq+jOWkPRDJQ69a5/uUm4XliPim/CbYDVoXO6D3fts5IEXcUTmIa60ynC/
 		})
 	}
 }
+
+func Test_runExportImportCmdFormats(t *testing.T) {
+	defer func(iterations, n int) {
+		crypto.PKCS8Iterations, crypto.KeystoreScryptN = iterations, n
+	}(crypto.PKCS8Iterations, crypto.KeystoreScryptN)
+	crypto.PKCS8Iterations, crypto.KeystoreScryptN = 1000, 1<<12
+
+	testCases := []struct {
+		format      string
+		exportArgs  []string
+		exportInput string
+		importInput string
+	}{
+		{"pkcs8", nil, "123456789\n", "123456789\n"},
+		{"keystore", nil, "123456789\n", "123456789\n"},
+		{"jwk", []string{"--unsafe"}, "y\n", ""},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.format, func(t *testing.T) {
+			kbHome := t.TempDir()
+			kb, err := keyring.New(sdk.KeyringServiceName(), keyring.BackendTest, kbHome, nil)
+			require.NoError(t, err)
+			info, err := kb.NewAccount("keyname1", testdata.TestMnemonic, "", sdk.GetConfig().GetFullBIP44Path(), hd.Secp256k1)
+			require.NoError(t, err)
+
+			clientCtx := client.Context{}.
+				WithKeyringDir(kbHome).
+				WithKeyring(kb)
+			formatArg := fmt.Sprintf("--%s=%s", flagFormat, tc.format)
+
+			exportCmd := ExportKeyCommand()
+			exportCmd.Flags().AddFlagSet(Commands("home").PersistentFlags())
+			mockIn, mockOut := testutil.ApplyMockIO(exportCmd)
+			mockIn.Reset(tc.exportInput)
+			exportCtx := clientCtx.WithInput(bufio.NewReader(mockIn))
+			exportCmd.SetArgs(append([]string{"keyname1", formatArg}, tc.exportArgs...))
+			require.NoError(t, exportCmd.ExecuteContext(context.WithValue(context.Background(), client.ClientContextKey, &exportCtx)))
+
+			keyfile := filepath.Join(kbHome, "key."+tc.format)
+			require.NoError(t, ioutil.WriteFile(keyfile, mockOut.Bytes(), 0600))
+			require.NoError(t, kb.Delete("keyname1"))
+
+			importCmd := ImportKeyCommand()
+			importCmd.Flags().AddFlagSet(Commands("home").PersistentFlags())
+			mockIn = testutil.ApplyMockIODiscardOutErr(importCmd)
+			mockIn.Reset(tc.importInput)
+			importCtx := clientCtx.WithInput(mockIn)
+			importCmd.SetArgs([]string{"keyname2", keyfile, formatArg})
+			require.NoError(t, importCmd.ExecuteContext(context.WithValue(context.Background(), client.ClientContextKey, &importCtx)))
+
+			imported, err := kb.Key("keyname2")
+			require.NoError(t, err)
+			require.True(t, info.GetPubKey().Equals(imported.GetPubKey()))
+		})
+	}
+}
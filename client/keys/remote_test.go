@@ -0,0 +1,63 @@
+package keys
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/line/lbm-sdk/client"
+	"github.com/line/lbm-sdk/client/flags"
+	"github.com/line/lbm-sdk/crypto/hd"
+	"github.com/line/lbm-sdk/crypto/keyring"
+	"github.com/line/lbm-sdk/testutil/testdata"
+	sdk "github.com/line/lbm-sdk/types"
+)
+
+func Test_remoteBackend(t *testing.T) {
+	// serve the keys of an in-memory keyring
+	signerKr := keyring.NewInMemory()
+	info, err := signerKr.NewAccount("remote", testdata.TestMnemonic, "", sdk.FullFundraiserPath, hd.Secp256k1)
+	require.NoError(t, err)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	server := grpc.NewServer()
+	keyring.RegisterRemoteSignerServer(server, keyring.NewRemoteSignerServer(signerKr, "s3cret"))
+	go server.Serve(listener) // nolint: errcheck
+	t.Cleanup(server.Stop)
+
+	kbHome := t.TempDir()
+	cfg, err := json.Marshal(keyring.RemoteSignerConfig{Address: listener.Addr().String(), Token: "s3cret", Insecure: true})
+	require.NoError(t, err)
+	require.NoError(t, os.MkdirAll(filepath.Join(kbHome, "keyring-remote"), 0o700))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(kbHome, "keyring-remote", keyring.RemoteSignerConfigFileName), cfg, 0o600))
+
+	clientCtx := client.Context{}.WithKeyringDir(kbHome)
+	ctx := context.WithValue(context.Background(), client.ClientContextKey, &clientCtx)
+	backendFlag := fmt.Sprintf("--%s=%s", flags.FlagKeyringBackend, keyring.BackendRemote)
+
+	cmd := ListKeysCmd()
+	cmd.Flags().AddFlagSet(Commands("home").PersistentFlags())
+	out := &bytes.Buffer{}
+	cmd.SetOut(out)
+	cmd.SetArgs([]string{backendFlag, fmt.Sprintf("--%s=true", flagListNames)})
+	require.NoError(t, cmd.ExecuteContext(ctx))
+	require.Equal(t, "remote\n", out.String())
+
+	cmd = ShowKeysCmd()
+	cmd.Flags().AddFlagSet(Commands("home").PersistentFlags())
+	out.Reset()
+	cmd.SetOut(out)
+	cmd.SetArgs([]string{"remote", backendFlag, fmt.Sprintf("--%s=true", FlagAddress)})
+	require.NoError(t, cmd.ExecuteContext(ctx))
+	require.Equal(t, info.GetAddress().String()+"\n", out.String())
+}
@@ -27,6 +27,11 @@ The keyring supports the following backends:
     pass        Uses the pass command line utility to store and retrieve keys.
     test        Stores keys insecurely to disk. It does not prompt for a password to be unlocked
                 and it should be use only for testing purposes.
+    remote      Looks up the keys and signs with them on a remote signer over gRPC. The signer is
+                configured by keyring-remote/config.json within the keyring directory, e.g.
+                {"address": "localhost:9091", "token": "...", "ca_cert_file": "ca.pem"}.
+                The keys are managed on the signer, so that they can not be added, deleted,
+                imported or exported with these commands.
 
 kwallet and pass backends depend on external tools. Refer to their respective documentation for more
 information:
@@ -50,7 +55,7 @@ The pass backend requires GnuPG: https://gnupg.org/
 
 	cmd.PersistentFlags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
 	cmd.PersistentFlags().String(flags.FlagKeyringDir, "", "The client Keyring directory; if omitted, the default 'home' directory will be used")
-	cmd.PersistentFlags().String(flags.FlagKeyringBackend, flags.DefaultKeyringBackend, "Select keyring's backend (os|file|test)")
+	cmd.PersistentFlags().String(flags.FlagKeyringBackend, flags.DefaultKeyringBackend, "Select keyring's backend (os|file|test|remote)")
 	cmd.PersistentFlags().String(cli.OutputFlag, "text", "Output format (text|json)")
 
 	return cmd
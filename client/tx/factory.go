@@ -31,6 +31,7 @@ type Factory struct {
 	memo               string
 	fees               sdk.Coins
 	gasPrices          sdk.DecCoins
+	autoGasPrices      bool
 	signMode           signing.SignMode
 	simulateAndExecute bool
 }
@@ -45,6 +46,8 @@ func NewFactoryCLI(clientCtx client.Context, flagSet *pflag.FlagSet) Factory {
 		signMode = signing.SignMode_SIGN_MODE_DIRECT
 	case flags.SignModeLegacyAminoJSON:
 		signMode = signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON
+	case flags.SignModeTextual:
+		signMode = signing.SignMode_SIGN_MODE_TEXTUAL
 	case flags.SignModeEIP191:
 		signMode = signing.SignMode_SIGN_MODE_EIP_191
 	}
@@ -98,6 +101,10 @@ func (f Factory) TimeoutHeight() uint64                     { return f.timeoutHe
 // using the gas from the simulation results
 func (f Factory) SimulateAndExecute() bool { return f.simulateAndExecute }
 
+// AutoGasPrices returns the option to estimate the gas prices by the node
+// before building the transaction
+func (f Factory) AutoGasPrices() bool { return f.autoGasPrices }
+
 // WithTxConfig returns a copy of the Factory with an updated TxConfig.
 func (f Factory) WithTxConfig(g client.TxConfig) Factory {
 	f.txConfig = g
@@ -133,14 +140,22 @@ func (f Factory) WithFees(fees string) Factory {
 	return f
 }
 
-// WithGasPrices returns a copy of the Factory with updated gas prices.
+// WithGasPrices returns a copy of the Factory with updated gas prices. If gas
+// prices is "auto", they are estimated by the node before building the tx.
 func (f Factory) WithGasPrices(gasPrices string) Factory {
+	if gasPrices == flags.GasFlagAuto {
+		f.gasPrices = nil
+		f.autoGasPrices = true
+		return f
+	}
+
 	parsedGasPrices, err := sdk.ParseDecCoins(gasPrices)
 	if err != nil {
 		panic(err)
 	}
 
 	f.gasPrices = parsedGasPrices
+	f.autoGasPrices = false
 	return f
 }
 
@@ -205,6 +220,10 @@ func (f Factory) BuildUnsignedTx(msgs ...sdk.Msg) (client.TxBuilder, error) {
 		return nil, fmt.Errorf("chain ID required but not specified")
 	}
 
+	if f.autoGasPrices {
+		return nil, errors.New("gas prices must be estimated before building the tx")
+	}
+
 	fees := f.fees
 
 	if !f.gasPrices.IsZero() {
@@ -243,6 +262,17 @@ func (f Factory) BuildUnsignedTx(msgs ...sdk.Msg) (client.TxBuilder, error) {
 // simulated and also printed to the same writer before the transaction is
 // printed.
 func (f Factory) PrintUnsignedTx(clientCtx client.Context, msgs ...sdk.Msg) error {
+	if f.AutoGasPrices() {
+		if clientCtx.Offline {
+			return errors.New("cannot estimate gas prices in offline mode")
+		}
+
+		var err error
+		if f, err = estimateFactoryGasPrices(clientCtx, f); err != nil {
+			return err
+		}
+	}
+
 	if f.SimulateAndExecute() {
 		if clientCtx.Offline {
 			return errors.New("cannot estimate gas in offline mode")
@@ -13,6 +13,7 @@ import (
 
 	"github.com/line/lbm-sdk/client"
 	"github.com/line/lbm-sdk/client/flags"
+	"github.com/line/lbm-sdk/client/grpc/feeestimate"
 	"github.com/line/lbm-sdk/client/input"
 	cryptotypes "github.com/line/lbm-sdk/crypto/types"
 	sdk "github.com/line/lbm-sdk/types"
@@ -55,6 +56,17 @@ func GenerateOrBroadcastTxWithFactory(clientCtx client.Context, txf Factory, msg
 // simulated and also printed to the same writer before the transaction is
 // printed.
 func GenerateTx(clientCtx client.Context, txf Factory, msgs ...sdk.Msg) error {
+	if txf.AutoGasPrices() {
+		if clientCtx.Offline {
+			return errors.New("cannot estimate gas prices in offline mode")
+		}
+
+		var err error
+		if txf, err = estimateFactoryGasPrices(clientCtx, txf); err != nil {
+			return err
+		}
+	}
+
 	if txf.SimulateAndExecute() {
 		if clientCtx.Offline {
 			return errors.New("cannot estimate gas in offline mode")
@@ -91,6 +103,12 @@ func BroadcastTx(clientCtx client.Context, txf Factory, msgs ...sdk.Msg) error {
 		return err
 	}
 
+	if txf.AutoGasPrices() {
+		if txf, err = estimateFactoryGasPrices(clientCtx, txf); err != nil {
+			return err
+		}
+	}
+
 	if txf.SimulateAndExecute() || clientCtx.Simulate {
 		_, adjusted, err := CalculateGas(clientCtx, txf, msgs...)
 		if err != nil {
@@ -251,6 +269,36 @@ func CalculateGas(
 	return simRes, uint64(txf.GasAdjustment() * float64(simRes.GasInfo.GasUsed)), nil
 }
 
+// EstimateGasPrices queries the fee estimation service of the node for the
+// suggested gas prices. It returns the median gas price in the denom of the
+// most txs, or no gas prices if the node suggests none.
+func EstimateGasPrices(clientCtx gogogrpc.ClientConn) (sdk.DecCoins, error) {
+	res, err := feeestimate.NewServiceClient(clientCtx).EstimateFee(context.Background(), &feeestimate.EstimateFeeRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(res.Suggestions) == 0 {
+		return sdk.DecCoins{}, nil
+	}
+
+	suggestion := res.Suggestions[0]
+	return sdk.NewDecCoins(sdk.NewDecCoinFromDec(suggestion.Denom, suggestion.Median)), nil
+}
+
+// estimateFactoryGasPrices returns a copy of the Factory with the gas prices
+// estimated by the node.
+func estimateFactoryGasPrices(clientCtx gogogrpc.ClientConn, txf Factory) (Factory, error) {
+	gasPrices, err := EstimateGasPrices(clientCtx)
+	if err != nil {
+		return txf, fmt.Errorf("cannot estimate gas prices: %w", err)
+	}
+
+	txf.gasPrices = gasPrices
+	txf.autoGasPrices = false
+	return txf, nil
+}
+
 // prepareFactory ensures the account defined by ctx.GetFromAddress() exists and
 // if the account number and/or the account sequence number are zero (not set),
 // they will be queried for and set on the provided Factory. A new Factory with
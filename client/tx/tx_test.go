@@ -9,6 +9,7 @@ import (
 	"google.golang.org/grpc"
 
 	"github.com/line/lbm-sdk/client"
+	"github.com/line/lbm-sdk/client/grpc/feeestimate"
 	"github.com/line/lbm-sdk/client/tx"
 	"github.com/line/lbm-sdk/crypto/hd"
 	"github.com/line/lbm-sdk/crypto/keyring"
@@ -376,3 +377,49 @@ func TestPrepare(t *testing.T) {
 	_, err = txf.Prepare(clientCtx)
 	require.NoError(t, err)
 }
+
+// mockFeeEstimateContext is a mock client.Context to return the gas price
+// suggestions, used to unit test EstimateGasPrices.
+type mockFeeEstimateContext struct {
+	suggestions []feeestimate.GasPriceSuggestion
+}
+
+func (m mockFeeEstimateContext) Invoke(grpcCtx gocontext.Context, method string, req, reply interface{}, opts ...grpc.CallOption) (err error) {
+	*(reply.(*feeestimate.EstimateFeeResponse)) = feeestimate.EstimateFeeResponse{Suggestions: m.suggestions, Blocks: 20}
+	return nil
+}
+
+func (mockFeeEstimateContext) NewStream(gocontext.Context, *grpc.StreamDesc, string, ...grpc.CallOption) (grpc.ClientStream, error) {
+	panic("not implemented")
+}
+
+func TestEstimateGasPrices(t *testing.T) {
+	suggestions := []feeestimate.GasPriceSuggestion{
+		{Denom: "stake", Low: sdk.NewDecWithPrec(1, 3), Median: sdk.NewDecWithPrec(25, 4), High: sdk.NewDecWithPrec(1, 2), BlockTxs: 10},
+		{Denom: "atom", Low: sdk.NewDec(1), Median: sdk.NewDec(2), High: sdk.NewDec(3), BlockTxs: 1},
+	}
+	gasPrices, err := tx.EstimateGasPrices(mockFeeEstimateContext{suggestions: suggestions})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecCoins(sdk.NewDecCoinFromDec("stake", sdk.NewDecWithPrec(25, 4))), gasPrices)
+
+	gasPrices, err = tx.EstimateGasPrices(mockFeeEstimateContext{})
+	require.NoError(t, err)
+	require.True(t, gasPrices.IsZero())
+
+	// the gas prices must be estimated before building the tx
+	txCfg := NewTestTxConfig()
+	txf := tx.Factory{}.
+		WithTxConfig(txCfg).
+		WithChainID("test-chain").
+		WithGas(200000).
+		WithGasPrices("auto")
+	require.True(t, txf.AutoGasPrices())
+	_, err = txf.BuildUnsignedTx(banktypes.NewMsgSend(sdk.AccAddress("from"), sdk.AccAddress("to"), nil))
+	require.Error(t, err)
+
+	txf = txf.WithGasPrices("0.0025stake")
+	require.False(t, txf.AutoGasPrices())
+	txb, err := txf.BuildUnsignedTx(banktypes.NewMsgSend(sdk.AccAddress("from"), sdk.AccAddress("to"), nil))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 500)), txb.GetTx().GetFee())
+}
@@ -0,0 +1,138 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	rpchttp "github.com/line/ostracon/rpc/client/http"
+	ctypes "github.com/line/ostracon/rpc/core/types"
+	octypes "github.com/line/ostracon/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/line/lbm-sdk/client/flags"
+	sdk "github.com/line/lbm-sdk/types"
+	"github.com/line/lbm-sdk/types/tx"
+)
+
+// waitTxPollInterval is the interval of querying a tx while waiting for it to
+// be included in a block.
+var waitTxPollInterval = time.Second
+
+// TxResult is a tx included in a block, with the typed events it emitted
+// parsed back into their proto messages.
+type TxResult struct {
+	TxResponse  *sdk.TxResponse
+	TypedEvents []proto.Message
+}
+
+// BroadcastTxWait broadcasts transaction bytes to a Tendermint node
+// synchronously, then waits for the tx to be included in a block until
+// ctx.WaitTimeout. If the tx fails CheckTx, the result holds the CheckTx
+// response only. Unlike BroadcastTxCommit, an error is returned if the tx is
+// not included in time.
+func (ctx Context) BroadcastTxWait(txBytes []byte) (*TxResult, error) {
+	timeout := ctx.WaitTimeout
+	if timeout <= 0 {
+		timeout = flags.DefaultWaitTimeout
+	}
+	goCtx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	// subscribe before broadcasting, not to miss the inclusion of the tx
+	hash := fmt.Sprintf("%X", octypes.Tx(txBytes).Hash())
+	included, unsubscribe := ctx.subscribeTx(goCtx, hash)
+	defer unsubscribe()
+
+	res, err := ctx.BroadcastTxSync(txBytes)
+	if err != nil || res.Code != 0 {
+		return &TxResult{TxResponse: res}, err
+	}
+
+	return ctx.waitTx(goCtx, hash, included)
+}
+
+// WaitTx waits for the tx of the given hex encoded hash to be included in a
+// block, until goCtx is done. It subscribes to the tx through the websocket
+// of the node, and polls the tx service as well as a fallback when the node
+// does not serve subscriptions.
+func (ctx Context) WaitTx(goCtx context.Context, hash string) (*TxResult, error) {
+	included, unsubscribe := ctx.subscribeTx(goCtx, hash)
+	defer unsubscribe()
+
+	return ctx.waitTx(goCtx, hash, included)
+}
+
+func (ctx Context) waitTx(goCtx context.Context, hash string, included <-chan ctypes.ResultEvent) (*TxResult, error) {
+	ticker := time.NewTicker(waitTxPollInterval)
+	defer ticker.Stop()
+
+	txClient := tx.NewServiceClient(ctx)
+	for {
+		res, err := txClient.GetTx(goCtx, &tx.GetTxRequest{Hash: hash})
+		if err == nil {
+			typedEvents, err := sdk.ParseTypedEvents(res.TxResponse.Events)
+			if err != nil {
+				return nil, err
+			}
+			return &TxResult{TxResponse: res.TxResponse, TypedEvents: typedEvents}, nil
+		}
+		if goCtx.Err() == nil && status.Code(err) != codes.NotFound {
+			return nil, err
+		}
+
+		select {
+		case <-goCtx.Done():
+			return nil, fmt.Errorf("tx %s was not included in a block: %w", hash, goCtx.Err())
+		case <-included:
+			// the tx may not be indexed yet, the polling goes on until it is
+		case <-ticker.C:
+		}
+	}
+}
+
+// subscribeTx subscribes to the inclusion of the tx of the given hash. The
+// returned channel is nil, so never ready, if the node does not serve
+// subscriptions.
+func (ctx Context) subscribeTx(goCtx context.Context, hash string) (<-chan ctypes.ResultEvent, func()) {
+	noop := func() {}
+	node, err := ctx.GetNode()
+	if err != nil {
+		return nil, noop
+	}
+
+	// the websocket of an HTTP client is connected on start, and cannot be
+	// reconnected once stopped, so a dedicated client is started not to stop
+	// the one of the context
+	stop := noop
+	if !node.IsRunning() {
+		httpClient, ok := node.(*rpchttp.HTTP)
+		if !ok {
+			return nil, noop
+		}
+		wsClient, err := NewClientFromNode(httpClient.Remote())
+		if err != nil {
+			return nil, noop
+		}
+		if err := wsClient.Start(); err != nil {
+			return nil, noop
+		}
+		node = wsClient
+		stop = func() { _ = wsClient.Stop() }
+	}
+
+	subscriber := "wait-tx-" + hash
+	query := fmt.Sprintf("%s='%s' AND %s='%s'", octypes.EventTypeKey, octypes.EventTx, octypes.TxHashKey, hash)
+	included, err := node.Subscribe(goCtx, subscriber, query)
+	if err != nil {
+		stop()
+		return nil, noop
+	}
+
+	return included, func() {
+		_ = node.Unsubscribe(context.Background(), subscriber, query)
+		stop()
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"os"
 	"path/filepath"
 	"strconv"
+	"time"
 )
 
 const (
@@ -15,6 +16,12 @@ const (
 	genesisDir  = "genesis"
 	upgradesDir = "upgrades"
 	currentLink = "current"
+	backupsDir  = "backups"
+
+	upgradeStateFile   = "upgrade-state.json"
+	upgradeHistoryFile = "upgrade-history.jsonl"
+
+	defaultRPCAddress = "http://localhost:26657"
 )
 
 // Config is the information passed in to control the daemon
@@ -24,6 +31,31 @@ type Config struct {
 	AllowDownloadBinaries bool
 	RestartAfterUpgrade   bool
 	LogBufferSize         int
+	// DataDir is the data directory of the node, where x/upgrade writes upgrade-info.json.
+	// It defaults to Home/data.
+	DataDir string
+	// PollInterval is the interval of polling upgrade-info.json
+	PollInterval time.Duration
+	// BackupData enables the backup of the data directory before switching to an upgrade binary
+	BackupData bool
+	// BackupDir is the directory of the backups, defaults to Root()/backups
+	BackupDir string
+	// BackupCompression is the compression of the backups, none or gzip
+	BackupCompression string
+	// BackupKeep is the number of backups kept, zero keeps all of them
+	BackupKeep int
+	// PreUpgradeCmd is run before an upgrade, which is aborted if it fails
+	PreUpgradeCmd string
+	// RollbackBlocks is the number of blocks the upgraded binary must commit. If it crashes before,
+	// the backup and the previous binary are restored. Zero disables the rollback.
+	RollbackBlocks int64
+	// RPCAddress is the RPC address of the node, used to check its height after an upgrade
+	RPCAddress string
+	// ManifestDir is the directory of the upgrade manifests, to install the binaries from local files
+	ManifestDir string
+	// TrustedKeysFile lists the ed25519 public keys trusted to sign the binaries of the manifests.
+	// If set, every binary installed from a manifest must be signed by one of them.
+	TrustedKeysFile string
 }
 
 // Root returns the root directory where all info lives
@@ -47,6 +79,32 @@ func (cfg *Config) UpgradeDir(upgradeName string) string {
 	return filepath.Join(cfg.Root(), upgradesDir, safeName)
 }
 
+// DataPath is the data directory of the node
+func (cfg *Config) DataPath() string {
+	if cfg.DataDir != "" {
+		return cfg.DataDir
+	}
+	return filepath.Join(cfg.Home, "data")
+}
+
+// UpgradeInfoFilePath is the path to the upgrade-info.json written by x/upgrade at the upgrade height
+func (cfg *Config) UpgradeInfoFilePath() string {
+	return filepath.Join(cfg.DataPath(), upgradeInfoFileName)
+}
+
+// BackupPath is the directory where the backups of the data directory are kept
+func (cfg *Config) BackupPath() string {
+	if cfg.BackupDir != "" {
+		return cfg.BackupDir
+	}
+	return filepath.Join(cfg.Root(), backupsDir)
+}
+
+// UpgradeStatePath is the path to the file recording the state of the last upgrade
+func (cfg *Config) UpgradeStatePath() string {
+	return filepath.Join(cfg.Root(), upgradeStateFile)
+}
+
 // Symlink to genesis
 func (cfg *Config) SymLinkToGenesis() (string, error) {
 	genesis := filepath.Join(cfg.Root(), genesisDir)
@@ -102,6 +160,45 @@ func GetConfigFromEnv() (*Config, error) {
 		cfg.RestartAfterUpgrade = true
 	}
 
+	cfg.DataDir = os.Getenv("DAEMON_DATA_DIR")
+
+	cfg.PollInterval = defaultPollInterval
+	if pollIntervalStr := os.Getenv("DAEMON_POLL_INTERVAL"); pollIntervalStr != "" {
+		pollInterval, err := time.ParseDuration(pollIntervalStr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid DAEMON_POLL_INTERVAL: %w", err)
+		}
+		if pollInterval <= 0 {
+			return nil, errors.New("DAEMON_POLL_INTERVAL must be positive")
+		}
+		cfg.PollInterval = pollInterval
+	}
+
+	if os.Getenv("DAEMON_BACKUP_DATA") == "true" {
+		cfg.BackupData = true
+	}
+	cfg.BackupDir = os.Getenv("DAEMON_BACKUP_DIR")
+	cfg.BackupCompression = os.Getenv("DAEMON_BACKUP_COMPRESSION")
+	cfg.BackupKeep = 1
+	if backupKeepStr := os.Getenv("DAEMON_BACKUP_KEEP"); backupKeepStr != "" {
+		backupKeep, err := strconv.Atoi(backupKeepStr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid DAEMON_BACKUP_KEEP: %w", err)
+		}
+		cfg.BackupKeep = backupKeep
+	}
+	cfg.PreUpgradeCmd = os.Getenv("DAEMON_PRE_UPGRADE_CMD")
+	if rollbackBlocksStr := os.Getenv("DAEMON_ROLLBACK_BLOCKS"); rollbackBlocksStr != "" {
+		rollbackBlocks, err := strconv.ParseInt(rollbackBlocksStr, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid DAEMON_ROLLBACK_BLOCKS: %w", err)
+		}
+		cfg.RollbackBlocks = rollbackBlocks
+	}
+	cfg.RPCAddress = os.Getenv("DAEMON_RPC_ADDRESS")
+	cfg.ManifestDir = os.Getenv("DAEMON_MANIFEST_DIR")
+	cfg.TrustedKeysFile = os.Getenv("DAEMON_TRUSTED_KEYS")
+
 	logBufferSizeStr := os.Getenv("DAEMON_LOG_BUFFER_SIZE")
 	if logBufferSizeStr != "" {
 		logBufferSize, err := strconv.Atoi(logBufferSizeStr)
@@ -136,6 +233,40 @@ func (cfg *Config) validate() error {
 		return errors.New("DAEMON_HOME must be an absolute path")
 	}
 
+	if cfg.DataDir != "" && !filepath.IsAbs(cfg.DataDir) {
+		return errors.New("DAEMON_DATA_DIR must be an absolute path")
+	}
+
+	if cfg.BackupDir != "" && !filepath.IsAbs(cfg.BackupDir) {
+		return errors.New("DAEMON_BACKUP_DIR must be an absolute path")
+	}
+
+	switch cfg.BackupCompression {
+	case "", compressionNone, compressionGzip:
+	default:
+		return fmt.Errorf("DAEMON_BACKUP_COMPRESSION must be either %s or %s", compressionNone, compressionGzip)
+	}
+
+	if cfg.BackupKeep < 0 {
+		return errors.New("DAEMON_BACKUP_KEEP must not be negative")
+	}
+
+	if cfg.RollbackBlocks < 0 {
+		return errors.New("DAEMON_ROLLBACK_BLOCKS must not be negative")
+	}
+
+	if cfg.RollbackBlocks > 0 && !cfg.BackupData {
+		return errors.New("DAEMON_ROLLBACK_BLOCKS requires DAEMON_BACKUP_DATA")
+	}
+
+	if cfg.ManifestDir != "" && !filepath.IsAbs(cfg.ManifestDir) {
+		return errors.New("DAEMON_MANIFEST_DIR must be an absolute path")
+	}
+
+	if cfg.TrustedKeysFile != "" && !filepath.IsAbs(cfg.TrustedKeysFile) {
+		return errors.New("DAEMON_TRUSTED_KEYS must be an absolute path")
+	}
+
 	// ensure the root directory exists
 	info, err := os.Stat(cfg.Root())
 	if err != nil {
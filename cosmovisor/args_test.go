@@ -88,6 +88,42 @@ func (s *argsTestSuite) TestValidate() {
 			cfg:   Config{Home: filepath.FromSlash("/no/such/dir"), Name: "bind"},
 			valid: false,
 		},
+		"happy with rollback": {
+			cfg:   Config{Home: absPath, Name: "bind", BackupData: true, BackupCompression: "gzip", BackupKeep: 2, RollbackBlocks: 10},
+			valid: true,
+		},
+		"rollback without backup": {
+			cfg:   Config{Home: absPath, Name: "bind", RollbackBlocks: 10},
+			valid: false,
+		},
+		"unknown compression": {
+			cfg:   Config{Home: absPath, Name: "bind", BackupData: true, BackupCompression: "zstd"},
+			valid: false,
+		},
+		"negative backup keep": {
+			cfg:   Config{Home: absPath, Name: "bind", BackupData: true, BackupKeep: -1},
+			valid: false,
+		},
+		"relative data dir": {
+			cfg:   Config{Home: absPath, Name: "bind", DataDir: "data"},
+			valid: false,
+		},
+		"relative backup dir": {
+			cfg:   Config{Home: absPath, Name: "bind", BackupData: true, BackupDir: "backups"},
+			valid: false,
+		},
+		"happy with manifests": {
+			cfg:   Config{Home: absPath, Name: "bind", ManifestDir: filepath.FromSlash("/manifests"), TrustedKeysFile: filepath.FromSlash("/trusted-keys")},
+			valid: true,
+		},
+		"relative manifest dir": {
+			cfg:   Config{Home: absPath, Name: "bind", ManifestDir: "manifests"},
+			valid: false,
+		},
+		"relative trusted keys": {
+			cfg:   Config{Home: absPath, Name: "bind", TrustedKeysFile: "trusted-keys"},
+			valid: false,
+		},
 	}
 
 	for _, tc := range cases {
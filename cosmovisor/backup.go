@@ -0,0 +1,247 @@
+package cosmovisor
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/otiai10/copy"
+)
+
+const (
+	compressionNone = "none"
+	compressionGzip = "gzip"
+
+	backupPrefix    = "data-"
+	backupGzipExt   = ".tar.gz"
+	backupTimestamp = "20060102-150405.000000000"
+)
+
+// BackupData copies the data directory of the node into the backup directory before the named upgrade.
+// It returns the path to the backup, and removes the oldest backups beyond cfg.BackupKeep.
+func BackupData(cfg *Config, upgradeName string) (string, error) {
+	dataDir := cfg.DataPath()
+	info, err := os.Stat(dataDir)
+	if err != nil {
+		return "", fmt.Errorf("cannot stat data dir: %w", err)
+	}
+	if !info.IsDir() {
+		return "", fmt.Errorf("%s is not a directory", dataDir)
+	}
+
+	if err := os.MkdirAll(cfg.BackupPath(), 0o700); err != nil {
+		return "", fmt.Errorf("creating backup dir: %w", err)
+	}
+
+	// the timestamp comes first, so that the backups sort by age
+	name := backupPrefix + time.Now().UTC().Format(backupTimestamp) + "-" + url.PathEscape(upgradeName)
+	backup := filepath.Join(cfg.BackupPath(), name)
+	switch cfg.BackupCompression {
+	case compressionGzip:
+		backup += backupGzipExt
+		err = archiveDir(dataDir, backup)
+	default:
+		err = copy.Copy(dataDir, backup)
+	}
+	if err != nil {
+		os.RemoveAll(backup)
+		return "", fmt.Errorf("backing up %s: %w", dataDir, err)
+	}
+
+	if err := pruneBackups(cfg); err != nil {
+		return "", err
+	}
+	return backup, nil
+}
+
+// RestoreData replaces the data directory of the node with the backup
+func RestoreData(cfg *Config, backup string) error {
+	if _, err := os.Stat(backup); err != nil {
+		return fmt.Errorf("cannot stat backup: %w", err)
+	}
+
+	// restore next to the data directory first, so that a failure leaves the data directory intact
+	dataDir := filepath.Clean(cfg.DataPath())
+	restored := dataDir + ".restore"
+	if err := os.RemoveAll(restored); err != nil {
+		return err
+	}
+
+	var err error
+	if strings.HasSuffix(backup, backupGzipExt) {
+		err = extractArchive(backup, restored)
+	} else {
+		err = copy.Copy(backup, restored)
+	}
+	if err != nil {
+		os.RemoveAll(restored)
+		return fmt.Errorf("restoring %s: %w", backup, err)
+	}
+
+	if err := os.RemoveAll(dataDir); err != nil {
+		return fmt.Errorf("removing data dir: %w", err)
+	}
+	return os.Rename(restored, dataDir)
+}
+
+// pruneBackups removes the oldest backups beyond cfg.BackupKeep
+func pruneBackups(cfg *Config) error {
+	if cfg.BackupKeep == 0 {
+		return nil
+	}
+
+	entries, err := ioutil.ReadDir(cfg.BackupPath())
+	if err != nil {
+		return fmt.Errorf("reading backup dir: %w", err)
+	}
+	var backups []string
+	for _, entry := range entries {
+		if strings.HasPrefix(entry.Name(), backupPrefix) {
+			backups = append(backups, entry.Name())
+		}
+	}
+	sort.Strings(backups)
+
+	for len(backups) > cfg.BackupKeep {
+		if err := os.RemoveAll(filepath.Join(cfg.BackupPath(), backups[0])); err != nil {
+			return fmt.Errorf("removing old backup: %w", err)
+		}
+		backups = backups[1:]
+	}
+	return nil
+}
+
+// archiveDir writes the content of dir into a gzipped tarball
+func archiveDir(dir, filename string) (err error) {
+	file, err := os.OpenFile(filename, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if cerr := file.Close(); err == nil {
+			err = cerr
+		}
+	}()
+
+	zw := gzip.NewWriter(file)
+	tw := tar.NewWriter(zw)
+
+	err = filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(dir, path)
+		if err != nil || rel == "." {
+			return err
+		}
+
+		link := ""
+		if info.Mode()&os.ModeSymlink != 0 {
+			if link, err = os.Readlink(path); err != nil {
+				return err
+			}
+		}
+		header, err := tar.FileInfoHeader(info, link)
+		if err != nil {
+			return err
+		}
+		header.Name = filepath.ToSlash(rel)
+		if err := tw.WriteHeader(header); err != nil {
+			return err
+		}
+
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+		f, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		_, err = io.Copy(tw, f)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := tw.Close(); err != nil {
+		return err
+	}
+	return zw.Close()
+}
+
+// extractArchive extracts a gzipped tarball written by archiveDir into dir
+func extractArchive(filename, dir string) error {
+	file, err := os.Open(filename)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	zr, err := gzip.NewReader(file)
+	if err != nil {
+		return err
+	}
+	tr := tar.NewReader(zr)
+
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return err
+	}
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		// refuse the entries escaping the directory
+		path := filepath.Join(dir, filepath.FromSlash(header.Name))
+		if !strings.HasPrefix(path, filepath.Clean(dir)+string(os.PathSeparator)) {
+			return fmt.Errorf("invalid path in backup: %s", header.Name)
+		}
+
+		mode := os.FileMode(header.Mode).Perm()
+		switch header.Typeflag {
+		case tar.TypeDir:
+			if err := os.MkdirAll(path, mode); err != nil {
+				return err
+			}
+		case tar.TypeSymlink:
+			if err := os.Symlink(header.Linkname, path); err != nil {
+				return err
+			}
+		case tar.TypeReg:
+			if err := extractFile(tr, path, mode); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("unsupported entry in backup: %s", header.Name)
+		}
+	}
+}
+
+func extractFile(r io.Reader, path string, mode os.FileMode) (err error) {
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, mode)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if cerr := f.Close(); err == nil {
+			err = cerr
+		}
+	}()
+
+	_, err = io.Copy(f, r)
+	return err
+}
@@ -0,0 +1,67 @@
+package cosmovisor_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/line/lbm-sdk/cosmovisor"
+)
+
+func TestBackupAndRestoreData(t *testing.T) {
+	for _, compression := range []string{"none", "gzip"} {
+		t.Run(compression, func(t *testing.T) {
+			home := t.TempDir()
+			cfg := &cosmovisor.Config{Home: home, Name: "dummyd", BackupCompression: compression, BackupKeep: 2}
+			dataDir := cfg.DataPath()
+			require.NoError(t, os.MkdirAll(filepath.Join(dataDir, "application.db"), 0o700))
+			require.NoError(t, ioutil.WriteFile(filepath.Join(dataDir, "application.db", "000001.ldb"), []byte("state"), 0o600))
+			require.NoError(t, os.Symlink("application.db", filepath.Join(dataDir, "app.db")))
+
+			backup, err := cosmovisor.BackupData(cfg, "chain2")
+			require.NoError(t, err)
+			require.True(t, strings.HasPrefix(backup, cfg.BackupPath()))
+			if compression == "gzip" {
+				require.True(t, strings.HasSuffix(backup, ".tar.gz"))
+			}
+
+			// break the data and restore it
+			require.NoError(t, os.RemoveAll(filepath.Join(dataDir, "application.db")))
+			require.NoError(t, ioutil.WriteFile(filepath.Join(dataDir, "migrated"), []byte("broken"), 0o600))
+			require.NoError(t, cosmovisor.RestoreData(cfg, backup))
+
+			bz, err := ioutil.ReadFile(filepath.Join(dataDir, "app.db", "000001.ldb"))
+			require.NoError(t, err)
+			require.Equal(t, "state", string(bz))
+			_, err = os.Stat(filepath.Join(dataDir, "migrated"))
+			require.True(t, os.IsNotExist(err))
+
+			// the oldest backups beyond the retention are removed
+			var backups []string
+			for _, name := range []string{"chain3", "chain4"} {
+				backup, err := cosmovisor.BackupData(cfg, name)
+				require.NoError(t, err)
+				backups = append(backups, filepath.Base(backup))
+			}
+			entries, err := ioutil.ReadDir(cfg.BackupPath())
+			require.NoError(t, err)
+			var kept []string
+			for _, entry := range entries {
+				kept = append(kept, entry.Name())
+			}
+			require.Equal(t, backups, kept)
+		})
+	}
+}
+
+func TestBackupDataWithoutDataDir(t *testing.T) {
+	cfg := &cosmovisor.Config{Home: t.TempDir(), Name: "dummyd"}
+	_, err := cosmovisor.BackupData(cfg, "chain2")
+	require.Error(t, err)
+
+	require.Error(t, cosmovisor.RestoreData(cfg, filepath.Join(cfg.BackupPath(), "missing")))
+}
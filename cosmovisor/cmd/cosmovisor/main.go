@@ -14,6 +14,9 @@ func main() {
 	}
 }
 
+// prepareUpgradeCmd is the only argument handled by cosmovisor itself, all the others go to the binary
+const prepareUpgradeCmd = "prepare-upgrade"
+
 // Run is the main loop, but returns an error
 func Run(args []string) error {
 	cfg, err := cosmovisor.GetConfigFromEnv()
@@ -21,6 +24,10 @@ func Run(args []string) error {
 		return err
 	}
 
+	if len(args) > 0 && args[0] == prepareUpgradeCmd {
+		return PrepareUpgrade(cfg, args[1:])
+	}
+
 	doUpgrade, err := cosmovisor.LaunchProcess(cfg, args, os.Stdout, os.Stderr)
 	// if RestartAfterUpgrade, we launch after a successful upgrade (only condition LaunchProcess returns nil)
 	for cfg.RestartAfterUpgrade && err == nil && doUpgrade {
@@ -28,3 +35,21 @@ func Run(args []string) error {
 	}
 	return err
 }
+
+// PrepareUpgrade stages the binary of an upgrade from its manifest, given as
+// prepare-upgrade <name> [manifest-file]
+func PrepareUpgrade(cfg *cosmovisor.Config, args []string) error {
+	if len(args) < 1 || len(args) > 2 {
+		return fmt.Errorf("usage: cosmovisor %s <upgrade-name> [manifest-file]", prepareUpgradeCmd)
+	}
+
+	manifestPath := ""
+	if len(args) == 2 {
+		manifestPath = args[1]
+	}
+	if err := cosmovisor.PrepareUpgrade(cfg, args[0], manifestPath); err != nil {
+		return err
+	}
+	fmt.Printf("upgrade %s prepared: %s\n", args[0], cfg.UpgradeBin(args[0]))
+	return nil
+}
@@ -0,0 +1,364 @@
+package cosmovisor
+
+import (
+	"bufio"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// installRecordFile is kept in the upgrade directory by the binaries installed from a manifest,
+// so that the binary is verified again before switching to it
+const installRecordFile = "install-record.json"
+
+// UpgradeManifest lists the binaries of an upgrade per os/arch, to install them from a local directory
+type UpgradeManifest struct {
+	// Name is the upgrade name, checked against the upgrade if set
+	Name     string                    `json:"name,omitempty"`
+	Binaries map[string]ManifestBinary `json:"binaries"`
+}
+
+// ManifestBinary is a binary in an UpgradeManifest
+type ManifestBinary struct {
+	// Path is the path to the binary, relative to the manifest file
+	Path string `json:"path"`
+	// Checksum is the checksum of the binary, as sha256:<hex> or sha512:<hex>
+	Checksum string `json:"checksum"`
+	// Signature is the path to the detached ed25519 signature of the binary, relative to the manifest file
+	Signature string `json:"signature,omitempty"`
+}
+
+// installRecord is what was verified when installing a binary from a manifest
+type installRecord struct {
+	Checksum  string `json:"checksum"`
+	Signature string `json:"signature,omitempty"`
+}
+
+// ManifestPath is the manifest of the named upgrade in cfg.ManifestDir, empty if no directory is configured
+func (cfg *Config) ManifestPath(upgradeName string) string {
+	if cfg.ManifestDir == "" {
+		return ""
+	}
+	return filepath.Join(cfg.ManifestDir, url.PathEscape(upgradeName)+".json")
+}
+
+// ReadUpgradeManifest reads and validates a manifest file
+func ReadUpgradeManifest(filename string) (*UpgradeManifest, error) {
+	bz, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	var manifest UpgradeManifest
+	if err := json.Unmarshal(bz, &manifest); err != nil {
+		return nil, fmt.Errorf("invalid manifest %s: %w", filename, err)
+	}
+	if len(manifest.Binaries) == 0 {
+		return nil, fmt.Errorf("invalid manifest %s: no binaries", filename)
+	}
+	for osArch, bin := range manifest.Binaries {
+		if bin.Path == "" {
+			return nil, fmt.Errorf("invalid manifest %s: no path for %s", filename, osArch)
+		}
+		if _, _, err := parseChecksum(bin.Checksum); err != nil {
+			return nil, fmt.Errorf("invalid manifest %s: %s: %w", filename, osArch, err)
+		}
+	}
+	return &manifest, nil
+}
+
+// PrepareUpgrade stages the binary of the named upgrade from a manifest ahead of the upgrade,
+// defaulting to the manifest of the upgrade in cfg.ManifestDir. A binary already in place is
+// kept if it matches the manifest.
+func PrepareUpgrade(cfg *Config, upgradeName, manifestPath string) error {
+	if manifestPath == "" {
+		manifestPath = cfg.ManifestPath(upgradeName)
+	}
+	if manifestPath == "" {
+		return errors.New("no manifest given, and DAEMON_MANIFEST_DIR is not set")
+	}
+
+	src, record, err := cfg.manifestBinary(upgradeName, manifestPath)
+	if err != nil {
+		return err
+	}
+	binPath := cfg.UpgradeBin(upgradeName)
+	if err := EnsureBinary(binPath); err == nil {
+		if err := cfg.verifyBinary(binPath, record); err != nil {
+			return fmt.Errorf("binary of upgrade %s already exists, won't overwrite: %w", upgradeName, err)
+		}
+		return writeInstallRecord(cfg, upgradeName, record)
+	}
+	return cfg.installBinary(upgradeName, src, record)
+}
+
+// InstallFromManifest verifies the binary listed in the manifest for this os/arch and installs it
+// as the binary of the named upgrade. Nothing is installed unless the checksum, and the signature
+// if any, check out. Signatures are required if trusted keys are configured.
+func InstallFromManifest(cfg *Config, upgradeName, manifestPath string) error {
+	src, record, err := cfg.manifestBinary(upgradeName, manifestPath)
+	if err != nil {
+		return err
+	}
+	return cfg.installBinary(upgradeName, src, record)
+}
+
+// manifestBinary returns the path to the binary listed in the manifest for this os/arch,
+// with the checksum and signature to verify it against
+func (cfg *Config) manifestBinary(upgradeName, manifestPath string) (string, installRecord, error) {
+	manifest, err := ReadUpgradeManifest(manifestPath)
+	if err != nil {
+		return "", installRecord{}, err
+	}
+	if manifest.Name != "" && manifest.Name != upgradeName {
+		return "", installRecord{}, fmt.Errorf("manifest %s is for upgrade %q, not %q", manifestPath, manifest.Name, upgradeName)
+	}
+
+	bin, ok := manifest.Binaries[OSArch()]
+	if !ok {
+		bin, ok = manifest.Binaries["any"]
+	}
+	if !ok {
+		return "", installRecord{}, fmt.Errorf("cannot find binary for os/arch in %s: neither %s, nor any", manifestPath, OSArch())
+	}
+
+	baseDir := filepath.Dir(manifestPath)
+	record := installRecord{Checksum: bin.Checksum}
+	if bin.Signature != "" {
+		signature, err := readSignature(resolvePath(baseDir, bin.Signature))
+		if err != nil {
+			return "", installRecord{}, err
+		}
+		record.Signature = base64.StdEncoding.EncodeToString(signature)
+	}
+	return resolvePath(baseDir, bin.Path), record, nil
+}
+
+// installBinary verifies the binary and copies it into the upgrade directory
+func (cfg *Config) installBinary(upgradeName, src string, record installRecord) error {
+	if err := cfg.verifyBinary(src, record); err != nil {
+		return fmt.Errorf("%s: %w", src, err)
+	}
+
+	// copy next to the destination and verify the copy, so that only a verified binary is ever in place
+	binPath := cfg.UpgradeBin(upgradeName)
+	if err := os.MkdirAll(filepath.Dir(binPath), 0o755); err != nil {
+		return err
+	}
+	tmp := binPath + ".tmp"
+	if err := copyFile(src, tmp); err != nil {
+		os.Remove(tmp)
+		return fmt.Errorf("copying %s: %w", src, err)
+	}
+	if err := cfg.verifyBinary(tmp, record); err != nil {
+		os.Remove(tmp)
+		return fmt.Errorf("copied binary: %w", err)
+	}
+	if err := MarkExecutable(tmp); err != nil {
+		os.Remove(tmp)
+		return err
+	}
+
+	if err := writeInstallRecord(cfg, upgradeName, record); err != nil {
+		os.Remove(tmp)
+		return err
+	}
+	return os.Rename(tmp, binPath)
+}
+
+func writeInstallRecord(cfg *Config, upgradeName string, record installRecord) error {
+	bz, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(filepath.Join(cfg.UpgradeDir(upgradeName), installRecordFile), bz, 0o644)
+}
+
+// VerifyUpgradeBinary verifies the binary of the named upgrade against its manifest in cfg.ManifestDir,
+// if any, and against what was verified when it was installed from a manifest. The binaries of the
+// upgrades without a manifest, installed otherwise, are not verified.
+func VerifyUpgradeBinary(cfg *Config, upgradeName string) error {
+	if manifestPath := cfg.ManifestPath(upgradeName); manifestPath != "" {
+		if _, err := os.Stat(manifestPath); err == nil {
+			_, record, err := cfg.manifestBinary(upgradeName, manifestPath)
+			if err != nil {
+				return err
+			}
+			if err := cfg.verifyBinary(cfg.UpgradeBin(upgradeName), record); err != nil {
+				return fmt.Errorf("binary of upgrade %s does not match %s: %w", upgradeName, manifestPath, err)
+			}
+		}
+	}
+
+	bz, err := ioutil.ReadFile(filepath.Join(cfg.UpgradeDir(upgradeName), installRecordFile))
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	var record installRecord
+	if err := json.Unmarshal(bz, &record); err != nil {
+		return fmt.Errorf("invalid %s of upgrade %s: %w", installRecordFile, upgradeName, err)
+	}
+	if err := cfg.verifyBinary(cfg.UpgradeBin(upgradeName), record); err != nil {
+		return fmt.Errorf("binary of upgrade %s: %w", upgradeName, err)
+	}
+	return nil
+}
+
+// verifyBinary checks the checksum of the file, and its signature against the trusted keys
+func (cfg *Config) verifyBinary(path string, record installRecord) error {
+	algo, expected, err := parseChecksum(record.Checksum)
+	if err != nil {
+		return err
+	}
+	var h hash.Hash
+	switch algo {
+	case "sha256":
+		h = sha256.New()
+	default:
+		h = sha512.New()
+	}
+
+	bz, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	h.Write(bz)
+	if actual := h.Sum(nil); !strings.EqualFold(hex.EncodeToString(actual), expected) {
+		return fmt.Errorf("%s checksum mismatch: expected %s, got %x", algo, expected, actual)
+	}
+
+	if record.Signature == "" {
+		if cfg.TrustedKeysFile != "" {
+			return errors.New("signature required by the trusted keys, but none given")
+		}
+		return nil
+	}
+	if cfg.TrustedKeysFile == "" {
+		return errors.New("signature given, but no trusted keys to verify it")
+	}
+	signature, err := base64.StdEncoding.DecodeString(record.Signature)
+	if err != nil {
+		return fmt.Errorf("invalid signature: %w", err)
+	}
+	keys, err := readTrustedKeys(cfg.TrustedKeysFile)
+	if err != nil {
+		return err
+	}
+	for _, key := range keys {
+		if ed25519.Verify(key, bz, signature) {
+			return nil
+		}
+	}
+	return errors.New("signature not verified by any trusted key")
+}
+
+// parseChecksum splits a checksum given as sha256:<hex> or sha512:<hex>
+func parseChecksum(checksum string) (string, string, error) {
+	parts := strings.SplitN(checksum, ":", 2)
+	if len(parts) != 2 {
+		return "", "", fmt.Errorf("invalid checksum %q, expected <sha256|sha512>:<hex>", checksum)
+	}
+
+	algo, sum := parts[0], parts[1]
+	var size int
+	switch algo {
+	case "sha256":
+		size = sha256.Size
+	case "sha512":
+		size = sha512.Size
+	default:
+		return "", "", fmt.Errorf("unsupported checksum type %q, expected sha256 or sha512", algo)
+	}
+	if bz, err := hex.DecodeString(sum); err != nil || len(bz) != size {
+		return "", "", fmt.Errorf("invalid %s checksum %q", algo, sum)
+	}
+	return algo, sum, nil
+}
+
+// readSignature reads a detached ed25519 signature, encoded in base64
+func readSignature(filename string) ([]byte, error) {
+	bz, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	signature, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(bz)))
+	if err != nil || len(signature) != ed25519.SignatureSize {
+		return nil, fmt.Errorf("invalid signature %s, expected a base64 encoded ed25519 signature", filename)
+	}
+	return signature, nil
+}
+
+// readTrustedKeys reads the base64 encoded ed25519 public keys, one per line. Empty lines and
+// lines starting with # are ignored.
+func readTrustedKeys(filename string) ([]ed25519.PublicKey, error) {
+	file, err := os.Open(filename)
+	if err != nil {
+		return nil, fmt.Errorf("reading trusted keys: %w", err)
+	}
+	defer file.Close()
+
+	var keys []ed25519.PublicKey
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		key, err := base64.StdEncoding.DecodeString(line)
+		if err != nil || len(key) != ed25519.PublicKeySize {
+			return nil, fmt.Errorf("invalid trusted key %q, expected a base64 encoded ed25519 public key", line)
+		}
+		keys = append(keys, key)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("no trusted keys in %s", filename)
+	}
+	return keys, nil
+}
+
+func resolvePath(baseDir, path string) string {
+	if filepath.IsAbs(path) {
+		return path
+	}
+	return filepath.Join(baseDir, path)
+}
+
+func copyFile(src, dst string) (err error) {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	out, err := os.OpenFile(dst, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o755)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if cerr := out.Close(); err == nil {
+			err = cerr
+		}
+	}()
+
+	_, err = io.Copy(out, in)
+	return err
+}
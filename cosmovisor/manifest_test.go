@@ -0,0 +1,222 @@
+package cosmovisor_test
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/line/lbm-sdk/cosmovisor"
+)
+
+var manifestBinary = []byte("#!/bin/sh\necho Chain 4 is live!\n")
+
+// writeManifest writes the binary and its manifest for the named upgrade into dir,
+// signing the binary with key if not nil
+func writeManifest(t *testing.T, dir, upgradeName, checksum string, key ed25519.PrivateKey) string {
+	t.Helper()
+
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "bin"), 0o755))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "bin", "dummyd"), manifestBinary, 0o644))
+	bin := cosmovisor.ManifestBinary{Path: "bin/dummyd", Checksum: checksum}
+	if key != nil {
+		signature := base64.StdEncoding.EncodeToString(ed25519.Sign(key, manifestBinary))
+		require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "bin", "dummyd.sig"), []byte(signature+"\n"), 0o644))
+		bin.Signature = "bin/dummyd.sig"
+	}
+
+	bz, err := json.Marshal(cosmovisor.UpgradeManifest{
+		Name:     upgradeName,
+		Binaries: map[string]cosmovisor.ManifestBinary{cosmovisor.OSArch(): bin},
+	})
+	require.NoError(t, err)
+	manifestPath := filepath.Join(dir, upgradeName+".json")
+	require.NoError(t, ioutil.WriteFile(manifestPath, bz, 0o644))
+	return manifestPath
+}
+
+func writeTrustedKeys(t *testing.T, keys ...ed25519.PublicKey) string {
+	t.Helper()
+
+	content := "# release signers\n"
+	for _, key := range keys {
+		content += base64.StdEncoding.EncodeToString(key) + "\n"
+	}
+	filename := filepath.Join(t.TempDir(), "trusted-keys")
+	require.NoError(t, ioutil.WriteFile(filename, []byte(content), 0o644))
+	return filename
+}
+
+func TestPrepareUpgrade(t *testing.T) {
+	sha256sum := fmt.Sprintf("sha256:%x", sha256.Sum256(manifestBinary))
+	sha512sum := fmt.Sprintf("sha512:%x", sha512.Sum512(manifestBinary))
+
+	for _, checksum := range []string{sha256sum, sha512sum} {
+		home := copyTestData(t, "validate")
+		cfg := &cosmovisor.Config{Home: home, Name: "dummyd"}
+		manifestPath := writeManifest(t, t.TempDir(), "chain4", checksum, nil)
+
+		require.NoError(t, cosmovisor.PrepareUpgrade(cfg, "chain4", manifestPath))
+		require.NoError(t, cosmovisor.EnsureBinary(cfg.UpgradeBin("chain4")))
+		bz, err := ioutil.ReadFile(cfg.UpgradeBin("chain4"))
+		require.NoError(t, err)
+		require.Equal(t, manifestBinary, bz)
+
+		// preparing again keeps the verified binary
+		require.NoError(t, cosmovisor.PrepareUpgrade(cfg, "chain4", manifestPath))
+
+		require.NoError(t, cosmovisor.DoUpgrade(cfg, &cosmovisor.UpgradeInfo{Name: "chain4"}))
+		currentBin, err := cfg.CurrentBin()
+		require.NoError(t, err)
+		require.Equal(t, cfg.UpgradeBin("chain4"), currentBin)
+	}
+}
+
+func TestPrepareUpgradeInvalid(t *testing.T) {
+	checksum := fmt.Sprintf("sha256:%x", sha256.Sum256(manifestBinary))
+	home := copyTestData(t, "validate")
+	cfg := &cosmovisor.Config{Home: home, Name: "dummyd"}
+
+	// no manifest
+	require.Error(t, cosmovisor.PrepareUpgrade(cfg, "chain4", ""))
+
+	// invalid checksums
+	for _, invalid := range []string{"", "abcd", "md5:d41d8cd98f00b204e9800998ecf8427e", "sha256:abcd", fmt.Sprintf("sha256:%x", sha256.Sum256([]byte("other")))} {
+		manifestPath := writeManifest(t, t.TempDir(), "chain4", invalid, nil)
+		require.Error(t, cosmovisor.PrepareUpgrade(cfg, "chain4", manifestPath), invalid)
+		_, err := os.Stat(cfg.UpgradeBin("chain4"))
+		require.True(t, os.IsNotExist(err), invalid)
+	}
+
+	// manifest of another upgrade
+	manifestPath := writeManifest(t, t.TempDir(), "chain5", checksum, nil)
+	require.Error(t, cosmovisor.PrepareUpgrade(cfg, "chain4", manifestPath))
+
+	// a binary in place not matching the manifest is not overwritten
+	manifestPath = writeManifest(t, t.TempDir(), "chain2", checksum, nil)
+	require.Error(t, cosmovisor.PrepareUpgrade(cfg, "chain2", manifestPath))
+	bz, err := ioutil.ReadFile(cfg.UpgradeBin("chain2"))
+	require.NoError(t, err)
+	require.NotEqual(t, manifestBinary, bz)
+}
+
+func TestPrepareUpgradeSignatures(t *testing.T) {
+	checksum := fmt.Sprintf("sha256:%x", sha256.Sum256(manifestBinary))
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	otherPub, otherPriv, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	cases := map[string]struct {
+		key         ed25519.PrivateKey
+		trustedKeys []ed25519.PublicKey
+		valid       bool
+	}{
+		"signed by a trusted key": {priv, []ed25519.PublicKey{otherPub, pub}, true},
+		"signed by another key":   {otherPriv, []ed25519.PublicKey{pub}, false},
+		"not signed":              {nil, []ed25519.PublicKey{pub}, false},
+		"no trusted keys":         {priv, nil, false},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			home := copyTestData(t, "validate")
+			cfg := &cosmovisor.Config{Home: home, Name: "dummyd"}
+			if tc.trustedKeys != nil {
+				cfg.TrustedKeysFile = writeTrustedKeys(t, tc.trustedKeys...)
+			}
+			manifestPath := writeManifest(t, t.TempDir(), "chain4", checksum, tc.key)
+
+			err := cosmovisor.PrepareUpgrade(cfg, "chain4", manifestPath)
+			if !tc.valid {
+				require.Error(t, err)
+				_, err = os.Stat(cfg.UpgradeBin("chain4"))
+				require.True(t, os.IsNotExist(err))
+				return
+			}
+			require.NoError(t, err)
+			require.NoError(t, cosmovisor.VerifyUpgradeBinary(cfg, "chain4"))
+		})
+	}
+}
+
+func TestDoUpgradeFromManifestDir(t *testing.T) {
+	checksum := fmt.Sprintf("sha512:%x", sha512.Sum512(manifestBinary))
+	home := copyTestData(t, "validate")
+	cfg := &cosmovisor.Config{Home: home, Name: "dummyd", ManifestDir: t.TempDir()}
+	writeManifest(t, cfg.ManifestDir, "chain4", checksum, nil)
+
+	// the manifest is used without downloads allowed
+	require.NoError(t, cosmovisor.DoUpgrade(cfg, &cosmovisor.UpgradeInfo{Name: "chain4"}))
+	currentBin, err := cfg.CurrentBin()
+	require.NoError(t, err)
+	require.Equal(t, cfg.UpgradeBin("chain4"), currentBin)
+}
+
+func TestDoUpgradeTamperedBinary(t *testing.T) {
+	checksum := fmt.Sprintf("sha256:%x", sha256.Sum256(manifestBinary))
+	home := copyTestData(t, "validate")
+	cfg := &cosmovisor.Config{Home: home, Name: "dummyd"}
+	manifestPath := writeManifest(t, t.TempDir(), "chain4", checksum, nil)
+	require.NoError(t, cosmovisor.PrepareUpgrade(cfg, "chain4", manifestPath))
+
+	// the staged binary is changed before the upgrade
+	require.NoError(t, ioutil.WriteFile(cfg.UpgradeBin("chain4"), []byte("#!/bin/sh\necho tampered\n"), 0o755))
+	require.Error(t, cosmovisor.DoUpgrade(cfg, &cosmovisor.UpgradeInfo{Name: "chain4"}))
+	currentBin, err := cfg.CurrentBin()
+	require.NoError(t, err)
+	require.Equal(t, cfg.GenesisBin(), currentBin)
+}
+
+func TestDoUpgradeBinaryInPlaceWithManifest(t *testing.T) {
+	checksum := fmt.Sprintf("sha256:%x", sha256.Sum256(manifestBinary))
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	_, otherPriv, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	cases := map[string]struct {
+		binary []byte
+		key    ed25519.PrivateKey
+		valid  bool
+	}{
+		"matching binary":            {manifestBinary, priv, true},
+		"tampered binary":            {[]byte("#!/bin/sh\necho tampered\n"), priv, false},
+		"signed by an untrusted key": {manifestBinary, otherPriv, false},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			home := copyTestData(t, "validate")
+			cfg := &cosmovisor.Config{
+				Home:            home,
+				Name:            "dummyd",
+				ManifestDir:     t.TempDir(),
+				TrustedKeysFile: writeTrustedKeys(t, pub),
+			}
+			writeManifest(t, cfg.ManifestDir, "chain4", checksum, tc.key)
+
+			// the binary is put in place by hand, without an install record
+			require.NoError(t, os.MkdirAll(filepath.Dir(cfg.UpgradeBin("chain4")), 0o755))
+			require.NoError(t, ioutil.WriteFile(cfg.UpgradeBin("chain4"), tc.binary, 0o755))
+
+			err := cosmovisor.DoUpgrade(cfg, &cosmovisor.UpgradeInfo{Name: "chain4"})
+			currentBin, cerr := cfg.CurrentBin()
+			require.NoError(t, cerr)
+			if !tc.valid {
+				require.Error(t, err)
+				require.Equal(t, cfg.GenesisBin(), currentBin)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, cfg.UpgradeBin("chain4"), currentBin)
+		})
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"os/signal"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"syscall"
 )
 
@@ -25,6 +26,11 @@ func LaunchProcess(cfg *Config, args []string, stdout, stderr io.Writer) (bool,
 		return false, fmt.Errorf("current binary invalid: %w", err)
 	}
 
+	state, err := ReadUpgradeState(cfg)
+	if err != nil {
+		return false, err
+	}
+
 	cmd := exec.Command(bin, args...)
 	outpipe, err := cmd.StdoutPipe()
 	if err != nil {
@@ -54,17 +60,40 @@ func LaunchProcess(cfg *Config, args []string, stdout, stderr io.Writer) (bool,
 		return false, fmt.Errorf("launching process %s %s: %w", bin, strings.Join(args, " "), err)
 	}
 
+	// a process stopped by a signal has not crashed
+	var stopped int32
 	sigs := make(chan os.Signal, 1)
 	signal.Notify(sigs, syscall.SIGQUIT, syscall.SIGTERM)
 	go func() {
 		sig := <-sigs
+		atomic.StoreInt32(&stopped, 1)
 		if err := cmd.Process.Signal(sig); err != nil {
 			log.Fatal(err)
 		}
 	}()
 
-	// three ways to exit - command ends, find regexp in scanOut, find regexp in scanErr
-	upgradeInfo, err := WaitForUpgradeOrExit(cmd, scanOut, scanErr)
+	// the binary of a pending upgrade is on probation until it commits cfg.RollbackBlocks
+	var prob *probation
+	probDone := make(chan struct{})
+	probErr := make(chan error, 1)
+	if state != nil && state.Status == UpgradeStatusPending {
+		prob = newProbation(cfg, state)
+		go func() { probErr <- prob.run(probDone) }()
+	}
+
+	// four ways to exit - command ends, upgrade-info.json written, find regexp in scanOut, find regexp in scanErr
+	fw := NewFileWatcher(cfg.UpgradeInfoFilePath(), cfg.PollInterval)
+	upgradeInfo, err := WaitForUpgradeOrExit(cmd, fw, scanOut, scanErr)
+
+	if prob != nil {
+		close(probDone)
+		if perr := <-probErr; perr != nil {
+			return false, perr
+		}
+		if err != nil && upgradeInfo == nil && atomic.LoadInt32(&stopped) == 0 && !prob.isConfirmed() {
+			return false, Rollback(cfg, state, err)
+		}
+	}
 	if err != nil {
 		return false, err
 	}
@@ -115,17 +144,20 @@ func (u *WaitResult) SetUpgrade(up *UpgradeInfo) {
 	}
 }
 
-// WaitForUpgradeOrExit listens to both output streams of the process, as well as the process state itself
+// WaitForUpgradeOrExit watches the upgrade-info.json written by x/upgrade, listens to both output streams
+// of the process as a fallback, as well as the process state itself. The file watcher may be nil.
 // When it returns, the process is finished and all streams have closed.
 //
 // It returns (info, nil) if an upgrade should be initiated (and we killed the process)
 // It returns (nil, err) if the process died by itself, or there was an issue reading the pipes
 // It returns (nil, nil) if the process exited normally without triggering an upgrade. This is very unlikely
 // to happened with "start" but may happened with short-lived commands like `gaiad export ...`
-func WaitForUpgradeOrExit(cmd *exec.Cmd, scanOut, scanErr *bufio.Scanner) (*UpgradeInfo, error) {
+func WaitForUpgradeOrExit(cmd *exec.Cmd, fw *FileWatcher, scanOut, scanErr *bufio.Scanner) (*UpgradeInfo, error) {
 	var res WaitResult
 
+	var scanning sync.WaitGroup
 	waitScan := func(scan *bufio.Scanner) {
+		defer scanning.Done()
 		upgrade, err := WaitForUpdate(scan)
 		if err != nil {
 			res.SetError(err)
@@ -137,16 +169,44 @@ func WaitForUpgradeOrExit(cmd *exec.Cmd, scanOut, scanErr *bufio.Scanner) (*Upgr
 	}
 
 	// wait for the scanners, which can trigger upgrade and kill cmd
+	scanning.Add(2)
 	go waitScan(scanOut)
 	go waitScan(scanErr)
 
+	// poll the upgrade-info.json, which can trigger upgrade and kill cmd as well
+	done := make(chan struct{})
+	watched := make(chan struct{})
+	go func() {
+		defer close(watched)
+		if fw == nil {
+			return
+		}
+		upgrade, err := fw.WaitForUpdate(done)
+		if err != nil {
+			res.SetError(err)
+		} else if upgrade != nil {
+			res.SetUpgrade(upgrade)
+			_ = cmd.Process.Kill()
+		}
+	}()
+
 	// if the command exits normally (eg. short command like `gaiad version`), just return (nil, nil)
 	// we often get broken read pipes if it runs too fast.
 	// if we had upgrade info, we would have killed it, and thus got a non-nil error code
+	// the pipes must be read before waiting, as Wait closes them
+	scanning.Wait()
 	err := cmd.Wait()
+	close(done)
+	<-watched
 	if err == nil {
 		return nil, nil
 	}
+	// the node may exit right after writing upgrade-info.json, before the file was polled
+	if fw != nil {
+		if upgrade, _ := fw.CheckUpdate(); upgrade != nil {
+			res.SetUpgrade(upgrade)
+		}
+	}
 	// this will set the error code if it wasn't killed due to upgrade
 	res.SetError(err)
 	return res.AsResult()
@@ -4,7 +4,16 @@ package cosmovisor_test
 
 import (
 	"bytes"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 
@@ -112,3 +121,193 @@ func (s *processTestSuite) TestLaunchProcessWithDownloads() {
 	s.Require().NoError(err)
 	s.Require().Equal(cfg.UpgradeBin("chain3"), currentBin)
 }
+
+// TestLaunchProcessWithUpgradeInfoFile will run binaries halting with upgrade-info.json only and watch
+// upgrades work properly without the log messages
+func (s *processTestSuite) TestLaunchProcessWithUpgradeInfoFile() {
+	home := copyTestData(s.T(), "upgrade-file")
+	cfg := &cosmovisor.Config{Home: home, Name: "dummyd", PollInterval: 100 * time.Millisecond}
+
+	// the genesis binary keeps running after writing the file, until it is killed
+	var stdout, stderr bytes.Buffer
+	args := []string{"foo", "bar"}
+	doUpgrade, err := cosmovisor.LaunchProcess(cfg, args, &stdout, &stderr)
+	s.Require().NoError(err)
+	s.Require().True(doUpgrade)
+	s.Require().Equal("", stderr.String())
+	s.Require().Equal(`{"level":"info","module":"main","msg":"Genesis foo bar"}`+"\n", stdout.String())
+
+	currentBin, err := cfg.CurrentBin()
+	s.Require().NoError(err)
+	s.Require().Equal(cfg.UpgradeBin("chain2"), currentBin)
+
+	// chain2 exits right after writing the file
+	stdout.Reset()
+	stderr.Reset()
+	doUpgrade, err = cosmovisor.LaunchProcess(cfg, args, &stdout, &stderr)
+	s.Require().NoError(err)
+	s.Require().True(doUpgrade)
+	s.Require().Equal("Chain 2 is live!\nArgs: foo bar\n", stdout.String())
+
+	currentBin, err = cfg.CurrentBin()
+	s.Require().NoError(err)
+	s.Require().Equal(cfg.UpgradeBin("chain3"), currentBin)
+
+	// the file left by the upgrade to chain3 does not trigger another upgrade
+	stdout.Reset()
+	stderr.Reset()
+	doUpgrade, err = cosmovisor.LaunchProcess(cfg, args, &stdout, &stderr)
+	s.Require().NoError(err)
+	s.Require().False(doUpgrade)
+	s.Require().Equal("Chain 3 is live!\nArgs: foo bar\nFinished successfully\n", stdout.String())
+}
+
+// fakeRPC serves the status endpoint of a node at the given height
+func fakeRPC(t *testing.T, height *int64) string {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"result":{"sync_info":{"latest_block_height":"%d"}}}`, atomic.LoadInt64(height))
+	}))
+	t.Cleanup(srv.Close)
+	return srv.URL
+}
+
+// TestLaunchProcessWithRollback will upgrade to a binary crashing before committing enough blocks
+// and watch the backup and the previous binary restored
+func (s *processTestSuite) TestLaunchProcessWithRollback() {
+	for _, compression := range []string{"none", "gzip"} {
+		s.Run(compression, func() {
+			home := copyTestData(s.T(), "rollback")
+			height := int64(50)
+			hook := filepath.Join(home, "hook.sh")
+			hookOut := filepath.Join(home, "hook.out")
+			s.Require().NoError(ioutil.WriteFile(hook, []byte("#!/bin/sh\necho $1 $UPGRADE_NAME $UPGRADE_HEIGHT > "+hookOut+"\n"), 0o755))
+			cfg := &cosmovisor.Config{
+				Home:              home,
+				Name:              "dummyd",
+				PollInterval:      50 * time.Millisecond,
+				BackupData:        true,
+				BackupCompression: compression,
+				PreUpgradeCmd:     "'" + hook + "' 'quoted arg'",
+				RollbackBlocks:    5,
+				RPCAddress:        fakeRPC(s.T(), &height),
+			}
+
+			var stdout, stderr bytes.Buffer
+			doUpgrade, err := cosmovisor.LaunchProcess(cfg, nil, &stdout, &stderr)
+			s.Require().NoError(err)
+			s.Require().True(doUpgrade)
+
+			out, err := ioutil.ReadFile(hookOut)
+			s.Require().NoError(err)
+			s.Require().Equal("quoted arg chain2 49\n", string(out))
+
+			state, err := cosmovisor.ReadUpgradeState(cfg)
+			s.Require().NoError(err)
+			s.Require().Equal(cosmovisor.UpgradeStatusPending, state.Status)
+			s.Require().Equal(filepath.Join(cfg.Root(), "genesis"), state.PreviousDir)
+			_, err = os.Stat(state.Backup)
+			s.Require().NoError(err)
+
+			// chain2 crashes at height 50, before 49 + 5
+			stdout.Reset()
+			stderr.Reset()
+			doUpgrade, err = cosmovisor.LaunchProcess(cfg, nil, &stdout, &stderr)
+			s.Require().Error(err)
+			s.Require().Contains(err.Error(), "rolled back")
+			s.Require().False(doUpgrade)
+			s.Require().Equal("panic: chain2 is broken\n", stderr.String())
+
+			currentBin, err := cfg.CurrentBin()
+			s.Require().NoError(err)
+			s.Require().Equal(cfg.GenesisBin(), currentBin)
+			data, err := ioutil.ReadFile(filepath.Join(home, "data", "state.db"))
+			s.Require().NoError(err)
+			s.Require().Equal("genesis state\n", string(data))
+
+			state, err = cosmovisor.ReadUpgradeState(cfg)
+			s.Require().NoError(err)
+			s.Require().Equal(cosmovisor.UpgradeStatusRolledBack, state.Status)
+			s.Require().Equal(int64(50), state.LastHeight)
+			s.Require().Equal("exit status 1", state.Error)
+
+			history, err := ioutil.ReadFile(filepath.Join(cfg.Root(), "upgrade-history.jsonl"))
+			s.Require().NoError(err)
+			s.Require().Len(strings.Split(strings.TrimSpace(string(history)), "\n"), 2)
+
+			// the previous binary asks for the upgrade again, which is not retried
+			s.Require().NoError(os.Remove(hookOut))
+			_, err = cosmovisor.LaunchProcess(cfg, nil, &stdout, &stderr)
+			s.Require().Error(err)
+			s.Require().Contains(err.Error(), "was rolled back")
+			_, err = os.Stat(hookOut)
+			s.Require().True(os.IsNotExist(err))
+			currentBin, err = cfg.CurrentBin()
+			s.Require().NoError(err)
+			s.Require().Equal(cfg.GenesisBin(), currentBin)
+
+			// until the operator clears the state
+			s.Require().NoError(os.Remove(cfg.UpgradeStatePath()))
+			doUpgrade, err = cosmovisor.LaunchProcess(cfg, nil, &stdout, &stderr)
+			s.Require().NoError(err)
+			s.Require().True(doUpgrade)
+			currentBin, err = cfg.CurrentBin()
+			s.Require().NoError(err)
+			s.Require().Equal(cfg.UpgradeBin("chain2"), currentBin)
+		})
+	}
+}
+
+// TestLaunchProcessConfirmedUpgrade will upgrade to a binary crashing after committing enough blocks
+// and watch it is not rolled back
+func (s *processTestSuite) TestLaunchProcessConfirmedUpgrade() {
+	home := copyTestData(s.T(), "rollback")
+	height := int64(54)
+	cfg := &cosmovisor.Config{
+		Home:           home,
+		Name:           "dummyd",
+		PollInterval:   50 * time.Millisecond,
+		BackupData:     true,
+		RollbackBlocks: 5,
+		RPCAddress:     fakeRPC(s.T(), &height),
+	}
+
+	var stdout, stderr bytes.Buffer
+	doUpgrade, err := cosmovisor.LaunchProcess(cfg, nil, &stdout, &stderr)
+	s.Require().NoError(err)
+	s.Require().True(doUpgrade)
+
+	doUpgrade, err = cosmovisor.LaunchProcess(cfg, nil, &stdout, &stderr)
+	s.Require().Error(err)
+	s.Require().NotContains(err.Error(), "rolled back")
+	s.Require().False(doUpgrade)
+
+	currentBin, err := cfg.CurrentBin()
+	s.Require().NoError(err)
+	s.Require().Equal(cfg.UpgradeBin("chain2"), currentBin)
+	data, err := ioutil.ReadFile(filepath.Join(home, "data", "state.db"))
+	s.Require().NoError(err)
+	s.Require().Equal("chain2 state\n", string(data))
+
+	state, err := cosmovisor.ReadUpgradeState(cfg)
+	s.Require().NoError(err)
+	s.Require().Equal(cosmovisor.UpgradeStatusConfirmed, state.Status)
+	s.Require().Equal(int64(54), state.LastHeight)
+}
+
+// TestLaunchProcessFailingHook will fail the pre-upgrade hook and watch the upgrade aborted
+func (s *processTestSuite) TestLaunchProcessFailingHook() {
+	home := copyTestData(s.T(), "rollback")
+	cfg := &cosmovisor.Config{Home: home, Name: "dummyd", PollInterval: 50 * time.Millisecond, PreUpgradeCmd: "false"}
+
+	var stdout, stderr bytes.Buffer
+	_, err := cosmovisor.LaunchProcess(cfg, nil, &stdout, &stderr)
+	s.Require().Error(err)
+	s.Require().Contains(err.Error(), "pre-upgrade hook")
+
+	currentBin, err := cfg.CurrentBin()
+	s.Require().NoError(err)
+	s.Require().Equal(cfg.GenesisBin(), currentBin)
+	state, err := cosmovisor.ReadUpgradeState(cfg)
+	s.Require().NoError(err)
+	s.Require().Nil(state)
+}
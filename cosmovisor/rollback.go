@@ -0,0 +1,243 @@
+package cosmovisor
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Upgrade statuses recorded in the upgrade state file
+const (
+	// UpgradeStatusApplied is an upgrade switched to without rollback
+	UpgradeStatusApplied = "applied"
+	// UpgradeStatusPending is an upgrade whose binary has not committed cfg.RollbackBlocks yet
+	UpgradeStatusPending = "pending"
+	// UpgradeStatusConfirmed is an upgrade whose binary has committed cfg.RollbackBlocks
+	UpgradeStatusConfirmed = "confirmed"
+	// UpgradeStatusRolledBack is an upgrade whose binary crashed, replaced by the backup and the previous binary
+	UpgradeStatusRolledBack = "rolled_back"
+	// UpgradeStatusRollbackFailed is an upgrade whose binary crashed, but could not be rolled back
+	UpgradeStatusRollbackFailed = "rollback_failed"
+)
+
+// UpgradeState records what happened to the last upgrade. It is kept in upgrade-state.json
+// in the cosmovisor directory, and every change is appended to upgrade-history.jsonl.
+type UpgradeState struct {
+	Name   string `json:"name"`
+	Height int64  `json:"height,omitempty"`
+	Status string `json:"status"`
+	// PreviousDir is the directory current linked to before the upgrade
+	PreviousDir string `json:"previous_dir"`
+	// Backup is the backup of the data directory taken before the upgrade
+	Backup string `json:"backup,omitempty"`
+	// LastHeight is the last height the upgraded binary was seen at
+	LastHeight int64     `json:"last_height,omitempty"`
+	Error      string    `json:"error,omitempty"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+// ReadUpgradeState reads the state of the last upgrade, nil if there was none
+func ReadUpgradeState(cfg *Config) (*UpgradeState, error) {
+	bz, err := ioutil.ReadFile(cfg.UpgradeStatePath())
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var state UpgradeState
+	if err := json.Unmarshal(bz, &state); err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", cfg.UpgradeStatePath(), err)
+	}
+	return &state, nil
+}
+
+// writeUpgradeState records the state and appends it to the history
+func writeUpgradeState(cfg *Config, state *UpgradeState) error {
+	state.UpdatedAt = time.Now().UTC()
+	bz, err := json.Marshal(state)
+	if err != nil {
+		return err
+	}
+
+	history, err := os.OpenFile(filepath.Join(cfg.Root(), upgradeHistoryFile), os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0o600)
+	if err != nil {
+		return err
+	}
+	_, err = history.Write(append(bz, '\n'))
+	if cerr := history.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return fmt.Errorf("writing upgrade history: %w", err)
+	}
+
+	// write then rename, so that the state file is never partially written
+	tmp := cfg.UpgradeStatePath() + ".tmp"
+	if err := ioutil.WriteFile(tmp, bz, 0o600); err != nil {
+		return fmt.Errorf("writing upgrade state: %w", err)
+	}
+	return os.Rename(tmp, cfg.UpgradeStatePath())
+}
+
+// RunPreUpgradeHook runs cfg.PreUpgradeCmd, if any, with the upgrade in the environment variables
+// UPGRADE_NAME, UPGRADE_HEIGHT and UPGRADE_INFO. It is run by sh -c, so that it may quote its arguments.
+// Its output goes to the output of cosmovisor.
+func RunPreUpgradeHook(cfg *Config, info *UpgradeInfo) error {
+	if strings.TrimSpace(cfg.PreUpgradeCmd) == "" {
+		return nil
+	}
+
+	cmd := exec.Command("sh", "-c", cfg.PreUpgradeCmd)
+	cmd.Env = append(os.Environ(),
+		"UPGRADE_NAME="+info.Name,
+		"UPGRADE_HEIGHT="+strconv.FormatInt(info.Height, 10),
+		"UPGRADE_INFO="+info.Info,
+	)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("pre-upgrade hook %s: %w", cfg.PreUpgradeCmd, err)
+	}
+	return nil
+}
+
+// Rollback restores the backup and the previous binary of the upgrade whose binary crashed
+func Rollback(cfg *Config, state *UpgradeState, cause error) error {
+	state.Error = cause.Error()
+	err := RestoreData(cfg, state.Backup)
+	if err == nil {
+		err = cfg.setCurrentLink(state.PreviousDir)
+	}
+	if err != nil {
+		state.Status = UpgradeStatusRollbackFailed
+		state.Error = fmt.Sprintf("%s; rollback: %s", state.Error, err)
+		if werr := writeUpgradeState(cfg, state); werr != nil {
+			return werr
+		}
+		return fmt.Errorf("upgrade %q crashed at height %d, rollback failed: %w", state.Name, state.LastHeight, err)
+	}
+
+	state.Status = UpgradeStatusRolledBack
+	if err := writeUpgradeState(cfg, state); err != nil {
+		return err
+	}
+	return fmt.Errorf("upgrade %q crashed at height %d, rolled back to %s: %w", state.Name, state.LastHeight, state.PreviousDir, cause)
+}
+
+// probation watches the height of the binary of a pending upgrade, until it has committed cfg.RollbackBlocks
+type probation struct {
+	cfg   *Config
+	state *UpgradeState
+	fetch func() (int64, error)
+
+	mutex     sync.Mutex
+	base      int64
+	confirmed bool
+}
+
+func newProbation(cfg *Config, state *UpgradeState) *probation {
+	client := &http.Client{Timeout: time.Second}
+	rpcAddress := cfg.RPCAddress
+	if rpcAddress == "" {
+		rpcAddress = defaultRPCAddress
+	}
+	return &probation{
+		cfg:   cfg,
+		state: state,
+		base:  state.Height,
+		fetch: func() (int64, error) { return fetchHeight(client, rpcAddress) },
+	}
+}
+
+// check records the height of the node, and confirms the upgrade once it has committed enough blocks
+func (p *probation) check() error {
+	height, err := p.fetch()
+	if err != nil || height == 0 {
+		// the node may not serve RPC yet
+		return nil
+	}
+
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	if p.confirmed || height <= p.state.LastHeight {
+		return nil
+	}
+	// without the upgrade height, count the blocks from the first height seen
+	if p.base == 0 {
+		p.base = height
+	}
+	p.state.LastHeight = height
+	if height < p.base+p.cfg.RollbackBlocks {
+		return nil
+	}
+
+	p.confirmed = true
+	p.state.Status = UpgradeStatusConfirmed
+	return writeUpgradeState(p.cfg, p.state)
+}
+
+// run checks the height until the upgrade is confirmed or done is closed
+func (p *probation) run(done <-chan struct{}) error {
+	interval := p.cfg.PollInterval
+	if interval <= 0 {
+		interval = defaultPollInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for !p.isConfirmed() {
+		select {
+		case <-done:
+			return nil
+		case <-ticker.C:
+			if err := p.check(); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (p *probation) isConfirmed() bool {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	return p.confirmed
+}
+
+// fetchHeight returns the latest block height from the status endpoint of the node RPC
+func fetchHeight(client *http.Client, rpcAddress string) (int64, error) {
+	resp, err := client.Get(strings.TrimSuffix(rpcAddress, "/") + "/status")
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return 0, fmt.Errorf("status: %s", resp.Status)
+	}
+
+	var status struct {
+		Result struct {
+			SyncInfo struct {
+				LatestBlockHeight string `json:"latest_block_height"`
+			} `json:"sync_info"`
+		} `json:"result"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&status); err != nil {
+		return 0, err
+	}
+	if status.Result.SyncInfo.LatestBlockHeight == "" {
+		return 0, errors.New("status: no latest block height")
+	}
+	return strconv.ParseInt(status.Result.SyncInfo.LatestBlockHeight, 10, 64)
+}
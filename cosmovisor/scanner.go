@@ -3,6 +3,7 @@ package cosmovisor
 import (
 	"bufio"
 	"regexp"
+	"strconv"
 )
 
 // Trim off whitespace around the info - match least greedy, grab as much space on both sides
@@ -20,6 +21,8 @@ var upgradeRegex = regexp.MustCompile(`UPGRADE "(.*)" NEEDED at ((height): (\d+)
 type UpgradeInfo struct {
 	Name string
 	Info string
+	// Height is the upgrade height, zero for the upgrades at a time
+	Height int64
 }
 
 // WaitForUpdate will listen to the scanner until a line matches upgradeRegexp.
@@ -31,9 +34,11 @@ func WaitForUpdate(scanner *bufio.Scanner) (*UpgradeInfo, error) {
 		line := scanner.Text()
 		if upgradeRegex.MatchString(line) {
 			subs := upgradeRegex.FindStringSubmatch(line)
+			height, _ := strconv.ParseInt(subs[4], 10, 64)
 			info := UpgradeInfo{
-				Name: subs[1],
-				Info: subs[7],
+				Name:   subs[1],
+				Info:   subs[7],
+				Height: height,
 			}
 			return &info, nil
 		}
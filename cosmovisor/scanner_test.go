@@ -22,15 +22,24 @@ func TestWaitForInfo(t *testing.T) {
 		"match name with no info": {
 			write: []string{"first line\n", `UPGRADE "myname" NEEDED at height: 123: `, "\nnext line\n"},
 			expectUpgrade: &cosmovisor.UpgradeInfo{
-				Name: "myname",
-				Info: "",
+				Name:   "myname",
+				Info:   "",
+				Height: 123,
 			},
 		},
 		"match name with info": {
 			write: []string{"first line\n", `UPGRADE "take2" NEEDED at height: 123:   DownloadData here!`, "\nnext line\n"},
 			expectUpgrade: &cosmovisor.UpgradeInfo{
-				Name: "take2",
-				Info: "DownloadData",
+				Name:   "take2",
+				Info:   "DownloadData",
+				Height: 123,
+			},
+		},
+		"match time": {
+			write: []string{`UPGRADE "take3" NEEDED at time: 2021-01-02T03:04:05Z: {}`, "\n"},
+			expectUpgrade: &cosmovisor.UpgradeInfo{
+				Name: "take3",
+				Info: "{}",
 			},
 		},
 	}
@@ -17,14 +17,73 @@ import (
 
 // DoUpgrade will be called after the log message has been parsed and the process has terminated.
 // We can now make any changes to the underlying directory without interference and leave it
-// in a state, so we can make a proper restart
+// in a state, so we can make a proper restart.
+// It runs the pre-upgrade hook first, and backs up the data directory before switching binaries
+// if configured so. An upgrade rolled back is not retried until its state is cleared.
 func DoUpgrade(cfg *Config, info *UpgradeInfo) error {
-	// Simplest case is to switch the link
+	state, err := ReadUpgradeState(cfg)
+	if err != nil {
+		return err
+	}
+	if state != nil && state.Name == info.Name && state.Status == UpgradeStatusRolledBack {
+		return fmt.Errorf("upgrade %q was rolled back, fix its binary and remove %s to retry it", info.Name, cfg.UpgradeStatePath())
+	}
+
+	if err := RunPreUpgradeHook(cfg, info); err != nil {
+		return err
+	}
+
+	if err := ensureUpgradeBinary(cfg, info); err != nil {
+		return err
+	}
+
+	// remember the current binary to roll back to
+	previous, err := cfg.currentDir()
+	if err != nil {
+		return err
+	}
+
+	state = &UpgradeState{
+		Name:        info.Name,
+		Height:      info.Height,
+		Status:      UpgradeStatusApplied,
+		PreviousDir: previous,
+	}
+	if cfg.BackupData {
+		if state.Backup, err = BackupData(cfg, info.Name); err != nil {
+			return err
+		}
+	}
+	if cfg.RollbackBlocks > 0 {
+		state.Status = UpgradeStatusPending
+	}
+
+	if err := cfg.SetCurrentUpgrade(info.Name); err != nil {
+		return err
+	}
+	return writeUpgradeState(cfg, state)
+}
+
+// ensureUpgradeBinary checks the binary of the upgrade is in place, installing it from its manifest
+// or downloading it if allowed. The binaries installed from a manifest are verified again here,
+// before switching to them.
+func ensureUpgradeBinary(cfg *Config, info *UpgradeInfo) error {
+	// Simplest case is the binary in place
 	err := EnsureBinary(cfg.UpgradeBin(info.Name))
 	if err == nil {
-		// we have the binary - do it
-		return cfg.SetCurrentUpgrade(info.Name)
+		return VerifyUpgradeBinary(cfg, info.Name)
 	}
+
+	// then the manifest of the upgrade, if any
+	if manifestPath := cfg.ManifestPath(info.Name); manifestPath != "" {
+		if _, serr := os.Stat(manifestPath); serr == nil {
+			if err := InstallFromManifest(cfg, info.Name, manifestPath); err != nil {
+				return fmt.Errorf("cannot install binary from manifest: %w", err)
+			}
+			return EnsureBinary(cfg.UpgradeBin(info.Name))
+		}
+	}
+
 	// if auto-download is disabled, we fail
 	if !cfg.AllowDownloadBinaries {
 		return fmt.Errorf("binary not present, downloading disabled: %w", err)
@@ -40,12 +99,11 @@ func DoUpgrade(cfg *Config, info *UpgradeInfo) error {
 		return fmt.Errorf("cannot download binary: %w", err)
 	}
 
-	// and then set the binary again
+	// and then check the binary again
 	if err := EnsureBinary(cfg.UpgradeBin(info.Name)); err != nil {
 		return fmt.Errorf("downloaded binary doesn't check out: %w", err)
 	}
-
-	return cfg.SetCurrentUpgrade(info.Name)
+	return nil
 }
 
 // DownloadBinary will grab the binary and place it in the proper directory
@@ -156,24 +214,37 @@ func (cfg *Config) SetCurrentUpgrade(upgradeName string) error {
 		return err
 	}
 
-	// set a symbolic link
-	link := filepath.Join(cfg.Root(), currentLink)
 	safeName := url.PathEscape(upgradeName)
-	upgrade := filepath.Join(cfg.Root(), upgradesDir, safeName)
+	return cfg.setCurrentLink(filepath.Join(cfg.Root(), upgradesDir, safeName))
+}
+
+// setCurrentLink points the current link to the directory of a binary
+func (cfg *Config) setCurrentLink(dir string) error {
+	link := filepath.Join(cfg.Root(), currentLink)
 
 	// remove link if it exists
-	if _, err := os.Stat(link); err == nil {
+	if _, err := os.Lstat(link); err == nil {
 		os.Remove(link)
 	}
 
 	// point to the new directory
-	if err := os.Symlink(upgrade, link); err != nil {
+	if err := os.Symlink(dir, link); err != nil {
 		return fmt.Errorf("creating current symlink: %w", err)
 	}
 
 	return nil
 }
 
+// currentDir is the directory the current link points to
+func (cfg *Config) currentDir() (string, error) {
+	bin, err := cfg.CurrentBin()
+	if err != nil {
+		return "", err
+	}
+	// strip bin/<name>
+	return filepath.Dir(filepath.Dir(bin)), nil
+}
+
 // EnsureBinary ensures the file exists and is executable, or returns an error
 func EnsureBinary(path string) error {
 	info, err := os.Stat(path)
@@ -0,0 +1,113 @@
+package cosmovisor
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"time"
+)
+
+const (
+	// upgradeInfoFileName is the file x/upgrade writes in the data directory at the upgrade height
+	upgradeInfoFileName = "upgrade-info.json"
+
+	defaultPollInterval = 300 * time.Millisecond
+)
+
+// upgradeInfoFile is the content of upgrade-info.json
+// Defined here: x/upgrade/keeper/keeper.go DumpUpgradeInfoWithInfoToDisk
+type upgradeInfoFile struct {
+	Name   string `json:"name"`
+	Height int64  `json:"height"`
+	Info   string `json:"info"`
+}
+
+// ParseUpgradeInfoFile reads the upgrade info from upgrade-info.json
+func ParseUpgradeInfoFile(filename string) (*UpgradeInfo, error) {
+	bz, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	var file upgradeInfoFile
+	if err := json.Unmarshal(bz, &file); err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", filename, err)
+	}
+	if file.Name == "" {
+		return nil, fmt.Errorf("invalid %s: upgrade name is empty", filename)
+	}
+
+	return &UpgradeInfo{
+		Name:   file.Name,
+		Info:   file.Info,
+		Height: file.Height,
+	}, nil
+}
+
+// FileWatcher polls upgrade-info.json for the upgrade the node halted at.
+// The file left by a past upgrade is ignored, the watcher only reports a file written after it started.
+type FileWatcher struct {
+	filename    string
+	interval    time.Duration
+	lastModTime time.Time
+}
+
+// NewFileWatcher creates a watcher of the given upgrade-info.json.
+// A non-positive interval falls back to the default.
+func NewFileWatcher(filename string, interval time.Duration) *FileWatcher {
+	if interval <= 0 {
+		interval = defaultPollInterval
+	}
+
+	fw := &FileWatcher{
+		filename: filename,
+		interval: interval,
+	}
+	if stat, err := os.Stat(filename); err == nil {
+		fw.lastModTime = stat.ModTime()
+	}
+	return fw
+}
+
+// CheckUpdate returns the upgrade info if the file has been written since the last check.
+// It returns (nil, nil) if there is no new upgrade, including when the file is being written
+// and can not be parsed yet, so that it is read again on the next check.
+func (fw *FileWatcher) CheckUpdate() (*UpgradeInfo, error) {
+	stat, err := os.Stat(fw.filename)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if stat.ModTime().Equal(fw.lastModTime) {
+		return nil, nil
+	}
+
+	info, err := ParseUpgradeInfoFile(fw.filename)
+	if err != nil {
+		return nil, nil
+	}
+	fw.lastModTime = stat.ModTime()
+	return info, nil
+}
+
+// WaitForUpdate polls the file until it reports an upgrade or done is closed.
+// It returns (nil, nil) if done was closed without an upgrade.
+func (fw *FileWatcher) WaitForUpdate(done <-chan struct{}) (*UpgradeInfo, error) {
+	ticker := time.NewTicker(fw.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-done:
+			return nil, nil
+		case <-ticker.C:
+			info, err := fw.CheckUpdate()
+			if err != nil || info != nil {
+				return info, err
+			}
+		}
+	}
+}
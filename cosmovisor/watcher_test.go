@@ -0,0 +1,112 @@
+package cosmovisor_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/line/lbm-sdk/cosmovisor"
+)
+
+func TestParseUpgradeInfoFile(t *testing.T) {
+	cases := map[string]struct {
+		content       string
+		expectUpgrade *cosmovisor.UpgradeInfo
+		expectErr     bool
+	}{
+		"name with no info": {
+			content:       `{"name":"myname","height":123}`,
+			expectUpgrade: &cosmovisor.UpgradeInfo{Name: "myname", Height: 123},
+		},
+		"name with info": {
+			content:       `{"name":"take2","height":123,"info":"{\"binaries\":{}}"}`,
+			expectUpgrade: &cosmovisor.UpgradeInfo{Name: "take2", Info: `{"binaries":{}}`, Height: 123},
+		},
+		"no name": {
+			content:   `{"height":123}`,
+			expectErr: true,
+		},
+		"partially written": {
+			content:   `{"name":"take2","hei`,
+			expectErr: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			filename := filepath.Join(t.TempDir(), "upgrade-info.json")
+			require.NoError(t, ioutil.WriteFile(filename, []byte(tc.content), 0o600))
+
+			info, err := cosmovisor.ParseUpgradeInfoFile(filename)
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expectUpgrade, info)
+		})
+	}
+}
+
+func TestFileWatcher(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "upgrade-info.json")
+	write := func(content string, modTime time.Time) {
+		require.NoError(t, ioutil.WriteFile(filename, []byte(content), 0o600))
+		require.NoError(t, os.Chtimes(filename, modTime, modTime))
+	}
+	now := time.Now()
+
+	// the file of a past upgrade is ignored
+	write(`{"name":"past","height":10}`, now)
+	fw := cosmovisor.NewFileWatcher(filename, time.Millisecond)
+	info, err := fw.CheckUpdate()
+	require.NoError(t, err)
+	require.Nil(t, info)
+
+	// the file being written is read again on the next check
+	write(`{"name":"next","hei`, now.Add(time.Second))
+	info, err = fw.CheckUpdate()
+	require.NoError(t, err)
+	require.Nil(t, info)
+
+	write(`{"name":"next","height":20}`, now.Add(2*time.Second))
+	info, err = fw.CheckUpdate()
+	require.NoError(t, err)
+	require.Equal(t, &cosmovisor.UpgradeInfo{Name: "next", Height: 20}, info)
+
+	// reported once
+	info, err = fw.CheckUpdate()
+	require.NoError(t, err)
+	require.Nil(t, info)
+
+	// polling stops on done
+	done := make(chan struct{})
+	close(done)
+	info, err = fw.WaitForUpdate(done)
+	require.NoError(t, err)
+	require.Nil(t, info)
+
+	// polling returns the upgrade
+	write(`{"name":"last","height":30}`, now.Add(3*time.Second))
+	info, err = fw.WaitForUpdate(make(chan struct{}))
+	require.NoError(t, err)
+	require.Equal(t, &cosmovisor.UpgradeInfo{Name: "last", Height: 30}, info)
+}
+
+func TestFileWatcherMissingFile(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "data", "upgrade-info.json")
+	fw := cosmovisor.NewFileWatcher(filename, 0)
+	info, err := fw.CheckUpdate()
+	require.NoError(t, err)
+	require.Nil(t, info)
+
+	require.NoError(t, os.MkdirAll(filepath.Dir(filename), 0o700))
+	require.NoError(t, ioutil.WriteFile(filename, []byte(`{"name":"first","height":1}`), 0o600))
+	info, err = fw.CheckUpdate()
+	require.NoError(t, err)
+	require.Equal(t, &cosmovisor.UpgradeInfo{Name: "first", Height: 1}, info)
+}
@@ -1,9 +1,12 @@
 package hd
 
 import (
+	"errors"
+
 	bip39 "github.com/cosmos/go-bip39"
 
 	"github.com/line/lbm-sdk/crypto/keys/secp256k1"
+	"github.com/line/lbm-sdk/crypto/keys/secp256r1"
 	"github.com/line/lbm-sdk/crypto/types"
 )
 
@@ -20,11 +23,16 @@ const (
 	Ed25519Type = PubKeyType("ed25519")
 	// Sr25519Type represents the Sr25519Type signature system.
 	Sr25519Type = PubKeyType("sr25519")
+	// Secp256r1Type uses the NIST P-256 ECDSA parameters.
+	// It is currently only supported for Ledger keys.
+	Secp256r1Type = PubKeyType("secp256r1")
 )
 
 var (
 	// Secp256k1 uses the Bitcoin secp256k1 ECDSA parameters.
 	Secp256k1 = secp256k1Algo{}
+	// Secp256r1 uses the NIST P-256 ECDSA parameters.
+	Secp256r1 = secp256r1Algo{}
 )
 
 type DeriveFn func(mnemonic string, bip39Passphrase, hdPath string) ([]byte, error)
@@ -69,3 +77,25 @@ func (s secp256k1Algo) Generate() GenerateFn {
 		return &secp256k1.PrivKey{Key: bzArr}
 	}
 }
+
+type secp256r1Algo struct {
+}
+
+func (s secp256r1Algo) Name() PubKeyType {
+	return Secp256r1Type
+}
+
+// Derive returns an error, as secp256r1 keys are only derived on Ledger
+// devices.
+func (s secp256r1Algo) Derive() DeriveFn {
+	return func(mnemonic string, bip39Passphrase, hdPath string) ([]byte, error) {
+		return nil, errors.New("secp256r1 keys can only be derived on a Ledger device")
+	}
+}
+
+// Generate generates a secp256r1 private key from the given bytes.
+func (s secp256r1Algo) Generate() GenerateFn {
+	return func(bz []byte) types.PrivKey {
+		return secp256r1.NewPrivKeyFromSecret(bz)
+	}
+}
@@ -0,0 +1,111 @@
+package crypto
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	cryptotypes "github.com/line/lbm-sdk/crypto/types"
+)
+
+// JWK key types and curves of RFC 7518, RFC 8037 and RFC 8812.
+const (
+	jwkTypeEC  = "EC"
+	jwkTypeOKP = "OKP"
+
+	jwkCurveSecp256k1 = "secp256k1"
+	jwkCurveP256      = "P-256"
+	jwkCurveEd25519   = "Ed25519"
+)
+
+// jsonWebKey is a private JSON Web Key of RFC 7517.
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y,omitempty"`
+	D   string `json:"d"`
+}
+
+// MarshalJWKPrivKey returns the private key as an unencrypted JSON Web Key.
+func MarshalJWKPrivKey(privKey cryptotypes.PrivKey) ([]byte, error) {
+	ks, err := newKeySecret(privKey)
+	if err != nil {
+		return nil, err
+	}
+
+	b64 := base64.RawURLEncoding
+	jwk := jsonWebKey{D: b64.EncodeToString(ks.secret)}
+	switch ks.algo {
+	case algoEd25519:
+		jwk.Kty, jwk.Crv = jwkTypeOKP, jwkCurveEd25519
+		jwk.X = b64.EncodeToString(ks.publicKey())
+	default:
+		jwk.Kty, jwk.Crv = jwkTypeEC, jwkCurveSecp256k1
+		if ks.algo == algoSecp256r1 {
+			jwk.Crv = jwkCurveP256
+		}
+		x, y := ks.publicPoint()
+		size := len(ks.secret)
+		jwk.X = b64.EncodeToString(x.FillBytes(make([]byte, size)))
+		jwk.Y = b64.EncodeToString(y.FillBytes(make([]byte, size)))
+	}
+
+	return json.Marshal(jwk)
+}
+
+// UnmarshalJWKPrivKey returns the private key of an unencrypted JSON Web Key.
+// The public key in the JWK must be the one of the private key.
+func UnmarshalJWKPrivKey(bz []byte) (cryptotypes.PrivKey, error) {
+	var jwk jsonWebKey
+	if err := json.Unmarshal(bz, &jwk); err != nil {
+		return nil, err
+	}
+	if jwk.D == "" {
+		return nil, errors.New("the JWK has no private key")
+	}
+
+	var ks keySecret
+	switch {
+	case jwk.Kty == jwkTypeEC && jwk.Crv == jwkCurveSecp256k1:
+		ks.algo = algoSecp256k1
+	case jwk.Kty == jwkTypeEC && jwk.Crv == jwkCurveP256:
+		ks.algo = algoSecp256r1
+	case jwk.Kty == jwkTypeOKP && jwk.Crv == jwkCurveEd25519:
+		ks.algo = algoEd25519
+	default:
+		return nil, fmt.Errorf("unsupported JWK key type %s of curve %s", jwk.Kty, jwk.Crv)
+	}
+
+	b64 := base64.RawURLEncoding
+	var err error
+	if ks.secret, err = b64.DecodeString(jwk.D); err != nil {
+		return nil, err
+	}
+	privKey, err := ks.privKey()
+	if err != nil {
+		return nil, err
+	}
+
+	var pub [][]byte
+	if ks.algo == algoEd25519 {
+		pub = [][]byte{ks.publicKey()}
+	} else {
+		x, y := ks.publicPoint()
+		size := len(ks.secret)
+		pub = [][]byte{x.FillBytes(make([]byte, size)), y.FillBytes(make([]byte, size))}
+	}
+	for i, coord := range []string{jwk.X, jwk.Y}[:len(pub)] {
+		bz, err := b64.DecodeString(coord)
+		if err != nil {
+			return nil, err
+		}
+		if !bytes.Equal(bz, pub[i]) {
+			return nil, errors.New("the public key does not match the private key")
+		}
+	}
+
+	return privKey, nil
+}
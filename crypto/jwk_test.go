@@ -0,0 +1,76 @@
+package crypto_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/line/lbm-sdk/crypto"
+)
+
+func TestMarshalUnmarshalJWKPrivKey(t *testing.T) {
+	for _, priv := range standardFormatKeys(t) {
+		t.Run(priv.Type(), func(t *testing.T) {
+			bz, err := crypto.MarshalJWKPrivKey(priv)
+			require.NoError(t, err)
+
+			decoded, err := crypto.UnmarshalJWKPrivKey(bz)
+			require.NoError(t, err)
+			require.True(t, priv.Equals(decoded))
+
+			// the public key of another key must be rejected
+			other, err := crypto.MarshalJWKPrivKey(standardFormatKeys(t)[0])
+			require.NoError(t, err)
+			var jwk, otherJWK map[string]string
+			require.NoError(t, json.Unmarshal(bz, &jwk))
+			require.NoError(t, json.Unmarshal(other, &otherJWK))
+			jwk["x"] = otherJWK["x"]
+			bz, err = json.Marshal(jwk)
+			require.NoError(t, err)
+			_, err = crypto.UnmarshalJWKPrivKey(bz)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestUnmarshalJWKPrivKey(t *testing.T) {
+	testCases := []struct {
+		name   string
+		jwk    string
+		expErr bool
+	}{
+		{
+			// RFC 8037 Appendix A.1
+			"ed25519",
+			`{"kty":"OKP","crv":"Ed25519","d":"nWGxne_9WmC6hEr0kuwsxERJxWl7MmkZcDusAxyuf2A","x":"11qYAYKxCrfVS_7TyWQHOg7hcvPapiMlrwIaaPcHURo"}`,
+			false,
+		},
+		{
+			"public key only",
+			`{"kty":"OKP","crv":"Ed25519","x":"11qYAYKxCrfVS_7TyWQHOg7hcvPapiMlrwIaaPcHURo"}`,
+			true,
+		},
+		{
+			"unsupported curve",
+			`{"kty":"EC","crv":"P-384","d":"nWGxne_9WmC6hEr0kuwsxERJxWl7MmkZcDusAxyuf2A","x":"","y":""}`,
+			true,
+		},
+		{
+			"zero scalar",
+			`{"kty":"EC","crv":"secp256k1","d":"AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA","x":"","y":""}`,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := crypto.UnmarshalJWKPrivKey([]byte(tc.jwk))
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
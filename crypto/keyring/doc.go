@@ -43,4 +43,7 @@
 // 			be unlocked and it should be use only for testing purposes.
 // 	memory	Same instance as returned by NewInMemory. This backend uses a transient storage. Keys
 // 			are discarded when the process terminates or the type instance is garbage collected.
+// 	remote	Same instance as returned by NewRemote. This backend looks up the keys and signs with
+// 			them on a remote signer over gRPC, configured by keyring-remote/config.json within
+// 			the app's configuration directory. The keys are managed on the signer.
 package keyring
@@ -32,6 +32,7 @@ var (
 	_ Info = &ledgerInfo{}
 	_ Info = &offlineInfo{}
 	_ Info = &multiInfo{}
+	_ Info = &remoteInfo{}
 )
 
 // localInfo is the public information about a locally stored key
@@ -177,6 +178,51 @@ func (i offlineInfo) GetPath() (*hd.BIP44Params, error) {
 	return nil, fmt.Errorf("BIP44 Paths are not available for this type")
 }
 
+// remoteInfo is the public information about a key held by a remote signer
+type remoteInfo struct {
+	Name   string             `json:"name"`
+	PubKey cryptotypes.PubKey `json:"pubkey"`
+	Algo   hd.PubKeyType      `json:"algo"`
+}
+
+func newRemoteInfo(name string, pub cryptotypes.PubKey, algo hd.PubKeyType) Info {
+	return &remoteInfo{
+		Name:   name,
+		PubKey: pub,
+		Algo:   algo,
+	}
+}
+
+// GetType implements Info interface
+func (i remoteInfo) GetType() KeyType {
+	return TypeRemote
+}
+
+// GetName implements Info interface
+func (i remoteInfo) GetName() string {
+	return i.Name
+}
+
+// GetPubKey implements Info interface
+func (i remoteInfo) GetPubKey() cryptotypes.PubKey {
+	return i.PubKey
+}
+
+// GetAlgo returns the signing algorithm for the key
+func (i remoteInfo) GetAlgo() hd.PubKeyType {
+	return i.Algo
+}
+
+// GetAddress implements Info interface
+func (i remoteInfo) GetAddress() types.AccAddress {
+	return i.PubKey.Address().Bytes()
+}
+
+// GetPath implements Info interface
+func (i remoteInfo) GetPath() (*hd.BIP44Params, error) {
+	return nil, fmt.Errorf("BIP44 Paths are not available for this type")
+}
+
 // Deprecated: this structure is not used anymore and it's here only to allow
 // decoding old multiInfo records from keyring.
 // The problem with legacy.Cdc.UnmarshalLengthPrefixed - the legacy codec doesn't
@@ -35,6 +35,7 @@ const (
 	BackendPass    = "pass"
 	BackendTest    = "test"
 	BackendMemory  = "memory"
+	BackendRemote  = "remote"
 )
 
 const (
@@ -114,6 +115,13 @@ type Importer interface {
 
 	// ImportPubKey imports ASCII armored public keys.
 	ImportPubKey(uid string, armor string) error
+
+	// ImportPrivKeyPKCS8 imports passphrase-encrypted PKCS#8 PEM private keys.
+	ImportPrivKeyPKCS8(uid, pem, passphrase string) error
+	// ImportPrivKeyKeystore imports private keys of Web3 JSON keystores.
+	ImportPrivKeyKeystore(uid string, keystore []byte, passphrase string) error
+	// ImportPrivKeyJWK imports unencrypted JSON Web Key private keys.
+	ImportPrivKeyJWK(uid string, jwk []byte) error
 }
 
 // LegacyInfoImporter is implemented by key stores that support import of Info types.
@@ -133,6 +141,11 @@ type Exporter interface {
 	// It returns an error if the key does not exist or a wrong encryption passphrase is supplied.
 	ExportPrivKeyArmor(uid, encryptPassphrase string) (armor string, err error)
 	ExportPrivKeyArmorByAddress(address sdk.Address, encryptPassphrase string) (armor string, err error)
+
+	// ExportPrivKeyPKCS8 returns a private key as a passphrase-encrypted PKCS#8 PEM.
+	ExportPrivKeyPKCS8(uid, encryptPassphrase string) (string, error)
+	// ExportPrivKeyKeystore returns a private key as a Web3 JSON keystore.
+	ExportPrivKeyKeystore(uid, encryptPassphrase string) ([]byte, error)
 }
 
 // UnsafeExporter is implemented by key stores that support unsafe export
@@ -140,6 +153,9 @@ type Exporter interface {
 type UnsafeExporter interface {
 	// UnsafeExportPrivKeyHex returns a private key in unarmored hex format
 	UnsafeExportPrivKeyHex(uid string) (string, error)
+
+	// UnsafeExportPrivKeyJWK returns a private key as an unencrypted JSON Web Key
+	UnsafeExportPrivKeyJWK(uid string) ([]byte, error)
 }
 
 // Option overrides keyring configuration options.
@@ -162,7 +178,7 @@ func NewInMemory(opts ...Option) Keyring {
 
 // New creates a new instance of a keyring.
 // Keyring ptions can be applied when generating the new instance.
-// Available backends are "os", "file", "kwallet", "memory", "pass", "test".
+// Available backends are "os", "file", "kwallet", "memory", "pass", "test", "remote".
 func New(
 	appName, backend, rootDir string, userInput io.Reader, opts ...Option,
 ) (Keyring, error) {
@@ -174,6 +190,12 @@ func New(
 	switch backend {
 	case BackendMemory:
 		return NewInMemory(opts...), err
+	case BackendRemote:
+		cfg, err := LoadRemoteSignerConfig(rootDir)
+		if err != nil {
+			return nil, err
+		}
+		return NewRemote(cfg, opts...)
 	case BackendTest:
 		db, err = keyring.Open(newTestBackendKeyringConfig(appName, rootDir))
 	case BackendFile:
@@ -207,7 +229,7 @@ func newKeystore(kr keyring.Keyring, opts ...Option) keystore {
 	// Default options for keybase
 	options := Options{
 		SupportedAlgos:       SigningAlgoList{hd.Secp256k1},
-		SupportedAlgosLedger: SigningAlgoList{hd.Secp256k1},
+		SupportedAlgosLedger: SigningAlgoList{hd.Secp256k1, hd.Secp256r1},
 	}
 
 	for _, optionFn := range opts {
@@ -308,6 +330,58 @@ func (ks keystore) ImportPrivKey(uid, armor, passphrase string) error {
 	return nil
 }
 
+func (ks keystore) ExportPrivKeyPKCS8(uid, encryptPassphrase string) (string, error) {
+	priv, err := ks.ExportPrivateKeyObject(uid)
+	if err != nil {
+		return "", err
+	}
+
+	return crypto.EncryptPKCS8PrivKey(priv, encryptPassphrase)
+}
+
+func (ks keystore) ExportPrivKeyKeystore(uid, encryptPassphrase string) ([]byte, error) {
+	priv, err := ks.ExportPrivateKeyObject(uid)
+	if err != nil {
+		return nil, err
+	}
+
+	return crypto.EncryptKeystorePrivKey(priv, encryptPassphrase)
+}
+
+func (ks keystore) ImportPrivKeyPKCS8(uid, pem, passphrase string) error {
+	return ks.importPrivKeyWith(uid, func() (types.PrivKey, error) {
+		return crypto.DecryptPKCS8PrivKey(pem, passphrase)
+	})
+}
+
+func (ks keystore) ImportPrivKeyKeystore(uid string, keystore []byte, passphrase string) error {
+	return ks.importPrivKeyWith(uid, func() (types.PrivKey, error) {
+		return crypto.DecryptKeystorePrivKey(keystore, passphrase)
+	})
+}
+
+func (ks keystore) ImportPrivKeyJWK(uid string, jwk []byte) error {
+	return ks.importPrivKeyWith(uid, func() (types.PrivKey, error) {
+		return crypto.UnmarshalJWKPrivKey(jwk)
+	})
+}
+
+// importPrivKeyWith saves the private key decoded by decode as a local key,
+// of the algorithm of the key type.
+func (ks keystore) importPrivKeyWith(uid string, decode func() (types.PrivKey, error)) error {
+	if _, err := ks.Key(uid); err == nil {
+		return fmt.Errorf("cannot overwrite key: %s", uid)
+	}
+
+	privKey, err := decode()
+	if err != nil {
+		return errors.Wrap(err, "failed to decode private key")
+	}
+
+	_, err = ks.writeLocalKey(uid, privKey, hd.PubKeyType(privKey.Type()))
+	return err
+}
+
 func (ks keystore) ImportPubKey(uid string, armor string) error {
 	if _, err := ks.Key(uid); err == nil {
 		return fmt.Errorf("cannot overwrite key: %s", uid)
@@ -393,7 +467,7 @@ func (ks keystore) SaveLedgerKey(uid string, algo SignatureAlgo, hrp string, coi
 
 	hdPath := hd.NewFundraiserParams(account, coinType, index)
 
-	priv, _, err := ledger.NewPrivKeySecp256k1(*hdPath, hrp)
+	priv, _, err := ledger.NewPrivKey(algo.Name(), *hdPath, hrp)
 	if err != nil {
 		return nil, fmt.Errorf("failed to generate ledger key: %w", err)
 	}
@@ -599,7 +673,7 @@ func SignWithLedger(info Info, msg []byte) (sig []byte, pub types.PubKey, err er
 		return
 	}
 
-	priv, err := ledger.NewPrivKeySecp256k1Unsafe(*path)
+	priv, err := ledger.NewPrivKeyUnsafe(hd.PubKeyType(info.GetPubKey().Type()), *path)
 	if err != nil {
 		return
 	}
@@ -852,6 +926,16 @@ func (ks unsafeKeystore) UnsafeExportPrivKeyHex(uid string) (privkey string, err
 	return hex.EncodeToString(priv.Bytes()), nil
 }
 
+// UnsafeExportPrivKeyJWK exports private keys as unencrypted JSON Web Keys.
+func (ks unsafeKeystore) UnsafeExportPrivKeyJWK(uid string) ([]byte, error) {
+	priv, err := ks.ExportPrivateKeyObject(uid)
+	if err != nil {
+		return nil, err
+	}
+
+	return crypto.MarshalJWKPrivKey(priv)
+}
+
 func addrHexKeyAsString(address sdk.Address) string {
 	return fmt.Sprintf("%s.%s", hex.EncodeToString(address.Bytes()), addressSuffix)
 }
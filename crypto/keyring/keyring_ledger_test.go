@@ -121,3 +121,34 @@ func TestAltKeyring_SaveLedgerKey(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, "m/44'/438'/3'/0/1", path.String())
 }
+
+func TestSignVerifyKeyRingWithLedgerSecp256r1(t *testing.T) {
+	dir := t.TempDir()
+
+	kb, err := New("keybasename", "test", dir, nil)
+	require.NoError(t, err)
+
+	i1, err := kb.SaveLedgerKey("key", hd.Secp256r1, "link", 438, 0, 0)
+	if err != nil {
+		require.Equal(t, "ledger nano S: support for ledger devices is not available in this executable", err.Error())
+		t.Skip("ledger nano S: support for ledger devices is not available in this executable")
+		return
+	}
+	require.Equal(t, hd.Secp256r1Type, i1.GetAlgo())
+	require.Equal(t, string(hd.Secp256r1Type), i1.GetPubKey().Type())
+
+	// the pubkey is restored from the keyring
+	restoredKey, err := kb.Key("key")
+	require.NoError(t, err)
+	require.True(t, i1.GetPubKey().Equals(restoredKey.GetPubKey()))
+
+	d1 := []byte("my first message")
+	s1, pub1, err := kb.Sign("key", d1)
+	require.NoError(t, err)
+	require.True(t, i1.GetPubKey().Equals(pub1))
+	require.True(t, pub1.VerifySignature(d1, s1))
+
+	// secp256r1 is supported for Ledger keys only
+	_, _, err = kb.NewMnemonic("test", English, sdk.FullFundraiserPath, DefaultBIP39Passphrase, hd.Secp256r1)
+	require.ErrorIs(t, err, ErrUnsupportedSigningAlgo)
+}
@@ -15,8 +15,10 @@ import (
 	"github.com/line/lbm-sdk/crypto/keys/ed25519"
 	"github.com/line/lbm-sdk/crypto/keys/multisig"
 	"github.com/line/lbm-sdk/crypto/keys/secp256k1"
+	"github.com/line/lbm-sdk/crypto/keys/secp256r1"
 	"github.com/line/lbm-sdk/crypto/types"
 	sdk "github.com/line/lbm-sdk/types"
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
 )
 
 const (
@@ -27,6 +29,8 @@ const (
 
 func init() {
 	crypto.BcryptSecurityParameter = 1
+	crypto.PKCS8Iterations = 1
+	crypto.KeystoreScryptN = 1 << 2
 }
 
 func TestNewKeyring(t *testing.T) {
@@ -1049,6 +1053,85 @@ func TestAltKeyring_ImportExportPrivKey_ByAddress(t *testing.T) {
 	require.EqualError(t, err, fmt.Sprintf("cannot overwrite key: %s", newUID))
 }
 
+func TestAltKeyring_ImportExportPrivKeyStandardFormats(t *testing.T) {
+	r1, err := secp256r1.GenPrivKey()
+	require.NoError(t, err)
+
+	passphrase := "somePass"
+	formats := []struct {
+		name     string
+		export   func(kr Keyring, uid string) ([]byte, error)
+		importFn func(kr Keyring, uid string, bz []byte, passphrase string) error
+	}{
+		{
+			"pkcs8",
+			func(kr Keyring, uid string) ([]byte, error) {
+				pem, err := kr.ExportPrivKeyPKCS8(uid, passphrase)
+				return []byte(pem), err
+			},
+			func(kr Keyring, uid string, bz []byte, passphrase string) error {
+				return kr.ImportPrivKeyPKCS8(uid, string(bz), passphrase)
+			},
+		},
+		{
+			"keystore",
+			func(kr Keyring, uid string) ([]byte, error) {
+				return kr.ExportPrivKeyKeystore(uid, passphrase)
+			},
+			func(kr Keyring, uid string, bz []byte, passphrase string) error {
+				return kr.ImportPrivKeyKeystore(uid, bz, passphrase)
+			},
+		},
+		{
+			"jwk",
+			func(kr Keyring, uid string) ([]byte, error) {
+				return NewUnsafe(kr).UnsafeExportPrivKeyJWK(uid)
+			},
+			func(kr Keyring, uid string, bz []byte, _ string) error {
+				return kr.ImportPrivKeyJWK(uid, bz)
+			},
+		},
+	}
+
+	for _, format := range formats {
+		for _, priv := range []types.PrivKey{secp256k1.GenPrivKey(), r1, ed25519.GenPrivKey()} {
+			t.Run(fmt.Sprintf("%s %s", format.name, priv.Type()), func(t *testing.T) {
+				kr := NewInMemory()
+				ks := kr.(keystore)
+
+				_, err = ks.writeLocalKey(theID, priv, hd.PubKeyType(priv.Type()))
+				require.NoError(t, err)
+
+				bz, err := format.export(kr, theID)
+				require.NoError(t, err)
+				require.NoError(t, kr.Delete(theID))
+
+				if format.name != "jwk" {
+					err = format.importFn(kr, otherID, bz, "wrongPass")
+					require.ErrorIs(t, err, sdkerrors.ErrWrongPassword)
+				}
+
+				err = format.importFn(kr, otherID, bz, passphrase)
+				require.NoError(t, err)
+
+				info, err := kr.Key(otherID)
+				require.NoError(t, err)
+				require.Equal(t, hd.PubKeyType(priv.Type()), info.GetAlgo())
+				require.True(t, priv.PubKey().Equals(info.GetPubKey()))
+
+				msg := []byte("some message")
+				sig, pub, err := kr.Sign(otherID, msg)
+				require.NoError(t, err)
+				require.True(t, pub.VerifySignature(msg, sig))
+
+				// Should fail importing private key on existing key.
+				err = format.importFn(kr, otherID, bz, passphrase)
+				require.EqualError(t, err, fmt.Sprintf("cannot overwrite key: %s", otherID))
+			})
+		}
+	}
+}
+
 func TestAltKeyring_ImportExportPubKey(t *testing.T) {
 	keyring, err := New(t.Name(), BackendTest, t.TempDir(), nil)
 	require.NoError(t, err)
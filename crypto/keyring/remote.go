@@ -0,0 +1,317 @@
+package keyring
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/status"
+
+	"github.com/line/lbm-sdk/codec/legacy"
+	"github.com/line/lbm-sdk/crypto"
+	"github.com/line/lbm-sdk/crypto/hd"
+	"github.com/line/lbm-sdk/crypto/types"
+	sdk "github.com/line/lbm-sdk/types"
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
+)
+
+const (
+	keyringRemoteDirName = "keyring-remote"
+
+	// RemoteSignerConfigFileName is the name of the file in the keyring-remote directory
+	// which configures the remote backend.
+	RemoteSignerConfigFileName = "config.json"
+
+	// remoteSignerTimeout is the max duration of a call to the remote signer
+	remoteSignerTimeout = 10 * time.Second
+
+	remoteSignerAuthKey = "authorization"
+)
+
+var _ Keyring = remoteKeystore{}
+
+// RemoteSignerConfig defines how to connect to a remote signer
+type RemoteSignerConfig struct {
+	// Address is the gRPC address of the signer, e.g. localhost:9091
+	Address string `json:"address"`
+	// Token authenticates the calls to the signer
+	Token string `json:"token"`
+	// CACertFile is the PEM encoded certificate authority to verify the signer with
+	CACertFile string `json:"ca_cert_file,omitempty"`
+	// Insecure allows a plaintext connection, when no CACertFile is given.
+	// It should be used only for signers on the same host.
+	Insecure bool `json:"insecure,omitempty"`
+}
+
+// LoadRemoteSignerConfig reads the remote backend configuration in the keyring-remote
+// directory of the root directory.
+func LoadRemoteSignerConfig(rootDir string) (RemoteSignerConfig, error) {
+	var cfg RemoteSignerConfig
+	path := filepath.Join(rootDir, keyringRemoteDirName, RemoteSignerConfigFileName)
+	bz, err := ioutil.ReadFile(path)
+	if err != nil {
+		return cfg, errors.Wrap(err, "failed to read the remote signer config")
+	}
+	if err := json.Unmarshal(bz, &cfg); err != nil {
+		return cfg, errors.Wrapf(err, "failed to parse %s", path)
+	}
+	return cfg, nil
+}
+
+// ValidateBasic checks the configuration
+func (cfg RemoteSignerConfig) ValidateBasic() error {
+	if cfg.Address == "" {
+		return errors.New("remote signer address is empty")
+	}
+	if cfg.Token == "" {
+		return errors.New("remote signer token is empty")
+	}
+	if cfg.CACertFile == "" && !cfg.Insecure {
+		return errors.New("remote signer requires either a CA certificate or insecure to be set")
+	}
+	return nil
+}
+
+// NewRemote creates a keyring which delegates the key lookups and signing to a remote signer.
+// The keys are managed by the signer, so that the operations creating, deleting, importing
+// or exporting private keys are not supported.
+func NewRemote(cfg RemoteSignerConfig, opts ...Option) (Keyring, error) {
+	if err := cfg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	transport := grpc.WithInsecure()
+	if cfg.CACertFile != "" {
+		creds, err := credentials.NewClientTLSFromFile(cfg.CACertFile, "")
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to load the remote signer CA certificate")
+		}
+		transport = grpc.WithTransportCredentials(creds)
+	}
+
+	conn, err := grpc.Dial(cfg.Address, transport, grpc.WithPerRPCCredentials(remoteSignerToken{
+		token:  cfg.Token,
+		secure: cfg.CACertFile != "",
+	}))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to connect to the remote signer")
+	}
+
+	return newRemoteKeystore(NewRemoteSignerClient(conn), opts...), nil
+}
+
+// remoteSignerToken passes the token as the authorization metadata of every call
+type remoteSignerToken struct {
+	token  string
+	secure bool
+}
+
+func (t remoteSignerToken) GetRequestMetadata(context.Context, ...string) (map[string]string, error) {
+	return map[string]string{remoteSignerAuthKey: "Bearer " + t.token}, nil
+}
+
+func (t remoteSignerToken) RequireTransportSecurity() bool {
+	return t.secure
+}
+
+type remoteKeystore struct {
+	client  RemoteSignerClient
+	options Options
+}
+
+func newRemoteKeystore(client RemoteSignerClient, opts ...Option) remoteKeystore {
+	// Default options for keybase
+	options := Options{
+		SupportedAlgos:       SigningAlgoList{hd.Secp256k1},
+		SupportedAlgosLedger: SigningAlgoList{hd.Secp256k1},
+	}
+
+	for _, optionFn := range opts {
+		optionFn(&options)
+	}
+
+	return remoteKeystore{client, options}
+}
+
+func errRemoteUnsupported(op string) error {
+	return fmt.Errorf("%s is not supported by the remote backend, manage the keys on the remote signer", op)
+}
+
+func (ks remoteKeystore) List() ([]Info, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), remoteSignerTimeout)
+	defer cancel()
+
+	res, err := ks.client.Keys(ctx, &RemoteKeysRequest{})
+	if err != nil {
+		return nil, wrapRemoteErr(err, "failed to list the keys")
+	}
+
+	infos := make([]Info, len(res.Keys))
+	for i, key := range res.Keys {
+		info, err := key.toInfo()
+		if err != nil {
+			return nil, err
+		}
+		infos[i] = info
+	}
+	return infos, nil
+}
+
+func (ks remoteKeystore) SupportedAlgorithms() (SigningAlgoList, SigningAlgoList) {
+	return ks.options.SupportedAlgos, ks.options.SupportedAlgosLedger
+}
+
+func (ks remoteKeystore) Key(uid string) (Info, error) {
+	return ks.key(&RemoteKeyRequest{Name: uid}, uid)
+}
+
+func (ks remoteKeystore) KeyByAddress(address sdk.Address) (Info, error) {
+	return ks.key(&RemoteKeyRequest{Address: address.Bytes()}, fmt.Sprint("key with address ", address))
+}
+
+func (ks remoteKeystore) key(req *RemoteKeyRequest, desc string) (Info, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), remoteSignerTimeout)
+	defer cancel()
+
+	res, err := ks.client.Key(ctx, req)
+	if err != nil {
+		return nil, wrapRemoteErr(err, desc)
+	}
+	if res.Key == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, desc)
+	}
+	return res.Key.toInfo()
+}
+
+func (ks remoteKeystore) Sign(uid string, msg []byte) ([]byte, types.PubKey, error) {
+	return ks.sign(&RemoteSignRequest{Name: uid, Msg: msg}, uid)
+}
+
+func (ks remoteKeystore) SignByAddress(address sdk.Address, msg []byte) ([]byte, types.PubKey, error) {
+	return ks.sign(&RemoteSignRequest{Address: address.Bytes(), Msg: msg}, fmt.Sprint("key with address ", address))
+}
+
+func (ks remoteKeystore) sign(req *RemoteSignRequest, desc string) ([]byte, types.PubKey, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), remoteSignerTimeout)
+	defer cancel()
+
+	res, err := ks.client.Sign(ctx, req)
+	if err != nil {
+		return nil, nil, wrapRemoteErr(err, desc)
+	}
+
+	pub, err := legacy.PubKeyFromBytes(res.PubKey)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "invalid public key from the remote signer")
+	}
+	// do not pass on a signature the chain would reject anyway
+	if !pub.VerifySignature(req.Msg, res.Signature) {
+		return nil, nil, errors.New("invalid signature from the remote signer")
+	}
+	return res.Signature, pub, nil
+}
+
+func (ks remoteKeystore) ExportPubKeyArmor(uid string) (string, error) {
+	info, err := ks.Key(uid)
+	if err != nil {
+		return "", err
+	}
+
+	return crypto.ArmorPubKeyBytes(legacy.Cdc.MustMarshal(info.GetPubKey()), string(info.GetAlgo())), nil
+}
+
+func (ks remoteKeystore) ExportPubKeyArmorByAddress(address sdk.Address) (string, error) {
+	info, err := ks.KeyByAddress(address)
+	if err != nil {
+		return "", err
+	}
+
+	return crypto.ArmorPubKeyBytes(legacy.Cdc.MustMarshal(info.GetPubKey()), string(info.GetAlgo())), nil
+}
+
+func (ks remoteKeystore) ExportPrivKeyArmor(string, string) (string, error) {
+	return "", errRemoteUnsupported("exporting private keys")
+}
+
+func (ks remoteKeystore) ExportPrivKeyArmorByAddress(sdk.Address, string) (string, error) {
+	return "", errRemoteUnsupported("exporting private keys")
+}
+
+func (ks remoteKeystore) ExportPrivKeyPKCS8(string, string) (string, error) {
+	return "", errRemoteUnsupported("exporting private keys")
+}
+
+func (ks remoteKeystore) ExportPrivKeyKeystore(string, string) ([]byte, error) {
+	return nil, errRemoteUnsupported("exporting private keys")
+}
+
+func (ks remoteKeystore) Delete(string) error {
+	return errRemoteUnsupported("deleting keys")
+}
+
+func (ks remoteKeystore) DeleteByAddress(sdk.Address) error {
+	return errRemoteUnsupported("deleting keys")
+}
+
+func (ks remoteKeystore) NewMnemonic(string, Language, string, string, SignatureAlgo) (Info, string, error) {
+	return nil, "", errRemoteUnsupported("creating keys")
+}
+
+func (ks remoteKeystore) NewAccount(string, string, string, string, SignatureAlgo) (Info, error) {
+	return nil, errRemoteUnsupported("creating keys")
+}
+
+func (ks remoteKeystore) SaveLedgerKey(string, SignatureAlgo, string, uint32, uint32, uint32) (Info, error) {
+	return nil, errRemoteUnsupported("saving ledger keys")
+}
+
+func (ks remoteKeystore) SavePubKey(string, types.PubKey, hd.PubKeyType) (Info, error) {
+	return nil, errRemoteUnsupported("saving public keys")
+}
+
+func (ks remoteKeystore) SaveMultisig(string, types.PubKey) (Info, error) {
+	return nil, errRemoteUnsupported("saving multisig keys")
+}
+
+func (ks remoteKeystore) ImportPrivKey(string, string, string) error {
+	return errRemoteUnsupported("importing keys")
+}
+
+func (ks remoteKeystore) ImportPubKey(string, string) error {
+	return errRemoteUnsupported("importing keys")
+}
+
+func (ks remoteKeystore) ImportPrivKeyPKCS8(string, string, string) error {
+	return errRemoteUnsupported("importing keys")
+}
+
+func (ks remoteKeystore) ImportPrivKeyKeystore(string, []byte, string) error {
+	return errRemoteUnsupported("importing keys")
+}
+
+func (ks remoteKeystore) ImportPrivKeyJWK(string, []byte) error {
+	return errRemoteUnsupported("importing keys")
+}
+
+// wrapRemoteErr maps the NotFound status of the remote signer to ErrKeyNotFound
+func wrapRemoteErr(err error, desc string) error {
+	if status.Code(err) == codes.NotFound {
+		return sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, desc)
+	}
+	return errors.Wrapf(err, "remote signer: %s", desc)
+}
+
+func (k RemoteKey) toInfo() (Info, error) {
+	pub, err := legacy.PubKeyFromBytes(k.PubKey)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid public key of %s from the remote signer", k.Name)
+	}
+	return newRemoteInfo(k.Name, pub, hd.PubKeyType(k.Algo)), nil
+}
@@ -0,0 +1,129 @@
+package keyring
+
+import (
+	"context"
+	"crypto/subtle"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/line/lbm-sdk/codec/legacy"
+	sdk "github.com/line/lbm-sdk/types"
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
+)
+
+var _ RemoteSignerServer = remoteSignerServer{}
+
+type remoteSignerServer struct {
+	kr    Keyring
+	token string
+}
+
+// NewRemoteSignerServer returns a reference implementation of the remote signer serving the keys of
+// the given keyring to the clients authenticated by the token. It stands in for a signing daemon in
+// tests and single host setups.
+func NewRemoteSignerServer(kr Keyring, token string) RemoteSignerServer {
+	return remoteSignerServer{kr: kr, token: token}
+}
+
+func (s remoteSignerServer) authenticate(ctx context.Context) error {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return status.Error(codes.Unauthenticated, "missing metadata")
+	}
+	values := md.Get(remoteSignerAuthKey)
+	if len(values) != 1 || subtle.ConstantTimeCompare([]byte(values[0]), []byte("Bearer "+s.token)) != 1 {
+		return status.Error(codes.Unauthenticated, "invalid token")
+	}
+	return nil
+}
+
+func (s remoteSignerServer) Keys(ctx context.Context, _ *RemoteKeysRequest) (*RemoteKeysResponse, error) {
+	if err := s.authenticate(ctx); err != nil {
+		return nil, err
+	}
+
+	infos, err := s.kr.List()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	keys := make([]RemoteKey, 0, len(infos))
+	for _, info := range infos {
+		if !canSign(info) {
+			continue
+		}
+		keys = append(keys, newRemoteKey(info))
+	}
+	return &RemoteKeysResponse{Keys: keys}, nil
+}
+
+func (s remoteSignerServer) Key(ctx context.Context, req *RemoteKeyRequest) (*RemoteKeyResponse, error) {
+	if err := s.authenticate(ctx); err != nil {
+		return nil, err
+	}
+
+	info, err := s.lookup(req.Name, req.Address)
+	if err != nil {
+		return nil, err
+	}
+	key := newRemoteKey(info)
+	return &RemoteKeyResponse{Key: &key}, nil
+}
+
+func (s remoteSignerServer) Sign(ctx context.Context, req *RemoteSignRequest) (*RemoteSignResponse, error) {
+	if err := s.authenticate(ctx); err != nil {
+		return nil, err
+	}
+
+	info, err := s.lookup(req.Name, req.Address)
+	if err != nil {
+		return nil, err
+	}
+	sig, pub, err := s.kr.Sign(info.GetName(), req.Msg)
+	if err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return &RemoteSignResponse{Signature: sig, PubKey: legacy.Cdc.MustMarshal(pub)}, nil
+}
+
+func (s remoteSignerServer) lookup(name string, address []byte) (Info, error) {
+	var (
+		info Info
+		err  error
+	)
+	switch {
+	case name != "" && len(address) != 0:
+		return nil, status.Error(codes.InvalidArgument, "both name and address given")
+	case name != "":
+		info, err = s.kr.Key(name)
+	case len(address) != 0:
+		info, err = s.kr.KeyByAddress(sdk.AccAddress(address))
+	default:
+		return nil, status.Error(codes.InvalidArgument, "either name or address required")
+	}
+	if err != nil {
+		if sdkerrors.ErrKeyNotFound.Is(err) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if !canSign(info) {
+		return nil, status.Errorf(codes.NotFound, "%s is not a signing key", info.GetName())
+	}
+	return info, nil
+}
+
+// canSign returns true for the keys of the signer it can sign with, which are the only keys it serves
+func canSign(info Info) bool {
+	return info.GetType() == TypeLocal || info.GetType() == TypeLedger
+}
+
+func newRemoteKey(info Info) RemoteKey {
+	return RemoteKey{
+		Name:   info.GetName(),
+		PubKey: legacy.Cdc.MustMarshal(info.GetPubKey()),
+		Algo:   string(info.GetAlgo()),
+	}
+}
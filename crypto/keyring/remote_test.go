@@ -0,0 +1,166 @@
+package keyring
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/line/lbm-sdk/codec/legacy"
+	"github.com/line/lbm-sdk/crypto"
+	"github.com/line/lbm-sdk/crypto/hd"
+	"github.com/line/lbm-sdk/crypto/keys/secp256k1"
+	sdk "github.com/line/lbm-sdk/types"
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
+)
+
+// startRemoteSigner serves the keyring on a local port and returns the keyring directory
+// configured to use it with the given token.
+func startRemoteSigner(t *testing.T, kr Keyring, serverToken, clientToken string) string {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	server := grpc.NewServer()
+	RegisterRemoteSignerServer(server, NewRemoteSignerServer(kr, serverToken))
+	go server.Serve(listener) // nolint: errcheck
+	t.Cleanup(server.Stop)
+
+	dir := t.TempDir()
+	bz, err := json.Marshal(RemoteSignerConfig{
+		Address:  listener.Addr().String(),
+		Token:    clientToken,
+		Insecure: true,
+	})
+	require.NoError(t, err)
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, keyringRemoteDirName), 0o700))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, keyringRemoteDirName, RemoteSignerConfigFileName), bz, 0o600))
+	return dir
+}
+
+func TestRemoteKeyring(t *testing.T) {
+	signerKr := NewInMemory()
+	local, _, err := signerKr.NewMnemonic("local", English, sdk.FullFundraiserPath, DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+	// the signer does not serve the keys it can not sign with
+	_, err = signerKr.SavePubKey("offline", secp256k1.GenPrivKey().PubKey(), hd.Secp256k1Type)
+	require.NoError(t, err)
+
+	dir := startRemoteSigner(t, signerKr, "s3cret", "s3cret")
+	kr, err := New("cosmos", BackendRemote, dir, nil)
+	require.NoError(t, err)
+
+	// key lookups
+	infos, err := kr.List()
+	require.NoError(t, err)
+	require.Len(t, infos, 1)
+	require.Equal(t, "local", infos[0].GetName())
+	require.Equal(t, TypeRemote, infos[0].GetType())
+	require.Equal(t, local.GetPubKey(), infos[0].GetPubKey())
+	require.Equal(t, hd.Secp256k1Type, infos[0].GetAlgo())
+
+	info, err := kr.Key("local")
+	require.NoError(t, err)
+	require.Equal(t, local.GetAddress(), info.GetAddress())
+	info, err = kr.KeyByAddress(local.GetAddress())
+	require.NoError(t, err)
+	require.Equal(t, "local", info.GetName())
+
+	_, err = kr.Key("unknown")
+	require.True(t, sdkerrors.ErrKeyNotFound.Is(err), "got %+v", err)
+	_, err = kr.Key("offline")
+	require.True(t, sdkerrors.ErrKeyNotFound.Is(err), "got %+v", err)
+	_, err = kr.KeyByAddress(sdk.AccAddress("unknown"))
+	require.True(t, sdkerrors.ErrKeyNotFound.Is(err), "got %+v", err)
+
+	// signing
+	msg := []byte("some bytes to sign")
+	sig, pub, err := kr.Sign("local", msg)
+	require.NoError(t, err)
+	require.Equal(t, local.GetPubKey(), pub)
+	require.True(t, pub.VerifySignature(msg, sig))
+	sig, pub, err = kr.SignByAddress(local.GetAddress(), msg)
+	require.NoError(t, err)
+	require.True(t, pub.VerifySignature(msg, sig))
+	_, _, err = kr.Sign("unknown", msg)
+	require.True(t, sdkerrors.ErrKeyNotFound.Is(err), "got %+v", err)
+
+	// public key export
+	armor, err := kr.ExportPubKeyArmor("local")
+	require.NoError(t, err)
+	pubBz, algo, err := crypto.UnarmorPubKeyBytes(armor)
+	require.NoError(t, err)
+	require.Equal(t, string(hd.Secp256k1Type), algo)
+	require.Equal(t, legacy.Cdc.MustMarshal(local.GetPubKey()), pubBz)
+
+	// the keys are managed by the signer
+	_, _, err = kr.NewMnemonic("new", English, sdk.FullFundraiserPath, DefaultBIP39Passphrase, hd.Secp256k1)
+	require.Error(t, err)
+	require.Error(t, kr.Delete("local"))
+	_, err = kr.ExportPrivKeyArmor("local", "passphrase")
+	require.Error(t, err)
+	_, err = kr.ExportPrivKeyPKCS8("local", "passphrase")
+	require.Error(t, err)
+	require.Error(t, kr.ImportPrivKeyJWK("new", []byte("{}")))
+	_, err = kr.Key("local")
+	require.NoError(t, err)
+}
+
+func TestRemoteKeyringUnauthenticated(t *testing.T) {
+	signerKr := NewInMemory()
+	_, _, err := signerKr.NewMnemonic("local", English, sdk.FullFundraiserPath, DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+
+	dir := startRemoteSigner(t, signerKr, "s3cret", "wrong")
+	kr, err := New("cosmos", BackendRemote, dir, nil)
+	require.NoError(t, err)
+
+	_, err = kr.List()
+	require.Error(t, err)
+	_, _, err = kr.Sign("local", []byte("some bytes to sign"))
+	require.Error(t, err)
+	require.False(t, sdkerrors.ErrKeyNotFound.Is(err))
+}
+
+func TestRemoteSignerConfig(t *testing.T) {
+	specs := map[string]struct {
+		cfg    RemoteSignerConfig
+		expErr bool
+	}{
+		"insecure": {
+			cfg: RemoteSignerConfig{Address: "localhost:9091", Token: "s3cret", Insecure: true},
+		},
+		"with CA certificate": {
+			cfg: RemoteSignerConfig{Address: "localhost:9091", Token: "s3cret", CACertFile: "ca.pem"},
+		},
+		"empty address": {
+			cfg:    RemoteSignerConfig{Token: "s3cret", Insecure: true},
+			expErr: true,
+		},
+		"empty token": {
+			cfg:    RemoteSignerConfig{Address: "localhost:9091", Insecure: true},
+			expErr: true,
+		},
+		"neither CA certificate nor insecure": {
+			cfg:    RemoteSignerConfig{Address: "localhost:9091", Token: "s3cret"},
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			err := spec.cfg.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+
+	// missing config file
+	_, err := New("cosmos", BackendRemote, t.TempDir(), nil)
+	require.Error(t, err)
+}
@@ -16,6 +16,11 @@ const (
 	pubKeySize = fieldSize + 1
 
 	name = "secp256r1"
+
+	// PubKeyName is the amino route of the public key.
+	PubKeyName = "lbm/PubKeySecp256r1"
+	// PrivKeyName is the amino route of the private key.
+	PrivKeyName = "lbm/PrivKeySecp256r1"
 )
 
 var secp256r1 elliptic.Curve
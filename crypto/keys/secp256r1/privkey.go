@@ -1,16 +1,52 @@
 package secp256r1
 
 import (
+	"errors"
+	"math/big"
+
+	"github.com/line/lbm-sdk/codec"
 	"github.com/line/lbm-sdk/crypto/keys/internal/ecdsa"
 	cryptotypes "github.com/line/lbm-sdk/crypto/types"
 )
 
+var _ codec.AminoMarshaler = &PrivKey{}
+
 // GenPrivKey generates a new secp256r1 private key. It uses operating system randomness.
 func GenPrivKey() (*PrivKey, error) {
 	key, err := ecdsa.GenPrivKey(secp256r1)
 	return &PrivKey{&ecdsaSK{key}}, err
 }
 
+// NewPrivKeyFromSecret returns the secp256r1 private key of the given secret.
+// The secret is mapped into [1, n-1] for the curve order n, as in FIPS 186-4
+// B.4.1, so any secret of enough entropy gives a valid key.
+func NewPrivKeyFromSecret(secret []byte) *PrivKey {
+	one := big.NewInt(1)
+	d := new(big.Int).SetBytes(secret)
+	d.Mod(d, new(big.Int).Sub(secp256r1.Params().N, one))
+	d.Add(d, one)
+
+	bz := make([]byte, fieldSize)
+	d.FillBytes(bz)
+	sk := &ecdsaSK{}
+	// the size of bz is always the expected one
+	_ = sk.Unmarshal(bz)
+	return &PrivKey{Secret: sk}
+}
+
+// NewPrivKeyFromBytes returns the secp256r1 private key of the given
+// big-endian scalar. It returns an error if the scalar is not in [1, n-1].
+func NewPrivKeyFromBytes(bz []byte) (*PrivKey, error) {
+	sk := &ecdsaSK{}
+	if err := sk.Unmarshal(bz); err != nil {
+		return nil, err
+	}
+	if sk.D.Sign() == 0 || sk.D.Cmp(secp256r1.Params().N) >= 0 {
+		return nil, errors.New("secp256r1 private key out of the curve order")
+	}
+	return &PrivKey{Secret: sk}, nil
+}
+
 // PubKey implements SDK PrivKey interface.
 func (m *PrivKey) PubKey() cryptotypes.PubKey {
 	return &PubKey{&ecdsaPK{m.Secret.PubKey()}}
@@ -48,6 +84,31 @@ func (m *PrivKey) Equals(other cryptotypes.LedgerPrivKey) bool {
 	return m.Secret.Equal(&sk2.Secret.PrivateKey)
 }
 
+// MarshalAmino overrides Amino binary marshalling.
+func (m PrivKey) MarshalAmino() ([]byte, error) {
+	return m.Bytes(), nil
+}
+
+// UnmarshalAmino overrides Amino binary marshalling.
+func (m *PrivKey) UnmarshalAmino(bz []byte) error {
+	sk, err := NewPrivKeyFromBytes(bz)
+	if err != nil {
+		return err
+	}
+	m.Secret = sk.Secret
+	return nil
+}
+
+// MarshalAminoJSON overrides Amino JSON marshalling.
+func (m PrivKey) MarshalAminoJSON() ([]byte, error) {
+	return m.MarshalAmino()
+}
+
+// UnmarshalAminoJSON overrides Amino JSON marshalling.
+func (m *PrivKey) UnmarshalAminoJSON(bz []byte) error {
+	return m.UnmarshalAmino(bz)
+}
+
 type ecdsaSK struct {
 	ecdsa.PrivKey
 }
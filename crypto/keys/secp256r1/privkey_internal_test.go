@@ -113,3 +113,50 @@ func (suite *SKSuite) TestSize() {
 	var nilPk *ecdsaSK
 	require.Equal(0, nilPk.Size(), "nil value must have zero size")
 }
+
+func (suite *SKSuite) TestNewPrivKeyFromSecret() {
+	require := suite.Require()
+
+	sk := NewPrivKeyFromSecret(suite.sk.Bytes())
+	require.Len(sk.Bytes(), fieldSize)
+	require.True(sk.Equals(NewPrivKeyFromSecret(suite.sk.Bytes())))
+	require.False(sk.Equals(suite.sk))
+
+	// secrets out of the curve order give valid keys
+	zero := NewPrivKeyFromSecret(make([]byte, fieldSize))
+	require.Equal(int64(1), zero.Secret.D.Int64())
+	sig, err := zero.Sign([]byte("msg"))
+	require.NoError(err)
+	require.True(zero.PubKey().VerifySignature([]byte("msg"), sig))
+	max := NewPrivKeyFromSecret(secp256r1.Params().N.Bytes())
+	require.True(max.Secret.D.Cmp(secp256r1.Params().N) < 0)
+}
+
+func (suite *SKSuite) TestNewPrivKeyFromBytes() {
+	require := suite.Require()
+
+	sk, err := NewPrivKeyFromBytes(suite.sk.Bytes())
+	require.NoError(err)
+	require.True(sk.Equals(suite.sk))
+
+	_, err = NewPrivKeyFromBytes(make([]byte, fieldSize))
+	require.Error(err)
+	_, err = NewPrivKeyFromBytes(secp256r1.Params().N.Bytes())
+	require.Error(err)
+	_, err = NewPrivKeyFromBytes(suite.sk.Bytes()[1:])
+	require.Error(err)
+}
+
+func (suite *SKSuite) TestMarshalAmino() {
+	require := suite.Require()
+
+	cdc := codec.NewLegacyAmino()
+	cdc.RegisterInterface((*cryptotypes.PrivKey)(nil), nil)
+	cdc.RegisterConcrete(&PrivKey{}, PrivKeyName, nil)
+
+	var sk cryptotypes.PrivKey
+	bz, err := cdc.Marshal(suite.sk)
+	require.NoError(err)
+	require.NoError(cdc.Unmarshal(bz, &sk))
+	require.True(sk.Equals(suite.sk))
+}
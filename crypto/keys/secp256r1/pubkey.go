@@ -1,13 +1,29 @@
 package secp256r1
 
 import (
+	"encoding/base64"
+	"encoding/json"
+
 	"github.com/gogo/protobuf/proto"
 	tmcrypto "github.com/line/ostracon/crypto"
 
+	"github.com/line/lbm-sdk/codec"
 	ecdsa "github.com/line/lbm-sdk/crypto/keys/internal/ecdsa"
 	cryptotypes "github.com/line/lbm-sdk/crypto/types"
 )
 
+var _ codec.AminoMarshaler = &PubKey{}
+
+// NewPubKeyFromBytes returns the secp256r1 public key of the given point in
+// the 33-byte compressed format.
+func NewPubKeyFromBytes(bz []byte) (*PubKey, error) {
+	pk := &ecdsaPK{}
+	if err := pk.Unmarshal(bz); err != nil {
+		return nil, err
+	}
+	return &PubKey{Key: pk}, nil
+}
+
 // String implements proto.Message interface.
 func (m *PubKey) String() string {
 	return m.Key.String(name)
@@ -45,6 +61,31 @@ func (m *PubKey) VerifySignature(msg []byte, sig []byte) bool {
 	return m.Key.VerifySignature(msg, sig)
 }
 
+// MarshalAmino overrides Amino binary marshalling.
+func (m PubKey) MarshalAmino() ([]byte, error) {
+	return m.Bytes(), nil
+}
+
+// UnmarshalAmino overrides Amino binary marshalling.
+func (m *PubKey) UnmarshalAmino(bz []byte) error {
+	pk := &ecdsaPK{}
+	if err := pk.Unmarshal(bz); err != nil {
+		return err
+	}
+	m.Key = pk
+	return nil
+}
+
+// MarshalAminoJSON overrides Amino JSON marshalling.
+func (m PubKey) MarshalAminoJSON() ([]byte, error) {
+	return m.MarshalAmino()
+}
+
+// UnmarshalAminoJSON overrides Amino JSON marshalling.
+func (m *PubKey) UnmarshalAminoJSON(bz []byte) error {
+	return m.UnmarshalAmino(bz)
+}
+
 type ecdsaPK struct {
 	ecdsa.PubKey
 }
@@ -61,3 +102,23 @@ func (pk *ecdsaPK) Size() int {
 func (pk *ecdsaPK) Unmarshal(bz []byte) error {
 	return pk.PubKey.Unmarshal(bz, secp256r1, pubKeySize)
 }
+
+// MarshalJSON implements the json.Marshaler interface for the proto JSON
+// encoding, as a base64 string of the compressed point. The receiver is a
+// value, as jsonpb looks the method up on the field value.
+func (pk ecdsaPK) MarshalJSON() ([]byte, error) {
+	return json.Marshal(base64.StdEncoding.EncodeToString(pk.Bytes()))
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (pk *ecdsaPK) UnmarshalJSON(bz []byte) error {
+	var b64 string
+	if err := json.Unmarshal(bz, &b64); err != nil {
+		return err
+	}
+	key, err := base64.StdEncoding.DecodeString(b64)
+	if err != nil {
+		return err
+	}
+	return pk.Unmarshal(key)
+}
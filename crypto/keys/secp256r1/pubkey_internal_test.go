@@ -115,6 +115,40 @@ func (suite *PKSuite) TestMarshalProto() {
 	require.Error(err, "nil should fail")
 }
 
+func (suite *PKSuite) TestMarshalAmino() {
+	require := suite.Require()
+
+	cdc := codec.NewLegacyAmino()
+	cdc.RegisterInterface((*cryptotypes.PubKey)(nil), nil)
+	cdc.RegisterConcrete(&PubKey{}, PubKeyName, nil)
+
+	var pk cryptotypes.PubKey
+	bz, err := cdc.Marshal(cryptotypes.PubKey(suite.pk))
+	require.NoError(err)
+	require.NoError(cdc.Unmarshal(bz, &pk))
+	require.True(pk.Equals(suite.pk))
+
+	pk = nil
+	bz, err = cdc.MarshalJSON(cryptotypes.PubKey(suite.pk))
+	require.NoError(err)
+	require.NoError(cdc.UnmarshalJSON(bz, &pk))
+	require.True(pk.Equals(suite.pk))
+}
+
+func (suite *PKSuite) TestMarshalJSON() {
+	require := suite.Require()
+
+	registry := types.NewInterfaceRegistry()
+	RegisterInterfaces(registry)
+	cdc := codec.NewProtoCodec(registry)
+
+	bz, err := cdc.MarshalInterfaceJSON(suite.pk)
+	require.NoError(err)
+	var pk cryptotypes.PubKey
+	require.NoError(cdc.UnmarshalInterfaceJSON(bz, &pk))
+	require.True(pk.Equals(suite.pk))
+}
+
 func (suite *PKSuite) TestSize() {
 	require := suite.Require()
 	var pk ecdsaPK
@@ -123,3 +157,14 @@ func (suite *PKSuite) TestSize() {
 	var nilPk *ecdsaPK
 	require.Equal(0, nilPk.Size(), "nil value must have zero size")
 }
+
+func (suite *PKSuite) TestNewPubKeyFromBytes() {
+	require := suite.Require()
+
+	pk, err := NewPubKeyFromBytes(suite.pk.Bytes())
+	require.NoError(err)
+	require.True(pk.Equals(suite.pk))
+
+	_, err = NewPubKeyFromBytes(suite.pk.Bytes()[1:])
+	require.Error(err)
+}
@@ -0,0 +1,96 @@
+package crypto
+
+import (
+	stded25519 "crypto/ed25519"
+	"crypto/elliptic"
+	"fmt"
+	"math/big"
+
+	"github.com/btcsuite/btcd/btcec"
+
+	"github.com/line/lbm-sdk/crypto/keys/ed25519"
+	"github.com/line/lbm-sdk/crypto/keys/secp256k1"
+	"github.com/line/lbm-sdk/crypto/keys/secp256r1"
+	cryptotypes "github.com/line/lbm-sdk/crypto/types"
+)
+
+// key types supported by the standard key formats of PKCS#8, JWK and the
+// Web3 keystore.
+const (
+	algoSecp256k1 = "secp256k1"
+	algoSecp256r1 = "secp256r1"
+	algoEd25519   = "ed25519"
+)
+
+// keySecret is the raw secret of a private key: the big-endian scalar of an
+// ECDSA key, or the seed of an ed25519 key. The standard key formats hold
+// the keys in these terms.
+type keySecret struct {
+	algo   string
+	secret []byte
+}
+
+func newKeySecret(privKey cryptotypes.PrivKey) (keySecret, error) {
+	switch privKey := privKey.(type) {
+	case *secp256k1.PrivKey:
+		return keySecret{algoSecp256k1, privKey.Key}, nil
+	case *secp256r1.PrivKey:
+		return keySecret{algoSecp256r1, privKey.Bytes()}, nil
+	case *ed25519.PrivKey:
+		return keySecret{algoEd25519, privKey.Key[:ed25519.SeedSize]}, nil
+	default:
+		return keySecret{}, fmt.Errorf("unsupported key type %s for the standard key formats", privKey.Type())
+	}
+}
+
+// privKey returns the private key of the secret, validating the secret.
+func (ks keySecret) privKey() (cryptotypes.PrivKey, error) {
+	switch ks.algo {
+	case algoSecp256k1:
+		if !validScalar(btcec.S256(), ks.secret) {
+			return nil, fmt.Errorf("invalid secp256k1 private key")
+		}
+		return &secp256k1.PrivKey{Key: ks.secret}, nil
+	case algoSecp256r1:
+		return secp256r1.NewPrivKeyFromBytes(ks.secret)
+	case algoEd25519:
+		if len(ks.secret) != ed25519.SeedSize {
+			return nil, fmt.Errorf("invalid ed25519 private key seed size %d", len(ks.secret))
+		}
+		return &ed25519.PrivKey{Key: stded25519.NewKeyFromSeed(ks.secret)}, nil
+	default:
+		return nil, fmt.Errorf("unsupported key type %s", ks.algo)
+	}
+}
+
+// curve returns the curve of an ECDSA key, or nil for an ed25519 key.
+func (ks keySecret) curve() elliptic.Curve {
+	switch ks.algo {
+	case algoSecp256k1:
+		return btcec.S256()
+	case algoSecp256r1:
+		return elliptic.P256()
+	default:
+		return nil
+	}
+}
+
+// publicPoint returns the coordinates of the public key of an ECDSA key.
+func (ks keySecret) publicPoint() (x, y *big.Int) {
+	return ks.curve().ScalarBaseMult(ks.secret)
+}
+
+// publicKey returns the public key of an ed25519 key.
+func (ks keySecret) publicKey() []byte {
+	return stded25519.NewKeyFromSeed(ks.secret).Public().(stded25519.PublicKey)
+}
+
+// validScalar returns true if the fixed size scalar is in [1, n-1] for the
+// order n of the curve.
+func validScalar(curve elliptic.Curve, bz []byte) bool {
+	if len(bz) != (curve.Params().BitSize+7)/8 {
+		return false
+	}
+	d := new(big.Int).SetBytes(bz)
+	return d.Sign() > 0 && d.Cmp(curve.Params().N) < 0
+}
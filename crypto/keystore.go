@@ -0,0 +1,236 @@
+package crypto
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/line/ostracon/crypto"
+	"golang.org/x/crypto/pbkdf2"
+	"golang.org/x/crypto/scrypt"
+	"golang.org/x/crypto/sha3"
+
+	cryptotypes "github.com/line/lbm-sdk/crypto/types"
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
+)
+
+const (
+	keystoreVersion = 3
+	keystoreCipher  = "aes-128-ctr"
+	keystoreKDFLen  = 32
+
+	keystoreKDFScrypt = "scrypt"
+	keystoreKDFPBKDF2 = "pbkdf2"
+	keystorePRFSHA256 = "hmac-sha256"
+
+	// keystoreMaxCost bounds the scrypt N * r and the PBKDF2 iteration count
+	// of the keys imported, like pkcs8MaxIterations.
+	keystoreMaxCost = 1 << 22
+)
+
+// KeystoreScryptN and KeystoreScryptP are the scrypt parameters of the Web3
+// keystores exported, the standard ones of Ethereum wallets by default.
+var (
+	KeystoreScryptN = 1 << 18
+	KeystoreScryptP = 1
+)
+
+// web3Keystore is the Web3 Secret Storage of version 3. Curve is an
+// extension for the keys other than secp256k1 ones, which Ethereum wallets
+// ignore.
+type web3Keystore struct {
+	Address string         `json:"address,omitempty"`
+	Curve   string         `json:"curve,omitempty"`
+	Crypto  keystoreCrypto `json:"crypto"`
+	ID      string         `json:"id"`
+	Version int            `json:"version"`
+}
+
+type keystoreCrypto struct {
+	Cipher       string                 `json:"cipher"`
+	CipherText   string                 `json:"ciphertext"`
+	CipherParams keystoreCipherParams   `json:"cipherparams"`
+	KDF          string                 `json:"kdf"`
+	KDFParams    map[string]interface{} `json:"kdfparams"`
+	MAC          string                 `json:"mac"`
+}
+
+type keystoreCipherParams struct {
+	IV string `json:"iv"`
+}
+
+// EncryptKeystorePrivKey returns the private key as an Ethereum style Web3
+// JSON keystore, encrypted with AES-128-CTR by a scrypt key.
+func EncryptKeystorePrivKey(privKey cryptotypes.PrivKey, passphrase string) ([]byte, error) {
+	ks, err := newKeySecret(privKey)
+	if err != nil {
+		return nil, err
+	}
+
+	salt := crypto.CRandBytes(32)
+	derivedKey, err := scrypt.Key([]byte(passphrase), salt, KeystoreScryptN, 8, KeystoreScryptP, keystoreKDFLen)
+	if err != nil {
+		return nil, err
+	}
+	iv := crypto.CRandBytes(aes.BlockSize)
+	cipherText, err := keystoreXORKeyStream(derivedKey[:16], iv, ks.secret)
+	if err != nil {
+		return nil, err
+	}
+
+	keystore := web3Keystore{
+		Crypto: keystoreCrypto{
+			Cipher:       keystoreCipher,
+			CipherText:   hex.EncodeToString(cipherText),
+			CipherParams: keystoreCipherParams{IV: hex.EncodeToString(iv)},
+			KDF:          keystoreKDFScrypt,
+			KDFParams: map[string]interface{}{
+				"dklen": keystoreKDFLen,
+				"n":     KeystoreScryptN,
+				"r":     8,
+				"p":     KeystoreScryptP,
+				"salt":  hex.EncodeToString(salt),
+			},
+			MAC: hex.EncodeToString(keystoreMAC(derivedKey, cipherText)),
+		},
+		ID:      newUUID(),
+		Version: keystoreVersion,
+	}
+	if ks.algo == algoSecp256k1 {
+		keystore.Address = ethereumAddress(ks)
+	} else {
+		keystore.Curve = ks.algo
+	}
+
+	return json.MarshalIndent(keystore, "", "  ")
+}
+
+// DecryptKeystorePrivKey returns the private key of a Web3 JSON keystore of
+// version 3 encrypted by a scrypt or PBKDF2 key. The keys are secp256k1 ones
+// unless the curve extension says otherwise.
+func DecryptKeystorePrivKey(bz []byte, passphrase string) (cryptotypes.PrivKey, error) {
+	var keystore web3Keystore
+	if err := json.Unmarshal(bz, &keystore); err != nil {
+		return nil, err
+	}
+	if keystore.Version != keystoreVersion {
+		return nil, fmt.Errorf("unsupported keystore version %d", keystore.Version)
+	}
+	if keystore.Crypto.Cipher != keystoreCipher {
+		return nil, fmt.Errorf("unsupported keystore cipher %s", keystore.Crypto.Cipher)
+	}
+
+	derivedKey, err := keystoreDerivedKey(keystore.Crypto, passphrase)
+	if err != nil {
+		return nil, err
+	}
+	cipherText, err := hex.DecodeString(keystore.Crypto.CipherText)
+	if err != nil {
+		return nil, err
+	}
+	mac, err := hex.DecodeString(keystore.Crypto.MAC)
+	if err != nil {
+		return nil, err
+	}
+	if subtle.ConstantTimeCompare(mac, keystoreMAC(derivedKey, cipherText)) != 1 {
+		return nil, sdkerrors.ErrWrongPassword
+	}
+	iv, err := hex.DecodeString(keystore.Crypto.CipherParams.IV)
+	if err != nil {
+		return nil, err
+	}
+	secret, err := keystoreXORKeyStream(derivedKey[:16], iv, cipherText)
+	if err != nil {
+		return nil, err
+	}
+
+	ks := keySecret{algo: keystore.Curve, secret: secret}
+	if ks.algo == "" {
+		ks.algo = algoSecp256k1
+	}
+	return ks.privKey()
+}
+
+// keystoreDerivedKey derives the key of the keystore from the passphrase.
+func keystoreDerivedKey(c keystoreCrypto, passphrase string) ([]byte, error) {
+	param := func(name string) int {
+		// JSON numbers are decoded as float64
+		v, _ := c.KDFParams[name].(float64)
+		return int(v)
+	}
+	saltHex, _ := c.KDFParams["salt"].(string)
+	salt, err := hex.DecodeString(saltHex)
+	if err != nil {
+		return nil, err
+	}
+	dkLen := param("dklen")
+	if dkLen < keystoreKDFLen || dkLen > 64 {
+		return nil, fmt.Errorf("invalid keystore KDF key length %d", dkLen)
+	}
+
+	switch c.KDF {
+	case keystoreKDFScrypt:
+		n, r, p := param("n"), param("r"), param("p")
+		if n <= 1 || r <= 0 || p <= 0 || p > 16 || n > keystoreMaxCost/r {
+			return nil, errors.New("invalid keystore scrypt parameters")
+		}
+		return scrypt.Key([]byte(passphrase), salt, n, r, p, dkLen)
+
+	case keystoreKDFPBKDF2:
+		if prf, _ := c.KDFParams["prf"].(string); prf != keystorePRFSHA256 {
+			return nil, fmt.Errorf("unsupported keystore PBKDF2 PRF %s", prf)
+		}
+		iterations := param("c")
+		if iterations <= 0 || iterations > keystoreMaxCost {
+			return nil, errors.New("invalid keystore PBKDF2 iteration count")
+		}
+		return pbkdf2.Key([]byte(passphrase), salt, iterations, dkLen, sha256.New), nil
+
+	default:
+		return nil, fmt.Errorf("unsupported keystore KDF %s", c.KDF)
+	}
+}
+
+// keystoreMAC returns Keccak-256(DK[16:32] || ciphertext).
+func keystoreMAC(derivedKey, cipherText []byte) []byte {
+	hash := sha3.NewLegacyKeccak256()
+	hash.Write(derivedKey[16:32])
+	hash.Write(cipherText)
+	return hash.Sum(nil)
+}
+
+func keystoreXORKeyStream(key, iv, src []byte) ([]byte, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(iv) != aes.BlockSize {
+		return nil, errors.New("invalid AES-CTR IV")
+	}
+	dst := make([]byte, len(src))
+	cipher.NewCTR(block, iv).XORKeyStream(dst, src)
+	return dst, nil
+}
+
+// ethereumAddress returns the hex Ethereum address of a secp256k1 key, the
+// last 20 bytes of the Keccak-256 hash of the uncompressed public key.
+func ethereumAddress(ks keySecret) string {
+	x, y := ks.publicPoint()
+	hash := sha3.NewLegacyKeccak256()
+	hash.Write(x.FillBytes(make([]byte, 32)))
+	hash.Write(y.FillBytes(make([]byte, 32)))
+	return hex.EncodeToString(hash.Sum(nil)[12:])
+}
+
+// newUUID returns a random UUID of version 4.
+func newUUID() string {
+	u := crypto.CRandBytes(16)
+	u[6] = u[6]&0x0f | 0x40
+	u[8] = u[8]&0x3f | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", u[0:4], u[4:6], u[6:8], u[8:10], u[10:])
+}
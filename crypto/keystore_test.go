@@ -0,0 +1,127 @@
+package crypto_test
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/line/lbm-sdk/crypto"
+	"github.com/line/lbm-sdk/crypto/keys/secp256k1"
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
+)
+
+func TestEncryptDecryptKeystorePrivKey(t *testing.T) {
+	defer func(n int) { crypto.KeystoreScryptN = n }(crypto.KeystoreScryptN)
+	crypto.KeystoreScryptN = 1 << 12
+
+	for _, priv := range standardFormatKeys(t) {
+		t.Run(priv.Type(), func(t *testing.T) {
+			bz, err := crypto.EncryptKeystorePrivKey(priv, "passphrase")
+			require.NoError(t, err)
+
+			_, err = crypto.DecryptKeystorePrivKey(bz, "wrongpassphrase")
+			require.ErrorIs(t, err, sdkerrors.ErrWrongPassword)
+
+			decrypted, err := crypto.DecryptKeystorePrivKey(bz, "passphrase")
+			require.NoError(t, err)
+			require.True(t, priv.Equals(decrypted))
+		})
+	}
+}
+
+func TestEncryptKeystorePrivKeyAddress(t *testing.T) {
+	defer func(n int) { crypto.KeystoreScryptN = n }(crypto.KeystoreScryptN)
+	crypto.KeystoreScryptN = 1 << 12
+
+	key := make([]byte, 32)
+	key[31] = 1
+	bz, err := crypto.EncryptKeystorePrivKey(&secp256k1.PrivKey{Key: key}, "passphrase")
+	require.NoError(t, err)
+
+	var keystore map[string]interface{}
+	require.NoError(t, json.Unmarshal(bz, &keystore))
+	require.Equal(t, "7e5f4552091a69125d5dfcb7b8c2659029395bdf", keystore["address"])
+	require.Equal(t, float64(3), keystore["version"])
+	require.NotContains(t, keystore, "curve")
+}
+
+// TestDecryptKeystorePrivKeyVectors decrypts the test vectors of the Web3
+// Secret Storage Definition.
+func TestDecryptKeystorePrivKeyVectors(t *testing.T) {
+	testCases := []struct {
+		name     string
+		keystore string
+	}{
+		{
+			"pbkdf2",
+			`{
+				"crypto": {
+					"cipher": "aes-128-ctr",
+					"cipherparams": {"iv": "6087dab2f9fdbbfaddc31a909735c1e6"},
+					"ciphertext": "5318b4d5bcd28de64ee5559e671353e16f075ecae9f99c7a79a38af5f869aa46",
+					"kdf": "pbkdf2",
+					"kdfparams": {
+						"c": 262144,
+						"dklen": 32,
+						"prf": "hmac-sha256",
+						"salt": "ae3cd4e7013836a3df6bd7241b12db061dbe2c6785853cce422d148a624ce0bd"
+					},
+					"mac": "517ead924a9d0dc3124507e3393d175ce3ff7c1e96529c6c555ce9e51205e9b2"
+				},
+				"id": "3198bc9c-6672-5ab3-d995-4942343ae5b6",
+				"version": 3
+			}`,
+		},
+		{
+			"scrypt",
+			`{
+				"crypto": {
+					"cipher": "aes-128-ctr",
+					"cipherparams": {"iv": "83dbcc02d8ccb40e466191a123791e0e"},
+					"ciphertext": "d172bf743a674da9cdad04534d56926ef8358534d458fffccd4e6ad2fbde479c",
+					"kdf": "scrypt",
+					"kdfparams": {
+						"dklen": 32,
+						"n": 262144,
+						"p": 8,
+						"r": 1,
+						"salt": "ab0c7876052600dd703518d6fc3fe8984592145b591fc8fb5c6d43190334ba19"
+					},
+					"mac": "2103ac29920d71da29f15d75b4a16dbe95cfd7ff8faea1056c33131d846e3097"
+				},
+				"id": "3198bc9c-6672-5ab3-d995-4942343ae5b6",
+				"version": 3
+			}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			priv, err := crypto.DecryptKeystorePrivKey([]byte(tc.keystore), "testpassword")
+			require.NoError(t, err)
+			require.Equal(t, "7a28b5ba57c53603b0b07b56bba752f7784bf506fa95edc395f5cf6c7514fe9d", hex.EncodeToString(priv.(*secp256k1.PrivKey).Key))
+		})
+	}
+}
+
+func TestDecryptKeystorePrivKeyInvalid(t *testing.T) {
+	testCases := []struct {
+		name     string
+		keystore string
+	}{
+		{"not JSON", `keystore`},
+		{"version", `{"version": 1}`},
+		{"cipher", `{"version": 3, "crypto": {"cipher": "aes-128-cbc"}}`},
+		{"kdf", `{"version": 3, "crypto": {"cipher": "aes-128-ctr", "kdf": "bcrypt", "kdfparams": {"dklen": 32}}}`},
+		{"scrypt cost", `{"version": 3, "crypto": {"cipher": "aes-128-ctr", "kdf": "scrypt", "kdfparams": {"dklen": 32, "n": 1073741824, "r": 8, "p": 1}}}`},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := crypto.DecryptKeystorePrivKey([]byte(tc.keystore), "testpassword")
+			require.Error(t, err)
+		})
+	}
+}
@@ -16,4 +16,6 @@ func init() {
 func RegisterAmino(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(PrivKeyLedgerSecp256k1{},
 		"tendermint/PrivKeyLedgerSecp256k1", nil)
+	cdc.RegisterConcrete(PrivKeyLedgerSecp256r1{},
+		"lbm/PrivKeyLedgerSecp256r1", nil)
 }
@@ -0,0 +1,226 @@
+package ledger
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/pkg/errors"
+
+	"github.com/line/lbm-sdk/crypto/hd"
+	"github.com/line/lbm-sdk/crypto/types"
+)
+
+var (
+	// discoverLedger defines a function to be invoked at runtime for discovering
+	// a connected Ledger device.
+	discoverLedger discoverLedgerFn
+
+	// curves are the account key curves supported on Ledger devices by name.
+	curves = make(map[hd.PubKeyType]Curve)
+)
+
+type (
+	// discoverLedgerFn defines a Ledger discovery function that returns a
+	// connected device or an error upon failure. Its allows a method to avoid CGO
+	// dependencies when Ledger support is potentially not enabled.
+	discoverLedgerFn func() (Device, error)
+
+	// Device reflects a connected Ledger device. The curves a device supports
+	// are given by the curve interfaces it implements, e.g. SECP256K1.
+	Device interface {
+		Close() error
+	}
+
+	// Curve reflects the implementation of an account key curve on Ledger
+	// devices. Curves are plugged in by RegisterCurve.
+	Curve interface {
+		// Name returns the key type of the curve.
+		Name() hd.PubKeyType
+		// GetPubKey reads the pubkey of the path without user verification.
+		GetPubKey(device Device, path hd.BIP44Params) (types.PubKey, error)
+		// GetPubKeyAddr reads the pubkey and the bech32 address of the path
+		// (requires user confirmation).
+		GetPubKeyAddr(device Device, path hd.BIP44Params, hrp string) (types.PubKey, string, error)
+		// Sign signs a message with the key of the path (requires user
+		// confirmation). The signature is verifiable by the pubkey.
+		Sign(device Device, path hd.BIP44Params, msg []byte) ([]byte, error)
+		// NewPrivKey returns the private key referring to the key of the path.
+		NewPrivKey(pubKey types.PubKey, path hd.BIP44Params) types.LedgerPrivKey
+	}
+)
+
+// RegisterCurve makes the curve available for Ledger keys. It panics if a
+// curve of the same name is already registered.
+func RegisterCurve(curve Curve) {
+	if _, ok := curves[curve.Name()]; ok {
+		panic(fmt.Sprintf("ledger curve %s is already registered", curve.Name()))
+	}
+	curves[curve.Name()] = curve
+}
+
+func getCurve(algo hd.PubKeyType) (Curve, error) {
+	curve, ok := curves[algo]
+	if !ok {
+		return nil, fmt.Errorf("ledger keys of %s are not supported", algo)
+	}
+	return curve, nil
+}
+
+// NewPrivKeyUnsafe will generate a new key of the given curve and store the
+// public key for later use.
+//
+// This function is marked as unsafe as it will retrieve a pubkey without user verification.
+// It can only be used to verify a pubkey but never to create new accounts/keys. In that case,
+// please refer to NewPrivKey
+func NewPrivKeyUnsafe(algo hd.PubKeyType, path hd.BIP44Params) (types.LedgerPrivKey, error) {
+	curve, err := getCurve(algo)
+	if err != nil {
+		return nil, err
+	}
+
+	device, err := getDevice()
+	if err != nil {
+		return nil, err
+	}
+	defer warnIfErrors(device.Close)
+
+	pubKey, err := curve.GetPubKey(device, path)
+	if err != nil {
+		return nil, err
+	}
+
+	return curve.NewPrivKey(pubKey, path), nil
+}
+
+// NewPrivKey will generate a new key of the given curve and store the public
+// key for later use. The request will require user confirmation and will show
+// account and index in the device
+func NewPrivKey(algo hd.PubKeyType, path hd.BIP44Params, hrp string) (types.LedgerPrivKey, string, error) {
+	curve, err := getCurve(algo)
+	if err != nil {
+		return nil, "", err
+	}
+
+	device, err := getDevice()
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to retrieve device: %w", err)
+	}
+	defer warnIfErrors(device.Close)
+
+	pubKey, addr, err := curve.GetPubKeyAddr(device, path, hrp)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to recover pubkey: %w", err)
+	}
+
+	return curve.NewPrivKey(pubKey, path), addr, nil
+}
+
+// ShowAddress triggers a ledger device to show the corresponding address.
+func ShowAddress(path hd.BIP44Params, expectedPubKey types.PubKey,
+	accountAddressPrefix string) error {
+	curve, err := getCurve(hd.PubKeyType(expectedPubKey.Type()))
+	if err != nil {
+		return err
+	}
+
+	device, err := getDevice()
+	if err != nil {
+		return err
+	}
+	defer warnIfErrors(device.Close)
+
+	pubKey, err := curve.GetPubKey(device, path)
+	if err != nil {
+		return err
+	}
+
+	if !pubKey.Equals(expectedPubKey) {
+		return fmt.Errorf("the key's pubkey does not match with the one retrieved from Ledger. Check that the HD path and device are the correct ones")
+	}
+
+	pubKey2, _, err := curve.GetPubKeyAddr(device, path, accountAddressPrefix)
+	if err != nil {
+		return err
+	}
+
+	if !pubKey2.Equals(expectedPubKey) {
+		return fmt.Errorf("the key's pubkey does not match with the one retrieved from Ledger. Check that the HD path and device are the correct ones")
+	}
+
+	return nil
+}
+
+// warnIfErrors wraps a function and writes a warning to stderr. This is required
+// to avoid ignoring errors when defer is used. Using defer may result in linter warnings.
+func warnIfErrors(f func() error) {
+	if err := f(); err != nil {
+		_, _ = fmt.Fprint(os.Stderr, "received error when closing ledger connection", err)
+	}
+}
+
+func getDevice() (Device, error) {
+	if discoverLedger == nil {
+		return nil, errors.New("no Ledger discovery function defined")
+	}
+
+	device, err := discoverLedger()
+	if err != nil {
+		return nil, errors.Wrap(err, "ledger nano S")
+	}
+
+	return device, nil
+}
+
+// validateKey allows us to verify the sanity of a public key cached for the
+// path, after loading it from disk.
+func validateKey(device Device, curve Curve, cachedPubKey types.PubKey, path hd.BIP44Params) error {
+	pub, err := curve.GetPubKey(device, path)
+	if err != nil {
+		return err
+	}
+
+	// verify this matches cached address
+	if !pub.Equals(cachedPubKey) {
+		return fmt.Errorf("cached key does not match retrieved key")
+	}
+
+	return nil
+}
+
+// sign calls the ledger after checking the key of the path still matches the
+// cached public key.
+//
+// Communication is checked on NewPrivKeyLedger and PrivKeyFromBytes, returning
+// an error, so this should only trigger if the private key is held in memory
+// for a while before use.
+func sign(device Device, curve Curve, cachedPubKey types.PubKey, path hd.BIP44Params, msg []byte) ([]byte, error) {
+	err := validateKey(device, curve, cachedPubKey, path)
+	if err != nil {
+		return nil, err
+	}
+
+	return curve.Sign(device, path, msg)
+}
+
+// signWithDevice signs a message with a newly discovered device.
+func signWithDevice(curve Curve, cachedPubKey types.PubKey, path hd.BIP44Params, msg []byte) ([]byte, error) {
+	device, err := getDevice()
+	if err != nil {
+		return nil, err
+	}
+	defer warnIfErrors(device.Close)
+
+	return sign(device, curve, cachedPubKey, path, msg)
+}
+
+// validateKeyWithDevice validates a cached public key with a newly discovered
+// device.
+func validateKeyWithDevice(curve Curve, cachedPubKey types.PubKey, path hd.BIP44Params) error {
+	device, err := getDevice()
+	if err != nil {
+		return err
+	}
+	defer warnIfErrors(device.Close)
+
+	return validateKey(device, curve, cachedPubKey, path)
+}
@@ -4,6 +4,10 @@
 package ledger
 
 import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
 	"fmt"
 
 	"github.com/btcsuite/btcd/btcec"
@@ -16,6 +20,7 @@ import (
 
 	"github.com/line/lbm-sdk/crypto/hd"
 	csecp256k1 "github.com/line/lbm-sdk/crypto/keys/secp256k1"
+	"github.com/line/lbm-sdk/crypto/keys/secp256r1"
 	"github.com/line/lbm-sdk/testutil/testdata"
 	sdk "github.com/line/lbm-sdk/types"
 )
@@ -24,11 +29,21 @@ import (
 // set the discoverLedger function which is responsible for loading the Ledger
 // device at runtime or returning an error.
 func init() {
-	discoverLedger = func() (SECP256K1, error) {
-		return LedgerSECP256K1Mock{}, nil
+	discoverLedger = func() (Device, error) {
+		return LedgerMock{}, nil
 	}
 }
 
+// LedgerMock mocks a ledger device supporting all the curves.
+type LedgerMock struct {
+	LedgerSECP256K1Mock
+	LedgerSECP256R1Mock
+}
+
+func (mock LedgerMock) Close() error {
+	return nil
+}
+
 type LedgerSECP256K1Mock struct {
 }
 
@@ -117,3 +132,78 @@ func (mock LedgerSECP256K1Mock) ShowAddressSECP256K1(bip32Path []uint32, hrp str
 	fmt.Printf("Request to show address for %v at %v", hrp, bip32Path)
 	return nil
 }
+
+type LedgerSECP256R1Mock struct {
+}
+
+func (mock LedgerSECP256R1Mock) Close() error {
+	return nil
+}
+
+// privKey derives the secp256r1 key of the path from the secret derived for
+// secp256k1, which suffices for a mock.
+func (mock LedgerSECP256R1Mock) privKey(derivationPath []uint32) (*ecdsa.PrivateKey, error) {
+	if derivationPath[0] != 44 {
+		return nil, errors.New("Invalid derivation path")
+	}
+
+	if derivationPath[1] != sdk.GetConfig().GetCoinType() {
+		return nil, errors.New("Invalid derivation path")
+	}
+
+	seed, err := bip39.NewSeedWithErrorChecking(testdata.TestMnemonic, "")
+	if err != nil {
+		return nil, err
+	}
+
+	path := hd.NewParams(derivationPath[0], derivationPath[1], derivationPath[2], derivationPath[3] != 0, derivationPath[4])
+	masterPriv, ch := hd.ComputeMastersFromSeed(seed)
+	derivedPriv, err := hd.DerivePrivateKeyForPath(masterPriv, ch, path.String())
+	if err != nil {
+		return nil, err
+	}
+
+	priv := secp256r1.NewPrivKeyFromSecret(derivedPriv)
+	return &priv.Secret.PrivateKey, nil
+}
+
+// GetPublicKeySECP256R1 mocks a ledger device, returning an uncompressed key
+func (mock LedgerSECP256R1Mock) GetPublicKeySECP256R1(derivationPath []uint32) ([]byte, error) {
+	priv, err := mock.privKey(derivationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	return elliptic.Marshal(priv.Curve, priv.X, priv.Y), nil
+}
+
+// GetAddressPubKeySECP256R1 mocks a ledger device, returning an uncompressed
+// key and a bech32 address
+func (mock LedgerSECP256R1Mock) GetAddressPubKeySECP256R1(derivationPath []uint32, hrp string) ([]byte, string, error) {
+	pk, err := mock.GetPublicKeySECP256R1(derivationPath)
+	if err != nil {
+		return nil, "", err
+	}
+
+	curve := elliptic.P256()
+	x, y := elliptic.Unmarshal(curve, pk)
+	pub, err := secp256r1.NewPubKeyFromBytes(elliptic.MarshalCompressed(curve, x, y))
+	if err != nil {
+		return nil, "", err
+	}
+
+	addr := sdk.AccAddress(pub.Address()).String()
+	return pk, addr, nil
+}
+
+// SignSECP256R1 mocks a ledger device, returning a DER signature which may
+// not be low-s normalized
+func (mock LedgerSECP256R1Mock) SignSECP256R1(derivationPath []uint32, message []byte) ([]byte, error) {
+	priv, err := mock.privKey(derivationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	digest := sha256.Sum256(message)
+	return ecdsa.SignASN1(rand.Reader, priv, digest[:])
+}
@@ -13,7 +13,7 @@ import (
 // set the discoverLedger function which is responsible for loading the Ledger
 // device at runtime or returning an error.
 func init() {
-	discoverLedger = func() (SECP256K1, error) {
+	discoverLedger = func() (Device, error) {
 		return nil, errors.New("support for ledger devices is not available in this executable")
 	}
 }
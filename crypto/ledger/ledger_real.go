@@ -8,7 +8,7 @@ import ledger "github.com/cosmos/ledger-cosmos-go"
 // set the discoverLedger function which is responsible for loading the Ledger
 // device at runtime or returning an error.
 func init() {
-	discoverLedger = func() (SECP256K1, error) {
+	discoverLedger = func() (Device, error) {
 		device, err := ledger.FindLedgerCosmosUserApp()
 		if err != nil {
 			return nil, err
@@ -1,12 +1,11 @@
 package ledger
 
 import (
+	"errors"
 	"fmt"
-	"os"
 
 	"github.com/btcsuite/btcd/btcec"
 	"github.com/line/ostracon/crypto"
-	"github.com/pkg/errors"
 
 	tmbtcec "github.com/tendermint/btcd/btcec"
 
@@ -15,18 +14,11 @@ import (
 	"github.com/line/lbm-sdk/crypto/types"
 )
 
-var (
-	// discoverLedger defines a function to be invoked at runtime for discovering
-	// a connected Ledger device.
-	discoverLedger discoverLedgerFn
-)
+func init() {
+	RegisterCurve(secp256k1Curve{})
+}
 
 type (
-	// discoverLedgerFn defines a Ledger discovery function that returns a
-	// connected device or an error upon failure. Its allows a method to avoid CGO
-	// dependencies when Ledger support is potentially not enabled.
-	discoverLedgerFn func() (SECP256K1, error)
-
 	// SECP256K1 reflects an interface a Ledger API must implement for SECP256K1
 	SECP256K1 interface {
 		Close() error
@@ -55,35 +47,13 @@ type (
 // It can only be used to verify a pubkey but never to create new accounts/keys. In that case,
 // please refer to NewPrivKeySecp256k1
 func NewPrivKeySecp256k1Unsafe(path hd.BIP44Params) (types.LedgerPrivKey, error) {
-	device, err := getDevice()
-	if err != nil {
-		return nil, err
-	}
-	defer warnIfErrors(device.Close)
-
-	pubKey, err := getPubKeyUnsafe(device, path)
-	if err != nil {
-		return nil, err
-	}
-
-	return PrivKeyLedgerSecp256k1{pubKey, path}, nil
+	return NewPrivKeyUnsafe(hd.Secp256k1Type, path)
 }
 
 // NewPrivKeySecp256k1 will generate a new key and store the public key for later use.
 // The request will require user confirmation and will show account and index in the device
 func NewPrivKeySecp256k1(path hd.BIP44Params, hrp string) (types.LedgerPrivKey, string, error) {
-	device, err := getDevice()
-	if err != nil {
-		return nil, "", fmt.Errorf("failed to retrieve device: %w", err)
-	}
-	defer warnIfErrors(device.Close)
-
-	pubKey, addr, err := getPubKeyAddrSafe(device, path, hrp)
-	if err != nil {
-		return nil, "", fmt.Errorf("failed to recover pubkey: %w", err)
-	}
-
-	return PrivKeyLedgerSecp256k1{pubKey, path}, addr, nil
+	return NewPrivKey(hd.Secp256k1Type, path, hrp)
 }
 
 // PubKey returns the cached public key.
@@ -97,55 +67,13 @@ func (pkl PrivKeyLedgerSecp256k1) VRFProve(seed []byte) (crypto.Proof, error) {
 
 // Sign returns a secp256k1 signature for the corresponding message
 func (pkl PrivKeyLedgerSecp256k1) Sign(message []byte) ([]byte, error) {
-	device, err := getDevice()
-	if err != nil {
-		return nil, err
-	}
-	defer warnIfErrors(device.Close)
-
-	return sign(device, pkl, message)
-}
-
-// ShowAddress triggers a ledger device to show the corresponding address.
-func ShowAddress(path hd.BIP44Params, expectedPubKey types.PubKey,
-	accountAddressPrefix string) error {
-	device, err := getDevice()
-	if err != nil {
-		return err
-	}
-	defer warnIfErrors(device.Close)
-
-	pubKey, err := getPubKeyUnsafe(device, path)
-	if err != nil {
-		return err
-	}
-
-	if !pubKey.Equals(expectedPubKey) {
-		return fmt.Errorf("the key's pubkey does not match with the one retrieved from Ledger. Check that the HD path and device are the correct ones")
-	}
-
-	pubKey2, _, err := getPubKeyAddrSafe(device, path, accountAddressPrefix)
-	if err != nil {
-		return err
-	}
-
-	if !pubKey2.Equals(expectedPubKey) {
-		return fmt.Errorf("the key's pubkey does not match with the one retrieved from Ledger. Check that the HD path and device are the correct ones")
-	}
-
-	return nil
+	return signWithDevice(secp256k1Curve{}, pkl.CachedPubKey, pkl.Path, message)
 }
 
 // ValidateKey allows us to verify the sanity of a public key after loading it
 // from disk.
 func (pkl PrivKeyLedgerSecp256k1) ValidateKey() error {
-	device, err := getDevice()
-	if err != nil {
-		return err
-	}
-	defer warnIfErrors(device.Close)
-
-	return validateKey(device, pkl)
+	return validateKeyWithDevice(secp256k1Curve{}, pkl.CachedPubKey, pkl.Path)
 }
 
 // AssertIsPrivKeyInner implements the PrivKey interface. It performs a no-op.
@@ -168,62 +96,75 @@ func (pkl PrivKeyLedgerSecp256k1) Equals(other types.LedgerPrivKey) bool {
 
 func (pkl PrivKeyLedgerSecp256k1) Type() string { return "PrivKeyLedgerSecp256k1" }
 
-// warnIfErrors wraps a function and writes a warning to stderr. This is required
-// to avoid ignoring errors when defer is used. Using defer may result in linter warnings.
-func warnIfErrors(f func() error) {
-	if err := f(); err != nil {
-		_, _ = fmt.Fprint(os.Stderr, "received error when closing ledger connection", err)
-	}
+// secp256k1Curve implements Curve for the devices implementing SECP256K1.
+type secp256k1Curve struct{}
+
+func (secp256k1Curve) Name() hd.PubKeyType {
+	return hd.Secp256k1Type
 }
 
-func convertDERtoBER(signatureDER []byte) ([]byte, error) {
-	sigDER, err := btcec.ParseDERSignature(signatureDER, btcec.S256())
-	if err != nil {
-		return nil, err
+func (secp256k1Curve) device(device Device) (SECP256K1, error) {
+	d, ok := device.(SECP256K1)
+	if !ok {
+		return nil, errors.New("the Ledger device does not support secp256k1 keys")
 	}
-	sigBER := tmbtcec.Signature{R: sigDER.R, S: sigDER.S}
-	return sigBER.Serialize(), nil
+	return d, nil
 }
 
-func getDevice() (SECP256K1, error) {
-	if discoverLedger == nil {
-		return nil, errors.New("no Ledger discovery function defined")
+// GetPubKey reads the pubkey from a ledger device
+//
+// This function is marked as unsafe as it will retrieve a pubkey without user verification
+// It can only be used to verify a pubkey but never to create new accounts/keys. In that case,
+// please refer to GetPubKeyAddr
+//
+// since this involves IO, it may return an error, which is not exposed
+// in the PubKey interface, so this function allows better error handling
+func (c secp256k1Curve) GetPubKey(device Device, path hd.BIP44Params) (types.PubKey, error) {
+	d, err := c.device(device)
+	if err != nil {
+		return nil, err
 	}
 
-	device, err := discoverLedger()
+	publicKey, err := d.GetPublicKeySECP256K1(path.DerivationPath())
 	if err != nil {
-		return nil, errors.Wrap(err, "ledger nano S")
+		return nil, fmt.Errorf("please open Cosmos app on the Ledger device - error: %v", err)
 	}
 
-	return device, nil
+	return parseSecp256k1PubKey(publicKey)
 }
 
-func validateKey(device SECP256K1, pkl PrivKeyLedgerSecp256k1) error {
-	pub, err := getPubKeyUnsafe(device, pkl.Path)
+// GetPubKeyAddr reads the pubkey and the address from a ledger device.
+// This function is marked as Safe as it will require user confirmation and
+// account and index will be shown in the device.
+//
+// Since this involves IO, it may return an error, which is not exposed
+// in the PubKey interface, so this function allows better error handling.
+func (c secp256k1Curve) GetPubKeyAddr(device Device, path hd.BIP44Params, hrp string) (types.PubKey, string, error) {
+	d, err := c.device(device)
+	if err != nil {
+		return nil, "", err
+	}
+
+	publicKey, addr, err := d.GetAddressPubKeySECP256K1(path.DerivationPath(), hrp)
 	if err != nil {
-		return err
+		return nil, "", fmt.Errorf("%w: address rejected for path %s", err, path.String())
 	}
 
-	// verify this matches cached address
-	if !pub.Equals(pkl.CachedPubKey) {
-		return fmt.Errorf("cached key does not match retrieved key")
+	pubKey, err := parseSecp256k1PubKey(publicKey)
+	if err != nil {
+		return nil, "", err
 	}
 
-	return nil
+	return pubKey, addr, nil
 }
 
-// Sign calls the ledger and stores the PubKey for future use.
-//
-// Communication is checked on NewPrivKeyLedger and PrivKeyFromBytes, returning
-// an error, so this should only trigger if the private key is held in memory
-// for a while before use.
-func sign(device SECP256K1, pkl PrivKeyLedgerSecp256k1, msg []byte) ([]byte, error) {
-	err := validateKey(device, pkl)
+func (c secp256k1Curve) Sign(device Device, path hd.BIP44Params, msg []byte) ([]byte, error) {
+	d, err := c.device(device)
 	if err != nil {
 		return nil, err
 	}
 
-	sig, err := device.SignSECP256K1(pkl.Path.DerivationPath(), msg)
+	sig, err := d.SignSECP256K1(path.DerivationPath(), msg)
 	if err != nil {
 		return nil, err
 	}
@@ -231,21 +172,12 @@ func sign(device SECP256K1, pkl PrivKeyLedgerSecp256k1, msg []byte) ([]byte, err
 	return convertDERtoBER(sig)
 }
 
-// getPubKeyUnsafe reads the pubkey from a ledger device
-//
-// This function is marked as unsafe as it will retrieve a pubkey without user verification
-// It can only be used to verify a pubkey but never to create new accounts/keys. In that case,
-// please refer to getPubKeyAddrSafe
-//
-// since this involves IO, it may return an error, which is not exposed
-// in the PubKey interface, so this function allows better error handling
-func getPubKeyUnsafe(device SECP256K1, path hd.BIP44Params) (types.PubKey, error) {
-	publicKey, err := device.GetPublicKeySECP256K1(path.DerivationPath())
-	if err != nil {
-		return nil, fmt.Errorf("please open Cosmos app on the Ledger device - error: %v", err)
-	}
+func (secp256k1Curve) NewPrivKey(pubKey types.PubKey, path hd.BIP44Params) types.LedgerPrivKey {
+	return PrivKeyLedgerSecp256k1{pubKey, path}
+}
 
-	// re-serialize in the 33-byte compressed format
+// parseSecp256k1PubKey re-serializes a pubkey in the 33-byte compressed format.
+func parseSecp256k1PubKey(publicKey []byte) (types.PubKey, error) {
 	cmp, err := btcec.ParsePubKey(publicKey, btcec.S256())
 	if err != nil {
 		return nil, fmt.Errorf("error parsing public key: %v", err)
@@ -257,26 +189,11 @@ func getPubKeyUnsafe(device SECP256K1, path hd.BIP44Params) (types.PubKey, error
 	return &secp256k1.PubKey{Key: compressedPublicKey}, nil
 }
 
-// getPubKeyAddr reads the pubkey and the address from a ledger device.
-// This function is marked as Safe as it will require user confirmation and
-// account and index will be shown in the device.
-//
-// Since this involves IO, it may return an error, which is not exposed
-// in the PubKey interface, so this function allows better error handling.
-func getPubKeyAddrSafe(device SECP256K1, path hd.BIP44Params, hrp string) (types.PubKey, string, error) {
-	publicKey, addr, err := device.GetAddressPubKeySECP256K1(path.DerivationPath(), hrp)
-	if err != nil {
-		return nil, "", fmt.Errorf("%w: address rejected for path %s", err, path.String())
-	}
-
-	// re-serialize in the 33-byte compressed format
-	cmp, err := btcec.ParsePubKey(publicKey, btcec.S256())
+func convertDERtoBER(signatureDER []byte) ([]byte, error) {
+	sigDER, err := btcec.ParseDERSignature(signatureDER, btcec.S256())
 	if err != nil {
-		return nil, "", fmt.Errorf("error parsing public key: %v", err)
+		return nil, err
 	}
-
-	compressedPublicKey := make([]byte, secp256k1.PubKeySize)
-	copy(compressedPublicKey, cmp.SerializeCompressed())
-
-	return &secp256k1.PubKey{Key: compressedPublicKey}, addr, nil
+	sigBER := tmbtcec.Signature{R: sigDER.R, S: sigDER.S}
+	return sigBER.Serialize(), nil
 }
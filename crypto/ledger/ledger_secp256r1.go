@@ -0,0 +1,193 @@
+package ledger
+
+import (
+	"crypto/elliptic"
+	"encoding/asn1"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/line/ostracon/crypto"
+
+	"github.com/line/lbm-sdk/crypto/hd"
+	"github.com/line/lbm-sdk/crypto/keys/secp256r1"
+	"github.com/line/lbm-sdk/crypto/types"
+)
+
+func init() {
+	RegisterCurve(secp256r1Curve{})
+}
+
+type (
+	// SECP256R1 reflects an interface a Ledger API must implement for SECP256R1
+	SECP256R1 interface {
+		Close() error
+		// Returns an uncompressed pubkey
+		GetPublicKeySECP256R1([]uint32) ([]byte, error)
+		// Returns an uncompressed pubkey and bech32 address (requires user confirmation)
+		GetAddressPubKeySECP256R1([]uint32, string) ([]byte, string, error)
+		// Signs a message hashed with SHA-256, returning a DER signature
+		// (requires user confirmation)
+		SignSECP256R1([]uint32, []byte) ([]byte, error)
+	}
+
+	// PrivKeyLedgerSecp256r1 implements PrivKey for the secp256r1 keys on a
+	// ledger, caching the PubKey from the first call to use it later.
+	PrivKeyLedgerSecp256r1 struct {
+		// CachedPubKey is exported to encode it via go-amino, as in
+		// PrivKeyLedgerSecp256k1.
+		CachedPubKey types.PubKey
+		Path         hd.BIP44Params
+	}
+)
+
+// PubKey returns the cached public key.
+func (pkl PrivKeyLedgerSecp256r1) PubKey() types.PubKey {
+	return pkl.CachedPubKey
+}
+
+func (pkl PrivKeyLedgerSecp256r1) VRFProve(seed []byte) (crypto.Proof, error) {
+	return nil, nil
+}
+
+// Sign returns a secp256r1 signature for the corresponding message
+func (pkl PrivKeyLedgerSecp256r1) Sign(message []byte) ([]byte, error) {
+	return signWithDevice(secp256r1Curve{}, pkl.CachedPubKey, pkl.Path, message)
+}
+
+// ValidateKey allows us to verify the sanity of a public key after loading it
+// from disk.
+func (pkl PrivKeyLedgerSecp256r1) ValidateKey() error {
+	return validateKeyWithDevice(secp256r1Curve{}, pkl.CachedPubKey, pkl.Path)
+}
+
+// AssertIsPrivKeyInner implements the PrivKey interface. It performs a no-op.
+func (pkl *PrivKeyLedgerSecp256r1) AssertIsPrivKeyInner() {}
+
+// Bytes implements the PrivKey interface. It stores the cached public key so
+// we can verify the same key when we reconnect to a ledger.
+func (pkl PrivKeyLedgerSecp256r1) Bytes() []byte {
+	return cdc.MustMarshal(pkl)
+}
+
+// Equals implements the PrivKey interface. It makes sure two private keys
+// refer to the same public key.
+func (pkl PrivKeyLedgerSecp256r1) Equals(other types.LedgerPrivKey) bool {
+	if otherKey, ok := other.(PrivKeyLedgerSecp256r1); ok {
+		return pkl.CachedPubKey.Equals(otherKey.CachedPubKey)
+	}
+	return false
+}
+
+func (pkl PrivKeyLedgerSecp256r1) Type() string { return "PrivKeyLedgerSecp256r1" }
+
+// secp256r1Curve implements Curve for the devices implementing SECP256R1.
+type secp256r1Curve struct{}
+
+func (secp256r1Curve) Name() hd.PubKeyType {
+	return hd.Secp256r1Type
+}
+
+func (secp256r1Curve) device(device Device) (SECP256R1, error) {
+	d, ok := device.(SECP256R1)
+	if !ok {
+		return nil, errors.New("the Ledger device does not support secp256r1 keys")
+	}
+	return d, nil
+}
+
+func (c secp256r1Curve) GetPubKey(device Device, path hd.BIP44Params) (types.PubKey, error) {
+	d, err := c.device(device)
+	if err != nil {
+		return nil, err
+	}
+
+	publicKey, err := d.GetPublicKeySECP256R1(path.DerivationPath())
+	if err != nil {
+		return nil, fmt.Errorf("please open the app supporting secp256r1 on the Ledger device - error: %v", err)
+	}
+
+	return parseSecp256r1PubKey(publicKey)
+}
+
+func (c secp256r1Curve) GetPubKeyAddr(device Device, path hd.BIP44Params, hrp string) (types.PubKey, string, error) {
+	d, err := c.device(device)
+	if err != nil {
+		return nil, "", err
+	}
+
+	publicKey, addr, err := d.GetAddressPubKeySECP256R1(path.DerivationPath(), hrp)
+	if err != nil {
+		return nil, "", fmt.Errorf("%w: address rejected for path %s", err, path.String())
+	}
+
+	pubKey, err := parseSecp256r1PubKey(publicKey)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return pubKey, addr, nil
+}
+
+func (c secp256r1Curve) Sign(device Device, path hd.BIP44Params, msg []byte) ([]byte, error) {
+	d, err := c.device(device)
+	if err != nil {
+		return nil, err
+	}
+
+	sig, err := d.SignSECP256R1(path.DerivationPath(), msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return convertP256DERtoRaw(sig)
+}
+
+func (secp256r1Curve) NewPrivKey(pubKey types.PubKey, path hd.BIP44Params) types.LedgerPrivKey {
+	return PrivKeyLedgerSecp256r1{pubKey, path}
+}
+
+// parseSecp256r1PubKey re-serializes an uncompressed or compressed pubkey in
+// the 33-byte compressed format.
+func parseSecp256r1PubKey(publicKey []byte) (types.PubKey, error) {
+	curve := elliptic.P256()
+	x, y := elliptic.Unmarshal(curve, publicKey)
+	if x == nil {
+		x, y = elliptic.UnmarshalCompressed(curve, publicKey)
+	}
+	if x == nil {
+		return nil, errors.New("error parsing public key: invalid secp256r1 point")
+	}
+
+	return secp256r1.NewPubKeyFromBytes(elliptic.MarshalCompressed(curve, x, y))
+}
+
+// convertP256DERtoRaw converts a DER signature into the low-s normalized,
+// fixed width R || S format secp256r1 pubkeys verify.
+func convertP256DERtoRaw(signatureDER []byte) ([]byte, error) {
+	var sig struct {
+		R, S *big.Int
+	}
+	rest, err := asn1.Unmarshal(signatureDER, &sig)
+	if err != nil {
+		return nil, err
+	}
+	order := elliptic.P256().Params().N
+	if len(rest) != 0 || !inOrder(sig.R, order) || !inOrder(sig.S, order) {
+		return nil, errors.New("malformed secp256r1 signature")
+	}
+
+	if sig.S.Cmp(new(big.Int).Rsh(order, 1)) > 0 {
+		sig.S = new(big.Int).Sub(order, sig.S)
+	}
+
+	raw := make([]byte, 64)
+	sig.R.FillBytes(raw[:32])
+	sig.S.FillBytes(raw[32:])
+	return raw, nil
+}
+
+// inOrder returns true if 0 < n < order.
+func inOrder(n, order *big.Int) bool {
+	return n != nil && n.Sign() > 0 && n.Cmp(order) < 0
+}
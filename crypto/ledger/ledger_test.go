@@ -240,3 +240,43 @@ func TestRealDeviceSecp256k1(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, pub, bpub)
 }
+
+func TestUnsupportedCurve(t *testing.T) {
+	path := *hd.NewFundraiserParams(0, sdk.CoinType, 0)
+	_, err := NewPrivKeyUnsafe(hd.Ed25519Type, path)
+	require.EqualError(t, err, "ledger keys of ed25519 are not supported")
+}
+
+func TestSecp256r1(t *testing.T) {
+	msg := getFakeTx(50)
+	path := *hd.NewFundraiserParams(0, sdk.CoinType, 0)
+	priv, addr, err := NewPrivKey(hd.Secp256r1Type, path, "link")
+	require.NoError(t, err)
+	require.Equal(t, sdk.AccAddress(priv.PubKey().Address()).String(), addr)
+	require.Equal(t, string(hd.Secp256r1Type), priv.PubKey().Type())
+	require.NoError(t, ShowAddress(path, priv.PubKey(), "link"))
+
+	tmp := priv.(PrivKeyLedgerSecp256r1)
+	require.NoError(t, tmp.ValidateKey())
+
+	// the keys differ from the secp256k1 ones of the same path
+	priv2, err := NewPrivKeySecp256k1Unsafe(path)
+	require.NoError(t, err)
+	require.False(t, priv.Equals(priv2))
+	require.False(t, priv.PubKey().Equals(priv2.PubKey()))
+
+	// signatures are low-s normalized, as the pubkey verifies
+	for i := 0; i < 10; i++ {
+		sig, err := priv.Sign(msg)
+		require.NoError(t, err)
+		require.True(t, priv.PubKey().VerifySignature(msg, sig))
+	}
+
+	// the cached pubkey is stored and restored
+	var restored PrivKeyLedgerSecp256r1
+	require.NoError(t, cdc.Unmarshal(priv.Bytes(), &restored))
+	require.True(t, priv.Equals(restored))
+	priv3, err := NewPrivKeyUnsafe(hd.Secp256r1Type, path)
+	require.NoError(t, err)
+	require.True(t, priv3.Equals(restored))
+}
@@ -0,0 +1,333 @@
+package crypto
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/elliptic"
+	"crypto/sha1" // nolint: gosec // the default PRF of PBKDF2, for decryption only
+	"crypto/sha256"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"hash"
+
+	"github.com/line/ostracon/crypto"
+	"golang.org/x/crypto/pbkdf2"
+
+	cryptotypes "github.com/line/lbm-sdk/crypto/types"
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
+)
+
+const (
+	blockTypeEncryptedPKCS8 = "ENCRYPTED PRIVATE KEY"
+
+	// pkcs8MaxIterations bounds the PBKDF2 iteration count of the keys
+	// imported, not to hang on a malicious key.
+	pkcs8MaxIterations = 10000000
+)
+
+// PKCS8Iterations is the PBKDF2 iteration count of the PKCS#8 keys exported.
+// Like BcryptSecurityParameter and the keystore scrypt parameters, the KDF
+// costs of the exported keys are vars so that the tests can lower them.
+var PKCS8Iterations = 600000
+
+var (
+	oidPublicKeyECDSA   = asn1.ObjectIdentifier{1, 2, 840, 10045, 2, 1}
+	oidPublicKeyEd25519 = asn1.ObjectIdentifier{1, 3, 101, 112}
+	oidCurveSecp256k1   = asn1.ObjectIdentifier{1, 3, 132, 0, 10}
+	oidCurveSecp256r1   = asn1.ObjectIdentifier{1, 2, 840, 10045, 3, 1, 7}
+
+	oidPBES2          = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 5, 13}
+	oidPBKDF2         = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 5, 12}
+	oidHMACWithSHA1   = asn1.ObjectIdentifier{1, 2, 840, 113549, 2, 7}
+	oidHMACWithSHA256 = asn1.ObjectIdentifier{1, 2, 840, 113549, 2, 9}
+	oidAES128CBC      = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 1, 2}
+	oidAES256CBC      = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 1, 42}
+)
+
+// pkcs8PrivateKey is the PrivateKeyInfo of RFC 5208.
+type pkcs8PrivateKey struct {
+	Version    int
+	Algo       pkix.AlgorithmIdentifier
+	PrivateKey []byte
+}
+
+// ecPrivateKey is the ECPrivateKey of RFC 5915.
+type ecPrivateKey struct {
+	Version       int
+	PrivateKey    []byte
+	NamedCurveOID asn1.ObjectIdentifier `asn1:"optional,explicit,tag:0"`
+	PublicKey     asn1.BitString        `asn1:"optional,explicit,tag:1"`
+}
+
+// encryptedPrivateKeyInfo is the EncryptedPrivateKeyInfo of RFC 5208.
+type encryptedPrivateKeyInfo struct {
+	Algo          pkix.AlgorithmIdentifier
+	EncryptedData []byte
+}
+
+// pbes2Params is the PBES2-params of RFC 8018.
+type pbes2Params struct {
+	KeyDerivationFunc pkix.AlgorithmIdentifier
+	EncryptionScheme  pkix.AlgorithmIdentifier
+}
+
+// pbkdf2Params is the PBKDF2-params of RFC 8018.
+type pbkdf2Params struct {
+	Salt           []byte
+	IterationCount int
+	KeyLength      int                      `asn1:"optional"`
+	PRF            pkix.AlgorithmIdentifier `asn1:"optional"`
+}
+
+// EncryptPKCS8PrivKey returns the private key as a PEM encoded PKCS#8
+// EncryptedPrivateKeyInfo, encrypted with PBES2 of PBKDF2-HMAC-SHA256 and
+// AES-256-CBC as `openssl pkcs8 -topk8 -v2 aes-256-cbc` does.
+func EncryptPKCS8PrivKey(privKey cryptotypes.PrivKey, passphrase string) (string, error) {
+	der, err := marshalPKCS8PrivKey(privKey)
+	if err != nil {
+		return "", err
+	}
+
+	salt := crypto.CRandBytes(16)
+	iv := crypto.CRandBytes(aes.BlockSize)
+	key := pbkdf2.Key([]byte(passphrase), salt, PKCS8Iterations, 32, sha256.New)
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return "", err
+	}
+	padding := aes.BlockSize - len(der)%aes.BlockSize
+	encrypted := append(der, bytes.Repeat([]byte{byte(padding)}, padding)...)
+	cipher.NewCBCEncrypter(block, iv).CryptBlocks(encrypted, encrypted)
+
+	kdfParams, err := asn1.Marshal(pbkdf2Params{
+		Salt:           salt,
+		IterationCount: PKCS8Iterations,
+		PRF:            pkix.AlgorithmIdentifier{Algorithm: oidHMACWithSHA256, Parameters: asn1.NullRawValue},
+	})
+	if err != nil {
+		return "", err
+	}
+	ivParams, err := asn1.Marshal(iv)
+	if err != nil {
+		return "", err
+	}
+	params, err := asn1.Marshal(pbes2Params{
+		KeyDerivationFunc: pkix.AlgorithmIdentifier{Algorithm: oidPBKDF2, Parameters: asn1.RawValue{FullBytes: kdfParams}},
+		EncryptionScheme:  pkix.AlgorithmIdentifier{Algorithm: oidAES256CBC, Parameters: asn1.RawValue{FullBytes: ivParams}},
+	})
+	if err != nil {
+		return "", err
+	}
+	bz, err := asn1.Marshal(encryptedPrivateKeyInfo{
+		Algo:          pkix.AlgorithmIdentifier{Algorithm: oidPBES2, Parameters: asn1.RawValue{FullBytes: params}},
+		EncryptedData: encrypted,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: blockTypeEncryptedPKCS8, Bytes: bz})), nil
+}
+
+// DecryptPKCS8PrivKey returns the private key of a PEM encoded PKCS#8
+// EncryptedPrivateKeyInfo encrypted with PBES2 of PBKDF2 and AES-CBC.
+func DecryptPKCS8PrivKey(pemStr string, passphrase string) (cryptotypes.PrivKey, error) {
+	block, _ := pem.Decode([]byte(pemStr))
+	if block == nil {
+		return nil, errors.New("no PEM block found")
+	}
+	if block.Type != blockTypeEncryptedPKCS8 {
+		return nil, fmt.Errorf("unrecognized PEM type %q, expected: %q", block.Type, blockTypeEncryptedPKCS8)
+	}
+
+	var info encryptedPrivateKeyInfo
+	if err := unmarshalDER(block.Bytes, &info); err != nil {
+		return nil, err
+	}
+	if !info.Algo.Algorithm.Equal(oidPBES2) {
+		return nil, fmt.Errorf("unsupported PKCS#8 encryption %s, only PBES2 is supported", info.Algo.Algorithm)
+	}
+	var params pbes2Params
+	if err := unmarshalDER(info.Algo.Parameters.FullBytes, &params); err != nil {
+		return nil, err
+	}
+
+	var keySize int
+	switch scheme := params.EncryptionScheme.Algorithm; {
+	case scheme.Equal(oidAES128CBC):
+		keySize = 16
+	case scheme.Equal(oidAES256CBC):
+		keySize = 32
+	default:
+		return nil, fmt.Errorf("unsupported PBES2 encryption scheme %s", scheme)
+	}
+	var iv []byte
+	if err := unmarshalDER(params.EncryptionScheme.Parameters.FullBytes, &iv); err != nil {
+		return nil, err
+	}
+	if len(iv) != aes.BlockSize {
+		return nil, errors.New("invalid AES-CBC IV")
+	}
+
+	if !params.KeyDerivationFunc.Algorithm.Equal(oidPBKDF2) {
+		return nil, fmt.Errorf("unsupported PBES2 key derivation function %s", params.KeyDerivationFunc.Algorithm)
+	}
+	var kdfParams pbkdf2Params
+	if err := unmarshalDER(params.KeyDerivationFunc.Parameters.FullBytes, &kdfParams); err != nil {
+		return nil, err
+	}
+	if kdfParams.IterationCount <= 0 || kdfParams.IterationCount > pkcs8MaxIterations {
+		return nil, fmt.Errorf("invalid PBKDF2 iteration count %d", kdfParams.IterationCount)
+	}
+	var prf func() hash.Hash
+	switch prfOID := kdfParams.PRF.Algorithm; {
+	case len(prfOID) == 0 || prfOID.Equal(oidHMACWithSHA1):
+		prf = sha1.New
+	case prfOID.Equal(oidHMACWithSHA256):
+		prf = sha256.New
+	default:
+		return nil, fmt.Errorf("unsupported PBKDF2 PRF %s", prfOID)
+	}
+
+	encrypted := info.EncryptedData
+	if len(encrypted) == 0 || len(encrypted)%aes.BlockSize != 0 {
+		return nil, errors.New("invalid PKCS#8 encrypted data size")
+	}
+	key := pbkdf2.Key([]byte(passphrase), kdfParams.Salt, kdfParams.IterationCount, keySize, prf)
+	cipherBlock, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	der := make([]byte, len(encrypted))
+	cipher.NewCBCDecrypter(cipherBlock, iv).CryptBlocks(der, encrypted)
+
+	// a wrong passphrase gives invalid padding or DER with a high probability
+	padding := int(der[len(der)-1])
+	if padding == 0 || padding > aes.BlockSize || !bytes.Equal(der[len(der)-padding:], bytes.Repeat([]byte{byte(padding)}, padding)) {
+		return nil, sdkerrors.ErrWrongPassword
+	}
+	der = der[:len(der)-padding]
+	var keyInfo pkcs8PrivateKey
+	if err := unmarshalDER(der, &keyInfo); err != nil {
+		return nil, sdkerrors.ErrWrongPassword
+	}
+
+	return parsePKCS8PrivKey(der)
+}
+
+// marshalPKCS8PrivKey returns the DER encoded PrivateKeyInfo of the private
+// key.
+func marshalPKCS8PrivKey(privKey cryptotypes.PrivKey) ([]byte, error) {
+	ks, err := newKeySecret(privKey)
+	if err != nil {
+		return nil, err
+	}
+
+	var info pkcs8PrivateKey
+	switch ks.algo {
+	case algoEd25519:
+		// CurvePrivateKey of RFC 8410
+		info.Algo = pkix.AlgorithmIdentifier{Algorithm: oidPublicKeyEd25519}
+		if info.PrivateKey, err = asn1.Marshal(ks.secret); err != nil {
+			return nil, err
+		}
+	default:
+		curveOID := oidCurveSecp256k1
+		if ks.algo == algoSecp256r1 {
+			curveOID = oidCurveSecp256r1
+		}
+		curveParams, err := asn1.Marshal(curveOID)
+		if err != nil {
+			return nil, err
+		}
+		info.Algo = pkix.AlgorithmIdentifier{Algorithm: oidPublicKeyECDSA, Parameters: asn1.RawValue{FullBytes: curveParams}}
+
+		x, y := ks.publicPoint()
+		pub := elliptic.Marshal(ks.curve(), x, y)
+		info.PrivateKey, err = asn1.Marshal(ecPrivateKey{
+			Version:    1,
+			PrivateKey: ks.secret,
+			PublicKey:  asn1.BitString{Bytes: pub, BitLength: 8 * len(pub)},
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return asn1.Marshal(info)
+}
+
+// parsePKCS8PrivKey returns the private key of a DER encoded
+// PrivateKeyInfo.
+func parsePKCS8PrivKey(der []byte) (cryptotypes.PrivKey, error) {
+	var info pkcs8PrivateKey
+	if err := unmarshalDER(der, &info); err != nil {
+		return nil, err
+	}
+
+	switch {
+	case info.Algo.Algorithm.Equal(oidPublicKeyEd25519):
+		var seed []byte
+		if err := unmarshalDER(info.PrivateKey, &seed); err != nil {
+			return nil, err
+		}
+		return keySecret{algoEd25519, seed}.privKey()
+
+	case info.Algo.Algorithm.Equal(oidPublicKeyECDSA):
+		var curveOID asn1.ObjectIdentifier
+		if err := unmarshalDER(info.Algo.Parameters.FullBytes, &curveOID); err != nil {
+			return nil, err
+		}
+		var ks keySecret
+		switch {
+		case curveOID.Equal(oidCurveSecp256k1):
+			ks.algo = algoSecp256k1
+		case curveOID.Equal(oidCurveSecp256r1):
+			ks.algo = algoSecp256r1
+		default:
+			return nil, fmt.Errorf("unsupported elliptic curve %s", curveOID)
+		}
+
+		var ecKey ecPrivateKey
+		if err := unmarshalDER(info.PrivateKey, &ecKey); err != nil {
+			return nil, err
+		}
+		if ecKey.Version != 1 {
+			return nil, fmt.Errorf("unknown EC private key version %d", ecKey.Version)
+		}
+		ks.secret = ecKey.PrivateKey
+		privKey, err := ks.privKey()
+		if err != nil {
+			return nil, err
+		}
+
+		// the optional public key must be the one of the private key
+		if len(ecKey.PublicKey.Bytes) != 0 {
+			x, y := ks.publicPoint()
+			if !bytes.Equal(ecKey.PublicKey.Bytes, elliptic.Marshal(ks.curve(), x, y)) &&
+				!bytes.Equal(ecKey.PublicKey.Bytes, elliptic.MarshalCompressed(ks.curve(), x, y)) {
+				return nil, errors.New("the public key does not match the private key")
+			}
+		}
+		return privKey, nil
+
+	default:
+		return nil, fmt.Errorf("unsupported private key algorithm %s", info.Algo.Algorithm)
+	}
+}
+
+// unmarshalDER unmarshals a DER value, failing on trailing data.
+func unmarshalDER(der []byte, val interface{}) error {
+	rest, err := asn1.Unmarshal(der, val)
+	if err != nil {
+		return err
+	}
+	if len(rest) != 0 {
+		return errors.New("trailing data after ASN.1 value")
+	}
+	return nil
+}
@@ -23,6 +23,10 @@ const (
 
 	// DefaultGRPCWebAddress defines the default address to bind the gRPC-web server to.
 	DefaultGRPCWebAddress = "0.0.0.0:9091"
+
+	// DefaultGasPriceHistoryBlocks defines the default number of recent blocks
+	// the gas prices are suggested from.
+	DefaultGasPriceHistoryBlocks = 20
 )
 
 // BaseConfig defines the server's basic configuration
@@ -82,6 +86,23 @@ type BaseConfig struct {
 	// Bech32CacheSize is the maximum bytes size of bech32 cache (Default : 1GB)
 	Bech32CacheSize int `mapstructure:"bech32-cache-size"`
 
+	// Archive enables the archive of the application state, which keeps every
+	// committed version so that queries can be served at pruned heights.
+	Archive bool `mapstructure:"archive"`
+
+	// MaxPendingTxsPerSigner is the maximum number of txs of a signer which can
+	// be pending in CheckTx at the same time. 0 means no limit.
+	MaxPendingTxsPerSigner uint64 `mapstructure:"max-pending-txs-per-signer"`
+
+	// StoreProfiling enables the profiling of the store operations of the
+	// delivered txs by Msg type and store key.
+	StoreProfiling bool `mapstructure:"store-profiling"`
+
+	// GasPriceHistoryBlocks is the number of recent blocks whose delivered txs
+	// the fee estimation service suggests gas prices from. 0 disables the
+	// history, leaving the mempool and the minimum gas prices.
+	GasPriceHistoryBlocks uint64 `mapstructure:"gas-price-history-blocks"`
+
 	// When true, Prometheus metrics are served under /metrics on prometheus_listen_addr in config.toml.
 	// It works when tendermint's prometheus option (config.toml) is set to true.
 	Prometheus bool `mapstructure:"prometheus"`
@@ -220,16 +241,17 @@ func (c *Config) GetMinGasPrices() sdk.DecCoins {
 func DefaultConfig() *Config {
 	return &Config{
 		BaseConfig: BaseConfig{
-			MinGasPrices:        defaultMinGasPrices,
-			InterBlockCache:     true,
-			InterBlockCacheSize: cache.DefaultCommitKVStoreCacheSize,
-			IAVLCacheSize:       iavl.DefaultIAVLCacheSize,
-			Pruning:             storetypes.PruningOptionDefault,
-			PruningKeepRecent:   "0",
-			PruningKeepEvery:    "0",
-			PruningInterval:     "0",
-			MinRetainBlocks:     0,
-			IndexEvents:         make([]string, 0),
+			MinGasPrices:          defaultMinGasPrices,
+			InterBlockCache:       true,
+			InterBlockCacheSize:   cache.DefaultCommitKVStoreCacheSize,
+			IAVLCacheSize:         iavl.DefaultIAVLCacheSize,
+			Pruning:               storetypes.PruningOptionDefault,
+			PruningKeepRecent:     "0",
+			PruningKeepEvery:      "0",
+			PruningInterval:       "0",
+			MinRetainBlocks:       0,
+			IndexEvents:           make([]string, 0),
+			GasPriceHistoryBlocks: DefaultGasPriceHistoryBlocks,
 		},
 		Telemetry: telemetry.Config{
 			Enabled:      false,
@@ -292,6 +314,11 @@ func GetConfig(v *viper.Viper) Config {
 			IndexEvents:       v.GetStringSlice("index-events"),
 			MinRetainBlocks:   v.GetUint64("min-retain-blocks"),
 			IAVLCacheSize:     v.GetUint64("iavl-cache-size"),
+			Archive:           v.GetBool("archive"),
+
+			MaxPendingTxsPerSigner: v.GetUint64("max-pending-txs-per-signer"),
+			StoreProfiling:         v.GetBool("store-profiling"),
+			GasPriceHistoryBlocks:  v.GetUint64("gas-price-history-blocks"),
 		},
 		Telemetry: telemetry.Config{
 			ServiceName:             v.GetString("telemetry.service-name"),
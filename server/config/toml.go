@@ -72,6 +72,25 @@ iavl-cache-size = {{ .BaseConfig.IAVLCacheSize }}
 # Bech32CacheSize is the maximum bytes size of bech32 cache (Default : 1GB)
 bech32-cache-size = {{ .BaseConfig.Bech32CacheSize }}
 
+# Archive enables the archive of the application state, which keeps every
+# committed version in data/archive.db so that queries can be served at heights
+# removed by pruning.
+archive = {{ .BaseConfig.Archive }}
+
+# MaxPendingTxsPerSigner is the maximum number of txs of a signer which can be
+# pending in CheckTx at the same time. 0 means no limit.
+max-pending-txs-per-signer = {{ .BaseConfig.MaxPendingTxsPerSigner }}
+
+# StoreProfiling enables the profiling of the store operations of the delivered
+# txs by Msg type and store key. The profile is reported to telemetry and served
+# at /lbm/base/profiler/v1/store_profile.
+store-profiling = {{ .BaseConfig.StoreProfiling }}
+
+# GasPriceHistoryBlocks is the number of recent blocks whose delivered txs the
+# fee estimation service at /lbm/base/feeestimate/v1/estimate_fee suggests gas
+# prices from, along with the mempool. 0 disables the history.
+gas-price-history-blocks = {{ .BaseConfig.GasPriceHistoryBlocks }}
+
 # IndexEvents defines the set of events in the form {eventType}.{attributeKey},
 # which informs Tendermint what to index. If empty, all events will be indexed.
 #
@@ -4,7 +4,9 @@ import (
 	"path/filepath"
 	"testing"
 
+	"github.com/spf13/viper"
 	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
 )
 
 func Test_openDB(t *testing.T) {
@@ -13,6 +15,19 @@ func Test_openDB(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestOpenDB(t *testing.T) {
+	t.Parallel()
+	v := viper.New()
+	db, err := OpenDB("archive", t.TempDir(), v)
+	require.NoError(t, err)
+	require.NoError(t, db.Close())
+
+	v.Set("db_backend", string(dbm.MemDBBackend))
+	db, err = OpenDB("archive", t.TempDir(), v)
+	require.NoError(t, err)
+	require.IsType(t, &dbm.MemDB{}, db)
+}
+
 func Test_openTraceWriter(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,358 @@
+package server
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/line/lbm-sdk/client/flags"
+	"github.com/line/lbm-sdk/store/rootmulti"
+	storetypes "github.com/line/lbm-sdk/store/types"
+	sdk "github.com/line/lbm-sdk/types"
+	"github.com/line/lbm-sdk/types/kv"
+)
+
+const (
+	flagPrefix = "prefix"
+)
+
+// DebugStoreCmd creates a command family to inspect and repair the multistore
+// of the application database without starting the node. The decoders are used
+// to print the key-value pairs of the stores in a readable form, they may be
+// nil.
+func DebugStoreCmd(defaultNodeHome string, decoders func() sdk.StoreDecoderRegistry) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "store",
+		Short: "Inspect and repair the multistore of the application database",
+		Long: `Inspect and repair the multistore of the application database offline.
+The node must be stopped before running these commands.`,
+	}
+
+	cmd.AddCommand(
+		debugStoreListCmd(),
+		debugStoreDumpCmd(decoders),
+		debugStoreDiffCmd(decoders),
+		debugStoreDeleteVersionCmd(),
+	)
+
+	cmd.PersistentFlags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	return cmd
+}
+
+func debugStoreListCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List the stores committed at a height and their commit IDs",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			db, err := openDBFromCmd(cmd)
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			height, _ := cmd.Flags().GetInt64(FlagHeight)
+			if height == 0 {
+				height = rootmulti.GetLatestVersion(db)
+			}
+			if height == 0 {
+				return fmt.Errorf("no version has been committed")
+			}
+
+			cInfo, err := rootmulti.GetCommitInfo(db, height)
+			if err != nil {
+				return fmt.Errorf("failed to get commit info of height %d: %w", height, err)
+			}
+
+			infos := cInfo.StoreInfos
+			sort.Slice(infos, func(i, j int) bool { return infos[i].Name < infos[j].Name })
+
+			out := cmd.OutOrStdout()
+			fmt.Fprintf(out, "height: %d\napp hash: %X\n", cInfo.Version, cInfo.Hash())
+			for _, info := range infos {
+				fmt.Fprintf(out, "%s %d %X\n", info.Name, info.CommitId.Version, info.CommitId.Hash)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().Int64(FlagHeight, 0, "Height of the commit, the latest one if zero")
+	return cmd
+}
+
+func debugStoreDumpCmd(decoders func() sdk.StoreDecoderRegistry) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dump [store]",
+		Short: "Dump the key-value pairs of a store at a height",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			prefix, err := prefixFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			db, err := openDBFromCmd(cmd)
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			height, _ := cmd.Flags().GetInt64(FlagHeight)
+			store, err := loadKVStoreAtHeight(db, args[0], height)
+			if err != nil {
+				return err
+			}
+
+			decode := storeDecoder(decoders, args[0])
+			out := cmd.OutOrStdout()
+
+			iter := sdk.KVStorePrefixIterator(store, prefix)
+			defer iter.Close()
+			for ; iter.Valid(); iter.Next() {
+				pair := kv.Pair{Key: iter.Key(), Value: iter.Value()}
+				fmt.Fprintf(out, "%X: %s\n", pair.Key, decode(pair, pair))
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().Int64(FlagHeight, 0, "Height of the store, the latest one if zero")
+	cmd.Flags().String(flagPrefix, "", "Hex encoded prefix of the keys to dump")
+	return cmd
+}
+
+func debugStoreDiffCmd(decoders func() sdk.StoreDecoderRegistry) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "diff [store] [height-a] [height-b]",
+		Short: "Print the key-value pairs of a store which differ between two heights",
+		Long: `Print the key-value pairs of a store which differ between two heights.
+Pairs only found at height-a are prefixed with "-", pairs only found at
+height-b with "+" and pairs whose values differ with "~".`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			heightA, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid height-a: %w", err)
+			}
+			heightB, err := strconv.ParseInt(args[2], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid height-b: %w", err)
+			}
+			prefix, err := prefixFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			db, err := openDBFromCmd(cmd)
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			storeA, err := loadKVStoreAtHeight(db, args[0], heightA)
+			if err != nil {
+				return err
+			}
+			storeB, err := loadKVStoreAtHeight(db, args[0], heightB)
+			if err != nil {
+				return err
+			}
+
+			iterA := sdk.KVStorePrefixIterator(storeA, prefix)
+			defer iterA.Close()
+			iterB := sdk.KVStorePrefixIterator(storeB, prefix)
+			defer iterB.Close()
+
+			diffIterators(cmd.OutOrStdout(), iterA, iterB, storeDecoder(decoders, args[0]))
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagPrefix, "", "Hex encoded prefix of the keys to compare")
+	return cmd
+}
+
+func debugStoreDeleteVersionCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "delete-version [height]",
+		Short: "Delete a corrupted version of all the stores",
+		Long: `Delete a version of all the IAVL stores along with its commit info. The latest
+version cannot be deleted, use the rollback command instead.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			height, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid height: %w", err)
+			}
+
+			db, err := openDBFromCmd(cmd)
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			cms, err := loadMultiStore(db)
+			if err != nil {
+				return err
+			}
+			if err := cms.DeleteVersion(height); err != nil {
+				return err
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "Deleted version %d\n", height)
+			return nil
+		},
+	}
+}
+
+func openDBFromCmd(cmd *cobra.Command) (dbm.DB, error) {
+	home, _ := cmd.Flags().GetString(flags.FlagHome)
+	return openDB(home)
+}
+
+func prefixFromFlags(cmd *cobra.Command) ([]byte, error) {
+	prefix, _ := cmd.Flags().GetString(flagPrefix)
+	if prefix == "" {
+		return nil, nil
+	}
+	bz, err := hex.DecodeString(prefix)
+	if err != nil {
+		return nil, fmt.Errorf("invalid prefix: %w", err)
+	}
+	return bz, nil
+}
+
+// loadMultiStore loads the latest version of the multistore with all the IAVL
+// stores committed in it mounted. Stores with an empty commit ID, such as
+// memory stores, are not mounted.
+func loadMultiStore(db dbm.DB) (*rootmulti.Store, error) {
+	latest := rootmulti.GetLatestVersion(db)
+	if latest == 0 {
+		return nil, fmt.Errorf("no version has been committed")
+	}
+
+	cInfo, err := rootmulti.GetCommitInfo(db, latest)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get commit info of height %d: %w", latest, err)
+	}
+
+	cms := rootmulti.NewStore(db)
+	for _, info := range cInfo.StoreInfos {
+		if info.CommitId.IsZero() {
+			continue
+		}
+		cms.MountStoreWithDB(sdk.NewKVStoreKey(info.Name), sdk.StoreTypeIAVL, nil)
+	}
+
+	if err := cms.LoadLatestVersion(); err != nil {
+		return nil, err
+	}
+	return cms, nil
+}
+
+// loadKVStoreAtHeight returns the store of the name at the height, or at the
+// latest height if the height is zero.
+func loadKVStoreAtHeight(db dbm.DB, name string, height int64) (sdk.KVStore, error) {
+	latest := rootmulti.GetLatestVersion(db)
+	if latest == 0 {
+		return nil, fmt.Errorf("no version has been committed")
+	}
+	if height == 0 {
+		height = latest
+	}
+
+	cInfo, err := rootmulti.GetCommitInfo(db, height)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get commit info of height %d: %w", height, err)
+	}
+	found := false
+	for _, info := range cInfo.StoreInfos {
+		found = found || info.Name == name
+	}
+	if !found {
+		return nil, fmt.Errorf("store %s is not committed at height %d", name, height)
+	}
+
+	cms := rootmulti.NewStore(db)
+	key := sdk.NewKVStoreKey(name)
+	cms.MountStoreWithDB(key, sdk.StoreTypeIAVL, nil)
+	if err := cms.LoadLatestVersion(); err != nil {
+		return nil, err
+	}
+
+	branch, err := cms.CacheMultiStoreWithVersion(height)
+	if err != nil {
+		return nil, err
+	}
+	return branch.GetKVStore(key), nil
+}
+
+// storeDecoder returns the decoder of the store, which falls back to hex when
+// the store has no decoder or the decoder cannot decode the pairs.
+func storeDecoder(decoders func() sdk.StoreDecoderRegistry, name string) func(kvA, kvB kv.Pair) string {
+	var decoder func(kvA, kvB kv.Pair) string
+	if decoders != nil {
+		decoder = decoders()[name]
+	}
+
+	return func(kvA, kvB kv.Pair) (s string) {
+		if decoder != nil {
+			// decoders panic on keys they do not know
+			defer func() {
+				if r := recover(); r != nil {
+					s = hexPairs(kvA, kvB)
+				}
+			}()
+			return decoder(kvA, kvB)
+		}
+		return hexPairs(kvA, kvB)
+	}
+}
+
+func hexPairs(kvA, kvB kv.Pair) string {
+	if bytes.Equal(kvA.Value, kvB.Value) {
+		return fmt.Sprintf("%X", kvA.Value)
+	}
+	return fmt.Sprintf("%X\n%X", kvA.Value, kvB.Value)
+}
+
+// diffIterators prints the pairs which differ between the iterators, both of
+// which must iterate in ascending order.
+func diffIterators(out io.Writer, iterA, iterB storetypes.Iterator, decode func(kvA, kvB kv.Pair) string) {
+	for iterA.Valid() || iterB.Valid() {
+		var cmp int
+		switch {
+		case !iterA.Valid():
+			cmp = 1
+		case !iterB.Valid():
+			cmp = -1
+		default:
+			cmp = bytes.Compare(iterA.Key(), iterB.Key())
+		}
+
+		switch {
+		case cmp < 0:
+			pair := kv.Pair{Key: iterA.Key(), Value: iterA.Value()}
+			fmt.Fprintf(out, "- %X: %s\n", pair.Key, decode(pair, pair))
+			iterA.Next()
+		case cmp > 0:
+			pair := kv.Pair{Key: iterB.Key(), Value: iterB.Value()}
+			fmt.Fprintf(out, "+ %X: %s\n", pair.Key, decode(pair, pair))
+			iterB.Next()
+		default:
+			if !bytes.Equal(iterA.Value(), iterB.Value()) {
+				pairA := kv.Pair{Key: iterA.Key(), Value: iterA.Value()}
+				pairB := kv.Pair{Key: iterB.Key(), Value: iterB.Value()}
+				fmt.Fprintf(out, "~ %X: %s\n", pairA.Key, decode(pairA, pairB))
+			}
+			iterA.Next()
+			iterB.Next()
+		}
+	}
+}
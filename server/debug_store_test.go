@@ -0,0 +1,139 @@
+package server_test
+
+import (
+	"bytes"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/line/lbm-sdk/client/flags"
+	"github.com/line/lbm-sdk/server"
+	"github.com/line/lbm-sdk/store/rootmulti"
+	sdk "github.com/line/lbm-sdk/types"
+	"github.com/line/lbm-sdk/types/kv"
+)
+
+func setupDebugStoreHome(t *testing.T) string {
+	home := t.TempDir()
+	db, err := sdk.NewLevelDB("application", filepath.Join(home, "data"))
+	require.NoError(t, err)
+	defer db.Close()
+
+	cms := rootmulti.NewStore(db)
+	keyA, keyB := sdk.NewKVStoreKey("a"), sdk.NewKVStoreKey("b")
+	cms.MountStoreWithDB(keyA, sdk.StoreTypeIAVL, nil)
+	cms.MountStoreWithDB(keyB, sdk.StoreTypeIAVL, nil)
+	require.NoError(t, cms.LoadLatestVersion())
+
+	// height 1
+	cms.GetKVStore(keyA).Set([]byte{0x01, 0x01}, []byte("one"))
+	cms.GetKVStore(keyA).Set([]byte{0x01, 0x02}, []byte("two"))
+	cms.GetKVStore(keyB).Set([]byte{0x01}, []byte("b"))
+	cms.Commit()
+	// height 2
+	cms.GetKVStore(keyA).Set([]byte{0x01, 0x02}, []byte("TWO"))
+	cms.GetKVStore(keyA).Delete([]byte{0x01, 0x01})
+	cms.GetKVStore(keyA).Set([]byte{0x02, 0x01}, []byte("three"))
+	cms.Commit()
+	// height 3
+	cms.Commit()
+
+	return home
+}
+
+func runDebugStoreCmd(home string, decoders func() sdk.StoreDecoderRegistry, args ...string) (string, error) {
+	cmd := server.DebugStoreCmd(home, decoders)
+	out := &bytes.Buffer{}
+	cmd.SetOut(out)
+	cmd.SetArgs(append(args, fmt.Sprintf("--%s=%s", flags.FlagHome, home)))
+	err := cmd.Execute()
+	return out.String(), err
+}
+
+func TestDebugStoreList(t *testing.T) {
+	home := setupDebugStoreHome(t)
+
+	out, err := runDebugStoreCmd(home, nil, "list")
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(out), "\n")
+	require.Len(t, lines, 4)
+	require.Equal(t, "height: 3", lines[0])
+	require.True(t, strings.HasPrefix(lines[1], "app hash: "))
+	require.True(t, strings.HasPrefix(lines[2], "a 3 "))
+	require.True(t, strings.HasPrefix(lines[3], "b 3 "))
+
+	out, err = runDebugStoreCmd(home, nil, "list", "--height=1")
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(out, "height: 1\n"))
+
+	_, err = runDebugStoreCmd(home, nil, "list", "--height=4")
+	require.Error(t, err)
+}
+
+func TestDebugStoreDump(t *testing.T) {
+	home := setupDebugStoreHome(t)
+
+	out, err := runDebugStoreCmd(home, nil, "dump", "a", "--height=1")
+	require.NoError(t, err)
+	require.Equal(t, "0101: 6F6E65\n0102: 74776F\n", out)
+
+	decoders := func() sdk.StoreDecoderRegistry {
+		return sdk.StoreDecoderRegistry{
+			"a": func(kvA, kvB kv.Pair) string {
+				if kvA.Key[0] != 0x01 {
+					panic("unknown key")
+				}
+				return fmt.Sprintf("%s %s", kvA.Value, kvB.Value)
+			},
+		}
+	}
+	out, err = runDebugStoreCmd(home, decoders, "dump", "a")
+	require.NoError(t, err)
+	require.Equal(t, "0102: TWO TWO\n0201: 7468726565\n", out)
+
+	out, err = runDebugStoreCmd(home, decoders, "dump", "a", "--prefix=02")
+	require.NoError(t, err)
+	require.Equal(t, "0201: 7468726565\n", out)
+
+	_, err = runDebugStoreCmd(home, nil, "dump", "c")
+	require.Error(t, err)
+	_, err = runDebugStoreCmd(home, nil, "dump", "a", "--prefix=zz")
+	require.Error(t, err)
+}
+
+func TestDebugStoreDiff(t *testing.T) {
+	home := setupDebugStoreHome(t)
+
+	out, err := runDebugStoreCmd(home, nil, "diff", "a", "1", "2")
+	require.NoError(t, err)
+	require.Equal(t, "- 0101: 6F6E65\n~ 0102: 74776F\n54574F\n+ 0201: 7468726565\n", out)
+
+	out, err = runDebugStoreCmd(home, nil, "diff", "a", "1", "2", "--prefix=02")
+	require.NoError(t, err)
+	require.Equal(t, "+ 0201: 7468726565\n", out)
+
+	out, err = runDebugStoreCmd(home, nil, "diff", "b", "1", "3")
+	require.NoError(t, err)
+	require.Empty(t, out)
+}
+
+func TestDebugStoreDeleteVersion(t *testing.T) {
+	home := setupDebugStoreHome(t)
+
+	_, err := runDebugStoreCmd(home, nil, "delete-version", "3")
+	require.Error(t, err)
+
+	out, err := runDebugStoreCmd(home, nil, "delete-version", "2")
+	require.NoError(t, err)
+	require.Equal(t, "Deleted version 2\n", out)
+
+	_, err = runDebugStoreCmd(home, nil, "list", "--height=2")
+	require.Error(t, err)
+	_, err = runDebugStoreCmd(home, nil, "dump", "a", "--height=2")
+	require.Error(t, err)
+	_, err = runDebugStoreCmd(home, nil, "dump", "a", "--height=1")
+	require.NoError(t, err)
+}
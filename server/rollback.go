@@ -1,15 +1,27 @@
 package server
 
 import (
+	"bytes"
 	"fmt"
 
-	"github.com/line/lbm-sdk/client/flags"
-	"github.com/line/lbm-sdk/store/rootmulti"
 	ostcmd "github.com/line/ostracon/cmd/ostracon/commands"
+	occfg "github.com/line/ostracon/config"
+	ocstate "github.com/line/ostracon/proto/ostracon/state"
+	ocstore "github.com/line/ostracon/proto/ostracon/store"
+	ocversion "github.com/line/ostracon/proto/ostracon/version"
+	"github.com/line/ostracon/state"
+	"github.com/line/ostracon/store"
+	"github.com/line/ostracon/version"
 	"github.com/spf13/cobra"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/line/lbm-sdk/client/flags"
+	"github.com/line/lbm-sdk/store/iavl"
+	"github.com/line/lbm-sdk/store/rootmulti"
 )
 
-// NewRollbackCmd creates a command to rollback tendermint and multistore state by one height.
+// NewRollbackCmd creates a command to rollback tendermint and multistore state by one height,
+// or to a given height.
 func NewRollbackCmd(defaultNodeHome string) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "rollback",
@@ -21,6 +33,12 @@ progress. Rollback overwrites a state at height n with the state at height n - 1
 The application also roll back to height n - 1. No blocks are removed, so upon
 restarting Tendermint the transactions in block n will be re-executed against the
 application.
+
+With --height, the state is rolled back to the given height h instead, for example to
+just before an upgrade. The blocks after h + 1 are removed, and the transactions in
+block h + 1 are re-executed upon restarting. The height must still be available in the
+IAVL stores and in the block store, and the validator set and the consensus params must
+not have changed after it.
 `,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx := GetServerContextFromCmd(cmd)
@@ -30,14 +48,32 @@ application.
 			if err != nil {
 				return err
 			}
-			// rollback tendermint state
-			height, hash, err := ostcmd.RollbackState(ctx.Config)
+			defer db.Close()
+
+			target, _ := cmd.Flags().GetInt64(FlagHeight)
+
+			var (
+				height int64
+				hash   []byte
+			)
+			if target == 0 {
+				// rollback tendermint state
+				height, hash, err = ostcmd.RollbackState(ctx.Config)
+			} else {
+				height, hash, err = rollbackStateToHeight(ctx.Config, db, target)
+			}
 			if err != nil {
 				return fmt.Errorf("failed to rollback tendermint state: %w", err)
 			}
+
 			// rollback the multistore
-			cms := rootmulti.NewStore(db)
-			cms.RollbackToVersion(height)
+			cms, err := loadMultiStore(db)
+			if err != nil {
+				return err
+			}
+			if err := cms.RollbackToVersion(height); err != nil {
+				return fmt.Errorf("failed to rollback the multistore: %w", err)
+			}
 
 			fmt.Printf("Rolled back state to height %d and hash %X", height, hash)
 			return nil
@@ -45,5 +81,210 @@ application.
 	}
 
 	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().Int64(FlagHeight, 0, "Height to rollback to, one height back if zero")
 	return cmd
 }
+
+// rollbackStateToHeight overwrites the Ostracon state with the state at the
+// target height and removes the blocks after target + 1 from the block store.
+// Nothing is written unless the target is verified to be safe to rollback to,
+// both in Ostracon and in the application db.
+func rollbackStateToHeight(config *occfg.Config, appDB dbm.DB, target int64) (int64, []byte, error) {
+	dbType := dbm.BackendType(config.DBBackend)
+	blockStoreDB, err := dbm.NewDB("blockstore", dbType, config.DBDir())
+	if err != nil {
+		return -1, nil, err
+	}
+	defer blockStoreDB.Close()
+	stateDB, err := dbm.NewDB("state", dbType, config.DBDir())
+	if err != nil {
+		return -1, nil, err
+	}
+	defer stateDB.Close()
+
+	blockStore := store.NewBlockStore(blockStoreDB)
+	stateStore := state.NewStore(stateDB)
+
+	rolledBackState, err := stateAtHeight(blockStore, stateStore, target)
+	if err != nil {
+		return -1, nil, err
+	}
+	if err := verifyAppAtHeight(appDB, target, rolledBackState.AppHash); err != nil {
+		return -1, nil, err
+	}
+
+	// the state is saved first so that an interrupted rollback can be resumed
+	if err := stateStore.Save(rolledBackState); err != nil {
+		return -1, nil, fmt.Errorf("failed to save rolled back state: %w", err)
+	}
+	if err := truncateBlockStore(blockStore, blockStoreDB, target+1); err != nil {
+		return -1, nil, err
+	}
+
+	return rolledBackState.LastBlockHeight, rolledBackState.AppHash, nil
+}
+
+// stateAtHeight builds the Ostracon state at the target height the same way as
+// state.Rollback does for the previous height. It refuses targets whose state
+// cannot be rebuilt from the state and block stores.
+func stateAtHeight(bs *store.BlockStore, ss state.Store, target int64) (state.State, error) {
+	latestState, err := ss.Load()
+	if err != nil {
+		return state.State{}, err
+	}
+	if latestState.IsEmpty() {
+		return state.State{}, fmt.Errorf("no state found")
+	}
+
+	height := bs.Height()
+	// a target equal to the state height is only accepted to resume an
+	// interrupted rollback, which has left the blocks after target + 1
+	resuming := latestState.LastBlockHeight == target && height > target+1
+	if !resuming && height != latestState.LastBlockHeight && height != latestState.LastBlockHeight+1 {
+		return state.State{}, fmt.Errorf("statestore height (%d) is not one below or equal to blockstore height (%d)",
+			latestState.LastBlockHeight, height)
+	}
+
+	switch {
+	case !resuming && target >= latestState.LastBlockHeight:
+		return state.State{}, fmt.Errorf("target height %d must be below the current height %d",
+			target, latestState.LastBlockHeight)
+	case target < latestState.InitialHeight:
+		return state.State{}, fmt.Errorf("target height %d is below the initial height %d",
+			target, latestState.InitialHeight)
+	case target < bs.Base():
+		return state.State{}, fmt.Errorf("target height %d has been pruned from the block store, whose base is %d",
+			target, bs.Base())
+	}
+
+	// The validator set and the consensus params of the target state cannot be
+	// rebuilt if they have changed since, as the heights of the changes before
+	// them are not known.
+	if latestState.LastHeightValidatorsChanged > target+1 {
+		return state.State{}, fmt.Errorf("the validator set has changed at height %d, after the target height %d; "+
+			"rolling back across a validator set change is not supported", latestState.LastHeightValidatorsChanged, target)
+	}
+	if latestState.LastHeightConsensusParamsChanged > target+1 {
+		return state.State{}, fmt.Errorf("the consensus params have changed at height %d, after the target height %d; "+
+			"rolling back across a consensus params change is not supported", latestState.LastHeightConsensusParamsChanged, target)
+	}
+
+	rollbackBlock := bs.LoadBlockMeta(target)
+	if rollbackBlock == nil {
+		return state.State{}, fmt.Errorf("block at target height %d not found", target)
+	}
+	// We also need to retrieve the next block because the app hash and last
+	// results hash is only agreed upon in the following block.
+	nextBlock := bs.LoadBlockMeta(target + 1)
+	if nextBlock == nil {
+		return state.State{}, fmt.Errorf("block at height %d not found", target+1)
+	}
+
+	_, prevVoterSet, _, _, err := ss.LoadVoters(target, nil)
+	if err != nil {
+		return state.State{}, err
+	}
+	currValidatorSet, currVoterSet, currVoterParam, currProofHash, err := ss.LoadVoters(target+1, nil)
+	if err != nil {
+		return state.State{}, err
+	}
+	params, err := ss.LoadConsensusParams(target + 1)
+	if err != nil {
+		return state.State{}, err
+	}
+
+	return state.State{
+		Version: ocstate.Version{
+			Consensus: ocversion.Consensus{
+				Block: version.BlockProtocol,
+				App:   params.Version.AppVersion,
+			},
+			Software: version.OCCoreSemVer,
+		},
+		// immutable fields
+		ChainID:       latestState.ChainID,
+		InitialHeight: latestState.InitialHeight,
+
+		LastBlockHeight: rollbackBlock.Header.Height,
+		LastBlockID:     rollbackBlock.BlockID,
+		LastBlockTime:   rollbackBlock.Header.Time,
+
+		// the validator set has not changed since target + 1
+		NextValidators:              latestState.Validators,
+		Validators:                  currValidatorSet,
+		Voters:                      currVoterSet,
+		VoterParams:                 currVoterParam,
+		LastVoters:                  prevVoterSet,
+		LastProofHash:               currProofHash,
+		LastHeightValidatorsChanged: latestState.LastHeightValidatorsChanged,
+
+		ConsensusParams:                  params,
+		LastHeightConsensusParamsChanged: latestState.LastHeightConsensusParamsChanged,
+
+		LastResultsHash: nextBlock.Header.LastResultsHash,
+		AppHash:         nextBlock.Header.AppHash,
+	}, nil
+}
+
+// verifyAppAtHeight checks the target height is available in all the IAVL
+// stores and the app hash committed at it is the one agreed upon in the block
+// store.
+func verifyAppAtHeight(db dbm.DB, target int64, appHash []byte) error {
+	cInfo, err := rootmulti.GetCommitInfo(db, target)
+	if err != nil {
+		return fmt.Errorf("target height %d is not available in the application db: %w", target, err)
+	}
+	if !bytes.Equal(cInfo.Hash(), appHash) {
+		return fmt.Errorf("app hash %X committed at target height %d does not match the app hash %X of the block store",
+			cInfo.Hash(), target, appHash)
+	}
+
+	cms, err := loadMultiStore(db)
+	if err != nil {
+		return err
+	}
+	for key, s := range cms.GetStores() {
+		if s, ok := s.(*iavl.Store); ok && !s.VersionExists(target) {
+			return fmt.Errorf("target height %d has been pruned from store %s", target, key.Name())
+		}
+	}
+
+	return nil
+}
+
+// truncateBlockStore removes the blocks after the height from the block store.
+// The keys mirror the ones of the Ostracon block store, which offers no way to
+// remove the latest blocks.
+func truncateBlockStore(bs *store.BlockStore, db dbm.DB, height int64) error {
+	batch := db.NewBatch()
+	defer batch.Close()
+
+	for h := height + 1; h <= bs.Height(); h++ {
+		meta := bs.LoadBlockMeta(h)
+		if meta == nil {
+			continue
+		}
+
+		keys := [][]byte{
+			[]byte(fmt.Sprintf("H:%v", h)),
+			[]byte(fmt.Sprintf("BH:%x", meta.BlockID.Hash)),
+			[]byte(fmt.Sprintf("C:%v", h)),
+			[]byte(fmt.Sprintf("SC:%v", h)),
+		}
+		for p := 0; p < int(meta.BlockID.PartSetHeader.Total); p++ {
+			keys = append(keys, []byte(fmt.Sprintf("P:%v:%v", h, p)))
+		}
+		for _, key := range keys {
+			if err := batch.Delete(key); err != nil {
+				return err
+			}
+		}
+	}
+
+	// the height is lowered first so that no one tries to access missing blocks
+	store.SaveBlockStoreState(&ocstore.BlockStoreState{Base: bs.Base(), Height: height}, db)
+	if err := batch.WriteSync(); err != nil {
+		return fmt.Errorf("failed to truncate the block store to height %d: %w", height, err)
+	}
+	return nil
+}
@@ -0,0 +1,33 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/line/lbm-sdk/store/rootmulti"
+	"github.com/line/lbm-sdk/store/types"
+)
+
+func TestVerifyAppAtHeight(t *testing.T) {
+	db := dbm.NewMemDB()
+	cms := rootmulti.NewStore(db)
+	key := types.NewKVStoreKey("store")
+	cms.MountStoreWithDB(key, types.StoreTypeIAVL, nil)
+	cms.SetPruning(types.PruningOptions{KeepRecent: 2, KeepEvery: 0, Interval: 1})
+	require.NoError(t, cms.LoadLatestVersion())
+
+	hashes := make(map[int64][]byte)
+	for i := 0; i < 5; i++ {
+		cms.GetKVStore(key).Set([]byte("key"), []byte{byte(i)})
+		commitID := cms.Commit()
+		hashes[commitID.Version] = commitID.Hash
+	}
+
+	require.NoError(t, verifyAppAtHeight(db, 4, hashes[4]))
+	require.NoError(t, verifyAppAtHeight(db, 3, hashes[3]))
+	require.Error(t, verifyAppAtHeight(db, 3, hashes[4]), "app hash mismatch")
+	require.Error(t, verifyAppAtHeight(db, 1, hashes[1]), "pruned height")
+	require.Error(t, verifyAppAtHeight(db, 6, nil), "future height")
+}
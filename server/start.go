@@ -49,6 +49,10 @@ const (
 	FlagInterBlockCacheSize = "inter-block-cache-size"
 	FlagIAVLCacheSize       = "iavl-cache-size"
 	FlagBech32CacheSize     = "bech32-cache-size"
+	FlagArchive             = "archive"
+	FlagMaxPendingTxs       = "max-pending-txs-per-signer"
+	FlagStoreProfiling      = "store-profiling"
+	FlagGasPriceHistory     = "gas-price-history-blocks"
 	FlagUnsafeSkipUpgrades  = "unsafe-skip-upgrades"
 	FlagTrace               = "trace"
 	FlagInvCheckPeriod      = "inv-check-period"
@@ -155,6 +159,10 @@ is performed. Note, when enabled, gRPC will also be automatically enabled.
 	cmd.Flags().Bool(FlagInterBlockCache, true, "Enable inter-block caching")
 	cmd.Flags().Int(FlagInterBlockCacheSize, cache.DefaultCommitKVStoreCacheSize, "The maximum bytes size of the inter-block cache")
 	cmd.Flags().Int(FlagIAVLCacheSize, iavl.DefaultIAVLCacheSize, "The maximum bytes size of the iavl node cache")
+	cmd.Flags().Bool(FlagArchive, false, "Keep every committed version of the application state to serve queries at pruned heights")
+	cmd.Flags().Uint64(FlagMaxPendingTxs, 0, "The maximum number of txs of a signer pending in CheckTx at the same time (0 means no limit)")
+	cmd.Flags().Bool(FlagStoreProfiling, false, "Profile the store operations of the delivered txs by Msg type and store key")
+	cmd.Flags().Uint64(FlagGasPriceHistory, config.DefaultGasPriceHistoryBlocks, "The number of recent blocks whose delivered txs the gas prices are suggested from (0 disables the history)")
 	cmd.Flags().String(flagCPUProfile, "", "Enable CPU profiling and write to the provided file")
 	cmd.Flags().Bool(FlagTrace, false, "Provide full stack traces for errors in ABCI Log")
 	cmd.Flags().String(FlagPruning, storetypes.PruningOptionDefault, "Pruning strategy (default|nothing|everything|custom)")
@@ -215,6 +223,9 @@ func startStandAlone(ctx *Context, appCreator types.AppCreator) error {
 		if err = svr.Stop(); err != nil {
 			ostos.Exit(err.Error())
 		}
+		if err = app.Close(); err != nil {
+			ostos.Exit(err.Error())
+		}
 	}()
 
 	// Wait for SIGINT or SIGTERM signal
@@ -433,6 +444,10 @@ func startInProcess(ctx *Context, clientCtx client.Context, appCreator types.App
 			}
 		}
 
+		if err := app.Close(); err != nil {
+			ctx.Logger.Error("failed to close application", "err", err)
+		}
+
 		ctx.Logger.Info("exiting...")
 	}()
 
@@ -51,6 +51,9 @@ type (
 
 		// RegisterTendermintService registers the gRPC Query service for ostracon queries.
 		RegisterTendermintService(clientCtx client.Context)
+
+		// Close is called on shutdown of the application to release its resources.
+		Close() error
 	}
 
 	// AppCreator is a function that allows us to lazily initialize an
@@ -19,6 +19,7 @@ import (
 	ostlog "github.com/line/ostracon/libs/log"
 	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
+	"github.com/spf13/cast"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 	"github.com/spf13/viper"
@@ -388,6 +389,18 @@ func openDB(rootDir string) (dbm.DB, error) {
 	return sdk.NewLevelDB("application", dataDir)
 }
 
+// OpenDB opens a db of the data directory with the db_backend of the
+// Ostracon config, falling back to the backend of sdk.NewLevelDB if none is
+// configured.
+func OpenDB(name, rootDir string, appOpts types.AppOptions) (dbm.DB, error) {
+	dataDir := filepath.Join(rootDir, "data")
+	backend := cast.ToString(appOpts.Get("db_backend"))
+	if backend == "" {
+		return sdk.NewLevelDB(name, dataDir)
+	}
+	return dbm.NewDB(name, dbm.BackendType(backend), dataDir)
+}
+
 func openTraceWriter(traceWriterFile string) (w io.Writer, err error) {
 	if traceWriterFile == "" {
 		return
@@ -19,6 +19,8 @@ import (
 
 	"github.com/line/lbm-sdk/baseapp"
 	"github.com/line/lbm-sdk/client"
+	"github.com/line/lbm-sdk/client/grpc/feeestimate"
+	"github.com/line/lbm-sdk/client/grpc/profiler"
 	"github.com/line/lbm-sdk/client/grpc/tmservice"
 	"github.com/line/lbm-sdk/client/rpc"
 	"github.com/line/lbm-sdk/codec"
@@ -67,6 +69,9 @@ import (
 	"github.com/line/lbm-sdk/x/feegrant"
 	feegrantkeeper "github.com/line/lbm-sdk/x/feegrant/keeper"
 	feegrantmodule "github.com/line/lbm-sdk/x/feegrant/module"
+	"github.com/line/lbm-sdk/x/feeshare"
+	feesharekeeper "github.com/line/lbm-sdk/x/feeshare/keeper"
+	feesharemodule "github.com/line/lbm-sdk/x/feeshare/module"
 	"github.com/line/lbm-sdk/x/foundation"
 	foundationclient "github.com/line/lbm-sdk/x/foundation/client"
 	foundationkeeper "github.com/line/lbm-sdk/x/foundation/keeper"
@@ -113,6 +118,8 @@ import (
 	upgradetypes "github.com/line/lbm-sdk/x/upgrade/types"
 	"github.com/line/lbm-sdk/x/wasm"
 	wasmclient "github.com/line/lbm-sdk/x/wasm/client"
+	wasmkeeper "github.com/line/lbm-sdk/x/wasm/keeper"
+	wasmtypes "github.com/line/lbm-sdk/x/wasm/types"
 
 	// unnamed import of statik for swagger UI support
 	_ "github.com/line/lbm-sdk/client/docs/statik"
@@ -162,6 +169,7 @@ var (
 		tokenmodule.AppModuleBasic{},
 		collectionmodule.AppModuleBasic{},
 		wasm.AppModuleBasic{},
+		feesharemodule.AppModuleBasic{},
 	)
 
 	// module account permissions
@@ -222,6 +230,7 @@ type SimApp struct {
 	TransferKeeper   ibctransferkeeper.Keeper
 	FeeGrantKeeper   feegrantkeeper.Keeper
 	TokenKeeper      tokenkeeper.Keeper
+	FeeShareKeeper   feesharekeeper.Keeper
 	CollectionKeeper collectionkeeper.Keeper
 	WasmKeeper       wasm.Keeper
 
@@ -287,6 +296,7 @@ func NewSimApp(
 		collection.StoreKey,
 		authzkeeper.StoreKey,
 		wasm.StoreKey,
+		feeshare.StoreKey,
 	)
 	tkeys := sdk.NewTransientStoreKeys(paramstypes.TStoreKey)
 	// NOTE: The testingkey is just mounted for testing purposes. Actual applications should
@@ -349,6 +359,12 @@ func NewSimApp(
 	app.UpgradeKeeper = upgradekeeper.NewKeeper(skipUpgradeHeights, keys[upgradetypes.StoreKey], appCodec, homePath, app.BaseApp)
 
 	foundationConfig := foundation.DefaultConfig()
+	foundationConfig.ExtraAuthorizations = []string{
+		wasmtypes.CircuitBreakerAuthorization{}.MsgTypeURL(),
+		wasmtypes.ContractQuotaAuthorization{}.MsgTypeURL(),
+		wasmtypes.RegisterScheduleAuthorization{}.MsgTypeURL(),
+		wasmtypes.DeregisterScheduleAuthorization{}.MsgTypeURL(),
+	}
 	app.FoundationKeeper = foundationkeeper.NewKeeper(appCodec, keys[foundation.StoreKey], app.BaseApp.MsgServiceRouter(), app.AccountKeeper, app.BankKeeper, stakingKeeper, authtypes.FeeCollectorName, foundationConfig)
 
 	classKeeper := classkeeper.NewKeeper(appCodec, keys[class.StoreKey])
@@ -377,6 +393,10 @@ func NewSimApp(
 	// The last arguments can contain custom message handlers, and custom query handlers,
 	// if we want to allow any custom callbacks
 	supportedFeatures := "iterator,staking,stargate"
+	// contracts may query the modules by the Stargate queries of the accept list
+	wasmOpts = append([]wasm.Option{wasmkeeper.WithQueryPlugins(&wasmkeeper.QueryPlugins{
+		Stargate: wasmkeeper.AcceptListStargateQuerier(AcceptedStargateQueries(), app.GRPCQueryRouter(), appCodec),
+	})}, wasmOpts...)
 	app.WasmKeeper = wasm.NewKeeper(
 		appCodec,
 		keys[wasm.StoreKey],
@@ -398,6 +418,18 @@ func NewSimApp(
 		nil,
 		wasmOpts...,
 	)
+	// notify the contracts opted in to the receiver hook on x/token and x/collection transfers
+	app.TokenKeeper.SetHooks(app.WasmKeeper.ReceiverHooks())
+	app.CollectionKeeper.SetHooks(app.WasmKeeper.ReceiverHooks())
+
+	app.FeeShareKeeper = feesharekeeper.NewKeeper(
+		appCodec,
+		keys[feeshare.StoreKey],
+		app.GetSubspace(feeshare.ModuleName),
+		app.BankKeeper,
+		&app.WasmKeeper,
+		authtypes.FeeCollectorName,
+	)
 
 	// register the proposal types
 	govRouter := govtypes.NewRouter()
@@ -472,7 +504,7 @@ func NewSimApp(
 		distr.NewAppModule(appCodec, app.DistrKeeper, app.AccountKeeper, app.BankKeeper, app.StakingKeeper),
 		stakingplusmodule.NewAppModule(appCodec, app.StakingKeeper, app.AccountKeeper, app.BankKeeper, app.FoundationKeeper),
 		upgrade.NewAppModule(app.UpgradeKeeper),
-		wasm.NewAppModule(appCodec, &app.WasmKeeper, app.StakingKeeper, app.AccountKeeper, app.BankKeeper),
+		wasm.NewAppModule(appCodec, &app.WasmKeeper, app.StakingKeeper, app.AccountKeeper, app.BankKeeper, app.FoundationKeeper),
 		evidence.NewAppModule(app.EvidenceKeeper),
 		ibc.NewAppModule(app.IBCKeeper),
 		params.NewAppModule(app.ParamsKeeper),
@@ -480,6 +512,7 @@ func NewSimApp(
 		collectionmodule.NewAppModule(appCodec, app.CollectionKeeper),
 		authzmodule.NewAppModule(appCodec, app.AuthzKeeper, app.AccountKeeper, app.BankKeeper, app.interfaceRegistry),
 		transferModule,
+		feesharemodule.NewAppModule(appCodec, app.FeeShareKeeper),
 	)
 
 	// During begin block slashing happens after distr.BeginBlocker so that
@@ -510,6 +543,7 @@ func NewSimApp(
 		token.ModuleName,
 		collection.ModuleName,
 		wasm.ModuleName,
+		feeshare.ModuleName,
 	)
 	app.mm.SetOrderEndBlockers(
 		crisistypes.ModuleName,
@@ -534,6 +568,7 @@ func NewSimApp(
 		token.ModuleName,
 		collection.ModuleName,
 		wasm.ModuleName,
+		feeshare.ModuleName,
 	)
 
 	// NOTE: The genutils module must occur after staking so that pools are
@@ -566,6 +601,7 @@ func NewSimApp(
 		collection.ModuleName,
 		// wasm after ibc transfer
 		wasm.ModuleName,
+		feeshare.ModuleName,
 	)
 
 	// Uncomment if you want to set a custom migration order here.
@@ -605,7 +641,11 @@ func NewSimApp(
 			BankKeeper:      app.BankKeeper,
 			SignModeHandler: encodingConfig.TxConfig.SignModeHandler(),
 			FeegrantKeeper:  app.FeeGrantKeeper,
+			FeeShareKeeper:  app.FeeShareKeeper,
 			SigGasConsumer:  ante.DefaultSigVerificationGasConsumer,
+			ExtraDecorators: []sdk.AnteDecorator{
+				wasmkeeper.NewCircuitBreakerDecorator(app.WasmKeeper),
+			},
 		},
 	)
 
@@ -614,6 +654,7 @@ func NewSimApp(
 	}
 
 	app.SetAnteHandler(anteHandler)
+	app.SetTxPriority(ante.GetTxPriority)
 	app.SetEndBlocker(app.EndBlocker)
 
 	if loadLatest {
@@ -762,6 +803,10 @@ func (app *SimApp) RegisterAPIRoutes(apiSvr *api.Server, apiConfig config.APICon
 	authtx.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
 	// Register new tendermint queries routes from grpc-gateway.
 	tmservice.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
+	// Register the store profile routes, which respond only if the store profiling is enabled.
+	profiler.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
+	// Register the fee estimation routes.
+	feeestimate.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
 
 	// Register legacy and grpc-gateway routes for all modules.
 	ModuleBasics.RegisterRESTRoutes(clientCtx, apiSvr.Router)
@@ -776,6 +821,7 @@ func (app *SimApp) RegisterAPIRoutes(apiSvr *api.Server, apiConfig config.APICon
 // RegisterTxService implements the Application.RegisterTxService method.
 func (app *SimApp) RegisterTxService(clientCtx client.Context) {
 	authtx.RegisterTxService(app.BaseApp.GRPCQueryRouter(), clientCtx, app.BaseApp.Simulate, app.interfaceRegistry)
+	feeestimate.RegisterFeeEstimateService(app.BaseApp.GRPCQueryRouter(), clientCtx, app.BaseApp)
 }
 
 // RegisterTendermintService implements the Application.RegisterTendermintService method.
@@ -794,6 +840,38 @@ func RegisterSwaggerAPI(ctx client.Context, rtr *mux.Router) {
 	rtr.PathPrefix("/swagger/").Handler(http.StripPrefix("/swagger/", staticServer))
 }
 
+// AcceptedStargateQueries returns the Stargate queries which contracts are
+// allowed to make, along with the types of their responses.
+func AcceptedStargateQueries() wasm.AcceptedStargateQueries {
+	return wasm.AcceptedStargateQueries{
+		"/cosmos.auth.v1beta1.Query/Account":  &authtypes.QueryAccountResponse{},
+		"/cosmos.bank.v1beta1.Query/Balance":  &banktypes.QueryBalanceResponse{},
+		"/cosmos.bank.v1beta1.Query/SupplyOf": &banktypes.QuerySupplyOfResponse{},
+
+		"/lbm.token.v1.Query/Balance":    &token.QueryBalanceResponse{},
+		"/lbm.token.v1.Query/Supply":     &token.QuerySupplyResponse{},
+		"/lbm.token.v1.Query/Minted":     &token.QueryMintedResponse{},
+		"/lbm.token.v1.Query/Burnt":      &token.QueryBurntResponse{},
+		"/lbm.token.v1.Query/TokenClass": &token.QueryTokenClassResponse{},
+		"/lbm.token.v1.Query/Approved":   &token.QueryApprovedResponse{},
+
+		"/lbm.collection.v1.Query/Balance":   &collection.QueryBalanceResponse{},
+		"/lbm.collection.v1.Query/FTSupply":  &collection.QueryFTSupplyResponse{},
+		"/lbm.collection.v1.Query/NFTSupply": &collection.QueryNFTSupplyResponse{},
+		"/lbm.collection.v1.Query/Contract":  &collection.QueryContractResponse{},
+		"/lbm.collection.v1.Query/TokenType": &collection.QueryTokenTypeResponse{},
+		"/lbm.collection.v1.Query/Token":     &collection.QueryTokenResponse{},
+		"/lbm.collection.v1.Query/Root":      &collection.QueryRootResponse{},
+		"/lbm.collection.v1.Query/Parent":    &collection.QueryParentResponse{},
+		"/lbm.collection.v1.Query/Approved":  &collection.QueryApprovedResponse{},
+
+		"/lbm.foundation.v1.Query/Params":         &foundation.QueryParamsResponse{},
+		"/lbm.foundation.v1.Query/Treasury":       &foundation.QueryTreasuryResponse{},
+		"/lbm.foundation.v1.Query/FoundationInfo": &foundation.QueryFoundationInfoResponse{},
+		"/lbm.foundation.v1.Query/Member":         &foundation.QueryMemberResponse{},
+	}
+}
+
 // GetMaccPerms returns a copy of the module account permissions
 func GetMaccPerms() map[string][]string {
 	dupMaccPerms := make(map[string][]string)
@@ -818,6 +896,7 @@ func initParamsKeeper(appCodec codec.BinaryCodec, legacyAmino *codec.LegacyAmino
 	paramsKeeper.Subspace(ibctransfertypes.ModuleName)
 	paramsKeeper.Subspace(ibchost.ModuleName)
 	paramsKeeper.Subspace(wasm.ModuleName)
+	paramsKeeper.Subspace(feeshare.ModuleName)
 
 	return paramsKeeper
 }
@@ -29,6 +29,7 @@ import (
 	"github.com/line/lbm-sdk/simapp/params"
 	"github.com/line/lbm-sdk/snapshots"
 	"github.com/line/lbm-sdk/store"
+	"github.com/line/lbm-sdk/store/archive"
 	sdk "github.com/line/lbm-sdk/types"
 	authcmd "github.com/line/lbm-sdk/x/auth/client/cli"
 	"github.com/line/lbm-sdk/x/auth/types"
@@ -156,11 +157,13 @@ func initRootCmd(rootCmd *cobra.Command, encodingConfig params.EncodingConfig) {
 		AddGenesisAccountCmd(simapp.DefaultNodeHome),
 		ostcli.NewCompletionCmd(rootCmd, true),
 		testnetCmd(simapp.ModuleBasics, banktypes.GenesisBalancesIterator{}),
-		debug.Cmd(),
 		config.Cmd(),
 	)
 
 	a := appCreator{encodingConfig}
+	debugCmd := debug.Cmd()
+	debugCmd.AddCommand(server.DebugStoreCmd(simapp.DefaultNodeHome, a.storeDecoders))
+	rootCmd.AddCommand(debugCmd)
 	server.AddCommands(rootCmd, simapp.DefaultNodeHome, a.newApp, a.appExport, addModuleInitFlags)
 
 	// add keybase, auxiliary RPC, query, and tx child commands
@@ -217,6 +220,7 @@ func txCommand() *cobra.Command {
 		authcmd.GetSignBatchCommand(),
 		authcmd.GetMultiSignCommand(),
 		authcmd.GetMultiSignBatchCmd(),
+		authcmd.GetMultisignSessionCommand(),
 		authcmd.GetValidateSignaturesCommand(),
 		authcmd.GetBroadcastCommand(),
 		authcmd.GetEncodeCommand(),
@@ -262,6 +266,41 @@ func (a appCreator) newApp(logger log.Logger, db dbm.DB, traceStore io.Writer, a
 	if err != nil {
 		panic(err)
 	}
+	baseappOpts := []func(*baseapp.BaseApp){
+		baseapp.SetPruning(pruningOpts),
+		baseapp.SetMinGasPrices(cast.ToString(appOpts.Get(server.FlagMinGasPrices))),
+		baseapp.SetHaltHeight(cast.ToUint64(appOpts.Get(server.FlagHaltHeight))),
+		baseapp.SetHaltTime(cast.ToUint64(appOpts.Get(server.FlagHaltTime))),
+		baseapp.SetMinRetainBlocks(cast.ToUint64(appOpts.Get(server.FlagMinRetainBlocks))),
+		baseapp.SetInterBlockCache(cache),
+		baseapp.SetTrace(cast.ToBool(appOpts.Get(server.FlagTrace))),
+		baseapp.SetIndexEvents(cast.ToStringSlice(appOpts.Get(server.FlagIndexEvents))),
+		baseapp.SetMaxPendingTxsPerSigner(cast.ToUint64(appOpts.Get(server.FlagMaxPendingTxs))),
+		baseapp.SetSnapshotStore(snapshotStore),
+		baseapp.SetSnapshotInterval(cast.ToUint64(appOpts.Get(server.FlagStateSyncSnapshotInterval))),
+		baseapp.SetSnapshotKeepRecent(cast.ToUint32(appOpts.Get(server.FlagStateSyncSnapshotKeepRecent))),
+	}
+
+	if cast.ToBool(appOpts.Get(server.FlagArchive)) {
+		archiveDB, err := server.OpenDB("archive", cast.ToString(appOpts.Get(flags.FlagHome)), appOpts)
+		if err != nil {
+			panic(err)
+		}
+		archiveStore, err := archive.NewStore(archiveDB)
+		if err != nil {
+			panic(err)
+		}
+		baseappOpts = append(baseappOpts, baseapp.SetArchiveStore(archiveStore))
+	}
+
+	if cast.ToBool(appOpts.Get(server.FlagStoreProfiling)) {
+		baseappOpts = append(baseappOpts, baseapp.SetStoreProfiler(baseapp.NewStoreProfiler()))
+	}
+
+	if blocks := cast.ToUint64(appOpts.Get(server.FlagGasPriceHistory)); blocks > 0 {
+		baseappOpts = append(baseappOpts, baseapp.SetGasPriceHistory(baseapp.NewGasPriceHistory(blocks)))
+	}
+
 	var wasmOpts []wasm.Option
 	if cast.ToBool(appOpts.Get("telemetry.enabled")) {
 		wasmOpts = append(wasmOpts, wasmkeeper.WithVMCacheMetrics(prometheus.DefaultRegisterer))
@@ -274,17 +313,7 @@ func (a appCreator) newApp(logger log.Logger, db dbm.DB, traceStore io.Writer, a
 		a.encCfg,
 		appOpts,
 		wasmOpts,
-		baseapp.SetPruning(pruningOpts),
-		baseapp.SetMinGasPrices(cast.ToString(appOpts.Get(server.FlagMinGasPrices))),
-		baseapp.SetHaltHeight(cast.ToUint64(appOpts.Get(server.FlagHaltHeight))),
-		baseapp.SetHaltTime(cast.ToUint64(appOpts.Get(server.FlagHaltTime))),
-		baseapp.SetMinRetainBlocks(cast.ToUint64(appOpts.Get(server.FlagMinRetainBlocks))),
-		baseapp.SetInterBlockCache(cache),
-		baseapp.SetTrace(cast.ToBool(appOpts.Get(server.FlagTrace))),
-		baseapp.SetIndexEvents(cast.ToStringSlice(appOpts.Get(server.FlagIndexEvents))),
-		baseapp.SetSnapshotStore(snapshotStore),
-		baseapp.SetSnapshotInterval(cast.ToUint64(appOpts.Get(server.FlagStateSyncSnapshotInterval))),
-		baseapp.SetSnapshotKeepRecent(cast.ToUint32(appOpts.Get(server.FlagStateSyncSnapshotKeepRecent))),
+		baseappOpts...,
 	)
 }
 
@@ -312,3 +341,16 @@ func (a appCreator) appExport(
 
 	return simApp.ExportAppStateAndValidators(forZeroHeight, jailAllowedAddrs)
 }
+
+// storeDecoders returns the store decoders of the simulation of simapp.
+func (a appCreator) storeDecoders() sdk.StoreDecoderRegistry {
+	// the app is only created to get the decoders of its modules
+	homePath, err := os.MkdirTemp("", "simd")
+	if err != nil {
+		panic(err)
+	}
+	defer os.RemoveAll(homePath)
+
+	simApp := simapp.NewSimApp(log.NewNopLogger(), dbm.NewMemDB(), nil, false, map[int64]bool{}, homePath, uint(1), a.encCfg, simapp.EmptyAppOptions{}, nil)
+	return simApp.SimulationManager().StoreDecoders
+}
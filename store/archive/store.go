@@ -0,0 +1,512 @@
+package archive
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/line/lbm-sdk/store/cachekv"
+	"github.com/line/lbm-sdk/store/listenkv"
+	"github.com/line/lbm-sdk/store/tracekv"
+	"github.com/line/lbm-sdk/store/types"
+)
+
+var (
+	latestVersionKey = []byte("s/latest")
+	baseVersionKey   = []byte("s/base")
+	dataPrefix       = []byte("d/")
+)
+
+const (
+	valueDeleted byte = 0x00
+	valueSet     byte = 0x01
+)
+
+var _ types.ArchiveStore = (*Store)(nil)
+
+// Store keeps every version of the writes made to the CommitKVStores of a
+// multistore in a separate database. Each write is stored under a key made of
+// the store name, the key and the version, so the state of any store at any
+// version between the base and the latest version can be reconstructed.
+//
+// Store implements the WriteListener interface and is fed by the multistore,
+// which commits the received writes on every Commit.
+type Store struct {
+	db dbm.DB
+
+	mtx     sync.RWMutex
+	base    int64
+	latest  int64
+	pending map[string]pendingWrite
+}
+
+type pendingWrite struct {
+	key   []byte
+	value []byte
+}
+
+// NewStore returns a reference to a new archive Store backed by the given DB.
+func NewStore(db dbm.DB) (*Store, error) {
+	base, err := getVersion(db, baseVersionKey)
+	if err != nil {
+		return nil, err
+	}
+	latest, err := getVersion(db, latestVersionKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Store{
+		db:      db,
+		base:    base,
+		latest:  latest,
+		pending: make(map[string]pendingWrite),
+	}, nil
+}
+
+// OnWrite implements the WriteListener interface. The write is kept in memory
+// until the next Commit.
+func (s *Store) OnWrite(storeKey types.StoreKey, key []byte, value []byte, delete bool) error {
+	prefix := storePrefix(storeKey.Name())
+	dataKey := append(prefix, encodeKey(key)...)
+
+	var bz []byte
+	if delete {
+		bz = []byte{valueDeleted}
+	} else {
+		bz = make([]byte, 1+len(value))
+		bz[0] = valueSet
+		copy(bz[1:], value)
+	}
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	s.pending[string(dataKey)] = pendingWrite{key: dataKey, value: bz}
+	return nil
+}
+
+// LatestVersion implements the ArchiveStore interface.
+func (s *Store) LatestVersion() int64 {
+	s.mtx.RLock()
+	defer s.mtx.RUnlock()
+
+	return s.latest
+}
+
+// BaseVersion returns the earliest version which can be read from the archive,
+// or 0 if the archive is empty.
+func (s *Store) BaseVersion() int64 {
+	s.mtx.RLock()
+	defer s.mtx.RUnlock()
+
+	return s.base
+}
+
+// Commit implements the ArchiveStore interface. Committing the latest version
+// again overwrites its writes, which happens when the multistore replays the
+// block of a version committed to the archive but not to the multistore.
+func (s *Store) Commit(version int64) error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	if version < s.latest {
+		return fmt.Errorf("cannot commit version %d to the archive; latest version is %d", version, s.latest)
+	}
+
+	batch := s.db.NewBatch()
+	defer batch.Close()
+
+	// sort the writes so the batch content does not depend on map ordering
+	keys := make([]string, 0, len(s.pending))
+	for k := range s.pending {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		w := s.pending[k]
+		if err := batch.Set(versionedKey(w.key, version), w.value); err != nil {
+			return err
+		}
+	}
+
+	if s.base == 0 {
+		if err := batch.Set(baseVersionKey, encodeVersion(version)); err != nil {
+			return err
+		}
+	}
+	if err := batch.Set(latestVersionKey, encodeVersion(version)); err != nil {
+		return err
+	}
+	if err := batch.WriteSync(); err != nil {
+		return err
+	}
+
+	if s.base == 0 {
+		s.base = version
+	}
+	s.latest = version
+	s.pending = make(map[string]pendingWrite)
+
+	return nil
+}
+
+// Close implements the ArchiveStore interface. It closes the underlying DB.
+func (s *Store) Close() error {
+	return s.db.Close()
+}
+
+// KVStoreAtVersion implements the ArchiveStore interface.
+func (s *Store) KVStoreAtVersion(storeName string, version int64) (types.KVStore, error) {
+	s.mtx.RLock()
+	defer s.mtx.RUnlock()
+
+	if s.base == 0 || version < s.base || version > s.latest {
+		return nil, fmt.Errorf("version %d is not available in the archive (available: %d-%d)", version, s.base, s.latest)
+	}
+
+	return &versionedStore{
+		db:      s.db,
+		prefix:  storePrefix(storeName),
+		version: version,
+	}, nil
+}
+
+func getVersion(db dbm.DB, key []byte) (int64, error) {
+	bz, err := db.Get(key)
+	if err != nil {
+		return 0, err
+	}
+	if bz == nil {
+		return 0, nil
+	}
+	if len(bz) != 8 {
+		return 0, fmt.Errorf("invalid archive version: %X", bz)
+	}
+
+	return int64(binary.BigEndian.Uint64(bz)), nil
+}
+
+//----------------------------------------
+// versionedStore
+
+var _ types.KVStore = (*versionedStore)(nil)
+
+// versionedStore is a read-only view of a single store at a given version.
+type versionedStore struct {
+	db      dbm.DB
+	prefix  []byte
+	version int64
+}
+
+// Get implements KVStore.
+func (vs *versionedStore) Get(key []byte) []byte {
+	types.AssertValidKey(key)
+
+	dataKey := append(cloneBytes(vs.prefix), encodeKey(key)...)
+	value := getAtVersion(vs.db, dataKey, vs.version)
+	if value == nil || value[0] == valueDeleted {
+		return nil
+	}
+
+	return value[1:]
+}
+
+// Has implements KVStore.
+func (vs *versionedStore) Has(key []byte) bool {
+	return vs.Get(key) != nil
+}
+
+// Set implements KVStore. It panics as the store is read-only.
+func (vs *versionedStore) Set(_, _ []byte) {
+	panic("cannot set a key to an archived store")
+}
+
+// Delete implements KVStore. It panics as the store is read-only.
+func (vs *versionedStore) Delete(_ []byte) {
+	panic("cannot delete a key from an archived store")
+}
+
+// Iterator implements KVStore.
+func (vs *versionedStore) Iterator(start, end []byte) types.Iterator {
+	return vs.iterator(start, end, false)
+}
+
+// ReverseIterator implements KVStore.
+func (vs *versionedStore) ReverseIterator(start, end []byte) types.Iterator {
+	return vs.iterator(start, end, true)
+}
+
+func (vs *versionedStore) iterator(start, end []byte, reverse bool) types.Iterator {
+	lower := cloneBytes(vs.prefix)
+	if start != nil {
+		lower = append(lower, encodeKey(start)...)
+	}
+
+	var upper []byte
+	if end != nil {
+		upper = append(cloneBytes(vs.prefix), encodeKey(end)...)
+	} else {
+		upper = types.PrefixEndBytes(vs.prefix)
+	}
+
+	return newVersionIterator(vs.db, lower, upper, len(vs.prefix), vs.version, start, end, reverse)
+}
+
+// getAtVersion returns the latest entry of the data key written at or before
+// the version, or nil if there is none.
+func getAtVersion(db dbm.DB, dataKey []byte, version int64) []byte {
+	iter, err := db.ReverseIterator(dataKey, versionedKey(dataKey, version+1))
+	if err != nil {
+		panic(err)
+	}
+	defer iter.Close()
+
+	if !iter.Valid() {
+		return nil
+	}
+
+	return cloneBytes(iter.Value())
+}
+
+// GetStoreType implements Store.
+func (vs *versionedStore) GetStoreType() types.StoreType {
+	return types.StoreTypeDB
+}
+
+// CacheWrap implements CacheWrapper.
+func (vs *versionedStore) CacheWrap() types.CacheWrap {
+	return cachekv.NewStore(vs)
+}
+
+// CacheWrapWithTrace implements CacheWrapper.
+func (vs *versionedStore) CacheWrapWithTrace(w io.Writer, tc types.TraceContext) types.CacheWrap {
+	return cachekv.NewStore(tracekv.NewStore(vs, w, tc))
+}
+
+// CacheWrapWithListeners implements CacheWrapper.
+func (vs *versionedStore) CacheWrapWithListeners(storeKey types.StoreKey, listeners []types.WriteListener) types.CacheWrap {
+	return cachekv.NewStore(listenkv.NewStore(vs, storeKey, listeners))
+}
+
+//----------------------------------------
+// versionIterator
+
+var _ types.Iterator = (*versionIterator)(nil)
+
+// versionIterator walks over the versioned entries of a store and yields, for
+// each key, the latest value written at or before the requested version.
+//
+// The value of each key is read by a point lookup, and the source iterator is
+// then reopened past all the entries of the key, so the cost of a step does not
+// depend on the number of versions of a key.
+type versionIterator struct {
+	db           dbm.DB
+	source       dbm.Iterator
+	lower, upper []byte
+	prefixLen    int
+	version      int64
+	start, end   []byte
+	reverse      bool
+
+	key   []byte
+	value []byte
+	valid bool
+}
+
+func newVersionIterator(db dbm.DB, lower, upper []byte, prefixLen int, version int64, start, end []byte, reverse bool) *versionIterator {
+	it := &versionIterator{
+		db:        db,
+		lower:     lower,
+		upper:     upper,
+		prefixLen: prefixLen,
+		version:   version,
+		start:     start,
+		end:       end,
+		reverse:   reverse,
+	}
+	it.source = it.open(lower, upper)
+	it.advance()
+
+	return it
+}
+
+func (it *versionIterator) open(lower, upper []byte) dbm.Iterator {
+	var (
+		source dbm.Iterator
+		err    error
+	)
+	if it.reverse {
+		source, err = it.db.ReverseIterator(lower, upper)
+	} else {
+		source, err = it.db.Iterator(lower, upper)
+	}
+	if err != nil {
+		panic(err)
+	}
+
+	return source
+}
+
+// advance moves the iterator to the next key which exists at the version.
+func (it *versionIterator) advance() {
+	for it.source.Valid() {
+		entry := it.source.Key()
+		if len(entry) < it.prefixLen+8 {
+			panic(fmt.Sprintf("invalid archive key: %X", entry))
+		}
+		dataKey := cloneBytes(entry[:len(entry)-8])
+		key, _ := it.decode(entry)
+
+		value := getAtVersion(it.db, dataKey, it.version)
+		it.skip(dataKey)
+
+		if value != nil && value[0] == valueSet {
+			it.key = key
+			it.value = value[1:]
+			it.valid = true
+			return
+		}
+	}
+
+	it.key, it.value, it.valid = nil, nil, false
+}
+
+// skip reopens the source iterator past all the entries of the data key.
+func (it *versionIterator) skip(dataKey []byte) {
+	if err := it.source.Close(); err != nil {
+		panic(err)
+	}
+
+	// encoded keys are never a prefix of each other, so all the entries of the
+	// data key are between the data key and its prefix end.
+	if it.reverse {
+		it.source = it.open(it.lower, dataKey)
+	} else {
+		it.source = it.open(types.PrefixEndBytes(dataKey), it.upper)
+	}
+}
+
+func (it *versionIterator) decode(dataKey []byte) ([]byte, int64) {
+	key, rest, err := decodeKey(dataKey[it.prefixLen:])
+	if err != nil || len(rest) != 8 {
+		panic(fmt.Sprintf("invalid archive key: %X", dataKey))
+	}
+
+	return key, int64(binary.BigEndian.Uint64(rest))
+}
+
+// Domain implements Iterator.
+func (it *versionIterator) Domain() ([]byte, []byte) {
+	return it.start, it.end
+}
+
+// Valid implements Iterator.
+func (it *versionIterator) Valid() bool {
+	return it.valid
+}
+
+// Next implements Iterator.
+func (it *versionIterator) Next() {
+	if !it.valid {
+		panic("iterator is invalid")
+	}
+	it.advance()
+}
+
+// Key implements Iterator.
+func (it *versionIterator) Key() []byte {
+	if !it.valid {
+		panic("iterator is invalid")
+	}
+	return it.key
+}
+
+// Value implements Iterator.
+func (it *versionIterator) Value() []byte {
+	if !it.valid {
+		panic("iterator is invalid")
+	}
+	return it.value
+}
+
+// Error implements Iterator.
+func (it *versionIterator) Error() error {
+	return it.source.Error()
+}
+
+// Close implements Iterator.
+func (it *versionIterator) Close() error {
+	return it.source.Close()
+}
+
+//----------------------------------------
+// key encoding
+
+// encodeKey escapes the key so that the encoding preserves the order of the
+// keys and no encoded key is a prefix of another one. Every 0x00 is escaped to
+// 0x00 0xFF, and the encoded key is terminated by 0x00 0x01.
+func encodeKey(key []byte) []byte {
+	bz := make([]byte, 0, len(key)+2)
+	for _, b := range key {
+		bz = append(bz, b)
+		if b == 0x00 {
+			bz = append(bz, 0xFF)
+		}
+	}
+
+	return append(bz, 0x00, 0x01)
+}
+
+// decodeKey reverses encodeKey, returning the key and the remaining bytes.
+func decodeKey(bz []byte) ([]byte, []byte, error) {
+	key := make([]byte, 0, len(bz))
+	for i := 0; i < len(bz); i++ {
+		if bz[i] != 0x00 {
+			key = append(key, bz[i])
+			continue
+		}
+		if i+1 >= len(bz) {
+			break
+		}
+		switch bz[i+1] {
+		case 0xFF:
+			key = append(key, 0x00)
+			i++
+		case 0x01:
+			return key, bz[i+2:], nil
+		default:
+			return nil, nil, fmt.Errorf("invalid escape sequence at %d", i)
+		}
+	}
+
+	return nil, nil, fmt.Errorf("unterminated key")
+}
+
+func storePrefix(storeName string) []byte {
+	return append(cloneBytes(dataPrefix), encodeKey([]byte(storeName))...)
+}
+
+func versionedKey(dataKey []byte, version int64) []byte {
+	return append(cloneBytes(dataKey), encodeVersion(version)...)
+}
+
+func encodeVersion(version int64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(version))
+	return bz
+}
+
+func cloneBytes(bz []byte) []byte {
+	if bz == nil {
+		return nil
+	}
+	cp := make([]byte, len(bz))
+	copy(cp, bz)
+	return cp
+}
@@ -0,0 +1,235 @@
+package archive
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/line/lbm-sdk/store/types"
+)
+
+var (
+	storeKey1 = types.NewKVStoreKey("store1")
+	storeKey2 = types.NewKVStoreKey("store2")
+)
+
+func newArchive(t *testing.T) *Store {
+	store, err := NewStore(dbm.NewMemDB())
+	require.NoError(t, err)
+	return store
+}
+
+func write(t *testing.T, store *Store, key types.StoreKey, k, v string) {
+	var value []byte
+	if v != "" {
+		value = []byte(v)
+	}
+	require.NoError(t, store.OnWrite(key, []byte(k), value, v == ""))
+}
+
+func TestKeyEncoding(t *testing.T) {
+	keys := [][]byte{{}, {0x00}, {0x00, 0x00}, {0x00, 0x01}, {0x00, 0xFF}, {0x01}, []byte("a"), []byte("a\x00"), []byte("ab")}
+
+	for i, key := range keys {
+		bz := encodeKey(key)
+		decoded, rest, err := decodeKey(append(bz, 0x42))
+		require.NoError(t, err)
+		require.Equal(t, key, decoded)
+		require.Equal(t, []byte{0x42}, rest)
+
+		// the encoding preserves the order
+		if i > 0 {
+			require.Less(t, string(encodeKey(keys[i-1])), string(bz))
+		}
+	}
+}
+
+func TestStoreVersions(t *testing.T) {
+	store := newArchive(t)
+	require.Equal(t, int64(0), store.LatestVersion())
+
+	_, err := store.KVStoreAtVersion(storeKey1.Name(), 1)
+	require.Error(t, err)
+
+	write(t, store, storeKey1, "a", "a1")
+	write(t, store, storeKey1, "b", "b1")
+	write(t, store, storeKey2, "a", "other")
+	require.NoError(t, store.Commit(3))
+
+	write(t, store, storeKey1, "a", "a2")
+	write(t, store, storeKey1, "b", "")
+	require.NoError(t, store.Commit(4))
+
+	write(t, store, storeKey1, "b", "b3")
+	write(t, store, storeKey1, "c", "c3")
+	write(t, store, storeKey1, "c", "")
+	require.NoError(t, store.Commit(5))
+
+	require.Equal(t, int64(3), store.BaseVersion())
+	require.Equal(t, int64(5), store.LatestVersion())
+	require.Error(t, store.Commit(4))
+
+	testCases := map[string]struct {
+		version int64
+		expect  map[string]string
+	}{
+		"base version": {
+			version: 3,
+			expect:  map[string]string{"a": "a1", "b": "b1"},
+		},
+		"deleted": {
+			version: 4,
+			expect:  map[string]string{"a": "a2"},
+		},
+		"set after deleted": {
+			version: 5,
+			expect:  map[string]string{"a": "a2", "b": "b3"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			kv, err := store.KVStoreAtVersion(storeKey1.Name(), tc.version)
+			require.NoError(t, err)
+
+			for _, k := range []string{"a", "b", "c"} {
+				v, ok := tc.expect[k]
+				require.Equal(t, ok, kv.Has([]byte(k)))
+				if ok {
+					require.Equal(t, []byte(v), kv.Get([]byte(k)))
+				} else {
+					require.Nil(t, kv.Get([]byte(k)))
+				}
+			}
+
+			var keys []string
+			iter := kv.Iterator(nil, nil)
+			for ; iter.Valid(); iter.Next() {
+				keys = append(keys, string(iter.Key()))
+				require.Equal(t, tc.expect[string(iter.Key())], string(iter.Value()))
+			}
+			require.NoError(t, iter.Close())
+			require.Len(t, keys, len(tc.expect))
+			require.IsIncreasing(t, keys)
+
+			keys = nil
+			iter = kv.ReverseIterator(nil, nil)
+			for ; iter.Valid(); iter.Next() {
+				keys = append(keys, string(iter.Key()))
+				require.Equal(t, tc.expect[string(iter.Key())], string(iter.Value()))
+			}
+			require.NoError(t, iter.Close())
+			require.Len(t, keys, len(tc.expect))
+			require.IsDecreasing(t, keys)
+
+			require.Panics(t, func() { kv.Set([]byte("a"), []byte("new")) })
+			require.Panics(t, func() { kv.Delete([]byte("a")) })
+		})
+	}
+
+	for _, version := range []int64{2, 6} {
+		_, err := store.KVStoreAtVersion(storeKey1.Name(), version)
+		require.Error(t, err)
+	}
+
+	// stores are isolated from each other
+	kv, err := store.KVStoreAtVersion(storeKey2.Name(), 5)
+	require.NoError(t, err)
+	require.Equal(t, []byte("other"), kv.Get([]byte("a")))
+	require.Nil(t, kv.Get([]byte("b")))
+}
+
+func TestStoreIteratorDomain(t *testing.T) {
+	store := newArchive(t)
+	for _, k := range []string{"a", "a\x00", "ab", "b", "c"} {
+		write(t, store, storeKey1, k, k)
+	}
+	require.NoError(t, store.Commit(1))
+
+	kv, err := store.KVStoreAtVersion(storeKey1.Name(), 1)
+	require.NoError(t, err)
+
+	collect := func(iter types.Iterator) []string {
+		defer iter.Close()
+		var keys []string
+		for ; iter.Valid(); iter.Next() {
+			keys = append(keys, string(iter.Key()))
+		}
+		return keys
+	}
+
+	require.Equal(t, []string{"a", "a\x00", "ab"}, collect(kv.Iterator([]byte("a"), []byte("b"))))
+	require.Equal(t, []string{"ab", "b"}, collect(kv.Iterator([]byte("ab"), []byte("c"))))
+	require.Equal(t, []string{"c", "b", "ab"}, collect(kv.ReverseIterator([]byte("ab"), nil)))
+	require.Equal(t, []string{"a\x00"}, collect(types.KVStoreReversePrefixIterator(kv, []byte("a\x00"))))
+}
+
+func TestStoreReopen(t *testing.T) {
+	db := dbm.NewMemDB()
+	store, err := NewStore(db)
+	require.NoError(t, err)
+
+	write(t, store, storeKey1, "a", "a1")
+	require.NoError(t, store.Commit(1))
+	// writes are not persisted until commit
+	write(t, store, storeKey1, "a", "a2")
+
+	store, err = NewStore(db)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), store.BaseVersion())
+	require.Equal(t, int64(1), store.LatestVersion())
+
+	kv, err := store.KVStoreAtVersion(storeKey1.Name(), 1)
+	require.NoError(t, err)
+	require.Equal(t, []byte("a1"), kv.Get([]byte("a")))
+}
+
+func TestStoreRecommitLatest(t *testing.T) {
+	store := newArchive(t)
+	write(t, store, storeKey1, "a", "a1")
+	require.NoError(t, store.Commit(1))
+	write(t, store, storeKey1, "a", "a2")
+	require.NoError(t, store.Commit(2))
+
+	// replaying the latest version overwrites its writes
+	write(t, store, storeKey1, "a", "a3")
+	require.NoError(t, store.Commit(2))
+	require.Equal(t, int64(2), store.LatestVersion())
+
+	kv, err := store.KVStoreAtVersion(storeKey1.Name(), 2)
+	require.NoError(t, err)
+	require.Equal(t, []byte("a3"), kv.Get([]byte("a")))
+}
+
+func BenchmarkStoreIterator(b *testing.B) {
+	const (
+		numKeys     = 100
+		numVersions = 100
+	)
+
+	store, err := NewStore(dbm.NewMemDB())
+	require.NoError(b, err)
+	for v := int64(1); v <= numVersions; v++ {
+		for k := 0; k < numKeys; k++ {
+			require.NoError(b, store.OnWrite(storeKey1, []byte(fmt.Sprintf("key%03d", k)), []byte(fmt.Sprintf("%d", v)), false))
+		}
+		require.NoError(b, store.Commit(v))
+	}
+
+	for _, version := range []int64{1, numVersions} {
+		b.Run(fmt.Sprintf("version %d", version), func(b *testing.B) {
+			kv, err := store.KVStoreAtVersion(storeKey1.Name(), version)
+			require.NoError(b, err)
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				iter := kv.Iterator(nil, nil)
+				for ; iter.Valid(); iter.Next() {
+				}
+				iter.Close()
+			}
+		})
+	}
+}
@@ -8,6 +8,8 @@ import (
 
 	"github.com/line/lbm-sdk/store/cachekv"
 	"github.com/line/lbm-sdk/store/dbadapter"
+	"github.com/line/lbm-sdk/store/listenkv"
+	"github.com/line/lbm-sdk/store/tracekv"
 	"github.com/line/lbm-sdk/store/types"
 )
 
@@ -49,16 +51,18 @@ func NewFromKVStore(
 	}
 
 	for key, store := range stores {
-		var cacheWrapped types.CacheWrap
-		if cms.TracingEnabled() {
-			cacheWrapped = store.CacheWrapWithTrace(cms.traceWriter, cms.traceContext)
-		} else {
-			cacheWrapped = store.CacheWrap()
-		}
-		if cms.ListeningEnabled(key) {
-			cms.stores[key] = cacheWrapped.CacheWrapWithListeners(key, cms.listeners[key])
-		} else {
-			cms.stores[key] = cacheWrapped
+		// The listeners must wrap the parent store itself, so that they are
+		// notified when the branch is written to it.
+		switch {
+		case cms.ListeningEnabled(key) && cms.TracingEnabled():
+			traced := tracekv.NewStore(store.(types.KVStore), cms.traceWriter, cms.traceContext)
+			cms.stores[key] = cachekv.NewStore(listenkv.NewStore(traced, key, cms.listeners[key]))
+		case cms.ListeningEnabled(key):
+			cms.stores[key] = store.CacheWrapWithListeners(key, cms.listeners[key])
+		case cms.TracingEnabled():
+			cms.stores[key] = store.CacheWrapWithTrace(cms.traceWriter, cms.traceContext)
+		default:
+			cms.stores[key] = store.CacheWrap()
 		}
 	}
 
@@ -81,7 +85,8 @@ func newCacheMultiStoreFromCMS(cms Store) Store {
 		stores[k] = v
 	}
 
-	return NewFromKVStore(cms.db, stores, nil, cms.traceWriter, cms.traceContext, cms.listeners)
+	// the writes are listened only once, when the root branch is written
+	return NewFromKVStore(cms.db, stores, nil, cms.traceWriter, cms.traceContext, nil)
 }
 
 // SetTracer sets the tracer for the MultiStore that the underlying
@@ -1,11 +1,15 @@
 package cachemulti
 
 import (
+	"bytes"
 	"fmt"
+	"io"
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
 
+	"github.com/line/lbm-sdk/store/dbadapter"
 	"github.com/line/lbm-sdk/store/types"
 )
 
@@ -22,3 +26,48 @@ func TestStoreGetKVStore(t *testing.T) {
 	require.PanicsWithValue(errMsg,
 		func() { s.GetKVStore(key) })
 }
+
+type recordingListener struct {
+	writes []string
+}
+
+func (l *recordingListener) OnWrite(storeKey types.StoreKey, key []byte, value []byte, delete bool) error {
+	l.writes = append(l.writes, fmt.Sprintf("%s/%s=%s/%t", storeKey.Name(), key, value, delete))
+	return nil
+}
+
+func TestStoreListeners(t *testing.T) {
+	for _, tracing := range []bool{false, true} {
+		t.Run(fmt.Sprintf("tracing %t", tracing), func(t *testing.T) {
+			key := types.NewKVStoreKey("store")
+			parent := dbadapter.Store{DB: dbm.NewMemDB()}
+			listener := &recordingListener{}
+			var traceWriter io.Writer
+			if tracing {
+				traceWriter = &bytes.Buffer{}
+			}
+			cms := NewFromKVStore(dbadapter.Store{DB: dbm.NewMemDB()},
+				map[types.StoreKey]types.CacheWrapper{key: parent}, nil, traceWriter, nil,
+				map[types.StoreKey][]types.WriteListener{key: {listener}})
+
+			// the writes are listened when the branch is written
+			cms.GetKVStore(key).Set([]byte("a"), []byte("1"))
+			require.Empty(t, listener.writes)
+			cms.Write()
+			require.Equal(t, []string{"store/a=1/false"}, listener.writes)
+			require.Equal(t, []byte("1"), parent.Get([]byte("a")))
+
+			// the writes of the nested branches are listened once, when they
+			// reach the root branch
+			listener.writes = nil
+			nested := cms.CacheMultiStore()
+			nested.GetKVStore(key).Set([]byte("b"), []byte("2"))
+			nested.GetKVStore(key).Delete([]byte("a"))
+			nested.Write()
+			require.Empty(t, listener.writes)
+			cms.Write()
+			require.ElementsMatch(t, []string{"store/a=/true", "store/b=2/false"}, listener.writes)
+			require.Nil(t, parent.Get([]byte("a")))
+		})
+	}
+}
@@ -31,6 +31,7 @@ type (
 		GetVersionedWithProof(key []byte, version int64) ([]byte, *iavl.RangeProof, error)
 		GetImmutable(version int64) (*iavl.ImmutableTree, error)
 		SetInitialVersion(version uint64)
+		LoadVersionForOverwriting(targetVersion int64) (int64, error)
 	}
 
 	// immutableTree is a simple wrapper around a reference to an iavl.ImmutableTree
@@ -57,6 +58,10 @@ func (it *immutableTree) DeleteVersion(_ int64) error {
 	panic("cannot call 'DeleteVersion' on an immutable IAVL tree")
 }
 
+func (it *immutableTree) LoadVersionForOverwriting(_ int64) (int64, error) {
+	panic("cannot call 'LoadVersionForOverwriting' on an immutable IAVL tree")
+}
+
 func (it *immutableTree) DeleteVersions(_ ...int64) error {
 	panic("cannot call 'DeleteVersions' on an immutable IAVL tree")
 }
@@ -0,0 +1,148 @@
+package profilekv
+
+import (
+	"io"
+
+	"github.com/line/lbm-sdk/store/gaskv"
+	"github.com/line/lbm-sdk/store/types"
+)
+
+var _ types.KVStore = &Store{}
+
+// Stats holds the numbers of the operations made to a KVStore.
+type Stats struct {
+	Reads      uint64
+	ReadBytes  uint64
+	Writes     uint64
+	WriteBytes uint64
+	Deletes    uint64
+	IterSteps  uint64
+	IterBytes  uint64
+	// Gas is the gas charged for the operations by the gas config of the
+	// Store.
+	Gas uint64
+}
+
+// Add adds the numbers of the other Stats.
+func (s *Stats) Add(other Stats) {
+	s.Reads += other.Reads
+	s.ReadBytes += other.ReadBytes
+	s.Writes += other.Writes
+	s.WriteBytes += other.WriteBytes
+	s.Deletes += other.Deletes
+	s.IterSteps += other.IterSteps
+	s.IterBytes += other.IterBytes
+	s.Gas += other.Gas
+}
+
+// Store records the operations made to an underlying KVStore into Stats. It
+// implements the KVStore interface.
+type Store struct {
+	parent types.KVStore
+	stats  *Stats
+}
+
+// NewStore returns a reference to a new profiling KVStore. The gas of the
+// operations is computed by the given gas config, the same way as gaskv does.
+func NewStore(parent types.KVStore, stats *Stats, gasConfig types.GasConfig) *Store {
+	return &Store{
+		parent: gaskv.NewStore(parent, statsGasMeter{types.NewInfiniteGasMeter(), stats}, gasConfig),
+		stats:  stats,
+	}
+}
+
+// Implements Store.
+func (s *Store) GetStoreType() types.StoreType {
+	return s.parent.GetStoreType()
+}
+
+// Implements KVStore.
+func (s *Store) Get(key []byte) []byte {
+	value := s.parent.Get(key)
+	s.stats.Reads++
+	s.stats.ReadBytes += uint64(len(key) + len(value))
+
+	return value
+}
+
+// Implements KVStore.
+func (s *Store) Has(key []byte) bool {
+	s.stats.Reads++
+	s.stats.ReadBytes += uint64(len(key))
+
+	return s.parent.Has(key)
+}
+
+// Implements KVStore.
+func (s *Store) Set(key []byte, value []byte) {
+	s.parent.Set(key, value)
+	s.stats.Writes++
+	s.stats.WriteBytes += uint64(len(key) + len(value))
+}
+
+// Implements KVStore.
+func (s *Store) Delete(key []byte) {
+	s.parent.Delete(key)
+	s.stats.Deletes++
+}
+
+// Implements KVStore.
+func (s *Store) Iterator(start, end []byte) types.Iterator {
+	return newIterator(s.parent.Iterator(start, end), s.stats)
+}
+
+// Implements KVStore.
+func (s *Store) ReverseIterator(start, end []byte) types.Iterator {
+	return newIterator(s.parent.ReverseIterator(start, end), s.stats)
+}
+
+// Implements KVStore.
+func (s *Store) CacheWrap() types.CacheWrap {
+	panic("cannot CacheWrap a profile KVStore")
+}
+
+// CacheWrapWithTrace implements the KVStore interface.
+func (s *Store) CacheWrapWithTrace(_ io.Writer, _ types.TraceContext) types.CacheWrap {
+	panic("cannot CacheWrapWithTrace a profile KVStore")
+}
+
+// CacheWrapWithListeners implements the CacheWrapper interface.
+func (s *Store) CacheWrapWithListeners(_ types.StoreKey, _ []types.WriteListener) types.CacheWrap {
+	panic("cannot CacheWrapWithListeners a profile KVStore")
+}
+
+type iterator struct {
+	types.Iterator
+	stats *Stats
+}
+
+func newIterator(parent types.Iterator, stats *Stats) types.Iterator {
+	it := &iterator{Iterator: parent, stats: stats}
+	it.record()
+
+	return it
+}
+
+// Next implements the Iterator interface.
+func (it *iterator) Next() {
+	it.Iterator.Next()
+	it.record()
+}
+
+func (it *iterator) record() {
+	if it.Valid() {
+		it.stats.IterSteps++
+		it.stats.IterBytes += uint64(len(it.Key()) + len(it.Value()))
+	}
+}
+
+// statsGasMeter adds the gas consumed to Stats.
+type statsGasMeter struct {
+	types.GasMeter
+	stats *Stats
+}
+
+func (m statsGasMeter) ConsumeGas(amount types.Gas, descriptor string) {
+	m.GasMeter.ConsumeGas(amount, descriptor)
+	m.stats.Gas += amount
+}
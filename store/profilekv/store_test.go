@@ -0,0 +1,63 @@
+package profilekv_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/line/lbm-sdk/store/dbadapter"
+	"github.com/line/lbm-sdk/store/gaskv"
+	"github.com/line/lbm-sdk/store/profilekv"
+	"github.com/line/lbm-sdk/store/types"
+)
+
+func bz(s string) []byte { return []byte(s) }
+
+func TestProfileKVStore(t *testing.T) {
+	var stats profilekv.Stats
+	mem := dbadapter.Store{DB: dbm.NewMemDB()}
+	store := profilekv.NewStore(mem, &stats, types.KVGasConfig())
+
+	// the same operations through a gas store to compare the gas
+	meter := types.NewInfiniteGasMeter()
+	gasStore := gaskv.NewStore(dbadapter.Store{DB: dbm.NewMemDB()}, meter, types.KVGasConfig())
+
+	for _, s := range []types.KVStore{store, gasStore} {
+		s.Set(bz("key1"), bz("value1"))
+		s.Set(bz("key2"), bz("value2"))
+		require.Equal(t, bz("value1"), s.Get(bz("key1")))
+		require.Nil(t, s.Get(bz("key3")))
+		require.True(t, s.Has(bz("key2")))
+		s.Delete(bz("key2"))
+
+		iter := s.Iterator(nil, nil)
+		for ; iter.Valid(); iter.Next() {
+		}
+		iter.Close()
+	}
+
+	require.Equal(t, profilekv.Stats{
+		Reads:      3,
+		ReadBytes:  4 + 6 + 4 + 4,
+		Writes:     2,
+		WriteBytes: 2 * (4 + 6),
+		Deletes:    1,
+		IterSteps:  1,
+		IterBytes:  4 + 6,
+		Gas:        meter.GasConsumed(),
+	}, stats)
+
+	var other profilekv.Stats
+	other.Add(stats)
+	other.Add(stats)
+	require.Equal(t, 2*stats.Reads, other.Reads)
+	require.Equal(t, 2*stats.Gas, other.Gas)
+}
+
+func TestProfileKVStoreNoCacheWrap(t *testing.T) {
+	store := profilekv.NewStore(dbadapter.Store{DB: dbm.NewMemDB()}, &profilekv.Stats{}, types.KVGasConfig())
+	require.Panics(t, func() { store.CacheWrap() })
+	require.Panics(t, func() { store.CacheWrapWithTrace(nil, nil) })
+	require.Panics(t, func() { store.CacheWrapWithListeners(nil, nil) })
+}
@@ -13,6 +13,7 @@ import (
 	protoio "github.com/gogo/protobuf/io"
 	gogotypes "github.com/gogo/protobuf/types"
 	abci "github.com/line/ostracon/abci/types"
+	"github.com/line/ostracon/libs/log"
 	"github.com/pkg/errors"
 	dbm "github.com/tendermint/tm-db"
 
@@ -56,6 +57,9 @@ type Store struct {
 	interBlockCache types.MultiStorePersistentCache
 
 	listeners map[types.StoreKey][]types.WriteListener
+
+	archive types.ArchiveStore
+	logger  log.Logger
 }
 
 var (
@@ -76,6 +80,7 @@ func NewStore(db dbm.DB) *Store {
 		keysByName:    make(map[string]types.StoreKey),
 		pruneHeights:  make([]int64, 0),
 		listeners:     make(map[types.StoreKey][]types.WriteListener),
+		logger:        log.NewNopLogger(),
 		iavlCacheSize: iavl.DefaultIAVLCacheSize,
 	}
 }
@@ -259,6 +264,133 @@ func (rs *Store) loadVersion(ver int64, upgrades *types.StoreUpgrades) error {
 		rs.pruneHeights = ph
 	}
 
+	if rs.archive != nil {
+		if err := rs.loadArchive(ver, upgrades); err != nil {
+			return errors.Wrap(err, "failed to load archive")
+		}
+	}
+
+	return nil
+}
+
+// loadArchive makes sure the archive is in sync with the loaded version.
+// An empty archive is initialized with the whole state of the loaded version,
+// and the changes made by store upgrades are recorded to be committed with the
+// next version. An archive out of sync with the loaded version is an error,
+// as it would serve wrong states; it must be rebuilt.
+func (rs *Store) loadArchive(ver int64, upgrades *types.StoreUpgrades) error {
+	for key, params := range rs.storesParams {
+		if params.typ == types.StoreTypeIAVL && !rs.isListening(key, rs.archive) {
+			rs.AddListeners(key, []types.WriteListener{rs.archive})
+		}
+	}
+
+	latest := rs.archive.LatestVersion()
+	switch {
+	case latest == 0 && ver != 0:
+		for key, store := range rs.stores {
+			if store.GetStoreType() != types.StoreTypeIAVL {
+				continue
+			}
+			if err := rs.reconcileArchive(key, store); err != nil {
+				return err
+			}
+		}
+		return rs.archive.Commit(ver)
+
+	case latest == ver+1:
+		// The archive is committed before the multistore, so it is one version
+		// ahead if the node stopped in between. The version is committed to the
+		// archive again when its block is replayed.
+		return nil
+
+	case latest != ver:
+		return errors.Errorf("archive version %d is out of sync with the store version %d; remove the archive to rebuild it", latest, ver)
+
+	case upgrades != nil:
+		for key, store := range rs.stores {
+			if store.GetStoreType() != types.StoreTypeIAVL {
+				continue
+			}
+			if upgrades.IsDeleted(key.Name()) {
+				if err := rs.reconcileArchive(key, store); err != nil {
+					return err
+				}
+			} else if oldName := upgrades.RenamedFrom(key.Name()); oldName != "" {
+				if err := rs.reconcileArchive(key, store); err != nil {
+					return err
+				}
+				// the data of the old store has been moved, so delete it
+				if err := rs.tombstoneArchive(types.NewKVStoreKey(oldName)); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// tombstoneArchive feeds the archive with the deletion of every archived key of
+// the given store.
+func (rs *Store) tombstoneArchive(key types.StoreKey) error {
+	archived, err := rs.archive.KVStoreAtVersion(key.Name(), rs.archive.LatestVersion())
+	if err != nil {
+		return err
+	}
+
+	var keys [][]byte
+	itr := archived.Iterator(nil, nil)
+	for ; itr.Valid(); itr.Next() {
+		keys = append(keys, itr.Key())
+	}
+	itr.Close()
+
+	for _, k := range keys {
+		if err := rs.archive.OnWrite(key, k, nil, true); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// reconcileArchive feeds the archive with the writes needed to bring the
+// archived state of the given store to its current state.
+func (rs *Store) reconcileArchive(key types.StoreKey, store types.CommitKVStore) error {
+	current := store.(types.KVStore)
+
+	if latest := rs.archive.LatestVersion(); latest != 0 {
+		archived, err := rs.archive.KVStoreAtVersion(key.Name(), latest)
+		if err != nil {
+			return err
+		}
+
+		// Note that we cannot write while iterating, so load all keys here, delete below
+		var deleted [][]byte
+		itr := archived.Iterator(nil, nil)
+		for ; itr.Valid(); itr.Next() {
+			if !current.Has(itr.Key()) {
+				deleted = append(deleted, itr.Key())
+			}
+		}
+		itr.Close()
+
+		for _, k := range deleted {
+			if err := rs.archive.OnWrite(key, k, nil, true); err != nil {
+				return err
+			}
+		}
+	}
+
+	itr := current.Iterator(nil, nil)
+	defer itr.Close()
+	for ; itr.Valid(); itr.Next() {
+		if err := rs.archive.OnWrite(key, itr.Key(), itr.Value(), false); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -308,6 +440,17 @@ func (rs *Store) SetInterBlockCache(c types.MultiStorePersistentCache) {
 	rs.interBlockCache = c
 }
 
+// SetArchive implements CommitMultiStore. The archive is registered as a
+// listener of every IAVL store on loading a version.
+func (rs *Store) SetArchive(archive types.ArchiveStore) {
+	rs.archive = archive
+}
+
+// SetLogger sets the logger of the Store.
+func (rs *Store) SetLogger(logger log.Logger) {
+	rs.logger = logger
+}
+
 // SetTracer sets the tracer for the MultiStore that the underlying
 // stores will utilize to trace operations. A MultiStore is returned.
 func (rs *Store) SetTracer(w io.Writer) types.MultiStore {
@@ -363,6 +506,15 @@ func (rs *Store) AddListeners(key types.StoreKey, listeners []types.WriteListene
 	}
 }
 
+func (rs *Store) isListening(key types.StoreKey, listener types.WriteListener) bool {
+	for _, l := range rs.listeners[key] {
+		if l == listener {
+			return true
+		}
+	}
+	return false
+}
+
 // ListeningEnabled returns if listening is enabled for a specific KVStore
 func (rs *Store) ListeningEnabled(key types.StoreKey) bool {
 	if ls, ok := rs.listeners[key]; ok {
@@ -421,6 +573,13 @@ func (rs *Store) Commit() types.CommitID {
 		rs.pruneStores()
 	}
 
+	// The archive is committed first, so that it is never behind the multistore.
+	if rs.archive != nil {
+		if err := rs.archive.Commit(version); err != nil {
+			panic(err)
+		}
+	}
+
 	flushMetadata(rs.db, version, rs.lastCommitInfo, rs.pruneHeights)
 
 	return types.CommitID{
@@ -481,7 +640,8 @@ func (rs *Store) CacheMultiStore() types.CacheMultiStore {
 // CacheMultiStoreWithVersion is analogous to CacheMultiStore except that it
 // attempts to load stores at a given version (height). An error is returned if
 // any store cannot be loaded. This should only be used for querying and
-// iterating at past heights.
+// iterating at past heights. If an archive is set, versions which do not exist
+// in the IAVL stores anymore are loaded from the archive.
 func (rs *Store) CacheMultiStoreWithVersion(version int64) (types.CacheMultiStore, error) {
 	cachedStores := make(map[types.StoreKey]types.CacheWrapper)
 	for key, store := range rs.stores {
@@ -494,6 +654,18 @@ func (rs *Store) CacheMultiStoreWithVersion(version int64) (types.CacheMultiStor
 			// Attempt to lazy-load an already saved IAVL store version. If the
 			// version does not exist or is pruned, an error should be returned.
 			iavlStore, err := store.(*iavl.Store).GetImmutable(version)
+
+			// Fall back to the archive if the version has been pruned. The
+			// version is checked after loading as pruning may be in progress.
+			if rs.archive != nil && (err != nil || !store.(*iavl.Store).VersionExists(version)) {
+				archived, err := rs.archive.KVStoreAtVersion(key.Name(), version)
+				if err != nil {
+					return nil, err
+				}
+
+				cachedStores[key] = archived
+				continue
+			}
 			if err != nil {
 				return nil, err
 			}
@@ -893,28 +1065,68 @@ func (rs *Store) buildCommitInfo(version int64) *types.CommitInfo {
 	}
 }
 
-// RollbackToVersion delete the versions after `target` and update the latest version.
-func (rs *Store) RollbackToVersion(target int64) int64 {
-	if target < 0 {
-		panic("Negative rollback target")
+// RollbackToVersion deletes the versions after `target` of the mounted IAVL
+// stores and updates the latest version. The target must be available in all
+// the IAVL stores, which are loaded at the target afterwards.
+func (rs *Store) RollbackToVersion(target int64) error {
+	if target <= 0 {
+		return fmt.Errorf("invalid rollback height target: %d", target)
 	}
-	current := getLatestVersion(rs.db)
-	if target >= current {
-		return current
+
+	cInfo, err := getCommitInfo(rs.db, target)
+	if err != nil {
+		return errors.Wrapf(err, "failed to rollback to version %d", target)
 	}
-	for ; current > target; current-- {
-		rs.pruneHeights = append(rs.pruneHeights, current)
+
+	for key, store := range rs.stores {
+		if store.GetStoreType() == types.StoreTypeIAVL {
+			// If the store is wrapped with an inter-block cache, we must first unwrap
+			// it to get the underlying IAVL store.
+			store = rs.GetCommitKVStore(key)
+
+			if _, err := store.(*iavl.Store).LoadVersionForOverwriting(target); err != nil {
+				return errors.Wrapf(err, "failed to rollback store %s to version %d", key.Name(), target)
+			}
+		}
 	}
-	rs.pruneStores()
 
-	// update latest height
-	bz, err := gogotypes.StdInt64Marshal(current)
-	if err != nil {
-		panic(err)
+	pruneHeights := make([]int64, 0, len(rs.pruneHeights))
+	for _, ph := range rs.pruneHeights {
+		if ph <= target {
+			pruneHeights = append(pruneHeights, ph)
+		}
+	}
+
+	flushMetadata(rs.db, target, cInfo, pruneHeights)
+	return rs.LoadLatestVersion()
+}
+
+// DeleteVersion deletes a version of the mounted IAVL stores along with its
+// commit info. It is meant to remove a corrupted version offline. The latest
+// version cannot be deleted, see RollbackToVersion for that.
+func (rs *Store) DeleteVersion(ver int64) error {
+	if ver >= getLatestVersion(rs.db) {
+		return fmt.Errorf("cannot delete the latest version %d", ver)
+	}
+	if _, err := getCommitInfo(rs.db, ver); err != nil {
+		return errors.Wrapf(err, "failed to delete version %d", ver)
+	}
+
+	for key, store := range rs.stores {
+		if store.GetStoreType() == types.StoreTypeIAVL {
+			// If the store is wrapped with an inter-block cache, we must first unwrap
+			// it to get the underlying IAVL store.
+			store = rs.GetCommitKVStore(key)
+
+			if err := store.(*iavl.Store).DeleteVersions(ver); err != nil {
+				if errCause := errors.Cause(err); errCause != nil && errCause != iavltree.ErrVersionDoesNotExist {
+					return errors.Wrapf(err, "failed to delete version %d of store %s", ver, key.Name())
+				}
+			}
+		}
 	}
 
-	rs.db.Set([]byte(latestVersionKey), bz)
-	return current
+	return rs.db.Delete([]byte(fmt.Sprintf(commitInfoKeyFmt, ver)))
 }
 
 type storeParams struct {
@@ -941,6 +1153,17 @@ func getLatestVersion(db dbm.DB) int64 {
 	return latestVersion
 }
 
+// GetLatestVersion returns the latest version committed to the db of a Store.
+func GetLatestVersion(db dbm.DB) int64 {
+	return getLatestVersion(db)
+}
+
+// GetCommitInfo returns the commit info of a version committed to the db of a
+// Store.
+func GetCommitInfo(db dbm.DB, ver int64) (*types.CommitInfo, error) {
+	return getCommitInfo(db, ver)
+}
+
 // Commits each store and returns a new commitInfo.
 func commitStores(version int64, storeMap map[types.StoreKey]types.CommitKVStore) *types.CommitInfo {
 	storeInfos := make([]types.StoreInfo, 0, len(storeMap))
@@ -16,6 +16,7 @@ import (
 
 	"github.com/line/lbm-sdk/codec"
 	codecTypes "github.com/line/lbm-sdk/codec/types"
+	"github.com/line/lbm-sdk/store/archive"
 	"github.com/line/lbm-sdk/store/cachemulti"
 	"github.com/line/lbm-sdk/store/iavl"
 	sdkmaps "github.com/line/lbm-sdk/store/internal/maps"
@@ -523,6 +524,101 @@ func TestMultiStore_Pruning(t *testing.T) {
 	}
 }
 
+func TestMultiStore_Archive(t *testing.T) {
+	db := dbm.NewMemDB()
+	ms := newMultiStoreWithMounts(db, types.PruneEverything)
+
+	require.NoError(t, ms.LoadLatestVersion())
+	ms.GetKVStore(testStoreKey1).Set([]byte("initial"), []byte("value"))
+	ms.Commit()
+
+	// the archive is initialized with the state of the loaded version
+	archiveStore, err := archive.NewStore(dbm.NewMemDB())
+	require.NoError(t, err)
+	ms = newMultiStoreWithMounts(db, types.PruneEverything)
+	ms.SetArchive(archiveStore)
+	require.NoError(t, ms.LoadLatestVersion())
+	require.Equal(t, int64(1), archiveStore.LatestVersion())
+
+	key := []byte("key")
+	for i := int64(2); i <= 10; i++ {
+		cms := ms.CacheMultiStore()
+		kv := cms.GetKVStore(testStoreKey1)
+		kv.Set(key, []byte(fmt.Sprintf("value%d", i)))
+		if i == 5 {
+			kv.Delete([]byte("initial"))
+		}
+		cms.Write()
+		ms.Commit()
+	}
+	require.Equal(t, int64(10), archiveStore.LatestVersion())
+
+	// pruned versions are served from the archive
+	for v := int64(1); v <= 10; v++ {
+		cms, err := ms.CacheMultiStoreWithVersion(v)
+		require.NoError(t, err)
+
+		kv := cms.GetKVStore(testStoreKey1)
+		if v == 1 {
+			require.Nil(t, kv.Get(key))
+		} else {
+			require.Equal(t, []byte(fmt.Sprintf("value%d", v)), kv.Get(key))
+		}
+		require.Equal(t, v < 5, kv.Has([]byte("initial")))
+	}
+
+	// the archive may be one version ahead of the store
+	ms = newMultiStoreWithMounts(db, types.PruneEverything)
+	ms.SetArchive(archiveStore)
+	require.NoError(t, ms.LoadVersion(10))
+	ms = newMultiStoreWithMounts(db, types.PruneEverything)
+	ms.SetArchive(archiveStore)
+	require.NoError(t, ms.LoadVersion(9))
+	require.NotNil(t, ms.archive)
+
+	// an archive out of sync fails the loading
+	ms = newMultiStoreWithMounts(db, types.PruneEverything)
+	ms.SetArchive(archiveStore)
+	require.Error(t, ms.LoadVersion(8))
+}
+
+func TestMultiStore_ArchiveUpgrades(t *testing.T) {
+	db := dbm.NewMemDB()
+	archiveStore, err := archive.NewStore(dbm.NewMemDB())
+	require.NoError(t, err)
+
+	ms := newMultiStoreWithMounts(db, types.PruneNothing)
+	ms.SetArchive(archiveStore)
+	require.NoError(t, ms.LoadLatestVersion())
+	ms.GetKVStore(testStoreKey2).Set([]byte("key2"), []byte("value2"))
+	ms.GetKVStore(testStoreKey3).Set([]byte("key3"), []byte("value3"))
+	ms.Commit()
+
+	ms, upgrades := newMultiStoreWithModifiedMounts(db, types.PruneNothing)
+	ms.SetArchive(archiveStore)
+	require.NoError(t, ms.LoadLatestVersionAndUpgrade(upgrades))
+	ms.Commit()
+	require.Equal(t, int64(2), archiveStore.LatestVersion())
+
+	testCases := []struct {
+		store, key string
+		expect     []byte
+	}{
+		{"store2", "key2", nil},
+		{"restore2", "key2", []byte("value2")},
+		{"store3", "key3", nil},
+	}
+	for _, tc := range testCases {
+		kv, err := archiveStore.KVStoreAtVersion(tc.store, 2)
+		require.NoError(t, err)
+		require.Equal(t, tc.expect, kv.Get([]byte(tc.key)), tc.store)
+	}
+
+	kv, err := archiveStore.KVStoreAtVersion("store2", 1)
+	require.NoError(t, err)
+	require.Equal(t, []byte("value2"), kv.Get([]byte("key2")))
+}
+
 func TestMultiStore_PruningRestart(t *testing.T) {
 	db := dbm.NewMemDB()
 	ms := newMultiStoreWithMounts(db, types.NewPruningOptions(2, 3, 11))
@@ -594,6 +690,70 @@ func TestSetInitialVersion(t *testing.T) {
 	require.True(t, iavlStore.VersionExists(5))
 }
 
+func TestRollbackToVersion(t *testing.T) {
+	db := dbm.NewMemDB()
+	multi := newMultiStoreWithMounts(db, types.PruneNothing)
+	require.NoError(t, multi.LoadLatestVersion())
+
+	commitIDs := make([]types.CommitID, 0, 5)
+	for i := 0; i < 5; i++ {
+		multi.GetKVStore(testStoreKey1).Set([]byte("key"), []byte{byte(i)})
+		commitIDs = append(commitIDs, multi.Commit())
+	}
+
+	require.Error(t, multi.RollbackToVersion(0))
+	require.Error(t, multi.RollbackToVersion(6))
+
+	require.NoError(t, multi.RollbackToVersion(2))
+	require.Equal(t, int64(2), GetLatestVersion(db))
+	require.Equal(t, commitIDs[1], multi.LastCommitID())
+	require.Equal(t, []byte{1}, multi.GetKVStore(testStoreKey1).Get([]byte("key")))
+	require.False(t, multi.GetCommitKVStore(testStoreKey1).(*iavl.Store).VersionExists(3))
+
+	// the versions after the target can be committed again with other data
+	multi.GetKVStore(testStoreKey1).Set([]byte("key"), []byte{10})
+	commitID := multi.Commit()
+	require.Equal(t, int64(3), commitID.Version)
+	require.NotEqual(t, commitIDs[2].Hash, commitID.Hash)
+
+	// a reloaded store is at the new version
+	multi = newMultiStoreWithMounts(db, types.PruneNothing)
+	require.NoError(t, multi.LoadLatestVersion())
+	require.Equal(t, commitID, multi.LastCommitID())
+}
+
+func TestDeleteVersion(t *testing.T) {
+	db := dbm.NewMemDB()
+	multi := newMultiStoreWithMounts(db, types.PruneNothing)
+	require.NoError(t, multi.LoadLatestVersion())
+
+	for i := 0; i < 3; i++ {
+		multi.GetKVStore(testStoreKey1).Set([]byte("key"), []byte{byte(i)})
+		multi.Commit()
+	}
+	require.Equal(t, int64(3), GetLatestVersion(db))
+
+	// the latest version and missing versions cannot be deleted
+	require.Error(t, multi.DeleteVersion(3))
+	require.Error(t, multi.DeleteVersion(4))
+	require.Error(t, multi.DeleteVersion(10))
+
+	require.NoError(t, multi.DeleteVersion(2))
+	_, err := GetCommitInfo(db, 2)
+	require.Error(t, err)
+	require.False(t, multi.GetCommitKVStore(testStoreKey1).(*iavl.Store).VersionExists(2))
+	require.Error(t, multi.DeleteVersion(2))
+
+	// the other versions are kept
+	cInfo, err := GetCommitInfo(db, 1)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), cInfo.Version)
+	cms, err := multi.CacheMultiStoreWithVersion(1)
+	require.NoError(t, err)
+	require.Equal(t, []byte{0}, cms.GetKVStore(testStoreKey1).Get([]byte("key")))
+	require.Equal(t, []byte{2}, multi.GetKVStore(testStoreKey1).Get([]byte("key")))
+}
+
 func TestAddListenersAndListeningEnabled(t *testing.T) {
 	db := dbm.NewMemDB()
 	multi := newMultiStoreWithMounts(db, types.PruneNothing)
@@ -191,6 +191,11 @@ type CommitMultiStore interface {
 
 	// SetIAVLCacheSize sets the cache size of the IAVL tree.
 	SetIAVLCacheSize(size int)
+
+	// SetArchive sets an archive which records every committed version of the
+	// IAVL stores. Versions removed by pruning are served from the archive.
+	// It must be called before loading a version.
+	SetArchive(archive ArchiveStore)
 }
 
 //---------subsp-------------------------------
@@ -413,6 +418,30 @@ type KVPair kv.Pair
 // every trace operation.
 type TraceContext map[string]interface{}
 
+// ArchiveStore defines an interface which keeps the full history of the
+// writes made to the CommitKVStores, so that any committed version can be read
+// even after it has been pruned from the underlying stores.
+type ArchiveStore interface {
+	// WriteListener receives the writes made during the current version.
+	WriteListener
+
+	// LatestVersion returns the latest version committed to the archive, or 0
+	// if the archive is empty.
+	LatestVersion() int64
+
+	// Commit persists the writes received since the last call of Commit as
+	// the given version.
+	Commit(version int64) error
+
+	// KVStoreAtVersion returns a read-only KVStore presenting the state of the
+	// named store at the given version. An error is returned if the archive
+	// does not cover the version.
+	KVStoreAtVersion(storeName string, version int64) (KVStore, error)
+
+	// Close releases the resources held by the archive.
+	Close() error
+}
+
 // MultiStorePersistentCache defines an interface which provides inter-block
 // (persistent) caching capabilities for multiple CommitKVStores based on StoreKeys.
 type MultiStorePersistentCache interface {
@@ -65,6 +65,7 @@ func NewAppConstructor(encodingCfg params.EncodingConfig) AppConstructor {
 			nil,
 			baseapp.SetPruning(storetypes.NewPruningOptionsFromString(val.AppConfig.Pruning)),
 			baseapp.SetMinGasPrices(val.AppConfig.MinGasPrices),
+			baseapp.SetGasPriceHistory(baseapp.NewGasPriceHistory(val.AppConfig.GasPriceHistoryBlocks)),
 		)
 	}
 }
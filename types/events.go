@@ -101,6 +101,25 @@ func TypedEventToEvent(tev proto.Message) (Event, error) {
 	}, nil
 }
 
+// ParseTypedEvents converts the typed events among the abci.Events back to typed
+// events, in order. The events whose type is not a registered proto message,
+// such as the events emitted with EmitEvent, are skipped.
+func ParseTypedEvents(events []abci.Event) ([]proto.Message, error) {
+	var msgs []proto.Message
+	for _, event := range events {
+		if proto.MessageType(event.Type) == nil {
+			continue
+		}
+
+		msg, err := ParseTypedEvent(event)
+		if err != nil {
+			return nil, err
+		}
+		msgs = append(msgs, msg)
+	}
+	return msgs, nil
+}
+
 // ParseTypedEvent converts abci.Event back to typed event
 func ParseTypedEvent(event abci.Event) (proto.Message, error) {
 	concreteGoType := proto.MessageType(event.Type)
@@ -228,6 +247,7 @@ var (
 	AttributeKeyAccountSequence = "acc_seq"
 	AttributeKeySignature       = "signature"
 	AttributeKeyFee             = "fee"
+	AttributeKeyPriority        = "priority"
 
 	EventTypeMessage = "message"
 
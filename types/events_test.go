@@ -102,6 +102,28 @@ func (s *eventsTestSuite) TestEventManagerTypedEvents() {
 	s.Require().Equal(hasAnimal.Animal.String(), response.Animal.String())
 }
 
+func (s *eventsTestSuite) TestParseTypedEvents() {
+	em := sdk.NewEventManager()
+
+	coin := sdk.NewCoin("fakedenom", sdk.NewInt(1999999))
+	dog := testdata.Dog{Size_: "big", Name: "Spot"}
+	s.Require().NoError(em.EmitTypedEvent(&coin))
+	em.EmitEvent(sdk.NewEvent("message", sdk.NewAttribute("module", "bank")))
+	s.Require().NoError(em.EmitTypedEvent(&dog))
+
+	msgs, err := sdk.ParseTypedEvents(em.Events().ToABCIEvents())
+	s.Require().NoError(err)
+	s.Require().Len(msgs, 2)
+	s.Require().Equal(coin.String(), msgs[0].String())
+	s.Require().Equal(&dog, msgs[1])
+
+	// a typed event which does not parse
+	events := em.Events().ToABCIEvents()
+	events[0].Attributes[0].Value = []byte("{")
+	_, err = sdk.ParseTypedEvents(events)
+	s.Require().Error(err)
+}
+
 func (s *eventsTestSuite) TestStringifyEvents() {
 	e := sdk.Events{
 		sdk.NewEvent("message", sdk.NewAttribute("sender", "foo")),
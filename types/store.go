@@ -22,6 +22,7 @@ type (
 	CacheMultiStore           = types.CacheMultiStore
 	CommitMultiStore          = types.CommitMultiStore
 	MultiStorePersistentCache = types.MultiStorePersistentCache
+	ArchiveStore              = types.ArchiveStore
 	KVStore                   = types.KVStore
 	Iterator                  = types.Iterator
 )
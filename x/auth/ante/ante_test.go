@@ -517,6 +517,76 @@ func (suite *AnteTestSuite) TestAnteHandlerFees() {
 	}
 }
 
+// rejectDecorator counts the txs it gets and rejects them.
+type rejectDecorator struct {
+	calls *int
+}
+
+var errRejected = errors.New("rejected")
+
+func (d rejectDecorator) AnteHandle(sdk.Context, sdk.Tx, bool, sdk.AnteHandler) (sdk.Context, error) {
+	*d.calls++
+	return sdk.Context{}, errRejected
+}
+
+// Test that the extra decorators only get signed txs paying their fees.
+func (suite *AnteTestSuite) TestAnteHandlerExtraDecorators() {
+	suite.SetupTest(false) // setup
+
+	var calls int
+	anteHandler, err := ante.NewAnteHandler(
+		ante.HandlerOptions{
+			AccountKeeper:   suite.app.AccountKeeper,
+			BankKeeper:      suite.app.BankKeeper,
+			SignModeHandler: suite.clientCtx.TxConfig.SignModeHandler(),
+			ExtraDecorators: []sdk.AnteDecorator{rejectDecorator{&calls}},
+		},
+	)
+	suite.Require().NoError(err)
+	suite.anteHandler = anteHandler
+
+	priv0, _, addr0 := testdata.KeyTestPubAddr()
+	acc0 := suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, addr0)
+	suite.app.AccountKeeper.SetAccount(suite.ctx, acc0)
+	msgs := []sdk.Msg{testdata.NewTestMsg(addr0)}
+	feeAmount := testdata.NewTestFeeAmount()
+	gasLimit := testdata.NewTestGasLimit()
+
+	testCases := []struct {
+		desc     string
+		privs    []cryptotypes.PrivKey
+		malleate func()
+		expErr   error
+		expCalls int
+	}{
+		{"unsigned tx", []cryptotypes.PrivKey{}, func() {}, sdkerrors.ErrNoSignatures, 0},
+		{"signer has no funds", []cryptotypes.PrivKey{priv0}, func() {}, sdkerrors.ErrInsufficientFunds, 0},
+		{
+			"signed tx paying the fees",
+			[]cryptotypes.PrivKey{priv0},
+			func() {
+				suite.Require().NoError(simapp.FundAccount(suite.app, suite.ctx, addr0, feeAmount))
+			},
+			errRejected,
+			1,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.desc), func() {
+			suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+			tc.malleate()
+
+			accNums, accSeqs := []uint64{acc0.GetAccountNumber()}, []uint64{0}
+			if len(tc.privs) == 0 {
+				accNums, accSeqs = []uint64{}, []uint64{}
+			}
+			suite.RunTestCase(tc.privs, msgs, feeAmount, gasLimit, accNums, accSeqs, suite.ctx.ChainID(), TestCase{tc.desc, tc.malleate, false, false, tc.expErr})
+			suite.Require().Equal(tc.expCalls, calls)
+		})
+	}
+}
+
 // Test logic around memo gas consumption.
 func (suite *AnteTestSuite) TestAnteHandlerMemoGas() {
 	suite.SetupTest(false) // setup
@@ -18,3 +18,9 @@ type AccountKeeper interface {
 type FeegrantKeeper interface {
 	UseGrantedFees(ctx sdk.Context, granter, grantee sdk.AccAddress, fee sdk.Coins, msgs []sdk.Msg) error
 }
+
+// FeeShareKeeper defines the expected keeper routing a share of the deducted
+// tx fees to third parties, e.g. the developers of the executed contracts.
+type FeeShareKeeper interface {
+	DistributeFeeShare(ctx sdk.Context, tx sdk.Tx, fees sdk.Coins) error
+}
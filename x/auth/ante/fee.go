@@ -2,6 +2,7 @@ package ante
 
 import (
 	"fmt"
+	"math"
 
 	sdk "github.com/line/lbm-sdk/types"
 	sdkerrors "github.com/line/lbm-sdk/types/errors"
@@ -56,19 +57,22 @@ func (mfd MempoolFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate b
 
 // DeductFeeDecorator deducts fees from the first signer of the tx
 // If the first signer does not have the funds to pay for the fees, return with InsufficientFunds error
+// If a fee share keeper is set, it is handed the deducted fees to route its share
 // Call next AnteHandler if fees successfully deducted
 // CONTRACT: Tx must implement FeeTx interface to use DeductFeeDecorator
 type DeductFeeDecorator struct {
 	ak             AccountKeeper
 	bankKeeper     types.BankKeeper
 	feegrantKeeper FeegrantKeeper
+	feeShareKeeper FeeShareKeeper
 }
 
-func NewDeductFeeDecorator(ak AccountKeeper, bk types.BankKeeper, fk FeegrantKeeper) DeductFeeDecorator {
+func NewDeductFeeDecorator(ak AccountKeeper, bk types.BankKeeper, fk FeegrantKeeper, fsk FeeShareKeeper) DeductFeeDecorator {
 	return DeductFeeDecorator{
 		ak:             ak,
 		bankKeeper:     bk,
 		feegrantKeeper: fk,
+		feeShareKeeper: fsk,
 	}
 }
 
@@ -115,6 +119,12 @@ func (dfd DeductFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bo
 		if err != nil {
 			return ctx, err
 		}
+
+		if dfd.feeShareKeeper != nil {
+			if err := dfd.feeShareKeeper.DistributeFeeShare(ctx, tx, feeTx.GetFee()); err != nil {
+				return ctx, err
+			}
+		}
 	}
 
 	events := sdk.Events{sdk.NewEvent(sdk.EventTypeTx,
@@ -138,3 +148,41 @@ func DeductFees(bankKeeper types.BankKeeper, ctx sdk.Context, acc types.AccountI
 
 	return nil
 }
+
+// TxPriorityPrecision is the number of decimal places of the gas price kept in
+// the priority returned by GetTxPriority.
+const TxPriorityPrecision = 6
+
+// GetTxPriority returns the priority of a tx derived from its effective gas
+// price, that is the fee paid per unit of gas, scaled by 10^TxPriorityPrecision
+// so that fractional gas prices are still ordered. If the fee consists of
+// several denominations, the lowest gas price among them is used. It can be set
+// to BaseApp by SetTxPriority.
+func GetTxPriority(_ sdk.Context, tx sdk.Tx) int64 {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return 0
+	}
+
+	gas := feeTx.GetGas()
+	if gas == 0 {
+		return 0
+	}
+
+	multiplier := sdk.NewIntWithDecimal(1, TxPriorityPrecision)
+	var priority int64
+	for i, fee := range feeTx.GetFee() {
+		gasPrice := fee.Amount.ToDec().QuoInt(sdk.NewIntFromUint64(gas))
+		scaled := gasPrice.MulInt(multiplier).TruncateInt()
+
+		p := int64(math.MaxInt64)
+		if scaled.IsInt64() {
+			p = scaled.Int64()
+		}
+		if i == 0 || p < priority {
+			priority = p
+		}
+	}
+
+	return priority
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/line/lbm-sdk/simapp"
 	sdk "github.com/line/lbm-sdk/types"
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
 	"github.com/line/lbm-sdk/x/auth/ante"
 )
 
@@ -87,7 +88,7 @@ func (suite *AnteTestSuite) TestDeductFees() {
 	err = simapp.FundAccount(suite.app, suite.ctx, addr1, coins)
 	suite.Require().NoError(err)
 
-	dfd := ante.NewDeductFeeDecorator(suite.app.AccountKeeper, suite.app.BankKeeper, nil)
+	dfd := ante.NewDeductFeeDecorator(suite.app.AccountKeeper, suite.app.BankKeeper, nil, nil)
 	antehandler := sdk.ChainAnteDecorators(dfd)
 
 	_, err = antehandler(suite.ctx, tx, false)
@@ -103,3 +104,104 @@ func (suite *AnteTestSuite) TestDeductFees() {
 
 	suite.Require().Nil(err, "Tx errored after account has been set with sufficient funds")
 }
+
+type feeShareKeeperMock struct {
+	distributed sdk.Coins
+	err         error
+}
+
+func (m *feeShareKeeperMock) DistributeFeeShare(_ sdk.Context, _ sdk.Tx, fees sdk.Coins) error {
+	m.distributed = m.distributed.Add(fees...)
+	return m.err
+}
+
+func (suite *AnteTestSuite) TestDeductFeesWithFeeShare() {
+	suite.SetupTest(false) // setup
+	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+
+	priv1, _, addr1 := testdata.KeyTestPubAddr()
+
+	msg := testdata.NewTestMsg(addr1)
+	feeAmount := testdata.NewTestFeeAmount()
+	suite.Require().NoError(suite.txBuilder.SetMsgs(msg))
+	suite.txBuilder.SetFeeAmount(feeAmount)
+	suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+
+	privs, accNums, accSeqs := []cryptotypes.PrivKey{priv1}, []uint64{0}, []uint64{0}
+	tx, err := suite.CreateTestTx(privs, accNums, accSeqs, suite.ctx.ChainID())
+	suite.Require().NoError(err)
+
+	acc := suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, addr1)
+	suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+	err = simapp.FundAccount(suite.app, suite.ctx, addr1, sdk.NewCoins(sdk.NewCoin("atom", sdk.NewInt(1000))))
+	suite.Require().NoError(err)
+
+	// the deducted fees are handed to the fee share keeper
+	fsk := &feeShareKeeperMock{}
+	antehandler := sdk.ChainAnteDecorators(ante.NewDeductFeeDecorator(suite.app.AccountKeeper, suite.app.BankKeeper, nil, fsk))
+	_, err = antehandler(suite.ctx, tx, false)
+	suite.Require().NoError(err)
+	suite.Require().Equal(feeAmount, fsk.distributed)
+
+	// a failing fee share fails the tx
+	fsk = &feeShareKeeperMock{err: sdkerrors.ErrInsufficientFunds}
+	antehandler = sdk.ChainAnteDecorators(ante.NewDeductFeeDecorator(suite.app.AccountKeeper, suite.app.BankKeeper, nil, fsk))
+	_, err = antehandler(suite.ctx, tx, false)
+	suite.Require().ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+}
+
+func (suite *AnteTestSuite) TestGetTxPriority() {
+	suite.SetupTest(true) // setup
+
+	priv1, _, addr1 := testdata.KeyTestPubAddr()
+
+	testCases := map[string]struct {
+		fee      sdk.Coins
+		gas      uint64
+		priority int64
+	}{
+		"no fee": {
+			gas:      100,
+			priority: 0,
+		},
+		"zero gas": {
+			fee:      sdk.NewCoins(sdk.NewInt64Coin("atom", 100)),
+			priority: 0,
+		},
+		"single denom": {
+			fee:      sdk.NewCoins(sdk.NewInt64Coin("atom", 1000)),
+			gas:      100,
+			priority: 10_000_000,
+		},
+		"fee lower than gas": {
+			fee:      sdk.NewCoins(sdk.NewInt64Coin("atom", 25)),
+			gas:      200000,
+			priority: 125,
+		},
+		"fee much lower than gas": {
+			fee:      sdk.NewCoins(sdk.NewInt64Coin("atom", 1)),
+			gas:      10000000,
+			priority: 0,
+		},
+		"lowest gas price among denoms": {
+			fee:      sdk.NewCoins(sdk.NewInt64Coin("atom", 1000), sdk.NewInt64Coin("stake", 500)),
+			gas:      100,
+			priority: 5_000_000,
+		},
+	}
+
+	for name, tc := range testCases {
+		suite.Run(name, func() {
+			suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+			suite.Require().NoError(suite.txBuilder.SetMsgs(testdata.NewTestMsg(addr1)))
+			suite.txBuilder.SetFeeAmount(tc.fee)
+			suite.txBuilder.SetGasLimit(tc.gas)
+
+			privs, accNums, accSeqs := []cryptotypes.PrivKey{priv1}, []uint64{0}, []uint64{0}
+			tx, err := suite.CreateTestTx(privs, accNums, accSeqs, suite.ctx.ChainID())
+			suite.Require().NoError(err)
+
+			suite.Require().Equal(tc.priority, ante.GetTxPriority(suite.ctx, tx))
+		})
+	}
+}
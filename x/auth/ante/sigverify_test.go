@@ -17,6 +17,7 @@ import (
 	"github.com/line/lbm-sdk/types/tx/signing"
 	"github.com/line/lbm-sdk/x/auth/ante"
 	"github.com/line/lbm-sdk/x/auth/legacy/legacytx"
+	authtx "github.com/line/lbm-sdk/x/auth/tx"
 	"github.com/line/lbm-sdk/x/auth/types"
 )
 
@@ -297,6 +298,76 @@ func (suite *AnteTestSuite) TestSigVerification_ExplicitAmino() {
 	}
 }
 
+// TestSigVerification_Textual signs with SIGN_MODE_TEXTUAL and verifies with
+// the default sign mode handler of the app.
+func (suite *AnteTestSuite) TestSigVerification_Textual() {
+	suite.SetupTest(true) // setup
+	signModeHandler := suite.clientCtx.TxConfig.SignModeHandler()
+
+	// sign in SIGN_MODE_TEXTUAL by default
+	encodingConfig := simapp.MakeTestEncodingConfig()
+	testdata.RegisterInterfaces(encodingConfig.InterfaceRegistry)
+	txConfig := authtx.NewTxConfig(codec.NewProtoCodec(encodingConfig.InterfaceRegistry), []signing.SignMode{signing.SignMode_SIGN_MODE_TEXTUAL})
+	suite.clientCtx = suite.clientCtx.WithTxConfig(txConfig)
+
+	// make block height non-zero to ensure account numbers part of signBytes
+	suite.ctx = suite.ctx.WithBlockHeight(1)
+
+	// keys and addresses
+	priv1, _, addr1 := testdata.KeyTestPubAddr()
+	priv2, _, addr2 := testdata.KeyTestPubAddr()
+
+	addrs := []sdk.AccAddress{addr1, addr2}
+
+	msgs := make([]sdk.Msg, len(addrs))
+	// set accounts and create msg for each address
+	for i, addr := range addrs {
+		acc := suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, addr)
+		suite.Require().NoError(acc.SetAccountNumber(uint64(i)))
+		suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+		msgs[i] = testdata.NewTestMsg(addr)
+	}
+
+	feeAmount := testdata.NewTestFeeAmount()
+	gasLimit := testdata.NewTestGasLimit()
+
+	spkd := ante.NewSetPubKeyDecorator(suite.app.AccountKeeper)
+	svd := ante.NewSigVerificationDecorator(suite.app.AccountKeeper, signModeHandler)
+	antehandler := sdk.ChainAnteDecorators(spkd, svd)
+
+	testCases := []struct {
+		name      string
+		accNums   []uint64
+		accSeqs   []uint64
+		malleate  func()
+		shouldErr bool
+	}{
+		{"valid tx", []uint64{0, 1}, []uint64{0, 0}, func() {}, false},
+		{"wrong accnums", []uint64{7, 8}, []uint64{0, 0}, func() {}, true},
+		{"wrong sequences", []uint64{0, 1}, []uint64{3, 4}, func() {}, true},
+		{"memo changed after signing", []uint64{0, 1}, []uint64{0, 0}, func() { suite.txBuilder.SetMemo("changed") }, true},
+		{"fee changed after signing", []uint64{0, 1}, []uint64{0, 0}, func() { suite.txBuilder.SetGasLimit(gasLimit + 1) }, true},
+	}
+	for i, tc := range testCases {
+		suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder() // Create new txBuilder for each test
+
+		suite.Require().NoError(suite.txBuilder.SetMsgs(msgs...))
+		suite.txBuilder.SetFeeAmount(feeAmount)
+		suite.txBuilder.SetGasLimit(gasLimit)
+
+		_, err := suite.CreateTestTx([]cryptotypes.PrivKey{priv1, priv2}, tc.accNums, tc.accSeqs, suite.ctx.ChainID())
+		suite.Require().NoError(err)
+		tc.malleate()
+
+		_, err = antehandler(suite.ctx, suite.txBuilder.GetTx(), false)
+		if tc.shouldErr {
+			suite.Require().NotNil(err, "TestCase %d: %s did not error as expected", i, tc.name)
+		} else {
+			suite.Require().Nil(err, "TestCase %d: %s errored unexpectedly. Err: %v", i, tc.name, err)
+		}
+	}
+}
+
 func (suite *AnteTestSuite) TestSigIntegration() {
 	// generate private keys
 	privs := []cryptotypes.PrivKey{
@@ -0,0 +1,336 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	ostcli "github.com/line/ostracon/libs/cli"
+
+	"github.com/line/lbm-sdk/client"
+	"github.com/line/lbm-sdk/client/flags"
+	"github.com/line/lbm-sdk/client/tx"
+	cryptotypes "github.com/line/lbm-sdk/crypto/types"
+	sdk "github.com/line/lbm-sdk/types"
+	signingtypes "github.com/line/lbm-sdk/types/tx/signing"
+	"github.com/line/lbm-sdk/version"
+	authclient "github.com/line/lbm-sdk/x/auth/client"
+)
+
+// GetMultisignSessionCommand returns the command grouping the multisig signing session subcommands
+func GetMultisignSessionCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "multisign-session",
+		Short: "Collect the signatures of the members of a multisig account in a session file",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Coordinate the signing of a transaction generated offline by the members of a
+multisig account. The session file carries the transaction, the multisig key and the
+signatures collected so far, so that it is the only file the co-signers pass around.
+
+Example:
+$ %[1]s tx multisign-session create transaction.json k1k2k3 --output-document session.json
+$ %[1]s tx multisign-session add session.json --from k1
+$ %[1]s tx multisign-session add session.json k2sig.json
+$ %[1]s tx multisign-session status session.json
+$ %[1]s tx multisign-session finalize session.json
+`,
+				version.AppName,
+			),
+		),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		GetMultisignSessionCreateCmd(),
+		GetMultisignSessionAddCmd(),
+		GetMultisignSessionStatusCmd(),
+		GetMultisignSessionFinalizeCmd(),
+	)
+
+	return cmd
+}
+
+// GetMultisignSessionCreateCmd returns the command creating a session file
+func GetMultisignSessionCreateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create [file] [name]",
+		Short: "Create a signing session of a transaction generated offline for a multisig key",
+		Long: `Create a signing session of the transaction read from [file], which must be signed by
+the multisig key [name] only.
+
+The account and sequence numbers the members sign with are queried from a node. If the
+--offline flag is on, they must be set with the --account-number and --sequence flags.
+`,
+		PreRun: preSignCmd,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			parsedTx, err := authclient.ReadTxFromFile(clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+
+			multisigInfo, err := getMultisigInfo(clientCtx, args[1])
+			if err != nil {
+				return err
+			}
+
+			txFactory := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if !clientCtx.Offline {
+				accnum, seq, err := clientCtx.AccountRetriever.GetAccountNumberSequence(clientCtx, multisigInfo.GetAddress())
+				if err != nil {
+					return err
+				}
+
+				txFactory = txFactory.WithAccountNumber(accnum).WithSequence(seq)
+			}
+			if txFactory.ChainID() == "" {
+				return fmt.Errorf("set the chain id with either the --chain-id flag or config file")
+			}
+
+			session, err := authclient.NewMultisigSession(parsedTx, multisigInfo.GetPubKey(), txFactory.ChainID(), txFactory.AccountNumber(), txFactory.Sequence())
+			if err != nil {
+				return err
+			}
+
+			json, err := authclient.MarshalMultisigSession(clientCtx, session)
+			if err != nil {
+				return err
+			}
+
+			outputDoc, _ := cmd.Flags().GetString(flags.FlagOutputDocument)
+			if outputDoc == "" {
+				cmd.Printf("%s\n", json)
+				return nil
+			}
+
+			return ioutil.WriteFile(outputDoc, append(json, '\n'), 0644)
+		},
+		Args: cobra.ExactArgs(2),
+	}
+
+	cmd.Flags().String(flags.FlagOutputDocument, "", "The session is written to the given file instead of STDOUT")
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().String(flags.FlagChainID, "", "network chain ID")
+
+	return cmd
+}
+
+// GetMultisignSessionAddCmd returns the command adding signatures to a session file
+func GetMultisignSessionAddCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add [session-file] [[signature-file]...]",
+		Short: "Add the signatures of multisig members to a signing session",
+		Long: `Verify the signatures read from the [signature-file]s and add them to the session in
+[session-file]. The signature files are the ones generated by the sign command with
+the --multisig flag. Without signature files, the transaction of the session is signed
+with the --from key.
+
+The session file is updated in place. Adding a signature the session already has
+is a no-op.
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			session, err := authclient.ReadMultisigSession(clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+
+			var sigs []signingtypes.SignatureV2
+			if len(args) == 1 {
+				if clientCtx.GetFromName() == "" {
+					return errors.New("either signature files or the --from flag required")
+				}
+
+				txFactory := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+				sig, err := session.Sign(txFactory, clientCtx.TxConfig, clientCtx.GetFromName())
+				if err != nil {
+					return err
+				}
+				sigs = append(sigs, sig)
+			}
+			for _, filename := range args[1:] {
+				fileSigs, err := unmarshalSignatureJSON(clientCtx, filename)
+				if err != nil {
+					return err
+				}
+				sigs = append(sigs, fileSigs...)
+			}
+
+			updated := false
+			for _, sig := range sigs {
+				added, err := session.AddSignature(clientCtx.TxConfig.SignModeHandler(), sig)
+				if err != nil {
+					return err
+				}
+
+				addr := sdk.AccAddress(sig.PubKey.Address())
+				if !added {
+					cmd.Printf("signature of %s already added\n", addr)
+					continue
+				}
+				cmd.Printf("added signature of %s\n", addr)
+				updated = true
+			}
+			cmd.Printf("%d of %d required signatures collected\n", len(session.Signatures), session.PubKey.Threshold)
+
+			if !updated {
+				return nil
+			}
+
+			json, err := authclient.MarshalMultisigSession(clientCtx, session)
+			if err != nil {
+				return err
+			}
+			return ioutil.WriteFile(args[0], append(json, '\n'), 0644)
+		},
+		Args: cobra.MinimumNArgs(1),
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().String(flags.FlagChainID, "", "network chain ID")
+
+	return cmd
+}
+
+// MultisignSessionSigner is a member of the multisig account in the status of a session
+type MultisignSessionSigner struct {
+	Address string `json:"address" yaml:"address"`
+	Name    string `json:"name,omitempty" yaml:"name,omitempty"`
+}
+
+// MultisignSessionStatus is the output of the status command
+type MultisignSessionStatus struct {
+	Address   string                   `json:"address" yaml:"address"`
+	ChainID   string                   `json:"chain_id" yaml:"chain_id"`
+	Sequence  uint64                   `json:"sequence" yaml:"sequence"`
+	Threshold uint32                   `json:"threshold" yaml:"threshold"`
+	Signed    []MultisignSessionSigner `json:"signed" yaml:"signed"`
+	Missing   []MultisignSessionSigner `json:"missing" yaml:"missing"`
+	Complete  bool                     `json:"complete" yaml:"complete"`
+}
+
+// GetMultisignSessionStatusCmd returns the command showing the status of a session file
+func GetMultisignSessionStatusCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "status [session-file]",
+		Short: "Show the signers and the missing signers of a signing session",
+		Long: `Show the members of the multisig account which have signed the transaction of the session,
+the members which have not signed yet and whether the session has collected enough signatures.
+The members are shown with their key names if they are in the keyring.
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			session, err := authclient.ReadMultisigSession(clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintObjectLegacy(MultisignSessionStatus{
+				Address:   session.Address().String(),
+				ChainID:   session.ChainID,
+				Sequence:  session.Sequence,
+				Threshold: session.PubKey.Threshold,
+				Signed:    newMultisignSessionSigners(clientCtx, session.Signed()),
+				Missing:   newMultisignSessionSigners(clientCtx, session.Missing()),
+				Complete:  session.Complete(),
+			})
+		},
+		Args: cobra.ExactArgs(1),
+	}
+
+	cmd.Flags().String(flags.FlagKeyringBackend, flags.DefaultKeyringBackend, "Select keyring's backend (os|file|kwallet|pass|test|memory|remote)")
+	cmd.Flags().StringP(ostcli.OutputFlag, "o", "text", "Output format (text|json)")
+
+	return cmd
+}
+
+func newMultisignSessionSigners(clientCtx client.Context, pubKeys []cryptotypes.PubKey) []MultisignSessionSigner {
+	signers := make([]MultisignSessionSigner, len(pubKeys))
+	for i, pk := range pubKeys {
+		addr := sdk.AccAddress(pk.Address())
+		signers[i].Address = addr.String()
+		if clientCtx.Keyring == nil {
+			continue
+		}
+		if info, err := clientCtx.Keyring.KeyByAddress(addr); err == nil {
+			signers[i].Name = info.GetName()
+		}
+	}
+	return signers
+}
+
+// GetMultisignSessionFinalizeCmd returns the command finalizing a session file
+func GetMultisignSessionFinalizeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "finalize [session-file]",
+		Short: "Combine the signatures of a signing session and broadcast the transaction",
+		Long: `Combine the signatures collected in [session-file] into the multisig signature and
+broadcast the signed transaction to a node.
+
+If the --generate-only flag is on, the signed transaction is printed instead, to be
+broadcast later with the broadcast command.
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			session, err := authclient.ReadMultisigSession(clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+
+			signedTx, err := session.Finalize(clientCtx.TxConfig)
+			if err != nil {
+				return err
+			}
+
+			if clientCtx.GenerateOnly {
+				json, err := clientCtx.TxConfig.TxJSONEncoder()(signedTx)
+				if err != nil {
+					return err
+				}
+				return clientCtx.PrintString(fmt.Sprintf("%s\n", json))
+			}
+			if clientCtx.Offline {
+				return errors.New("cannot broadcast tx during offline mode")
+			}
+
+			txBytes, err := clientCtx.TxConfig.TxEncoder()(signedTx)
+			if err != nil {
+				return err
+			}
+
+			res, err := clientCtx.BroadcastTx(txBytes)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+		Args: cobra.ExactArgs(1),
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().String(flags.FlagChainID, "", "network chain ID")
+
+	return cmd
+}
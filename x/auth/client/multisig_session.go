@@ -0,0 +1,295 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/line/lbm-sdk/client"
+	"github.com/line/lbm-sdk/client/tx"
+	kmultisig "github.com/line/lbm-sdk/crypto/keys/multisig"
+	cryptotypes "github.com/line/lbm-sdk/crypto/types"
+	"github.com/line/lbm-sdk/crypto/types/multisig"
+	sdk "github.com/line/lbm-sdk/types"
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
+	signingtypes "github.com/line/lbm-sdk/types/tx/signing"
+	"github.com/line/lbm-sdk/x/auth/signing"
+)
+
+// MultisigSession collects the signatures of the members of a multisig account on a transaction
+// generated offline. The co-signers pass around a single session file instead of the transaction
+// and a signature file per member, and every signature is verified as it is added.
+type MultisigSession struct {
+	ChainID       string
+	AccountNumber uint64
+	Sequence      uint64
+	PubKey        *kmultisig.LegacyAminoPubKey
+	Tx            signing.Tx
+	// Signatures are the signatures of the members in the order they were added
+	Signatures []signingtypes.SignatureV2
+}
+
+// multisigSessionJSON is the layout of a session file
+type multisigSessionJSON struct {
+	ChainID       string          `json:"chain_id"`
+	AccountNumber uint64          `json:"account_number,string"`
+	Sequence      uint64          `json:"sequence,string"`
+	PubKey        json.RawMessage `json:"pub_key"`
+	Tx            json.RawMessage `json:"tx"`
+	Signatures    json.RawMessage `json:"signatures"`
+}
+
+// NewMultisigSession starts a session collecting the signatures on the unsigned transaction for
+// the multisig account with the given account and sequence numbers.
+func NewMultisigSession(sdkTx sdk.Tx, pubKey cryptotypes.PubKey, chainID string, accNum, sequence uint64) (*MultisigSession, error) {
+	multisigPub, ok := pubKey.(*kmultisig.LegacyAminoPubKey)
+	if !ok {
+		return nil, fmt.Errorf("%T is not a multisig public key", pubKey)
+	}
+	if chainID == "" {
+		return nil, fmt.Errorf("chain id is empty")
+	}
+
+	sigTx, ok := sdkTx.(signing.Tx)
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrTxDecode, "%T is not a signing.Tx", sdkTx)
+	}
+	signers := sigTx.GetSigners()
+	if len(signers) != 1 || !signers[0].Equals(sdk.AccAddress(multisigPub.Address())) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrorInvalidSigner, "transaction must be signed by %s only", sdk.AccAddress(multisigPub.Address()))
+	}
+	sigs, err := sigTx.GetSignaturesV2()
+	if err != nil {
+		return nil, err
+	}
+	if len(sigs) != 0 {
+		return nil, fmt.Errorf("transaction is already signed")
+	}
+
+	return &MultisigSession{
+		ChainID:       chainID,
+		AccountNumber: accNum,
+		Sequence:      sequence,
+		PubKey:        multisigPub,
+		Tx:            sigTx,
+	}, nil
+}
+
+// Address returns the address of the multisig account
+func (s MultisigSession) Address() sdk.AccAddress {
+	return sdk.AccAddress(s.PubKey.Address())
+}
+
+func (s MultisigSession) signerData() signing.SignerData {
+	return signing.SignerData{
+		ChainID:       s.ChainID,
+		AccountNumber: s.AccountNumber,
+		Sequence:      s.Sequence,
+	}
+}
+
+// AddSignature verifies the signature of a member and adds it to the session. It returns false
+// when the session already has the same signature, so that adding a signature is idempotent.
+func (s *MultisigSession) AddSignature(handler signing.SignModeHandler, sig signingtypes.SignatureV2) (bool, error) {
+	addr := sdk.AccAddress(sig.PubKey.Address())
+	if !s.isMember(sig.PubKey) {
+		return false, sdkerrors.Wrapf(sdkerrors.ErrorInvalidSigner, "%s is not a member of multisig %s", addr, s.Address())
+	}
+	if sig.Sequence != s.Sequence {
+		return false, sdkerrors.Wrapf(sdkerrors.ErrWrongSequence, "signature of %s has sequence %d, expected %d", addr, sig.Sequence, s.Sequence)
+	}
+	if single, ok := sig.Data.(*signingtypes.SingleSignatureData); ok && single.SignMode == signingtypes.SignMode_SIGN_MODE_DIRECT {
+		return false, fmt.Errorf("signature of %s: %s is not supported for multisig", addr, single.SignMode)
+	}
+	if err := signing.VerifySignature(sig.PubKey, s.signerData(), sig.Data, handler, s.Tx); err != nil {
+		return false, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "couldn't verify signature of %s: %s", addr, err)
+	}
+
+	for _, prev := range s.Signatures {
+		if !prev.PubKey.Equals(sig.PubKey) {
+			continue
+		}
+		if !proto.Equal(signingtypes.SignatureDataToProto(prev.Data), signingtypes.SignatureDataToProto(sig.Data)) {
+			return false, fmt.Errorf("%s has already signed with a different signature", addr)
+		}
+		return false, nil
+	}
+
+	s.Signatures = append(s.Signatures, sig)
+	return true, nil
+}
+
+func (s MultisigSession) isMember(pubKey cryptotypes.PubKey) bool {
+	for _, pk := range s.PubKey.GetPubKeys() {
+		if pk.Equals(pubKey) {
+			return true
+		}
+	}
+	return false
+}
+
+// Signed returns the members which have signed, in the order of the multisig public key
+func (s MultisigSession) Signed() []cryptotypes.PubKey {
+	return s.members(true)
+}
+
+// Missing returns the members which have not signed yet, in the order of the multisig public key
+func (s MultisigSession) Missing() []cryptotypes.PubKey {
+	return s.members(false)
+}
+
+func (s MultisigSession) members(signed bool) []cryptotypes.PubKey {
+	var res []cryptotypes.PubKey
+	for _, pk := range s.PubKey.GetPubKeys() {
+		found := false
+		for _, sig := range s.Signatures {
+			if sig.PubKey.Equals(pk) {
+				found = true
+				break
+			}
+		}
+		if found == signed {
+			res = append(res, pk)
+		}
+	}
+	return res
+}
+
+// Complete returns true if the session has collected the signatures of the threshold
+func (s MultisigSession) Complete() bool {
+	return len(s.Signatures) >= int(s.PubKey.Threshold)
+}
+
+// Sign signs the transaction of the session with the key of a member. The signature is not
+// added to the session.
+func (s MultisigSession) Sign(txf tx.Factory, txCfg client.TxConfig, name string) (signingtypes.SignatureV2, error) {
+	// Multisigs only support LEGACY_AMINO_JSON signing.
+	if txf.SignMode() == signingtypes.SignMode_SIGN_MODE_UNSPECIFIED {
+		txf = txf.WithSignMode(signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON)
+	}
+	txf = txf.WithChainID(s.ChainID).WithAccountNumber(s.AccountNumber).WithSequence(s.Sequence)
+
+	txBuilder, err := s.txBuilder(txCfg)
+	if err != nil {
+		return signingtypes.SignatureV2{}, err
+	}
+	if err := tx.Sign(txf, name, txBuilder, true); err != nil {
+		return signingtypes.SignatureV2{}, err
+	}
+
+	sigs, err := txBuilder.GetTx().GetSignaturesV2()
+	if err != nil {
+		return signingtypes.SignatureV2{}, err
+	}
+	return sigs[0], nil
+}
+
+// Finalize combines the signatures into the multisig signature and returns the signed transaction
+func (s MultisigSession) Finalize(txCfg client.TxConfig) (signing.Tx, error) {
+	if !s.Complete() {
+		return nil, fmt.Errorf("%d of %d required signatures collected", len(s.Signatures), s.PubKey.Threshold)
+	}
+
+	multisigSig := multisig.NewMultisig(len(s.PubKey.PubKeys))
+	for _, sig := range s.Signatures {
+		if err := multisig.AddSignatureV2(multisigSig, sig, s.PubKey.GetPubKeys()); err != nil {
+			return nil, err
+		}
+	}
+
+	txBuilder, err := s.txBuilder(txCfg)
+	if err != nil {
+		return nil, err
+	}
+	err = txBuilder.SetSignatures(signingtypes.SignatureV2{
+		PubKey:   s.PubKey,
+		Data:     multisigSig,
+		Sequence: s.Sequence,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return txBuilder.GetTx(), nil
+}
+
+// txBuilder returns a builder of a copy of the transaction, so that signing leaves the session intact
+func (s MultisigSession) txBuilder(txCfg client.TxConfig) (client.TxBuilder, error) {
+	bz, err := txCfg.TxEncoder()(s.Tx)
+	if err != nil {
+		return nil, err
+	}
+	sdkTx, err := txCfg.TxDecoder()(bz)
+	if err != nil {
+		return nil, err
+	}
+	return txCfg.WrapTxBuilder(sdkTx)
+}
+
+// MarshalMultisigSession encodes the session as the JSON of a session file
+func MarshalMultisigSession(clientCtx client.Context, s *MultisigSession) ([]byte, error) {
+	pubKey, err := clientCtx.Codec.MarshalInterfaceJSON(s.PubKey)
+	if err != nil {
+		return nil, err
+	}
+	txJSON, err := clientCtx.TxConfig.TxJSONEncoder()(s.Tx)
+	if err != nil {
+		return nil, err
+	}
+	sigs, err := clientCtx.TxConfig.MarshalSignatureJSON(s.Signatures)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.MarshalIndent(multisigSessionJSON{
+		ChainID:       s.ChainID,
+		AccountNumber: s.AccountNumber,
+		Sequence:      s.Sequence,
+		PubKey:        pubKey,
+		Tx:            txJSON,
+		Signatures:    sigs,
+	}, "", "  ")
+}
+
+// UnmarshalMultisigSession decodes a session file. The signatures are verified again, so that
+// a session file edited by hand can not carry invalid signatures.
+func UnmarshalMultisigSession(clientCtx client.Context, bz []byte) (*MultisigSession, error) {
+	var sessionJSON multisigSessionJSON
+	if err := json.Unmarshal(bz, &sessionJSON); err != nil {
+		return nil, err
+	}
+
+	var pubKey cryptotypes.PubKey
+	if err := clientCtx.Codec.UnmarshalInterfaceJSON(sessionJSON.PubKey, &pubKey); err != nil {
+		return nil, err
+	}
+	sdkTx, err := clientCtx.TxConfig.TxJSONDecoder()(sessionJSON.Tx)
+	if err != nil {
+		return nil, err
+	}
+	session, err := NewMultisigSession(sdkTx, pubKey, sessionJSON.ChainID, sessionJSON.AccountNumber, sessionJSON.Sequence)
+	if err != nil {
+		return nil, err
+	}
+
+	sigs, err := clientCtx.TxConfig.UnmarshalSignatureJSON(sessionJSON.Signatures)
+	if err != nil {
+		return nil, err
+	}
+	for _, sig := range sigs {
+		if _, err := session.AddSignature(clientCtx.TxConfig.SignModeHandler(), sig); err != nil {
+			return nil, err
+		}
+	}
+	return session, nil
+}
+
+// ReadMultisigSession reads and decodes a session file
+func ReadMultisigSession(clientCtx client.Context, filename string) (*MultisigSession, error) {
+	bz, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	return UnmarshalMultisigSession(clientCtx, bz)
+}
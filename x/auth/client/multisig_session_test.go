@@ -0,0 +1,130 @@
+package client_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/line/lbm-sdk/client"
+	"github.com/line/lbm-sdk/client/tx"
+	"github.com/line/lbm-sdk/codec"
+	"github.com/line/lbm-sdk/crypto/hd"
+	"github.com/line/lbm-sdk/crypto/keyring"
+	kmultisig "github.com/line/lbm-sdk/crypto/keys/multisig"
+	cryptotypes "github.com/line/lbm-sdk/crypto/types"
+	"github.com/line/lbm-sdk/simapp"
+	sdk "github.com/line/lbm-sdk/types"
+	signingtypes "github.com/line/lbm-sdk/types/tx/signing"
+	authclient "github.com/line/lbm-sdk/x/auth/client"
+	banktypes "github.com/line/lbm-sdk/x/bank/types"
+)
+
+func TestMultisigSession(t *testing.T) {
+	encodingConfig := simapp.MakeTestEncodingConfig()
+	txCfg := encodingConfig.TxConfig
+	kr := keyring.NewInMemory()
+	clientCtx := client.Context{}.
+		WithInterfaceRegistry(encodingConfig.InterfaceRegistry).
+		WithCodec(codec.NewProtoCodec(encodingConfig.InterfaceRegistry)).
+		WithTxConfig(txCfg).
+		WithKeyring(kr)
+	txf := tx.Factory{}.WithTxConfig(txCfg).WithKeybase(kr)
+
+	var members []cryptotypes.PubKey
+	for _, name := range []string{"k1", "k2", "k3", "outsider"} {
+		info, _, err := kr.NewMnemonic(name, keyring.English, sdk.FullFundraiserPath, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+		require.NoError(t, err)
+		members = append(members, info.GetPubKey())
+	}
+	multisigPub := kmultisig.NewLegacyAminoPubKey(2, members[:3])
+	multisigAddr := sdk.AccAddress(multisigPub.Address())
+
+	txBuilder := txCfg.NewTxBuilder()
+	require.NoError(t, txBuilder.SetMsgs(banktypes.NewMsgSend(multisigAddr, multisigAddr, sdk.NewCoins(sdk.NewInt64Coin("stake", 10)))))
+	txBuilder.SetGasLimit(200000)
+
+	// the multisig must be the only signer
+	otherBuilder := txCfg.NewTxBuilder()
+	require.NoError(t, otherBuilder.SetMsgs(banktypes.NewMsgSend(sdk.AccAddress(members[0].Address()), multisigAddr, sdk.NewCoins(sdk.NewInt64Coin("stake", 10)))))
+	_, err := authclient.NewMultisigSession(otherBuilder.GetTx(), multisigPub, "test-chain", 1, 2)
+	require.Error(t, err)
+	_, err = authclient.NewMultisigSession(txBuilder.GetTx(), members[0], "test-chain", 1, 2)
+	require.Error(t, err)
+	_, err = authclient.NewMultisigSession(txBuilder.GetTx(), multisigPub, "", 1, 2)
+	require.Error(t, err)
+
+	session, err := authclient.NewMultisigSession(txBuilder.GetTx(), multisigPub, "test-chain", 1, 2)
+	require.NoError(t, err)
+	require.Equal(t, members[:3], session.Missing())
+	require.False(t, session.Complete())
+	_, err = session.Finalize(txCfg)
+	require.Error(t, err)
+
+	handler := txCfg.SignModeHandler()
+	sig1, err := session.Sign(txf, txCfg, "k1")
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), sig1.Sequence)
+	added, err := session.AddSignature(handler, sig1)
+	require.NoError(t, err)
+	require.True(t, added)
+
+	// signing leaves the transaction of the session unsigned
+	sigs, err := session.Tx.GetSignaturesV2()
+	require.NoError(t, err)
+	require.Empty(t, sigs)
+
+	// adding the same signature is a no-op
+	added, err = session.AddSignature(handler, sig1)
+	require.NoError(t, err)
+	require.False(t, added)
+
+	// invalid signatures
+	outsiderSig, err := session.Sign(txf, txCfg, "outsider")
+	require.NoError(t, err)
+	_, err = session.AddSignature(handler, outsiderSig)
+	require.Error(t, err)
+
+	wrongSeqSig, err := session.Sign(txf.WithSequence(3), txCfg, "k2")
+	require.NoError(t, err)
+	wrongSeqSig.Sequence = 3
+	_, err = session.AddSignature(handler, wrongSeqSig)
+	require.Error(t, err)
+
+	forgedSig := sig1
+	forgedSig.PubKey = members[1]
+	_, err = session.AddSignature(handler, forgedSig)
+	require.Error(t, err)
+
+	directSig, err := session.Sign(txf.WithSignMode(signingtypes.SignMode_SIGN_MODE_DIRECT), txCfg, "k2")
+	require.NoError(t, err)
+	_, err = session.AddSignature(handler, directSig)
+	require.Error(t, err)
+	require.Len(t, session.Signatures, 1)
+
+	// the session survives a round trip through the session file
+	bz, err := authclient.MarshalMultisigSession(clientCtx, session)
+	require.NoError(t, err)
+	session, err = authclient.UnmarshalMultisigSession(clientCtx, bz)
+	require.NoError(t, err)
+	require.Equal(t, "test-chain", session.ChainID)
+	require.Equal(t, uint64(1), session.AccountNumber)
+	require.Equal(t, uint64(2), session.Sequence)
+	require.Equal(t, members[:1], session.Signed())
+	require.Equal(t, members[1:3], session.Missing())
+
+	sig3, err := session.Sign(txf, txCfg, "k3")
+	require.NoError(t, err)
+	added, err = session.AddSignature(handler, sig3)
+	require.NoError(t, err)
+	require.True(t, added)
+	require.True(t, session.Complete())
+	require.Equal(t, members[1:2], session.Missing())
+
+	signedTx, err := session.Finalize(txCfg)
+	require.NoError(t, err)
+	sigs, err = signedTx.GetSignaturesV2()
+	require.NoError(t, err)
+	require.Len(t, sigs, 1)
+	require.True(t, multisigPub.Equals(sigs[0].PubKey))
+	require.Equal(t, uint64(2), sigs[0].Sequence)
+}
@@ -67,6 +67,15 @@ func TxMultiSignExec(clientCtx client.Context, from string, filename string, ext
 	return clitestutil.ExecTestCLICmd(clientCtx, cli.GetMultiSignCommand(), append(args, extraArgs...))
 }
 
+func TxMultisignSessionExec(clientCtx client.Context, subcommand string, extraArgs ...string) (testutil.BufferWriter, error) {
+	args := []string{
+		subcommand,
+		fmt.Sprintf("--%s=%s", flags.FlagKeyringBackend, keyring.BackendTest),
+	}
+
+	return clitestutil.ExecTestCLICmd(clientCtx, cli.GetMultisignSessionCommand(), append(args, extraArgs...))
+}
+
 func TxSignBatchExec(clientCtx client.Context, from fmt.Stringer, filename string, extraArgs ...string) (testutil.BufferWriter, error) {
 	args := []string{
 		fmt.Sprintf("--%s=%s", flags.FlagKeyringBackend, keyring.BackendTest),
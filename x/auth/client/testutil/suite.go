@@ -935,6 +935,87 @@ func (s *IntegrationTestSuite) TestCLIMultisign() {
 	s.Require().NoError(s.network.WaitForNextBlock())
 }
 
+func (s *IntegrationTestSuite) TestCLIMultisignSession() {
+	val1 := s.network.Validators[0]
+
+	account1, err := val1.ClientCtx.Keyring.Key("newAccount1")
+	s.Require().NoError(err)
+
+	account2, err := val1.ClientCtx.Keyring.Key("newAccount2")
+	s.Require().NoError(err)
+
+	multisigInfo, err := val1.ClientCtx.Keyring.Key("multi")
+	s.Require().NoError(err)
+
+	// Send coins from validator to multisig.
+	_, err = s.createBankMsg(
+		val1, multisigInfo.GetAddress(),
+		sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 10)),
+	)
+	s.Require().NoError(err)
+	s.Require().NoError(s.network.WaitForNextBlock())
+
+	// Generate multisig transaction.
+	multiGeneratedTx, err := bankcli.MsgSendExec(
+		val1.ClientCtx,
+		multisigInfo.GetAddress(),
+		val1.Address,
+		sdk.NewCoins(
+			sdk.NewInt64Coin(s.cfg.BondDenom, 5),
+		),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+		fmt.Sprintf("--%s=true", flags.FlagGenerateOnly),
+	)
+	s.Require().NoError(err)
+	multiGeneratedTxFile := testutil.WriteToNewTempFile(s.T(), multiGeneratedTx.String())
+	chainIDFlag := fmt.Sprintf("--%s=%s", flags.FlagChainID, val1.ClientCtx.ChainID)
+
+	// Create the session.
+	sessionFile := filepath.Join(s.T().TempDir(), "session.json")
+	_, err = TxMultisignSessionExec(val1.ClientCtx, "create", multiGeneratedTxFile.Name(), multisigInfo.GetName(), chainIDFlag,
+		fmt.Sprintf("--%s=%s", flags.FlagOutputDocument, sessionFile))
+	s.Require().NoError(err)
+
+	// Finalizing fails before the threshold is reached.
+	_, err = TxMultisignSessionExec(val1.ClientCtx, "finalize", sessionFile, chainIDFlag)
+	s.Require().Error(err)
+
+	// Sign with account1 into the session.
+	out, err := TxMultisignSessionExec(val1.ClientCtx, "add", sessionFile, chainIDFlag,
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, account1.GetName()))
+	s.Require().NoError(err)
+	s.Require().Contains(out.String(), "1 of 2 required signatures collected")
+
+	out, err = TxMultisignSessionExec(val1.ClientCtx, "status", sessionFile, fmt.Sprintf("--%s=json", ostcli.OutputFlag))
+	s.Require().NoError(err)
+	var status authcli.MultisignSessionStatus
+	s.Require().NoError(val1.ClientCtx.LegacyAmino.UnmarshalJSON(out.Bytes(), &status))
+	s.Require().Equal(multisigInfo.GetAddress().String(), status.Address)
+	s.Require().Equal([]authcli.MultisignSessionSigner{{Address: account1.GetAddress().String(), Name: account1.GetName()}}, status.Signed)
+	s.Require().Equal([]authcli.MultisignSessionSigner{{Address: account2.GetAddress().String(), Name: account2.GetName()}}, status.Missing)
+	s.Require().False(status.Complete)
+
+	// Add the signature file of account2, twice.
+	account2Signature, err := TxSignExec(val1.ClientCtx, account2.GetAddress(), multiGeneratedTxFile.Name(), "--multisig", multisigInfo.GetAddress().String())
+	s.Require().NoError(err)
+	sign2File := testutil.WriteToNewTempFile(s.T(), account2Signature.String())
+
+	_, err = TxMultisignSessionExec(val1.ClientCtx, "add", sessionFile, sign2File.Name(), chainIDFlag)
+	s.Require().NoError(err)
+	out, err = TxMultisignSessionExec(val1.ClientCtx, "add", sessionFile, sign2File.Name(), chainIDFlag)
+	s.Require().NoError(err)
+	s.Require().Contains(out.String(), "already added")
+	s.Require().Contains(out.String(), "2 of 2 required signatures collected")
+
+	// Finalize and broadcast.
+	out, err = TxMultisignSessionExec(val1.ClientCtx, "finalize", sessionFile, chainIDFlag,
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock))
+	s.Require().NoError(err)
+	var txRes sdk.TxResponse
+	s.Require().NoError(val1.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &txRes))
+	s.Require().Equal(uint32(0), txRes.Code, txRes.RawLog)
+}
+
 func (s *IntegrationTestSuite) TestSignBatchMultisig() {
 	val := s.network.Validators[0]
 
@@ -0,0 +1,254 @@
+package tx
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/line/lbm-sdk/codec"
+	codectypes "github.com/line/lbm-sdk/codec/types"
+	sdk "github.com/line/lbm-sdk/types"
+	types "github.com/line/lbm-sdk/types/tx"
+	signingtypes "github.com/line/lbm-sdk/types/tx/signing"
+	"github.com/line/lbm-sdk/x/auth/signing"
+)
+
+var _ signing.SignModeHandler = signModeTextualHandler{}
+
+// signModeTextualHandler defines the SIGN_MODE_TEXTUAL SignModeHandler.
+//
+// The sign bytes are the UTF-8 encoded screens of human-readable text describing the transaction,
+// separated by newlines, so that a hardware or mobile wallet can display exactly what gets signed.
+// Each screen is encoded as `[*][>...] Title: Content`, where `*` marks a screen which is shown in
+// the expert mode only and each `>` is one level of indentation. Backslashes, line breaks and
+// non-printable characters are escaped.
+//
+// Messages are rendered field by field from their protobuf JSON representation, which covers
+// the messages of all the modules registered in the interface registry. The last screen is the
+// hash of the raw TxBody and Fee bytes, which binds any data not rendered in the screens.
+type signModeTextualHandler struct {
+	resolver jsonpb.AnyResolver
+}
+
+// DefaultMode implements SignModeHandler.DefaultMode
+func (signModeTextualHandler) DefaultMode() signingtypes.SignMode {
+	return signingtypes.SignMode_SIGN_MODE_TEXTUAL
+}
+
+// Modes implements SignModeHandler.Modes
+func (signModeTextualHandler) Modes() []signingtypes.SignMode {
+	return []signingtypes.SignMode{signingtypes.SignMode_SIGN_MODE_TEXTUAL}
+}
+
+// GetSignBytes implements SignModeHandler.GetSignBytes
+func (h signModeTextualHandler) GetSignBytes(mode signingtypes.SignMode, data signing.SignerData, tx sdk.Tx) ([]byte, error) {
+	if mode != signingtypes.SignMode_SIGN_MODE_TEXTUAL {
+		return nil, fmt.Errorf("expected %s, got %s", signingtypes.SignMode_SIGN_MODE_TEXTUAL, mode)
+	}
+
+	protoTx, ok := tx.(*wrapper)
+	if !ok {
+		return nil, fmt.Errorf("can only handle a protobuf Tx, got %T", tx)
+	}
+
+	screens, err := h.renderTx(data, protoTx)
+	if err != nil {
+		return nil, err
+	}
+
+	return encodeTextualScreens(screens), nil
+}
+
+// textualScreen is a single unit of text displayed to the signer
+type textualScreen struct {
+	Title   string
+	Content string
+	Indent  int
+	Expert  bool
+}
+
+func (h signModeTextualHandler) renderTx(data signing.SignerData, protoTx *wrapper) ([]textualScreen, error) {
+	body := protoTx.tx.Body
+	fee := protoTx.tx.AuthInfo.Fee
+	if fee == nil {
+		fee = &types.Fee{}
+	}
+
+	screens := []textualScreen{
+		{Title: "Chain id", Content: data.ChainID},
+		{Title: "Account number", Content: formatInteger(strconv.FormatUint(data.AccountNumber, 10))},
+		{Title: "Sequence", Content: formatInteger(strconv.FormatUint(data.Sequence, 10))},
+	}
+
+	msgs := body.Messages
+	if len(msgs) == 1 {
+		screens = append(screens, textualScreen{Title: "This transaction has 1 Message"})
+	} else {
+		screens = append(screens, textualScreen{Title: fmt.Sprintf("This transaction has %d Messages", len(msgs))})
+	}
+	msgScreens, err := h.renderAnys("Message", msgs, false)
+	if err != nil {
+		return nil, err
+	}
+	screens = append(screens, msgScreens...)
+
+	if body.Memo != "" {
+		screens = append(screens, textualScreen{Title: "Memo", Content: body.Memo})
+	}
+	if !fee.Amount.Empty() {
+		screens = append(screens, textualScreen{Title: "Fees", Content: formatCoins(fee.Amount)})
+	}
+	if fee.Payer != "" {
+		screens = append(screens, textualScreen{Title: "Fee payer", Content: fee.Payer, Expert: true})
+	}
+	if fee.Granter != "" {
+		screens = append(screens, textualScreen{Title: "Fee granter", Content: fee.Granter, Expert: true})
+	}
+	screens = append(screens, textualScreen{Title: "Gas limit", Content: formatInteger(strconv.FormatUint(fee.GasLimit, 10)), Expert: true})
+	if body.TimeoutHeight != 0 {
+		screens = append(screens, textualScreen{Title: "Timeout height", Content: formatInteger(strconv.FormatUint(body.TimeoutHeight, 10)), Expert: true})
+	}
+
+	for _, options := range []struct {
+		title string
+		anys  []*codectypes.Any
+	}{
+		{"Extension option", body.ExtensionOptions},
+		{"Non critical extension option", body.NonCriticalExtensionOptions},
+	} {
+		optionScreens, err := h.renderAnys(options.title, options.anys, true)
+		if err != nil {
+			return nil, err
+		}
+		screens = append(screens, optionScreens...)
+	}
+
+	feeBz, err := fee.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	hash := sha256.New()
+	hash.Write(protoTx.getBodyBytes())
+	hash.Write(feeBz)
+	screens = append(screens, textualScreen{Title: "Hash of raw bytes", Content: hex.EncodeToString(hash.Sum(nil)), Expert: true})
+
+	return screens, nil
+}
+
+// renderAnys renders the packed messages one after another, enclosed by a header and a footer
+func (h signModeTextualHandler) renderAnys(title string, anys []*codectypes.Any, expert bool) ([]textualScreen, error) {
+	var screens []textualScreen
+	for i, any := range anys {
+		screens = append(screens, textualScreen{
+			Title:   fmt.Sprintf("%s (%d/%d)", title, i+1, len(anys)),
+			Content: strings.TrimPrefix(any.TypeUrl, "/"),
+			Expert:  expert,
+		})
+
+		msg, ok := any.GetCachedValue().(proto.Message)
+		if !ok {
+			return nil, fmt.Errorf("can not render %s without its cached value", any.TypeUrl)
+		}
+		bz, err := codec.ProtoMarshalJSON(msg, h.resolver)
+		if err != nil {
+			return nil, err
+		}
+		fields, err := renderJSON(bz, 1)
+		if err != nil {
+			return nil, err
+		}
+		for _, field := range fields {
+			field.Expert = field.Expert || expert
+			screens = append(screens, field)
+		}
+
+		screens = append(screens, textualScreen{Title: "End of " + title, Expert: expert})
+	}
+	return screens, nil
+}
+
+// encodeTextualScreens encodes the screens into the sign bytes
+func encodeTextualScreens(screens []textualScreen) []byte {
+	var sb strings.Builder
+	for i, screen := range screens {
+		if i != 0 {
+			sb.WriteByte('\n')
+		}
+		prefix := strings.Repeat(">", screen.Indent)
+		if screen.Expert {
+			prefix = "*" + prefix
+		}
+		if prefix != "" {
+			sb.WriteString(prefix)
+			sb.WriteByte(' ')
+		}
+		sb.WriteString(escapeTextual(screen.Title))
+		if screen.Content != "" {
+			sb.WriteString(": ")
+			sb.WriteString(escapeTextual(screen.Content))
+		}
+	}
+	return []byte(sb.String())
+}
+
+// escapeTextual escapes backslashes, line breaks and non-printable characters,
+// so that every screen is a single line of printable text.
+func escapeTextual(s string) string {
+	var sb strings.Builder
+	for i := 0; i < len(s); {
+		r, size := utf8.DecodeRuneInString(s[i:])
+		switch {
+		case r == utf8.RuneError && size == 1:
+			fmt.Fprintf(&sb, `\x%02X`, s[i])
+		case r == '\\':
+			sb.WriteString(`\\`)
+		case r == '\n':
+			sb.WriteString(`\n`)
+		case r == '\r':
+			sb.WriteString(`\r`)
+		case r == '\t':
+			sb.WriteString(`\t`)
+		case !unicode.IsPrint(r):
+			if r > 0xFFFF {
+				fmt.Fprintf(&sb, `\U%08X`, r)
+			} else {
+				fmt.Fprintf(&sb, `\u%04X`, r)
+			}
+		default:
+			sb.WriteRune(r)
+		}
+		i += size
+	}
+	return sb.String()
+}
+
+// formatInteger groups the digits of a decimal integer by thousands, e.g. 1'000'000.
+// Any other string is returned as it is.
+func formatInteger(s string) string {
+	if s == "" || strings.TrimLeft(s, "0123456789") != "" {
+		return s
+	}
+	var sb strings.Builder
+	for i, c := range s {
+		if i != 0 && (len(s)-i)%3 == 0 {
+			sb.WriteByte('\'')
+		}
+		sb.WriteRune(c)
+	}
+	return sb.String()
+}
+
+func formatCoins(coins sdk.Coins) string {
+	res := make([]string, len(coins))
+	for i, coin := range coins {
+		res[i] = formatInteger(coin.Amount.String()) + " " + coin.Denom
+	}
+	return strings.Join(res, ", ")
+}
@@ -0,0 +1,189 @@
+package tx
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// jsonField is a field of a JSON object, kept in the order of the encoding
+type jsonField struct {
+	key   string
+	value json.RawMessage
+}
+
+// renderJSON renders the fields of the protobuf JSON object into screens at the given indentation.
+// Fields with null values, empty strings and empty lists are omitted.
+func renderJSON(bz []byte, indent int) ([]textualScreen, error) {
+	fields, err := decodeJSONObject(bz)
+	if err != nil {
+		return nil, err
+	}
+	var screens []textualScreen
+	for _, field := range fields {
+		// the type of a packed message
+		if field.key == "@type" {
+			var typeURL string
+			if err := json.Unmarshal(field.value, &typeURL); err != nil {
+				return nil, err
+			}
+			screens = append(screens, textualScreen{Title: "Type", Content: strings.TrimPrefix(typeURL, "/"), Indent: indent})
+			continue
+		}
+		fieldScreens, err := renderJSONValue(jsonFieldTitle(field.key), field.value, indent)
+		if err != nil {
+			return nil, err
+		}
+		screens = append(screens, fieldScreens...)
+	}
+	return screens, nil
+}
+
+func renderJSONValue(title string, value json.RawMessage, indent int) ([]textualScreen, error) {
+	value = bytes.TrimSpace(value)
+	if len(value) == 0 {
+		return nil, fmt.Errorf("empty value of %s", title)
+	}
+
+	switch value[0] {
+	case '{':
+		fields, err := decodeJSONObject(value)
+		if err != nil {
+			return nil, err
+		}
+		if coin, ok := renderJSONCoin(fields); ok {
+			return []textualScreen{{Title: title, Content: coin, Indent: indent}}, nil
+		}
+		if len(fields) == 0 {
+			return nil, nil
+		}
+		children, err := renderJSON(value, indent+1)
+		if err != nil {
+			return nil, err
+		}
+		return append([]textualScreen{{Title: title, Indent: indent}}, children...), nil
+	case '[':
+		var elems []json.RawMessage
+		if err := json.Unmarshal(value, &elems); err != nil {
+			return nil, err
+		}
+		if coins, ok := renderJSONCoins(elems); ok {
+			return []textualScreen{{Title: title, Content: coins, Indent: indent}}, nil
+		}
+		var screens []textualScreen
+		for i, elem := range elems {
+			elemScreens, err := renderJSONValue(fmt.Sprintf("%s (%d/%d)", title, i+1, len(elems)), elem, indent)
+			if err != nil {
+				return nil, err
+			}
+			screens = append(screens, elemScreens...)
+		}
+		return screens, nil
+	case '"':
+		var s string
+		if err := json.Unmarshal(value, &s); err != nil {
+			return nil, err
+		}
+		if s == "" {
+			return nil, nil
+		}
+		return []textualScreen{{Title: title, Content: s, Indent: indent}}, nil
+	default:
+		// numbers and booleans
+		if string(value) == "null" {
+			return nil, nil
+		}
+		return []textualScreen{{Title: title, Content: string(value), Indent: indent}}, nil
+	}
+}
+
+// renderJSONCoins renders a non-empty list of coins in a single line, e.g. 10 stake, 5 link
+func renderJSONCoins(elems []json.RawMessage) (string, bool) {
+	if len(elems) == 0 {
+		return "", false
+	}
+	res := make([]string, len(elems))
+	for i, elem := range elems {
+		if !bytes.HasPrefix(bytes.TrimSpace(elem), []byte("{")) {
+			return "", false
+		}
+		fields, err := decodeJSONObject(elem)
+		if err != nil {
+			return "", false
+		}
+		coin, ok := renderJSONCoin(fields)
+		if !ok {
+			return "", false
+		}
+		res[i] = coin
+	}
+	return strings.Join(res, ", "), true
+}
+
+// renderJSONCoin renders a coin of x/bank (denom and amount) or of x/collection (token_id and amount)
+func renderJSONCoin(fields []jsonField) (string, bool) {
+	if len(fields) != 2 {
+		return "", false
+	}
+	values := map[string]string{}
+	for _, field := range fields {
+		var s string
+		if err := json.Unmarshal(field.value, &s); err != nil {
+			return "", false
+		}
+		values[field.key] = s
+	}
+	amount, ok := values["amount"]
+	if !ok {
+		return "", false
+	}
+	for _, key := range []string{"denom", "token_id"} {
+		if id, ok := values[key]; ok {
+			return formatInteger(amount) + " " + id, true
+		}
+	}
+	return "", false
+}
+
+// decodeJSONObject decodes the fields of a JSON object preserving their order
+func decodeJSONObject(bz []byte) ([]jsonField, error) {
+	dec := json.NewDecoder(bytes.NewReader(bz))
+	tok, err := dec.Token()
+	if err != nil {
+		return nil, err
+	}
+	if delim, ok := tok.(json.Delim); !ok || delim != '{' {
+		return nil, fmt.Errorf("expected a JSON object, got %v", tok)
+	}
+
+	var fields []jsonField
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return nil, err
+		}
+		key, ok := tok.(string)
+		if !ok {
+			return nil, fmt.Errorf("expected a JSON object key, got %v", tok)
+		}
+		var value json.RawMessage
+		if err := dec.Decode(&value); err != nil {
+			return nil, err
+		}
+		fields = append(fields, jsonField{key: key, value: value})
+	}
+	return fields, nil
+}
+
+// jsonFieldTitle turns a protobuf field name into a title, e.g. from_address into From address
+func jsonFieldTitle(key string) string {
+	title := strings.ReplaceAll(key, "_", " ")
+	if title == "" {
+		return title
+	}
+	r, size := utf8.DecodeRuneInString(title)
+	return string(unicode.ToUpper(r)) + title[size:]
+}
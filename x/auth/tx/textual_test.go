@@ -0,0 +1,155 @@
+package tx
+
+import (
+	"bytes"
+	"flag"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/line/lbm-sdk/codec"
+	codectypes "github.com/line/lbm-sdk/codec/types"
+	"github.com/line/lbm-sdk/std"
+	sdk "github.com/line/lbm-sdk/types"
+	signingtypes "github.com/line/lbm-sdk/types/tx/signing"
+	"github.com/line/lbm-sdk/x/auth/signing"
+	"github.com/line/lbm-sdk/x/authz"
+	banktypes "github.com/line/lbm-sdk/x/bank/types"
+	"github.com/line/lbm-sdk/x/collection"
+	"github.com/line/lbm-sdk/x/token"
+)
+
+var updateGolden = flag.Bool("update", false, "update the golden files of SIGN_MODE_TEXTUAL")
+
+func TestTextualHandler_GetSignBytes(t *testing.T) {
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	std.RegisterInterfaces(interfaceRegistry)
+	banktypes.RegisterInterfaces(interfaceRegistry)
+	authz.RegisterInterfaces(interfaceRegistry)
+	token.RegisterInterfaces(interfaceRegistry)
+	collection.RegisterInterfaces(interfaceRegistry)
+	txConfig := NewTxConfig(codec.NewProtoCodec(interfaceRegistry), []signingtypes.SignMode{signingtypes.SignMode_SIGN_MODE_TEXTUAL})
+
+	addrFrom := sdk.AccAddress(bytes.Repeat([]byte{1}, 20))
+	addrTo := sdk.AccAddress(bytes.Repeat([]byte{2}, 20))
+	bankSend := banktypes.NewMsgSend(addrFrom, addrTo, sdk.NewCoins(sdk.NewInt64Coin("cony", 1234567), sdk.NewInt64Coin("stake", 10)))
+	authzExec := authz.NewMsgExec(addrTo, []sdk.Msg{bankSend})
+	option, err := codectypes.NewAnyWithValue(bankSend)
+	require.NoError(t, err)
+
+	specs := map[string]struct {
+		msgs          []sdk.Msg
+		memo          string
+		fee           sdk.Coins
+		feePayer      sdk.AccAddress
+		feeGranter    sdk.AccAddress
+		timeoutHeight uint64
+		option        *codectypes.Any
+	}{
+		"bank_send": {
+			msgs:          []sdk.Msg{bankSend},
+			memo:          "for the coffee",
+			fee:           sdk.NewCoins(sdk.NewInt64Coin("stake", 2000)),
+			timeoutHeight: 1000,
+		},
+		"token_and_collection": {
+			msgs: []sdk.Msg{
+				&token.MsgSend{
+					ContractId: "9be17165",
+					From:       addrFrom.String(),
+					To:         addrTo.String(),
+					Amount:     sdk.NewInt(1000000),
+				},
+				&collection.MsgTransferFT{
+					ContractId: "deadbeef",
+					From:       addrFrom.String(),
+					To:         addrTo.String(),
+					Amount:     collection.NewCoins(collection.NewFTCoin("00000001", sdk.NewInt(5))),
+				},
+				&collection.MsgTransferNFT{
+					ContractId: "deadbeef",
+					From:       addrFrom.String(),
+					To:         addrTo.String(),
+					TokenIds:   []string{"1000000100000001", "1000000100000002"},
+				},
+			},
+			fee: sdk.NewCoins(sdk.NewInt64Coin("stake", 2000)),
+		},
+		"authz_exec": {
+			msgs:       []sdk.Msg{&authzExec},
+			fee:        sdk.NewCoins(sdk.NewInt64Coin("stake", 2000)),
+			feePayer:   addrTo,
+			feeGranter: addrFrom,
+			option:     option,
+		},
+		"escaped_memo": {
+			msgs: []sdk.Msg{bankSend},
+			memo: "line one\nline two \\ ✓ \x00\xff",
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			bldr := txConfig.NewTxBuilder().(*wrapper)
+			require.NoError(t, bldr.SetMsgs(spec.msgs...))
+			bldr.SetMemo(spec.memo)
+			bldr.SetFeeAmount(spec.fee)
+			bldr.SetGasLimit(200000)
+			bldr.SetFeePayer(spec.feePayer)
+			bldr.SetFeeGranter(spec.feeGranter)
+			bldr.SetTimeoutHeight(spec.timeoutHeight)
+			if spec.option != nil {
+				bldr.SetNonCriticalExtensionOptions(spec.option)
+			}
+
+			signerData := signing.SignerData{ChainID: "lbm-testnet", AccountNumber: 12, Sequence: 3456}
+			signBz, err := txConfig.SignModeHandler().GetSignBytes(signingtypes.SignMode_SIGN_MODE_TEXTUAL, signerData, bldr.GetTx())
+			require.NoError(t, err)
+
+			golden := filepath.Join("testdata", "textual", name+".golden")
+			if *updateGolden {
+				require.NoError(t, ioutil.WriteFile(golden, signBz, 0o600))
+			}
+			exp, err := ioutil.ReadFile(golden)
+			require.NoError(t, err)
+			require.Equal(t, string(exp), string(signBz))
+		})
+	}
+
+	// expect error with wrong sign mode
+	_, err = signModeTextualHandler{}.GetSignBytes(signingtypes.SignMode_SIGN_MODE_DIRECT, signing.SignerData{}, newBuilder().GetTx())
+	require.Error(t, err)
+}
+
+func TestTextualHandler_BindsRawBytes(t *testing.T) {
+	txConfig := NewTxConfig(codec.NewProtoCodec(codectypes.NewInterfaceRegistry()), []signingtypes.SignMode{signingtypes.SignMode_SIGN_MODE_TEXTUAL})
+	handler := txConfig.SignModeHandler()
+	signerData := signing.SignerData{ChainID: "lbm-testnet"}
+
+	bldr := newBuilder()
+	bldr.SetGasLimit(200000)
+	signBz, err := handler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_TEXTUAL, signerData, bldr.GetTx())
+	require.NoError(t, err)
+
+	// unknown non-critical fields are not rendered, but change the sign bytes
+	bldr.bodyBz = append(bldr.getBodyBytes(), 0xfa, 0x3f, 0x01, 0x01)
+	changedBz, err := handler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_TEXTUAL, signerData, bldr.GetTx())
+	require.NoError(t, err)
+	require.NotEqual(t, signBz, changedBz)
+}
+
+func TestFormatInteger(t *testing.T) {
+	specs := map[string]string{
+		"":         "",
+		"0":        "0",
+		"999":      "999",
+		"1000":     "1'000",
+		"12345678": "12'345'678",
+		"1.5":      "1.5",
+		"-1000":    "-1000",
+	}
+	for src, exp := range specs {
+		require.Equal(t, exp, formatInteger(src), src)
+	}
+}
@@ -19,4 +19,10 @@ type (
 		NewID(ctx sdk.Context) string
 		HasID(ctx sdk.Context, id string) bool
 	}
+
+	// ReceiverHooks defines the hooks called on the recipient of a transfer.
+	ReceiverHooks interface {
+		// OnCollectionReceived is called after the coins are transferred. Returning an error reverts the transfer.
+		OnCollectionReceived(ctx sdk.Context, contractID string, operator, from, to sdk.AccAddress, amount []Coin) error
+	}
 )
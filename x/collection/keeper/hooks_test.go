@@ -0,0 +1,76 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"time"
+
+	sdk "github.com/line/lbm-sdk/types"
+	"github.com/line/lbm-sdk/x/collection"
+)
+
+// instantiateReceiver instantiates the hackatom contract, whose sudo entry point rejects any receiver hook message
+func (s *KeeperTestSuite) instantiateReceiver(ctx sdk.Context) sdk.AccAddress {
+	wasmCode, err := ioutil.ReadFile("../../wasm/keeper/testdata/hackatom.wasm")
+	s.Require().NoError(err)
+	codeID, err := s.wasmKeeper.Create(ctx, s.vendor, wasmCode, nil)
+	s.Require().NoError(err)
+	initMsg, err := json.Marshal(map[string]string{
+		"verifier":    s.vendor.String(),
+		"beneficiary": s.vendor.String(),
+	})
+	s.Require().NoError(err)
+	contract, _, err := s.wasmKeeper.Instantiate(ctx, codeID, s.vendor, s.vendor, initMsg, "receiver", nil)
+	s.Require().NoError(err)
+	return contract
+}
+
+func (s *KeeperTestSuite) TestReceiverHooks() {
+	testCases := map[string]struct {
+		optIn bool
+		valid bool
+	}{
+		"contract not opted in": {
+			valid: true,
+		},
+		"contract rejects": {
+			optIn: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			// contracts require a block time
+			ctx, _ := s.ctx.WithBlockTime(time.Now()).CacheContext()
+			contract := s.instantiateReceiver(ctx)
+			if tc.optIn {
+				err := s.wasmKeeper.UpdateReceiverHook(ctx, contract, s.vendor, true)
+				s.Require().NoError(err)
+			}
+
+			_, err := s.msgServer.TransferFT(sdk.WrapSDKContext(ctx), &collection.MsgTransferFT{
+				ContractId: s.contractID,
+				From:       s.customer.String(),
+				To:         contract.String(),
+				Amount:     collection.NewCoins(collection.NewFTCoin(s.ftClassID, sdk.OneInt())),
+			})
+			if tc.valid {
+				s.Require().NoError(err)
+			} else {
+				s.Require().Error(err)
+			}
+
+			_, err = s.msgServer.TransferNFT(sdk.WrapSDKContext(ctx), &collection.MsgTransferNFT{
+				ContractId: s.contractID,
+				From:       s.customer.String(),
+				To:         contract.String(),
+				TokenIds:   []string{collection.NewNFTID(s.nftClassID, 1)},
+			})
+			if tc.valid {
+				s.Require().NoError(err)
+			} else {
+				s.Require().Error(err)
+			}
+		})
+	}
+}
@@ -11,6 +11,7 @@ import (
 type Keeper struct {
 	accountKeeper collection.AccountKeeper
 	classKeeper   collection.ClassKeeper
+	hooks         collection.ReceiverHooks
 
 	// The (unexposed) keys used to access the stores from the Context.
 	storeKey sdk.StoreKey
@@ -34,6 +35,17 @@ func NewKeeper(
 	}
 }
 
+// SetHooks sets the hooks called on the recipient of a transfer
+func (k *Keeper) SetHooks(hooks collection.ReceiverHooks) *Keeper {
+	if k.hooks != nil {
+		panic("cannot set collection hooks twice")
+	}
+
+	k.hooks = hooks
+
+	return k
+}
+
 func (k Keeper) createAccountOnAbsence(ctx sdk.Context, address sdk.AccAddress) {
 	if !k.accountKeeper.HasAccount(ctx, address) {
 		defer telemetry.IncrCounter(1, "new", "account")
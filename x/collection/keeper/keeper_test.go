@@ -13,6 +13,8 @@ import (
 	sdk "github.com/line/lbm-sdk/types"
 	"github.com/line/lbm-sdk/x/collection"
 	"github.com/line/lbm-sdk/x/collection/keeper"
+	wasmkeeper "github.com/line/lbm-sdk/x/wasm/keeper"
+	wasmtypes "github.com/line/lbm-sdk/x/wasm/types"
 )
 
 type KeeperTestSuite struct {
@@ -23,6 +25,9 @@ type KeeperTestSuite struct {
 	queryServer collection.QueryServer
 	msgServer   collection.MsgServer
 
+	// wasmKeeper deploys the contracts receiving transfers
+	wasmKeeper wasmtypes.ContractOpsKeeper
+
 	vendor   sdk.AccAddress
 	operator sdk.AccAddress
 	customer sdk.AccAddress
@@ -61,6 +66,7 @@ func (s *KeeperTestSuite) SetupTest() {
 	s.ctx = app.BaseApp.NewContext(checkTx, ocproto.Header{})
 	s.goCtx = sdk.WrapSDKContext(s.ctx)
 	s.keeper = app.CollectionKeeper
+	s.wasmKeeper = wasmkeeper.NewDefaultPermissionKeeper(app.WasmKeeper)
 
 	s.queryServer = keeper.NewQueryServer(s.keeper)
 	s.msgServer = keeper.NewMsgServer(s.keeper)
@@ -53,6 +53,10 @@ func (s msgServer) TransferFT(c context.Context, req *collection.MsgTransferFT)
 		panic(err)
 	}
 
+	if err := s.keeper.callReceiverHooks(ctx, req.ContractId, fromAddr, fromAddr, toAddr, req.Amount); err != nil {
+		return nil, err
+	}
+
 	return &collection.MsgTransferFTResponse{}, nil
 }
 
@@ -94,6 +98,10 @@ func (s msgServer) TransferFTFrom(c context.Context, req *collection.MsgTransfer
 		panic(err)
 	}
 
+	if err := s.keeper.callReceiverHooks(ctx, req.ContractId, proxyAddr, fromAddr, toAddr, req.Amount); err != nil {
+		return nil, err
+	}
+
 	return &collection.MsgTransferFTFromResponse{}, nil
 }
 
@@ -133,6 +141,10 @@ func (s msgServer) TransferNFT(c context.Context, req *collection.MsgTransferNFT
 		panic(err)
 	}
 
+	if err := s.keeper.callReceiverHooks(ctx, req.ContractId, fromAddr, fromAddr, toAddr, amount); err != nil {
+		return nil, err
+	}
+
 	return &collection.MsgTransferNFTResponse{}, nil
 }
 
@@ -180,6 +192,10 @@ func (s msgServer) TransferNFTFrom(c context.Context, req *collection.MsgTransfe
 		panic(err)
 	}
 
+	if err := s.keeper.callReceiverHooks(ctx, req.ContractId, proxyAddr, fromAddr, toAddr, amount); err != nil {
+		return nil, err
+	}
+
 	return &collection.MsgTransferNFTFromResponse{}, nil
 }
 
@@ -36,6 +36,15 @@ func (k Keeper) SendCoins(ctx sdk.Context, contractID string, from, to sdk.AccAd
 	return nil
 }
 
+// callReceiverHooks notifies the recipient of a transfer, if any hooks are set.
+// An error returned by the hooks must revert the transfer.
+func (k Keeper) callReceiverHooks(ctx sdk.Context, contractID string, operator, from, to sdk.AccAddress, amount []collection.Coin) error {
+	if k.hooks == nil {
+		return nil
+	}
+	return k.hooks.OnCollectionReceived(ctx, contractID, operator, from, to, amount)
+}
+
 func (k Keeper) addCoins(ctx sdk.Context, contractID string, address sdk.AccAddress, amount []collection.Coin) error {
 	for _, coin := range amount {
 		balance := k.GetBalance(ctx, contractID, address, coin.TokenId)
@@ -0,0 +1,149 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+
+	"github.com/line/lbm-sdk/client"
+	"github.com/line/lbm-sdk/client/flags"
+	"github.com/line/lbm-sdk/x/feeshare"
+)
+
+// NewQueryCmd returns the parent command for all x/feeshare CLi query commands.
+func NewQueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   feeshare.ModuleName,
+		Short: "Querying commands for the feeshare module",
+	}
+
+	cmd.AddCommand(
+		NewQueryCmdParams(),
+		NewQueryCmdFeeShare(),
+		NewQueryCmdFeeShares(),
+		NewQueryCmdAccruedFees(),
+	)
+
+	return cmd
+}
+
+// NewQueryCmdParams returns the query feeshare parameters command.
+func NewQueryCmdParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "Query feeshare params",
+		Long:  "Gets the current parameters of feeshare",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := feeshare.NewQueryClient(clientCtx)
+
+			req := feeshare.QueryParamsRequest{}
+			res, err := queryClient.Params(context.Background(), &req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewQueryCmdFeeShare returns the fee share registration of a contract.
+func NewQueryCmdFeeShare() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fee-share [contract-address]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the fee share registration of a contract",
+		Long: `Query the fee share registration of a contract
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := feeshare.NewQueryClient(clientCtx)
+
+			req := feeshare.QueryFeeShareRequest{ContractAddress: args[0]}
+			res, err := queryClient.FeeShare(context.Background(), &req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewQueryCmdFeeShares returns all the fee share registrations.
+func NewQueryCmdFeeShares() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fee-shares",
+		Args:  cobra.NoArgs,
+		Short: "Query all the fee share registrations",
+		Long: `Query all the fee share registrations
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := feeshare.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := feeshare.QueryFeeSharesRequest{Pagination: pageReq}
+			res, err := queryClient.FeeShares(context.Background(), &req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "fee shares")
+	return cmd
+}
+
+// NewQueryCmdAccruedFees returns the fees routed to the withdraw address of a contract so far.
+func NewQueryCmdAccruedFees() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "accrued-fees [contract-address]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the fees routed to the withdraw address of a contract so far",
+		Long: `Query the fees routed to the withdraw address of a contract so far
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := feeshare.NewQueryClient(clientCtx)
+
+			req := feeshare.QueryAccruedFeesRequest{ContractAddress: args[0]}
+			res, err := queryClient.AccruedFees(context.Background(), &req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -0,0 +1,99 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/line/lbm-sdk/client"
+	"github.com/line/lbm-sdk/client/flags"
+	"github.com/line/lbm-sdk/client/tx"
+	"github.com/line/lbm-sdk/x/feeshare"
+)
+
+// NewTxCmd returns the transaction commands for this module
+func NewTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        feeshare.ModuleName,
+		Short:                      fmt.Sprintf("%s transactions subcommands", feeshare.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		NewTxCmdRegisterFeeShare(),
+		NewTxCmdCancelFeeShare(),
+	)
+
+	return txCmd
+}
+
+func NewTxCmdRegisterFeeShare() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register [sender] [contract-address] [withdrawer-address]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Register the withdraw address of a contract for the fee share",
+		Long: `Register the withdraw address of a contract for the fee share.
+The sender must be the admin of the contract. An existing registration is overwritten.
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			sender := args[0]
+			if err := cmd.Flags().Set(flags.FlagFrom, sender); err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := feeshare.MsgRegisterFeeShare{
+				Sender:            sender,
+				ContractAddress:   args[1],
+				WithdrawerAddress: args[2],
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewTxCmdCancelFeeShare() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel [sender] [contract-address]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Cancel the fee share registration of a contract",
+		Long: `Cancel the fee share registration of a contract.
+The sender must be the admin of the contract.
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			sender := args[0]
+			if err := cmd.Flags().Set(flags.FlagFrom, sender); err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := feeshare.MsgCancelFeeShare{
+				Sender:          sender,
+				ContractAddress: args[1],
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
@@ -0,0 +1,20 @@
+package feeshare
+
+import (
+	sdk "github.com/line/lbm-sdk/types"
+	wasmtypes "github.com/line/lbm-sdk/x/wasm/types"
+)
+
+type (
+	// BankKeeper defines the bank module interface contract needed by the
+	// feeshare module.
+	BankKeeper interface {
+		SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+	}
+
+	// WasmKeeper defines the wasm module interface contract needed by the
+	// feeshare module.
+	WasmKeeper interface {
+		GetContractInfo(ctx sdk.Context, contractAddress sdk.AccAddress) *wasmtypes.ContractInfo
+	}
+)
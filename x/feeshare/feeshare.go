@@ -0,0 +1,27 @@
+package feeshare
+
+import (
+	sdk "github.com/line/lbm-sdk/types"
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
+)
+
+// NewFeeShare returns a registration of the contract for the fee share.
+func NewFeeShare(contract, withdrawer sdk.AccAddress) FeeShare {
+	return FeeShare{
+		ContractAddress:   contract.String(),
+		WithdrawerAddress: withdrawer.String(),
+	}
+}
+
+// ValidateBasic performs basic validation on the registration.
+func (f FeeShare) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(f.ContractAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid contract address: %s", f.ContractAddress)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(f.WithdrawerAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid withdrawer address: %s", f.WithdrawerAddress)
+	}
+
+	return nil
+}
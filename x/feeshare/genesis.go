@@ -0,0 +1,48 @@
+package feeshare
+
+import (
+	sdk "github.com/line/lbm-sdk/types"
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
+)
+
+// DefaultGenesisState creates a default GenesisState object
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		Params: DefaultParams(),
+	}
+}
+
+// ValidateGenesis validates the provided genesis state to ensure the
+// expected invariants holds.
+func ValidateGenesis(data GenesisState) error {
+	if err := data.Params.ValidateBasic(); err != nil {
+		return err
+	}
+
+	registered := map[string]bool{}
+	for _, fs := range data.FeeShares {
+		if err := fs.ValidateBasic(); err != nil {
+			return err
+		}
+		if registered[fs.ContractAddress] {
+			return sdkerrors.ErrInvalidRequest.Wrapf("duplicate fee share of contract: %s", fs.ContractAddress)
+		}
+		registered[fs.ContractAddress] = true
+	}
+
+	accrued := map[string]bool{}
+	for _, fees := range data.AccruedFees {
+		if _, err := sdk.AccAddressFromBech32(fees.ContractAddress); err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("invalid contract address: %s", fees.ContractAddress)
+		}
+		if !fees.Amount.IsValid() {
+			return sdkerrors.ErrInvalidCoins.Wrap(fees.Amount.String())
+		}
+		if accrued[fees.ContractAddress] {
+			return sdkerrors.ErrInvalidRequest.Wrapf("duplicate accrued fees of contract: %s", fees.ContractAddress)
+		}
+		accrued[fees.ContractAddress] = true
+	}
+
+	return nil
+}
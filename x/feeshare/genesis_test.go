@@ -0,0 +1,100 @@
+package feeshare_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/line/lbm-sdk/crypto/keys/secp256k1"
+	sdk "github.com/line/lbm-sdk/types"
+	"github.com/line/lbm-sdk/x/feeshare"
+)
+
+func TestValidateGenesis(t *testing.T) {
+	createAddress := func() sdk.AccAddress {
+		return sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	}
+	contract, withdrawer := createAddress(), createAddress()
+
+	testCases := map[string]struct {
+		data  feeshare.GenesisState
+		valid bool
+	}{
+		"default genesis": {
+			data:  *feeshare.DefaultGenesisState(),
+			valid: true,
+		},
+		"all fields": {
+			data: feeshare.GenesisState{
+				Params: feeshare.Params{
+					EnableFeeShare:  true,
+					DeveloperShares: sdk.OneDec(),
+				},
+				FeeShares: []feeshare.FeeShare{feeshare.NewFeeShare(contract, withdrawer)},
+				AccruedFees: []feeshare.AccruedFees{{
+					ContractAddress: contract.String(),
+					Amount:          sdk.NewCoins(sdk.NewInt64Coin("stake", 10)),
+				}},
+			},
+			valid: true,
+		},
+		"nil developer shares": {
+			data: feeshare.GenesisState{},
+		},
+		"negative developer shares": {
+			data: feeshare.GenesisState{
+				Params: feeshare.Params{DeveloperShares: sdk.NewDec(-1)},
+			},
+		},
+		"developer shares exceeding one": {
+			data: feeshare.GenesisState{
+				Params: feeshare.Params{DeveloperShares: sdk.NewDecWithPrec(101, 2)},
+			},
+		},
+		"invalid withdrawer": {
+			data: feeshare.GenesisState{
+				Params: feeshare.DefaultParams(),
+				FeeShares: []feeshare.FeeShare{{
+					ContractAddress:   contract.String(),
+					WithdrawerAddress: "invalid",
+				}},
+			},
+		},
+		"duplicate fee shares": {
+			data: feeshare.GenesisState{
+				Params: feeshare.DefaultParams(),
+				FeeShares: []feeshare.FeeShare{
+					feeshare.NewFeeShare(contract, withdrawer),
+					feeshare.NewFeeShare(contract, createAddress()),
+				},
+			},
+		},
+		"invalid accrued fees": {
+			data: feeshare.GenesisState{
+				Params: feeshare.DefaultParams(),
+				AccruedFees: []feeshare.AccruedFees{{
+					ContractAddress: contract.String(),
+					Amount:          sdk.Coins{sdk.Coin{Denom: "stake", Amount: sdk.NewInt(-1)}},
+				}},
+			},
+		},
+		"duplicate accrued fees": {
+			data: feeshare.GenesisState{
+				Params: feeshare.DefaultParams(),
+				AccruedFees: []feeshare.AccruedFees{
+					{ContractAddress: contract.String()},
+					{ContractAddress: contract.String()},
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		err := feeshare.ValidateGenesis(tc.data)
+		if tc.valid {
+			require.NoError(t, err, name)
+		} else {
+			require.Error(t, err, name)
+		}
+	}
+}
@@ -0,0 +1,188 @@
+package keeper
+
+import (
+	sdk "github.com/line/lbm-sdk/types"
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
+	"github.com/line/lbm-sdk/x/authz"
+	"github.com/line/lbm-sdk/x/feeshare"
+	wasmtypes "github.com/line/lbm-sdk/x/wasm/types"
+)
+
+// RegisterFeeShare registers the withdraw address of the contract. Only the
+// admin of the contract is allowed to register it.
+func (k Keeper) RegisterFeeShare(ctx sdk.Context, sender, contract, withdrawer sdk.AccAddress) error {
+	if err := k.validateContractAdmin(ctx, sender, contract); err != nil {
+		return err
+	}
+
+	k.setFeeShare(ctx, feeshare.NewFeeShare(contract, withdrawer))
+	return nil
+}
+
+// CancelFeeShare removes the registration of the contract. Only the admin of
+// the contract is allowed to cancel it.
+func (k Keeper) CancelFeeShare(ctx sdk.Context, sender, contract sdk.AccAddress) error {
+	if _, err := k.GetFeeShare(ctx, contract); err != nil {
+		return err
+	}
+	if err := k.validateContractAdmin(ctx, sender, contract); err != nil {
+		return err
+	}
+
+	ctx.KVStore(k.storeKey).Delete(feeShareKey(contract))
+	return nil
+}
+
+func (k Keeper) validateContractAdmin(ctx sdk.Context, sender, contract sdk.AccAddress) error {
+	info := k.wasmKeeper.GetContractInfo(ctx, contract)
+	if info == nil {
+		return sdkerrors.ErrNotFound.Wrapf("contract: %s", contract)
+	}
+	if info.Admin != sender.String() {
+		return sdkerrors.ErrUnauthorized.Wrapf("%s is not the admin of contract %s", sender, contract)
+	}
+	return nil
+}
+
+func (k Keeper) GetFeeShare(ctx sdk.Context, contract sdk.AccAddress) (*feeshare.FeeShare, error) {
+	bz := ctx.KVStore(k.storeKey).Get(feeShareKey(contract))
+	if bz == nil {
+		return nil, sdkerrors.ErrNotFound.Wrapf("fee share of contract: %s", contract)
+	}
+
+	var fs feeshare.FeeShare
+	k.cdc.MustUnmarshal(bz, &fs)
+	return &fs, nil
+}
+
+func (k Keeper) setFeeShare(ctx sdk.Context, fs feeshare.FeeShare) {
+	contract := sdk.MustAccAddressFromBech32(fs.ContractAddress)
+	ctx.KVStore(k.storeKey).Set(feeShareKey(contract), k.cdc.MustMarshal(&fs))
+}
+
+func (k Keeper) iterateFeeShares(ctx sdk.Context, fn func(fs feeshare.FeeShare) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), feeShareKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var fs feeshare.FeeShare
+		k.cdc.MustUnmarshal(iterator.Value(), &fs)
+		if fn(fs) {
+			break
+		}
+	}
+}
+
+// GetAccruedFees returns the fees routed to the withdraw address of the contract so far.
+func (k Keeper) GetAccruedFees(ctx sdk.Context, contract sdk.AccAddress) sdk.Coins {
+	bz := ctx.KVStore(k.storeKey).Get(accruedFeesKey(contract))
+	if bz == nil {
+		return sdk.NewCoins()
+	}
+
+	var fees feeshare.AccruedFees
+	k.cdc.MustUnmarshal(bz, &fees)
+	return fees.Amount
+}
+
+func (k Keeper) setAccruedFees(ctx sdk.Context, fees feeshare.AccruedFees) {
+	contract := sdk.MustAccAddressFromBech32(fees.ContractAddress)
+	ctx.KVStore(k.storeKey).Set(accruedFeesKey(contract), k.cdc.MustMarshal(&fees))
+}
+
+func (k Keeper) iterateAccruedFees(ctx sdk.Context, fn func(fees feeshare.AccruedFees) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), accruedFeesKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var fees feeshare.AccruedFees
+		k.cdc.MustUnmarshal(iterator.Value(), &fees)
+		if fn(fees) {
+			break
+		}
+	}
+}
+
+// DistributeFeeShare routes the developer shares of the fees, which have been
+// deducted to the fee collector, to the withdraw addresses of the registered
+// contracts executed by the tx. The shares are split evenly between the
+// contracts.
+func (k Keeper) DistributeFeeShare(ctx sdk.Context, tx sdk.Tx, fees sdk.Coins) error {
+	params := k.GetParams(ctx)
+	if !params.EnableFeeShare || params.DeveloperShares.IsZero() || fees.IsZero() {
+		return nil
+	}
+
+	var registered []feeshare.FeeShare
+	for _, contract := range executedContracts(tx.GetMsgs()) {
+		if fs, err := k.GetFeeShare(ctx, contract); err == nil {
+			registered = append(registered, *fs)
+		}
+	}
+	if len(registered) == 0 {
+		return nil
+	}
+
+	shares := params.DeveloperShares.QuoInt64(int64(len(registered)))
+	amount, _ := sdk.NewDecCoinsFromCoins(fees...).MulDecTruncate(shares).TruncateDecimal()
+	if amount.IsZero() {
+		return nil
+	}
+
+	for _, fs := range registered {
+		withdrawer := sdk.MustAccAddressFromBech32(fs.WithdrawerAddress)
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, k.feeCollectorName, withdrawer, amount); err != nil {
+			return err
+		}
+
+		contract := sdk.MustAccAddressFromBech32(fs.ContractAddress)
+		k.setAccruedFees(ctx, feeshare.AccruedFees{
+			ContractAddress: fs.ContractAddress,
+			Amount:          k.GetAccruedFees(ctx, contract).Add(amount...),
+		})
+
+		if err := ctx.EventManager().EmitTypedEvent(&feeshare.EventDistributeFeeShare{
+			ContractAddress:   fs.ContractAddress,
+			WithdrawerAddress: fs.WithdrawerAddress,
+			Amount:            amount,
+		}); err != nil {
+			panic(err)
+		}
+	}
+
+	return nil
+}
+
+// executedContracts returns the distinct contracts executed by the msgs, in
+// order of appearance. The msgs executed through authz are included.
+func executedContracts(msgs []sdk.Msg) []sdk.AccAddress {
+	var contracts []sdk.AccAddress
+	seen := map[string]bool{}
+
+	var collect func(msgs []sdk.Msg)
+	collect = func(msgs []sdk.Msg) {
+		for _, msg := range msgs {
+			switch m := msg.(type) {
+			case *wasmtypes.MsgExecuteContract:
+				if seen[m.Contract] {
+					continue
+				}
+				contract, err := sdk.AccAddressFromBech32(m.Contract)
+				if err != nil {
+					continue
+				}
+				seen[m.Contract] = true
+				contracts = append(contracts, contract)
+			case *authz.MsgExec:
+				nested, err := m.GetMessages()
+				if err != nil {
+					continue
+				}
+				collect(nested)
+			}
+		}
+	}
+	collect(msgs)
+
+	return contracts
+}
@@ -0,0 +1,113 @@
+package keeper_test
+
+import (
+	"github.com/line/lbm-sdk/simapp"
+	sdk "github.com/line/lbm-sdk/types"
+	authtypes "github.com/line/lbm-sdk/x/auth/types"
+	"github.com/line/lbm-sdk/x/authz"
+	banktypes "github.com/line/lbm-sdk/x/bank/types"
+	"github.com/line/lbm-sdk/x/feeshare"
+	wasmtypes "github.com/line/lbm-sdk/x/wasm/types"
+)
+
+type txMock []sdk.Msg
+
+func (m txMock) GetMsgs() []sdk.Msg   { return m }
+func (m txMock) ValidateBasic() error { return nil }
+
+func (s *KeeperTestSuite) TestDistributeFeeShare() {
+	execute := func(contract sdk.AccAddress) sdk.Msg {
+		return &wasmtypes.MsgExecuteContract{Sender: s.stranger.String(), Contract: contract.String()}
+	}
+	otherWithdrawer := s.stranger
+	fees := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+
+	testCases := map[string]struct {
+		params   *feeshare.Params
+		register bool
+		msgs     []sdk.Msg
+		fees     sdk.Coins
+		expected map[string]sdk.Coins
+	}{
+		"registered contract": {
+			msgs: []sdk.Msg{execute(s.contract)},
+			expected: map[string]sdk.Coins{
+				s.withdrawer.String(): sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 50)),
+			},
+		},
+		"contract executed twice": {
+			msgs: []sdk.Msg{execute(s.contract), execute(s.contract)},
+			expected: map[string]sdk.Coins{
+				s.withdrawer.String(): sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 50)),
+			},
+		},
+		"split between registered contracts": {
+			register: true,
+			msgs:     []sdk.Msg{execute(s.contract), execute(s.unregistered)},
+			expected: map[string]sdk.Coins{
+				s.withdrawer.String():    sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 25)),
+				otherWithdrawer.String(): sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 25)),
+			},
+		},
+		"executed through authz": {
+			msgs: []sdk.Msg{func() sdk.Msg {
+				msg := authz.NewMsgExec(s.stranger, []sdk.Msg{execute(s.contract)})
+				return &msg
+			}()},
+			expected: map[string]sdk.Coins{
+				s.withdrawer.String(): sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 50)),
+			},
+		},
+		"contract not registered": {
+			msgs: []sdk.Msg{execute(s.unregistered)},
+		},
+		"no contract executed": {
+			msgs: []sdk.Msg{banktypes.NewMsgSend(s.stranger, s.admin, fees)},
+		},
+		"disabled": {
+			params: &feeshare.Params{EnableFeeShare: false, DeveloperShares: sdk.OneDec()},
+			msgs:   []sdk.Msg{execute(s.contract)},
+		},
+		"truncated to zero": {
+			msgs: []sdk.Msg{execute(s.contract)},
+			fees: sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1)),
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+
+			if tc.params != nil {
+				s.keeper.SetParams(ctx, *tc.params)
+			}
+			if tc.register {
+				s.Require().NoError(s.keeper.RegisterFeeShare(ctx, s.admin, s.unregistered, otherWithdrawer))
+			}
+			txFees := fees
+			if tc.fees != nil {
+				txFees = tc.fees
+			}
+			s.Require().NoError(simapp.FundModuleAccount(s.app, ctx, authtypes.FeeCollectorName, txFees))
+
+			err := s.keeper.DistributeFeeShare(ctx, txMock(tc.msgs), txFees)
+			s.Require().NoError(err)
+
+			distributed := sdk.NewCoins()
+			for _, withdrawer := range []sdk.AccAddress{s.withdrawer, otherWithdrawer} {
+				balance := s.app.BankKeeper.GetAllBalances(ctx, withdrawer)
+				s.Require().Equal(tc.expected[withdrawer.String()].String(), balance.String(), withdrawer)
+				distributed = distributed.Add(balance...)
+			}
+
+			collector := s.app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+			s.Require().Equal(txFees.Sub(distributed), s.app.BankKeeper.GetAllBalances(ctx, collector))
+
+			if expected, ok := tc.expected[s.withdrawer.String()]; ok {
+				s.Require().Equal(expected, s.keeper.GetAccruedFees(ctx, s.contract))
+			} else {
+				s.Require().True(s.keeper.GetAccruedFees(ctx, s.contract).IsZero())
+			}
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package keeper
+
+import (
+	sdk "github.com/line/lbm-sdk/types"
+	"github.com/line/lbm-sdk/x/feeshare"
+)
+
+// InitGenesis initializes the feeshare module's state from a given genesis state.
+func (k Keeper) InitGenesis(ctx sdk.Context, data *feeshare.GenesisState) error {
+	k.SetParams(ctx, data.Params)
+
+	for _, fs := range data.FeeShares {
+		k.setFeeShare(ctx, fs)
+	}
+
+	for _, fees := range data.AccruedFees {
+		k.setAccruedFees(ctx, fees)
+	}
+
+	return nil
+}
+
+// ExportGenesis returns a GenesisState for a given context and keeper.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *feeshare.GenesisState {
+	var feeShares []feeshare.FeeShare
+	k.iterateFeeShares(ctx, func(fs feeshare.FeeShare) (stop bool) {
+		feeShares = append(feeShares, fs)
+		return false
+	})
+
+	var accruedFees []feeshare.AccruedFees
+	k.iterateAccruedFees(ctx, func(fees feeshare.AccruedFees) (stop bool) {
+		accruedFees = append(accruedFees, fees)
+		return false
+	})
+
+	return &feeshare.GenesisState{
+		Params:      k.GetParams(ctx),
+		FeeShares:   feeShares,
+		AccruedFees: accruedFees,
+	}
+}
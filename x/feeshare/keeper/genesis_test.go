@@ -0,0 +1,28 @@
+package keeper_test
+
+import (
+	sdk "github.com/line/lbm-sdk/types"
+	"github.com/line/lbm-sdk/x/feeshare"
+)
+
+func (s *KeeperTestSuite) TestImportExportGenesis() {
+	ctx, _ := s.ctx.CacheContext()
+
+	genesis := &feeshare.GenesisState{
+		Params: feeshare.Params{
+			EnableFeeShare:  true,
+			DeveloperShares: sdk.NewDecWithPrec(25, 2),
+		},
+		FeeShares: []feeshare.FeeShare{
+			feeshare.NewFeeShare(s.contract, s.withdrawer),
+		},
+		AccruedFees: []feeshare.AccruedFees{{
+			ContractAddress: s.contract.String(),
+			Amount:          sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)),
+		}},
+	}
+	s.Require().NoError(s.keeper.InitGenesis(ctx, genesis))
+
+	exported := s.keeper.ExportGenesis(ctx)
+	s.Require().Equal(genesis, exported)
+}
@@ -0,0 +1,91 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/line/lbm-sdk/store/prefix"
+	sdk "github.com/line/lbm-sdk/types"
+	"github.com/line/lbm-sdk/types/query"
+	"github.com/line/lbm-sdk/x/feeshare"
+)
+
+type queryServer struct {
+	keeper Keeper
+}
+
+func NewQueryServer(keeper Keeper) feeshare.QueryServer {
+	return &queryServer{
+		keeper: keeper,
+	}
+}
+
+var _ feeshare.QueryServer = (*queryServer)(nil)
+
+func (s queryServer) Params(c context.Context, req *feeshare.QueryParamsRequest) (*feeshare.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &feeshare.QueryParamsResponse{Params: s.keeper.GetParams(ctx)}, nil
+}
+
+func (s queryServer) FeeShare(c context.Context, req *feeshare.QueryFeeShareRequest) (*feeshare.QueryFeeShareResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	contract, err := sdk.AccAddressFromBech32(req.ContractAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	fs, err := s.keeper.GetFeeShare(ctx, contract)
+	if err != nil {
+		return nil, err
+	}
+
+	return &feeshare.QueryFeeShareResponse{FeeShare: *fs}, nil
+}
+
+func (s queryServer) FeeShares(c context.Context, req *feeshare.QueryFeeSharesRequest) (*feeshare.QueryFeeSharesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(s.keeper.storeKey)
+	feeShareStore := prefix.NewStore(store, feeShareKeyPrefix)
+	var feeShares []feeshare.FeeShare
+	pageRes, err := query.Paginate(feeShareStore, req.Pagination, func(key []byte, value []byte) error {
+		var fs feeshare.FeeShare
+		s.keeper.cdc.MustUnmarshal(value, &fs)
+		feeShares = append(feeShares, fs)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &feeshare.QueryFeeSharesResponse{FeeShares: feeShares, Pagination: pageRes}, nil
+}
+
+func (s queryServer) AccruedFees(c context.Context, req *feeshare.QueryAccruedFeesRequest) (*feeshare.QueryAccruedFeesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	contract, err := sdk.AccAddressFromBech32(req.ContractAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &feeshare.QueryAccruedFeesResponse{Amount: s.keeper.GetAccruedFees(ctx, contract)}, nil
+}
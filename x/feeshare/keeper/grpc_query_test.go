@@ -0,0 +1,91 @@
+package keeper_test
+
+import (
+	sdk "github.com/line/lbm-sdk/types"
+	"github.com/line/lbm-sdk/types/query"
+	"github.com/line/lbm-sdk/x/feeshare"
+)
+
+func (s *KeeperTestSuite) TestQueryParams() {
+	res, err := s.queryServer.Params(sdk.WrapSDKContext(s.ctx), &feeshare.QueryParamsRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(s.keeper.GetParams(s.ctx), res.Params)
+
+	_, err = s.queryServer.Params(sdk.WrapSDKContext(s.ctx), nil)
+	s.Require().Error(err)
+}
+
+func (s *KeeperTestSuite) TestQueryFeeShare() {
+	testCases := map[string]struct {
+		contract string
+		valid    bool
+	}{
+		"valid request": {
+			contract: s.contract.String(),
+			valid:    true,
+		},
+		"not registered": {
+			contract: s.unregistered.String(),
+		},
+		"invalid address": {
+			contract: "invalid",
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			req := &feeshare.QueryFeeShareRequest{ContractAddress: tc.contract}
+			res, err := s.queryServer.FeeShare(sdk.WrapSDKContext(s.ctx), req)
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(feeshare.NewFeeShare(s.contract, s.withdrawer), res.FeeShare)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestQueryFeeShares() {
+	ctx, _ := s.ctx.CacheContext()
+	s.Require().NoError(s.keeper.RegisterFeeShare(ctx, s.admin, s.unregistered, s.withdrawer))
+
+	res, err := s.queryServer.FeeShares(sdk.WrapSDKContext(ctx), &feeshare.QueryFeeSharesRequest{})
+	s.Require().NoError(err)
+	s.Require().Len(res.FeeShares, 2)
+
+	res, err = s.queryServer.FeeShares(sdk.WrapSDKContext(ctx), &feeshare.QueryFeeSharesRequest{
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(res.FeeShares, 1)
+	s.Require().Equal(uint64(2), res.Pagination.Total)
+}
+
+func (s *KeeperTestSuite) TestQueryAccruedFees() {
+	testCases := map[string]struct {
+		contract string
+		valid    bool
+	}{
+		"valid request": {
+			contract: s.contract.String(),
+			valid:    true,
+		},
+		"invalid address": {
+			contract: "invalid",
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			req := &feeshare.QueryAccruedFeesRequest{ContractAddress: tc.contract}
+			res, err := s.queryServer.AccruedFees(sdk.WrapSDKContext(s.ctx), req)
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().True(res.Amount.IsZero())
+		})
+	}
+}
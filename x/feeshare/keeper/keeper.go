@@ -0,0 +1,72 @@
+package keeper
+
+import (
+	"github.com/line/ostracon/libs/log"
+
+	"github.com/line/lbm-sdk/codec"
+	sdk "github.com/line/lbm-sdk/types"
+	"github.com/line/lbm-sdk/x/feeshare"
+	paramtypes "github.com/line/lbm-sdk/x/params/types"
+)
+
+// Keeper defines the feeshare module Keeper
+type Keeper struct {
+	// The codec for binary encoding/decoding.
+	cdc codec.Codec
+
+	// The (unexposed) keys used to access the stores from the Context.
+	storeKey sdk.StoreKey
+
+	paramSpace paramtypes.Subspace
+
+	// keepers
+	bankKeeper feeshare.BankKeeper
+	wasmKeeper feeshare.WasmKeeper
+
+	feeCollectorName string
+}
+
+// NewKeeper returns a feeshare keeper. It handles:
+// - registering the withdraw addresses of contracts.
+// - routing a share of the tx fees to the registered contracts.
+//
+// CONTRACT: the fee collector must be the module account the ante handler
+// deducts the tx fees to.
+func NewKeeper(
+	cdc codec.Codec,
+	key sdk.StoreKey,
+	paramSpace paramtypes.Subspace,
+	bankKeeper feeshare.BankKeeper,
+	wasmKeeper feeshare.WasmKeeper,
+	feeCollectorName string,
+) Keeper {
+	// set KeyTable if it has not already been set
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(feeshare.ParamKeyTable())
+	}
+
+	return Keeper{
+		cdc:              cdc,
+		storeKey:         key,
+		paramSpace:       paramSpace,
+		bankKeeper:       bankKeeper,
+		wasmKeeper:       wasmKeeper,
+		feeCollectorName: feeCollectorName,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+feeshare.ModuleName)
+}
+
+// GetParams returns the total set of feeshare parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params feeshare.Params) {
+	k.paramSpace.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParams sets the total set of feeshare parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params feeshare.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
@@ -0,0 +1,86 @@
+package keeper_test
+
+import (
+	"testing"
+
+	ocproto "github.com/line/ostracon/proto/ostracon/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/line/lbm-sdk/crypto/keys/secp256k1"
+	"github.com/line/lbm-sdk/simapp"
+	sdk "github.com/line/lbm-sdk/types"
+	authtypes "github.com/line/lbm-sdk/x/auth/types"
+	"github.com/line/lbm-sdk/x/feeshare"
+	"github.com/line/lbm-sdk/x/feeshare/keeper"
+	wasmtypes "github.com/line/lbm-sdk/x/wasm/types"
+)
+
+type wasmKeeperMock map[string]*wasmtypes.ContractInfo
+
+func (m wasmKeeperMock) GetContractInfo(_ sdk.Context, contractAddress sdk.AccAddress) *wasmtypes.ContractInfo {
+	return m[contractAddress.String()]
+}
+
+type KeeperTestSuite struct {
+	suite.Suite
+	ctx sdk.Context
+
+	app         *simapp.SimApp
+	keeper      keeper.Keeper
+	queryServer feeshare.QueryServer
+	msgServer   feeshare.MsgServer
+
+	admin      sdk.AccAddress
+	stranger   sdk.AccAddress
+	withdrawer sdk.AccAddress
+
+	// registered contract
+	contract sdk.AccAddress
+	// contract not registered
+	unregistered sdk.AccAddress
+	// contract without admin
+	adminless sdk.AccAddress
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	checkTx := false
+	s.app = simapp.Setup(checkTx)
+	s.ctx = s.app.BaseApp.NewContext(checkTx, ocproto.Header{})
+
+	createAddress := func() sdk.AccAddress {
+		return sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	}
+	s.admin = createAddress()
+	s.stranger = createAddress()
+	s.withdrawer = createAddress()
+	s.contract = createAddress()
+	s.unregistered = createAddress()
+	s.adminless = createAddress()
+
+	wasmKeeper := wasmKeeperMock{
+		s.contract.String():     &wasmtypes.ContractInfo{Admin: s.admin.String()},
+		s.unregistered.String(): &wasmtypes.ContractInfo{Admin: s.admin.String()},
+		s.adminless.String():    &wasmtypes.ContractInfo{},
+	}
+	s.keeper = keeper.NewKeeper(
+		s.app.AppCodec(),
+		s.app.GetKey(feeshare.StoreKey),
+		s.app.GetSubspace(feeshare.ModuleName),
+		s.app.BankKeeper,
+		wasmKeeper,
+		authtypes.FeeCollectorName,
+	)
+	s.keeper.SetParams(s.ctx, feeshare.Params{
+		EnableFeeShare:  true,
+		DeveloperShares: sdk.NewDecWithPrec(50, 2),
+	})
+
+	s.queryServer = keeper.NewQueryServer(s.keeper)
+	s.msgServer = keeper.NewMsgServer(s.keeper)
+
+	s.Require().NoError(s.keeper.RegisterFeeShare(s.ctx, s.admin, s.contract, s.withdrawer))
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
@@ -0,0 +1,28 @@
+package keeper
+
+import (
+	sdk "github.com/line/lbm-sdk/types"
+)
+
+// Keys for feeshare store
+// Items are stored with the following key: values
+var (
+	feeShareKeyPrefix    = []byte{0x01}
+	accruedFeesKeyPrefix = []byte{0x02}
+)
+
+// feeShareKey key for the registration of a contract from the store
+func feeShareKey(contract sdk.AccAddress) []byte {
+	key := make([]byte, len(feeShareKeyPrefix)+len(contract))
+	copy(key, feeShareKeyPrefix)
+	copy(key[len(feeShareKeyPrefix):], contract)
+	return key
+}
+
+// accruedFeesKey key for the accrued fees of a contract from the store
+func accruedFeesKey(contract sdk.AccAddress) []byte {
+	key := make([]byte, len(accruedFeesKeyPrefix)+len(contract))
+	copy(key, accruedFeesKeyPrefix)
+	copy(key[len(accruedFeesKeyPrefix):], contract)
+	return key
+}
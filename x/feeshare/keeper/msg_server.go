@@ -0,0 +1,79 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/line/lbm-sdk/types"
+	"github.com/line/lbm-sdk/x/feeshare"
+)
+
+type msgServer struct {
+	keeper Keeper
+}
+
+// NewMsgServer returns an implementation of the feeshare MsgServer interface
+// for the provided Keeper.
+func NewMsgServer(keeper Keeper) feeshare.MsgServer {
+	return &msgServer{
+		keeper: keeper,
+	}
+}
+
+var _ feeshare.MsgServer = msgServer{}
+
+// RegisterFeeShare registers the withdraw address of a contract for the fee share.
+func (s msgServer) RegisterFeeShare(c context.Context, req *feeshare.MsgRegisterFeeShare) (*feeshare.MsgRegisterFeeShareResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, err
+	}
+	contract, err := sdk.AccAddressFromBech32(req.ContractAddress)
+	if err != nil {
+		return nil, err
+	}
+	withdrawer, err := sdk.AccAddressFromBech32(req.WithdrawerAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.keeper.RegisterFeeShare(ctx, sender, contract, withdrawer); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&feeshare.EventRegisterFeeShare{
+		ContractAddress:   req.ContractAddress,
+		WithdrawerAddress: req.WithdrawerAddress,
+	}); err != nil {
+		panic(err)
+	}
+
+	return &feeshare.MsgRegisterFeeShareResponse{}, nil
+}
+
+// CancelFeeShare cancels the registration of a contract.
+func (s msgServer) CancelFeeShare(c context.Context, req *feeshare.MsgCancelFeeShare) (*feeshare.MsgCancelFeeShareResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, err
+	}
+	contract, err := sdk.AccAddressFromBech32(req.ContractAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.keeper.CancelFeeShare(ctx, sender, contract); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&feeshare.EventCancelFeeShare{
+		ContractAddress: req.ContractAddress,
+	}); err != nil {
+		panic(err)
+	}
+
+	return &feeshare.MsgCancelFeeShareResponse{}, nil
+}
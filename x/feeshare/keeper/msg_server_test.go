@@ -0,0 +1,103 @@
+package keeper_test
+
+import (
+	sdk "github.com/line/lbm-sdk/types"
+	"github.com/line/lbm-sdk/x/feeshare"
+)
+
+func (s *KeeperTestSuite) TestMsgRegisterFeeShare() {
+	testCases := map[string]struct {
+		sender   sdk.AccAddress
+		contract sdk.AccAddress
+		valid    bool
+	}{
+		"valid request": {
+			sender:   s.admin,
+			contract: s.unregistered,
+			valid:    true,
+		},
+		"overwrite the registration": {
+			sender:   s.admin,
+			contract: s.contract,
+			valid:    true,
+		},
+		"not the admin": {
+			sender:   s.stranger,
+			contract: s.unregistered,
+		},
+		"contract without admin": {
+			sender:   s.stranger,
+			contract: s.adminless,
+		},
+		"contract not found": {
+			sender:   s.admin,
+			contract: s.stranger,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+
+			req := &feeshare.MsgRegisterFeeShare{
+				Sender:            tc.sender.String(),
+				ContractAddress:   tc.contract.String(),
+				WithdrawerAddress: s.stranger.String(),
+			}
+			res, err := s.msgServer.RegisterFeeShare(sdk.WrapSDKContext(ctx), req)
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().NotNil(res)
+
+			fs, err := s.keeper.GetFeeShare(ctx, tc.contract)
+			s.Require().NoError(err)
+			s.Require().Equal(feeshare.NewFeeShare(tc.contract, s.stranger), *fs)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestMsgCancelFeeShare() {
+	testCases := map[string]struct {
+		sender   sdk.AccAddress
+		contract sdk.AccAddress
+		valid    bool
+	}{
+		"valid request": {
+			sender:   s.admin,
+			contract: s.contract,
+			valid:    true,
+		},
+		"not the admin": {
+			sender:   s.stranger,
+			contract: s.contract,
+		},
+		"not registered": {
+			sender:   s.admin,
+			contract: s.unregistered,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+
+			req := &feeshare.MsgCancelFeeShare{
+				Sender:          tc.sender.String(),
+				ContractAddress: tc.contract.String(),
+			}
+			res, err := s.msgServer.CancelFeeShare(sdk.WrapSDKContext(ctx), req)
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().NotNil(res)
+
+			_, err = s.keeper.GetFeeShare(ctx, tc.contract)
+			s.Require().Error(err)
+		})
+	}
+}
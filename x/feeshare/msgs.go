@@ -0,0 +1,52 @@
+package feeshare
+
+import (
+	sdk "github.com/line/lbm-sdk/types"
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
+)
+
+var _ sdk.Msg = (*MsgRegisterFeeShare)(nil)
+
+// ValidateBasic implements Msg.
+func (m MsgRegisterFeeShare) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", m.Sender)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.ContractAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid contract address: %s", m.ContractAddress)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.WithdrawerAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid withdrawer address: %s", m.WithdrawerAddress)
+	}
+
+	return nil
+}
+
+// GetSigners implements Msg.
+func (m MsgRegisterFeeShare) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{signer}
+}
+
+var _ sdk.Msg = (*MsgCancelFeeShare)(nil)
+
+// ValidateBasic implements Msg.
+func (m MsgCancelFeeShare) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", m.Sender)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.ContractAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid contract address: %s", m.ContractAddress)
+	}
+
+	return nil
+}
+
+// GetSigners implements Msg.
+func (m MsgCancelFeeShare) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{signer}
+}
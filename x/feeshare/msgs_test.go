@@ -0,0 +1,102 @@
+package feeshare_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/line/lbm-sdk/crypto/keys/secp256k1"
+	sdk "github.com/line/lbm-sdk/types"
+	"github.com/line/lbm-sdk/x/feeshare"
+)
+
+func TestMsgRegisterFeeShare(t *testing.T) {
+	addrs := make([]sdk.AccAddress, 3)
+	for i := range addrs {
+		addrs[i] = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	}
+
+	testCases := map[string]struct {
+		sender     sdk.AccAddress
+		contract   sdk.AccAddress
+		withdrawer sdk.AccAddress
+		valid      bool
+	}{
+		"valid msg": {
+			sender:     addrs[0],
+			contract:   addrs[1],
+			withdrawer: addrs[2],
+			valid:      true,
+		},
+		"empty sender": {
+			contract:   addrs[1],
+			withdrawer: addrs[2],
+		},
+		"empty contract": {
+			sender:     addrs[0],
+			withdrawer: addrs[2],
+		},
+		"empty withdrawer": {
+			sender:   addrs[0],
+			contract: addrs[1],
+		},
+	}
+
+	for name, tc := range testCases {
+		msg := feeshare.MsgRegisterFeeShare{
+			Sender:            tc.sender.String(),
+			ContractAddress:   tc.contract.String(),
+			WithdrawerAddress: tc.withdrawer.String(),
+		}
+
+		err := msg.ValidateBasic()
+		if !tc.valid {
+			require.Error(t, err, name)
+			continue
+		}
+		require.NoError(t, err, name)
+
+		require.Equal(t, []sdk.AccAddress{tc.sender}, msg.GetSigners(), name)
+	}
+}
+
+func TestMsgCancelFeeShare(t *testing.T) {
+	addrs := make([]sdk.AccAddress, 2)
+	for i := range addrs {
+		addrs[i] = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	}
+
+	testCases := map[string]struct {
+		sender   sdk.AccAddress
+		contract sdk.AccAddress
+		valid    bool
+	}{
+		"valid msg": {
+			sender:   addrs[0],
+			contract: addrs[1],
+			valid:    true,
+		},
+		"empty sender": {
+			contract: addrs[1],
+		},
+		"empty contract": {
+			sender: addrs[0],
+		},
+	}
+
+	for name, tc := range testCases {
+		msg := feeshare.MsgCancelFeeShare{
+			Sender:          tc.sender.String(),
+			ContractAddress: tc.contract.String(),
+		}
+
+		err := msg.ValidateBasic()
+		if !tc.valid {
+			require.Error(t, err, name)
+			continue
+		}
+		require.NoError(t, err, name)
+
+		require.Equal(t, []sdk.AccAddress{tc.sender}, msg.GetSigners(), name)
+	}
+}
@@ -0,0 +1,82 @@
+package feeshare
+
+import (
+	"fmt"
+
+	"gopkg.in/yaml.v2"
+
+	sdk "github.com/line/lbm-sdk/types"
+	paramtypes "github.com/line/lbm-sdk/x/params/types"
+)
+
+// Parameter store keys
+var (
+	ParamStoreKeyEnableFeeShare  = []byte("EnableFeeShare")
+	ParamStoreKeyDeveloperShares = []byte("DeveloperShares")
+)
+
+// DefaultDeveloperShares is the fraction of the tx fees routed to the contract developers by default.
+var DefaultDeveloperShares = sdk.NewDecWithPrec(50, 2)
+
+var _ paramtypes.ParamSet = (*Params)(nil)
+
+// ParamKeyTable returns the parameter key table.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// DefaultParams returns default feeshare parameters. The fee share is disabled by default.
+func DefaultParams() Params {
+	return Params{
+		EnableFeeShare:  false,
+		DeveloperShares: DefaultDeveloperShares,
+	}
+}
+
+func (p Params) String() string {
+	out, err := yaml.Marshal(p)
+	if err != nil {
+		panic(err)
+	}
+	return string(out)
+}
+
+// ParamSetPairs returns the parameter set pairs.
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(ParamStoreKeyEnableFeeShare, &p.EnableFeeShare, validateEnableFeeShare),
+		paramtypes.NewParamSetPair(ParamStoreKeyDeveloperShares, &p.DeveloperShares, validateDeveloperShares),
+	}
+}
+
+// ValidateBasic performs basic validation on the parameters.
+func (p Params) ValidateBasic() error {
+	if err := validateEnableFeeShare(p.EnableFeeShare); err != nil {
+		return err
+	}
+	return validateDeveloperShares(p.DeveloperShares)
+}
+
+func validateEnableFeeShare(i interface{}) error {
+	if _, ok := i.(bool); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateDeveloperShares(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.IsNil() {
+		return fmt.Errorf("developer shares must not be nil")
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("developer shares must not be negative: %s", v)
+	}
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("developer shares must not exceed 1: %s", v)
+	}
+	return nil
+}
@@ -14,6 +14,8 @@ type Config struct {
 	MaxMetadataLen uint64
 	MinThreshold   sdk.Dec
 	MinPercentage  sdk.Dec
+	// ExtraAuthorizations defines the msg type urls of the authorizations of other modules, which the foundation can grant.
+	ExtraAuthorizations []string
 }
 
 func DefaultConfig() Config {
@@ -10,10 +10,13 @@ import (
 
 const gasCostPerIteration = uint64(20)
 
-func canFoundationAuthorize(msgTypeURL string) bool {
+func (k Keeper) canFoundationAuthorize(msgTypeURL string) bool {
 	urls := map[string]bool{
 		foundation.ReceiveFromTreasuryAuthorization{}.MsgTypeURL(): true,
 	}
+	for _, url := range k.config.ExtraAuthorizations {
+		urls[url] = true
+	}
 	return urls[msgTypeURL]
 }
 
@@ -276,7 +279,7 @@ func (s msgServer) Grant(c context.Context, req *foundation.MsgGrant) (*foundati
 	}
 
 	msgTypeURL := req.GetAuthorization().MsgTypeURL()
-	if !canFoundationAuthorize(msgTypeURL) {
+	if !s.keeper.canFoundationAuthorize(msgTypeURL) {
 		return nil, sdkerrors.ErrUnauthorized.Wrapf("foundation cannot grant %s", msgTypeURL)
 	}
 
@@ -299,7 +302,7 @@ func (s msgServer) Revoke(c context.Context, req *foundation.MsgRevoke) (*founda
 		return nil, err
 	}
 
-	if !canFoundationAuthorize(req.MsgTypeUrl) {
+	if !s.keeper.canFoundationAuthorize(req.MsgTypeUrl) {
 		return nil, sdkerrors.ErrUnauthorized.Wrapf("foundation cannot revoke %s", req.MsgTypeUrl)
 	}
 
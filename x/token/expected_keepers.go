@@ -22,4 +22,10 @@ type (
 		InitGenesis(ctx sdk.Context, data *ClassGenesisState)
 		ExportGenesis(ctx sdk.Context) *ClassGenesisState
 	}
+
+	// ReceiverHooks defines the hooks called on the recipient of a transfer.
+	ReceiverHooks interface {
+		// OnTokenReceived is called after the tokens are transferred. Returning an error reverts the transfer.
+		OnTokenReceived(ctx sdk.Context, contractID string, operator, from, to sdk.AccAddress, amount sdk.Int) error
+	}
 )
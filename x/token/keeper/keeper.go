@@ -10,6 +10,7 @@ import (
 type Keeper struct {
 	accountKeeper token.AccountKeeper
 	classKeeper   token.ClassKeeper
+	hooks         token.ReceiverHooks
 
 	// The (unexposed) keys used to access the stores from the Context.
 	storeKey sdk.StoreKey
@@ -32,3 +33,14 @@ func NewKeeper(
 		cdc:           cdc,
 	}
 }
+
+// SetHooks sets the hooks called on the recipient of a transfer
+func (k *Keeper) SetHooks(hooks token.ReceiverHooks) *Keeper {
+	if k.hooks != nil {
+		panic("cannot set token hooks twice")
+	}
+
+	k.hooks = hooks
+
+	return k
+}
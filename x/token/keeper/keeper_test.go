@@ -13,6 +13,8 @@ import (
 	sdk "github.com/line/lbm-sdk/types"
 	"github.com/line/lbm-sdk/x/token"
 	"github.com/line/lbm-sdk/x/token/keeper"
+	wasmkeeper "github.com/line/lbm-sdk/x/wasm/keeper"
+	wasmtypes "github.com/line/lbm-sdk/x/wasm/types"
 )
 
 type KeeperTestSuite struct {
@@ -23,6 +25,9 @@ type KeeperTestSuite struct {
 	queryServer token.QueryServer
 	msgServer   token.MsgServer
 
+	// wasmKeeper deploys the contracts receiving transfers
+	wasmKeeper wasmtypes.ContractOpsKeeper
+
 	vendor   sdk.AccAddress
 	operator sdk.AccAddress
 	customer sdk.AccAddress
@@ -57,6 +62,7 @@ func (s *KeeperTestSuite) SetupTest() {
 	s.ctx = app.BaseApp.NewContext(checkTx, ocproto.Header{})
 	s.goCtx = sdk.WrapSDKContext(s.ctx)
 	s.keeper = app.TokenKeeper
+	s.wasmKeeper = wasmkeeper.NewDefaultPermissionKeeper(app.WasmKeeper)
 
 	s.queryServer = keeper.NewQueryServer(s.keeper)
 	s.msgServer = keeper.NewMsgServer(s.keeper)
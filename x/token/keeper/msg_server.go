@@ -50,6 +50,10 @@ func (s msgServer) Send(c context.Context, req *token.MsgSend) (*token.MsgSendRe
 		panic(err)
 	}
 
+	if err := s.keeper.callReceiverHooks(ctx, req.ContractId, from, from, to, req.Amount); err != nil {
+		return nil, err
+	}
+
 	return &token.MsgSendResponse{}, nil
 }
 
@@ -90,6 +94,10 @@ func (s msgServer) TransferFrom(c context.Context, req *token.MsgTransferFrom) (
 		panic(err)
 	}
 
+	if err := s.keeper.callReceiverHooks(ctx, req.ContractId, proxy, from, to, req.Amount); err != nil {
+		return nil, err
+	}
+
 	return &token.MsgTransferFromResponse{}, nil
 }
 
@@ -19,6 +19,15 @@ func (k Keeper) Send(ctx sdk.Context, contractID string, from, to sdk.AccAddress
 	return nil
 }
 
+// callReceiverHooks notifies the recipient of a transfer, if any hooks are set.
+// An error returned by the hooks must revert the transfer.
+func (k Keeper) callReceiverHooks(ctx sdk.Context, contractID string, operator, from, to sdk.AccAddress, amount sdk.Int) error {
+	if k.hooks == nil {
+		return nil
+	}
+	return k.hooks.OnTokenReceived(ctx, contractID, operator, from, to, amount)
+}
+
 func (k Keeper) AuthorizeOperator(ctx sdk.Context, contractID string, holder, operator sdk.AccAddress) error {
 	if _, err := k.GetClass(ctx, contractID); err != nil {
 		return err
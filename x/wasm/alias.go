@@ -32,40 +32,42 @@ const (
 
 var (
 	// functions aliases
-	RegisterCodec             = types.RegisterLegacyAminoCodec
-	RegisterInterfaces        = types.RegisterInterfaces
-	ValidateGenesis           = types.ValidateGenesis
-	ConvertToProposals        = types.ConvertToProposals
-	GetCodeKey                = types.GetCodeKey
-	GetContractAddressKey     = types.GetContractAddressKey
-	GetContractStorePrefixKey = types.GetContractStorePrefix
-	NewCodeInfo               = types.NewCodeInfo
-	NewAbsoluteTxPosition     = types.NewAbsoluteTxPosition
-	NewContractInfo           = types.NewContractInfo
-	NewEnv                    = types.NewEnv
-	NewWasmCoins              = types.NewWasmCoins
-	DefaultWasmConfig         = types.DefaultWasmConfig
-	DefaultParams             = types.DefaultParams
-	InitGenesis               = keeper.InitGenesis
-	ExportGenesis             = keeper.ExportGenesis
-	NewMessageHandler         = keeper.NewDefaultMessageHandler
-	DefaultEncoders           = keeper.DefaultEncoders
-	EncodeBankMsg             = keeper.EncodeBankMsg
-	EncodeStakingMsg          = keeper.EncodeStakingMsg
-	EncodeWasmMsg             = keeper.EncodeWasmMsg
-	NewKeeper                 = keeper.NewKeeper
-	NewLegacyQuerier          = keeper.NewLegacyQuerier
-	DefaultQueryPlugins       = keeper.DefaultQueryPlugins
-	BankQuerier               = keeper.BankQuerier
-	StakingQuerier            = keeper.StakingQuerier
-	WasmQuerier               = keeper.WasmQuerier
-	CreateTestInput           = keeper.CreateTestInput
-	TestHandler               = keeper.TestHandler
-	NewWasmProposalHandler    = keeper.NewWasmProposalHandler
-	NewQuerier                = keeper.Querier
-	ContractFromPortID        = keeper.ContractFromPortID
-	WithWasmEngine            = keeper.WithWasmEngine
-	NewCountTXDecorator       = keeper.NewCountTXDecorator
+	RegisterCodec              = types.RegisterLegacyAminoCodec
+	RegisterInterfaces         = types.RegisterInterfaces
+	ValidateGenesis            = types.ValidateGenesis
+	ConvertToProposals         = types.ConvertToProposals
+	GetCodeKey                 = types.GetCodeKey
+	GetContractAddressKey      = types.GetContractAddressKey
+	GetContractStorePrefixKey  = types.GetContractStorePrefix
+	NewCodeInfo                = types.NewCodeInfo
+	NewAbsoluteTxPosition      = types.NewAbsoluteTxPosition
+	NewContractInfo            = types.NewContractInfo
+	NewEnv                     = types.NewEnv
+	NewWasmCoins               = types.NewWasmCoins
+	DefaultWasmConfig          = types.DefaultWasmConfig
+	DefaultParams              = types.DefaultParams
+	InitGenesis                = keeper.InitGenesis
+	ExportGenesis              = keeper.ExportGenesis
+	NewMessageHandler          = keeper.NewDefaultMessageHandler
+	DefaultEncoders            = keeper.DefaultEncoders
+	EncodeBankMsg              = keeper.EncodeBankMsg
+	EncodeStakingMsg           = keeper.EncodeStakingMsg
+	EncodeWasmMsg              = keeper.EncodeWasmMsg
+	NewKeeper                  = keeper.NewKeeper
+	NewLegacyQuerier           = keeper.NewLegacyQuerier
+	DefaultQueryPlugins        = keeper.DefaultQueryPlugins
+	BankQuerier                = keeper.BankQuerier
+	StakingQuerier             = keeper.StakingQuerier
+	WasmQuerier                = keeper.WasmQuerier
+	AcceptListStargateQuerier  = keeper.AcceptListStargateQuerier
+	CreateTestInput            = keeper.CreateTestInput
+	TestHandler                = keeper.TestHandler
+	NewWasmProposalHandler     = keeper.NewWasmProposalHandler
+	NewQuerier                 = keeper.Querier
+	ContractFromPortID         = keeper.ContractFromPortID
+	WithWasmEngine             = keeper.WithWasmEngine
+	NewCountTXDecorator        = keeper.NewCountTXDecorator
+	NewCircuitBreakerDecorator = keeper.NewCircuitBreakerDecorator
 
 	// variable aliases
 	ModuleCdc            = types.ModuleCdc
@@ -96,6 +98,7 @@ type (
 	MsgStoreCode                               = types.MsgStoreCode
 	MsgStoreCodeResponse                       = types.MsgStoreCodeResponse
 	MsgInstantiateContract                     = types.MsgInstantiateContract
+	MsgInstantiateContract2                    = types.MsgInstantiateContract2
 	MsgInstantiateContractResponse             = types.MsgInstantiateContractResponse
 	MsgStoreCodeAndInstantiateContract         = types.MsgStoreCodeAndInstantiateContract
 	MsgStoreCodeAndInstantiateContractResponse = types.MsgStoreCodeAndInstantiateContractResponse
@@ -108,6 +111,16 @@ type (
 	MsgClearAdmin                              = types.MsgClearAdmin
 	MsgWasmIBCCall                             = types.MsgIBCSend
 	MsgClearAdminResponse                      = types.MsgClearAdminResponse
+	MsgUpdateCircuitBreaker                    = types.MsgUpdateCircuitBreaker
+	MsgUpdateCircuitBreakerResponse            = types.MsgUpdateCircuitBreakerResponse
+	MsgUpdateContractQuota                     = types.MsgUpdateContractQuota
+	MsgUpdateContractQuotaResponse             = types.MsgUpdateContractQuotaResponse
+	MsgRegisterSchedule                        = types.MsgRegisterSchedule
+	MsgRegisterScheduleResponse                = types.MsgRegisterScheduleResponse
+	MsgDeregisterSchedule                      = types.MsgDeregisterSchedule
+	MsgDeregisterScheduleResponse              = types.MsgDeregisterScheduleResponse
+	MsgUpdateReceiverHook                      = types.MsgUpdateReceiverHook
+	MsgUpdateReceiverHookResponse              = types.MsgUpdateReceiverHookResponse
 	MsgServer                                  = types.MsgServer
 	Model                                      = types.Model
 	CodeInfo                                   = types.CodeInfo
@@ -125,5 +138,6 @@ type (
 	QueryHandler                               = keeper.QueryHandler
 	CustomQuerier                              = keeper.CustomQuerier
 	QueryPlugins                               = keeper.QueryPlugins
+	AcceptedStargateQueries                    = keeper.AcceptedStargateQueries
 	Option                                     = keeper.Option
 )
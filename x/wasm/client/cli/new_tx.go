@@ -1,13 +1,16 @@
 package cli
 
 import (
+	"fmt"
 	"strconv"
 
 	"github.com/spf13/cobra"
+	flag "github.com/spf13/pflag"
 
 	"github.com/line/lbm-sdk/client"
 	"github.com/line/lbm-sdk/client/flags"
 	"github.com/line/lbm-sdk/client/tx"
+	sdk "github.com/line/lbm-sdk/types"
 	sdkerrors "github.com/line/lbm-sdk/types/errors"
 	"github.com/line/lbm-sdk/x/wasm/types"
 )
@@ -117,3 +120,214 @@ func ClearContractAdminCmd() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+// UpdateReceiverHookCmd opts a contract in to or out of the receiver hook
+func UpdateReceiverHookCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-receiver-hook [contract_addr_bech32] [true|false]",
+		Short: "Enable or disable the notification of a contract about received x/token and x/collection transfers",
+		Long: `Enable or disable the notification of a contract about received x/token and x/collection transfers.
+An enabled contract gets the transfers passed to its sudo entry point and rejects them by returning an error.
+The sender must be the admin of the contract.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			enabled, err := strconv.ParseBool(args[1])
+			if err != nil {
+				return sdkerrors.Wrap(err, "enabled")
+			}
+			msg := types.MsgUpdateReceiverHook{
+				Sender:   clientCtx.GetFromAddress().String(),
+				Contract: args[0],
+				Enabled:  enabled,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// UpdateCircuitBreakerCmd pauses or resumes the executions of contracts by the circuit breaker
+func UpdateCircuitBreakerCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-circuit-breaker [pause|resume] --contracts [contract_addr_bech32,...] --code-ids [code_id,...]",
+		Short: "Pause or resume the executions of contracts and of all contracts of codes",
+		Long: `Pause or resume the executions of contracts and of all contracts of codes.
+The operator must be granted the CircuitBreakerAuthorization by the foundation.`,
+		Aliases: []string{"circuit-breaker", "cb"},
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg, err := parseUpdateCircuitBreakerArgs(args[0], clientCtx.GetFromAddress(), cmd.Flags())
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+	cmd.Flags().StringSlice(flagContracts, []string{}, "Contract addresses to pause or resume")
+	cmd.Flags().StringSlice(flagCodeIDs, []string{}, "Code ids whose contracts to pause or resume")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func parseUpdateCircuitBreakerArgs(action string, operator sdk.AccAddress, flags *flag.FlagSet) (types.MsgUpdateCircuitBreaker, error) {
+	var paused bool
+	switch action {
+	case "pause":
+		paused = true
+	case "resume":
+		paused = false
+	default:
+		return types.MsgUpdateCircuitBreaker{}, fmt.Errorf("unknown action %q, expected pause or resume", action)
+	}
+
+	contracts, err := flags.GetStringSlice(flagContracts)
+	if err != nil {
+		return types.MsgUpdateCircuitBreaker{}, fmt.Errorf("contracts: %s", err)
+	}
+	rawCodeIDs, err := flags.GetStringSlice(flagCodeIDs)
+	if err != nil {
+		return types.MsgUpdateCircuitBreaker{}, fmt.Errorf("code ids: %s", err)
+	}
+	codeIDs := make([]uint64, len(rawCodeIDs))
+	for i, rawCodeID := range rawCodeIDs {
+		codeIDs[i], err = strconv.ParseUint(rawCodeID, 10, 64)
+		if err != nil {
+			return types.MsgUpdateCircuitBreaker{}, sdkerrors.Wrap(err, "code id")
+		}
+	}
+
+	return types.MsgUpdateCircuitBreaker{
+		Operator:          operator.String(),
+		ContractAddresses: contracts,
+		CodeIDs:           codeIDs,
+		Paused:            paused,
+	}, nil
+}
+
+// SetContractQuotaCmd sets the per block execution quota of a contract
+func SetContractQuotaCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-contract-quota [contract_addr_bech32] [max_executions_per_block] [max_gas_per_block]",
+		Short: "Set the per block execution quota of a contract",
+		Long: `Set the per block execution quota of a contract. A zero value means no limit, zero for both removes the quota.
+The operator must be granted the ContractQuotaAuthorization by the foundation.`,
+		Aliases: []string{"quota"},
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			maxExecutions, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return sdkerrors.Wrap(err, "max executions per block")
+			}
+			maxGas, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return sdkerrors.Wrap(err, "max gas per block")
+			}
+
+			msg := types.MsgUpdateContractQuota{
+				Operator: clientCtx.GetFromAddress().String(),
+				Contract: args[0],
+				Quota: types.ContractQuota{
+					MaxExecutionsPerBlock: maxExecutions,
+					MaxGasPerBlock:        maxGas,
+				},
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// RegisterScheduleCmd registers a periodic call of the sudo entry point of a contract
+func RegisterScheduleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register-schedule [contract_addr_bech32] [name] [json_encoded_sudo_args] [interval] [gas_limit]",
+		Short: "Register a periodic call of the sudo entry point of a contract",
+		Long: `Register a call of the sudo entry point of a contract every [interval] blocks, limited to [gas_limit] gas.
+An existing schedule of the same name is replaced. The operator must be granted the RegisterScheduleAuthorization by the foundation.`,
+		Args: cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			interval, err := strconv.ParseUint(args[3], 10, 64)
+			if err != nil {
+				return sdkerrors.Wrap(err, "interval")
+			}
+			gasLimit, err := strconv.ParseUint(args[4], 10, 64)
+			if err != nil {
+				return sdkerrors.Wrap(err, "gas limit")
+			}
+
+			msg := types.MsgRegisterSchedule{
+				Operator: clientCtx.GetFromAddress().String(),
+				Contract: args[0],
+				Name:     args[1],
+				Msg:      []byte(args[2]),
+				Interval: interval,
+				GasLimit: gasLimit,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// DeregisterScheduleCmd removes a schedule of a contract
+func DeregisterScheduleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deregister-schedule [contract_addr_bech32] [name]",
+		Short: "Remove a schedule of a contract",
+		Long:  "Remove a schedule of a contract. The operator must be granted the DeregisterScheduleAuthorization by the foundation.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgDeregisterSchedule{
+				Operator: clientCtx.GetFromAddress().String(),
+				Contract: args[0],
+				Name:     args[1],
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
@@ -13,10 +13,14 @@ import (
 	wasmvm "github.com/line/wasmvm"
 	"github.com/spf13/cobra"
 	flag "github.com/spf13/pflag"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 
 	"github.com/line/lbm-sdk/client"
 	"github.com/line/lbm-sdk/client/flags"
 	sdk "github.com/line/lbm-sdk/types"
+	grpctypes "github.com/line/lbm-sdk/types/grpc"
+	"github.com/line/lbm-sdk/types/query"
 	"github.com/line/lbm-sdk/x/wasm/types"
 )
 
@@ -31,6 +35,8 @@ func GetQueryCmd() *cobra.Command {
 	queryCmd.AddCommand(
 		GetCmdListCode(),
 		GetCmdListContractByCode(),
+		GetCmdListContractsByCreator(),
+		GetCmdListContractsByAdmin(),
 		GetCmdQueryCode(),
 		GetCmdQueryCodeInfo(),
 		GetCmdGetContractInfo(),
@@ -38,6 +44,14 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdGetContractState(),
 		GetCmdListPinnedCode(),
 		GetCmdLibVersion(),
+		GetCmdBuildAddress(),
+		GetCmdExportContract(),
+		GetCmdTraceExecute(),
+		GetCmdContractLimits(),
+		GetCmdListPausedContracts(),
+		GetCmdListPausedCodes(),
+		GetCmdListSchedules(),
+		GetCmdContractSchedules(),
 	)
 	return queryCmd
 }
@@ -140,6 +154,88 @@ func GetCmdListContractByCode() *cobra.Command {
 	return cmd
 }
 
+// GetCmdListContractsByCreator lists all contracts instantiated by the given creator
+func GetCmdListContractsByCreator() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "list-contracts-by-creator [creator]",
+		Short:   "List all contracts by creator",
+		Long:    "List all contracts instantiated by the given creator, in order of creation",
+		Aliases: []string{"lcc"},
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			_, err = sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(withPageKeyDecoded(cmd.Flags()))
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ContractsByCreator(
+				context.Background(),
+				&types.QueryContractsByCreatorRequest{
+					CreatorAddress: args[0],
+					Pagination:     pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "list contracts by creator")
+	return cmd
+}
+
+// GetCmdListContractsByAdmin lists all contracts whose admin is the given address
+func GetCmdListContractsByAdmin() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "list-contracts-by-admin [admin]",
+		Short:   "List all contracts by admin",
+		Long:    "List all contracts which the given admin can migrate, in order of contract address",
+		Aliases: []string{"lcadm"},
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			_, err = sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(withPageKeyDecoded(cmd.Flags()))
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ContractsByAdmin(
+				context.Background(),
+				&types.QueryContractsByAdminRequest{
+					AdminAddress: args[0],
+					Pagination:   pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "list contracts by admin")
+	return cmd
+}
+
 // GetCmdQueryCode returns the bytecode for a given contract
 func GetCmdQueryCode() *cobra.Command {
 	cmd := &cobra.Command{
@@ -255,6 +351,48 @@ func GetCmdGetContractInfo() *cobra.Command {
 	return cmd
 }
 
+// GetCmdBuildAddress builds the predictable address of a contract instantiated with instantiate2
+func GetCmdBuildAddress() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "build-address [code-hash] [creator-address] [salt-hex-encoded] [json_encoded_init_args (required when set as fixed)]",
+		Short:   "Build the predictable address of a contract",
+		Long:    "Build the address of a contract instantiated with instantiate2 given the code hash, the creator, the salt and, if fixed, the init msg",
+		Aliases: []string{"address"},
+		Args:    cobra.RangeArgs(3, 4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var initArgs []byte
+			if len(args) == 4 {
+				initArgs = []byte(args[3])
+				if !json.Valid(initArgs) {
+					return errors.New("init args must be json")
+				}
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.BuildAddress(
+				context.Background(),
+				&types.QueryBuildAddressRequest{
+					CodeHash:       args[0],
+					CreatorAddress: args[1],
+					Salt:           args[2],
+					InitArgs:       initArgs,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetCmdGetContractState dumps full internal state of a given contract
 func GetCmdGetContractState() *cobra.Command {
 	cmd := &cobra.Command{
@@ -402,6 +540,68 @@ func GetCmdGetContractStateSmart() *cobra.Command {
 	return cmd
 }
 
+// GetCmdTraceExecute simulates a contract execution and prints its gas trace
+func GetCmdTraceExecute() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "trace-execute [bech32_address] [json_encoded_send_args] --sender [address] --amount [coins,optional]",
+		Short: "Simulates a contract execution and prints the gas consumption per nested call, submessage and query",
+		Long: `Simulates a contract execution and prints the gas consumption per nested call, submessage and query.
+The execution runs on a branch of the state that is discarded, so nothing is committed. The gas limit is capped
+by the smart query gas limit of the node.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if _, err = sdk.AccAddressFromBech32(args[0]); err != nil {
+				return err
+			}
+			sender, err := cmd.Flags().GetString(flagSender)
+			if err != nil {
+				return fmt.Errorf("sender: %s", err)
+			}
+			if _, err = sdk.AccAddressFromBech32(sender); err != nil {
+				return fmt.Errorf("sender: %s", err)
+			}
+			amountStr, err := cmd.Flags().GetString(flagAmount)
+			if err != nil {
+				return fmt.Errorf("amount: %s", err)
+			}
+			amount, err := sdk.ParseCoinsNormalized(amountStr)
+			if err != nil {
+				return fmt.Errorf("amount: %s", err)
+			}
+			gasLimit, err := cmd.Flags().GetUint64(flagGasLimit)
+			if err != nil {
+				return fmt.Errorf("gas limit: %s", err)
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.TraceExecuteContract(
+				context.Background(),
+				&types.QueryTraceExecuteContractRequest{
+					Sender:   sender,
+					Contract: args[0],
+					Msg:      []byte(args[1]),
+					Funds:    amount,
+					GasLimit: gasLimit,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	cmd.Flags().String(flagSender, "", "Address that executes the contract")
+	cmd.Flags().String(flagAmount, "", "Coins to send to the contract along with command")
+	cmd.Flags().Uint64(flagGasLimit, 0, "Gas limit of the simulation, defaults to the smart query gas limit of the node")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetCmdGetContractHistory prints the code history for a given contract
 func GetCmdGetContractHistory() *cobra.Command {
 	cmd := &cobra.Command{
@@ -446,6 +646,119 @@ func GetCmdGetContractHistory() *cobra.Command {
 	return cmd
 }
 
+// GetCmdExportContract exports the code, info, history and state of a contract into a file
+func GetCmdExportContract() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export-contract [bech32_address] [output filename]",
+		Short: "Exports a contract with its code, history and state into a file",
+		Long: `Exports a contract with its code, info, history and state into a file.
+All data is read at the same block height. The file carries a checksum and can be imported into another chain
+with the import-contract governance proposal.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contractAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			var header metadata.MD
+			contractRes, err := types.NewQueryClient(clientCtx).ContractInfo(
+				context.Background(),
+				&types.QueryContractInfoRequest{
+					Address: args[0],
+				},
+				grpc.Header(&header),
+			)
+			if err != nil {
+				return err
+			}
+			// pin all following queries to the height of the first one
+			if heights := header.Get(grpctypes.GRPCBlockHeightHeader); len(heights) == 1 {
+				height, err := strconv.ParseInt(heights[0], 10, 64)
+				if err != nil {
+					return fmt.Errorf("failed to parse block height: %w", err)
+				}
+				clientCtx = clientCtx.WithHeight(height)
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			codeRes, err := queryClient.Code(
+				context.Background(),
+				&types.QueryCodeRequest{
+					CodeId: contractRes.CodeID,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			var history []types.ContractCodeHistoryEntry
+			pageReq := &query.PageRequest{}
+			for {
+				res, err := queryClient.ContractHistory(
+					context.Background(),
+					&types.QueryContractHistoryRequest{
+						Address:    args[0],
+						Pagination: pageReq,
+					},
+				)
+				if err != nil {
+					return err
+				}
+				history = append(history, res.Entries...)
+				if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+					break
+				}
+				pageReq = &query.PageRequest{Key: res.Pagination.NextKey}
+			}
+
+			var state []types.Model
+			pageReq = &query.PageRequest{}
+			for {
+				res, err := queryClient.AllContractState(
+					context.Background(),
+					&types.QueryAllContractStateRequest{
+						Address:    args[0],
+						Pagination: pageReq,
+					},
+				)
+				if err != nil {
+					return err
+				}
+				state = append(state, res.Models...)
+				if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+					break
+				}
+				pageReq = &query.PageRequest{Key: res.Pagination.NextKey}
+			}
+
+			codeInfo := types.CodeInfo{
+				CodeHash:          codeRes.DataHash,
+				Creator:           codeRes.Creator,
+				InstantiateConfig: codeRes.InstantiatePermission,
+			}
+			export, err := types.NewContractExport(codeInfo, codeRes.Data, contractAddr, contractRes.ContractInfo, history, state)
+			if err != nil {
+				return err
+			}
+			bz, err := clientCtx.Codec.MarshalJSON(&export)
+			if err != nil {
+				return err
+			}
+
+			fmt.Printf("Exporting contract to %s\n", args[1])
+			return ioutil.WriteFile(args[1], bz, 0600)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetCmdListPinnedCode lists all wasm code ids that are pinned
 func GetCmdListPinnedCode() *cobra.Command {
 	cmd := &cobra.Command{
@@ -481,6 +794,186 @@ func GetCmdListPinnedCode() *cobra.Command {
 	return cmd
 }
 
+// GetCmdContractLimits shows the circuit breaker state and the quota of a contract
+func GetCmdContractLimits() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "contract-limits [bech32_address]",
+		Short:   "Prints out the circuit breaker state and the quota usage of a contract",
+		Long:    "Prints out the circuit breaker state and the quota usage of a contract in the current block",
+		Aliases: []string{"limits"},
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			_, err = sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ContractLimits(
+				context.Background(),
+				&types.QueryContractLimitsRequest{
+					Address: args[0],
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdListPausedContracts lists all contracts paused by the circuit breaker
+func GetCmdListPausedContracts() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "paused-contracts",
+		Short: "List all contracts paused by the circuit breaker",
+		Long:  "List all contracts paused by the circuit breaker",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(withPageKeyDecoded(cmd.Flags()))
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.PausedContracts(
+				context.Background(),
+				&types.QueryPausedContractsRequest{
+					Pagination: pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "list paused contracts")
+	return cmd
+}
+
+// GetCmdListPausedCodes lists all code ids paused by the circuit breaker
+func GetCmdListPausedCodes() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "paused-codes",
+		Short: "List all code ids paused by the circuit breaker",
+		Long:  "List all code ids paused by the circuit breaker",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(withPageKeyDecoded(cmd.Flags()))
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.PausedCodes(
+				context.Background(),
+				&types.QueryPausedCodesRequest{
+					Pagination: pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "list paused codes")
+	return cmd
+}
+
+// GetCmdListSchedules lists all schedules calling the sudo entry point of contracts
+func GetCmdListSchedules() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "schedules",
+		Short: "List all schedules calling the sudo entry point of contracts",
+		Long:  "List all schedules calling the sudo entry point of contracts, in order of contract address and name",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(withPageKeyDecoded(cmd.Flags()))
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Schedules(
+				context.Background(),
+				&types.QuerySchedulesRequest{
+					Pagination: pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "list schedules")
+	return cmd
+}
+
+// GetCmdContractSchedules lists the schedules of a contract
+func GetCmdContractSchedules() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "contract-schedules [bech32_address]",
+		Short: "List the schedules of a contract",
+		Long:  "List the schedules calling the sudo entry point of a contract, in order of name",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			_, err = sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(withPageKeyDecoded(cmd.Flags()))
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ContractSchedules(
+				context.Background(),
+				&types.QueryContractSchedulesRequest{
+					Address:    args[0],
+					Pagination: pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "list contract schedules")
+	return cmd
+}
+
 type argumentDecoder struct {
 	// dec is the default decoder
 	dec                func(string) ([]byte, error)
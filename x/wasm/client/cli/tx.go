@@ -1,10 +1,12 @@
 package cli
 
 import (
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"io/ioutil"
 	"strconv"
+	"time"
 
 	"github.com/spf13/cobra"
 	flag "github.com/spf13/pflag"
@@ -14,6 +16,8 @@ import (
 	"github.com/line/lbm-sdk/client/tx"
 	sdk "github.com/line/lbm-sdk/types"
 	sdkerrors "github.com/line/lbm-sdk/types/errors"
+	"github.com/line/lbm-sdk/version"
+	"github.com/line/lbm-sdk/x/authz"
 	"github.com/line/lbm-sdk/x/wasm/ioutils"
 	"github.com/line/lbm-sdk/x/wasm/types"
 )
@@ -28,6 +32,17 @@ const (
 	flagInstantiateNobody      = "instantiate-nobody"
 	flagInstantiateByAddress   = "instantiate-only-address"
 	flagProposalType           = "type"
+	flagFixMsg                 = "fix-msg"
+	flagHex                    = "hex"
+	flagAllowedMsgKeys         = "allow-msg-keys"
+	flagAllowAllMsgs           = "allow-all-messages"
+	flagMaxCalls               = "max-calls"
+	flagMaxFunds               = "max-funds"
+	flagExpiration             = "expiration"
+	flagSender                 = "sender"
+	flagGasLimit               = "gas-limit"
+	flagContracts              = "contracts"
+	flagCodeIDs                = "code-ids"
 )
 
 // GetTxCmd returns the transaction commands for this module
@@ -42,11 +57,18 @@ func GetTxCmd() *cobra.Command {
 	txCmd.AddCommand(
 		StoreCodeCmd(),
 		InstantiateContractCmd(),
+		InstantiateContract2Cmd(),
 		StoreCodeAndInstantiateContractCmd(),
 		ExecuteContractCmd(),
 		MigrateContractCmd(),
 		UpdateContractAdminCmd(),
 		ClearContractAdminCmd(),
+		UpdateReceiverHookCmd(),
+		GrantAuthorizationCmd(),
+		UpdateCircuitBreakerCmd(),
+		SetContractQuotaCmd(),
+		RegisterScheduleCmd(),
+		DeregisterScheduleCmd(),
 	)
 	return txCmd
 }
@@ -232,6 +254,76 @@ func parseInstantiateArgs(rawCodeID, initMsg string, sender sdk.AccAddress, flag
 	return msg, nil
 }
 
+// InstantiateContract2Cmd will instantiate a contract from previously uploaded code at a predictable address.
+func InstantiateContract2Cmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "instantiate2 [code_id_int64] [json_encoded_init_args] [salt] --label [text] --admin [address,optional] --amount [coins,optional] " +
+			"--fix-msg [bool,optional]",
+		Short: "Instantiate a wasm contract with a predictable address",
+		Long: `Instantiate a wasm contract at an address derived from the code checksum, the sender and the salt.
+With --fix-msg the init msg is part of the address too. The salt is a string, or hex encoded with --hex.`,
+		Aliases: []string{"start2", "init2", "inst2", "i2"},
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			salt, err := parseSalt(args[2], cmd.Flags())
+			if err != nil {
+				return err
+			}
+			fixMsg, err := cmd.Flags().GetBool(flagFixMsg)
+			if err != nil {
+				return fmt.Errorf("fix msg: %s", err)
+			}
+			data, err := parseInstantiateArgs(args[0], args[1], clientCtx.GetFromAddress(), cmd.Flags())
+			if err != nil {
+				return err
+			}
+			msg := types.MsgInstantiateContract2{
+				Sender: data.Sender,
+				Admin:  data.Admin,
+				CodeID: data.CodeID,
+				Label:  data.Label,
+				Msg:    data.Msg,
+				Funds:  data.Funds,
+				Salt:   salt,
+				FixMsg: fixMsg,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	cmd.Flags().String(flagAmount, "", "Coins to send to the contract during instantiation")
+	cmd.Flags().String(flagLabel, "", "A human-readable name for this contract in lists")
+	cmd.Flags().String(flagAdmin, "", "Address of an admin")
+	cmd.Flags().Bool(flagNoAdmin, false, "You must set this explicitly if you don't want an admin")
+	cmd.Flags().Bool(flagFixMsg, false, "An optional flag to include the json_encoded_init_args for the predictable address generation mode")
+	cmd.Flags().Bool(flagHex, false, "The salt is hex encoded")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func parseSalt(rawSalt string, flags *flag.FlagSet) ([]byte, error) {
+	isHex, err := flags.GetBool(flagHex)
+	if err != nil {
+		return nil, fmt.Errorf("hex: %s", err)
+	}
+	if !isHex {
+		return []byte(rawSalt), nil
+	}
+	salt, err := hex.DecodeString(rawSalt)
+	if err != nil {
+		return nil, fmt.Errorf("salt: %s", err)
+	}
+	return salt, nil
+}
+
 // StoreCodeAndInstantiatecontractcmd will upload code and instantiate a contract using it
 func StoreCodeAndInstantiateContractCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -387,3 +479,117 @@ func parseExecuteArgs(contractAddr string, execMsg string, sender sdk.AccAddress
 		Msg:      []byte(execMsg),
 	}, nil
 }
+
+// GrantAuthorizationCmd grants an authorization to execute or migrate a contract on behalf of the granter
+func GrantAuthorizationCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant [grantee] [message_type=\"execution\"|\"migration\"] [contract_addr_bech32] --allow-all-messages|--allow-msg-keys [keys] --max-calls [n] --max-funds [coins]",
+		Short: "Grant authorization to interact with a contract on behalf of you",
+		Long: `Grant authorization to an address to execute or migrate a contract on behalf of you.
+The grant is limited by the number of calls with --max-calls, by the funds sent to the
+contract with --max-funds, or by both. The messages are restricted to the top-level JSON
+keys of --allow-msg-keys, or not restricted with --allow-all-messages.`,
+		Example: fmt.Sprintf("$ %s tx %s grant <grantee_addr> execution <contract_addr> --allow-all-messages --max-calls 1 --max-funds 100000link --from <granter>",
+			version.AppName, types.ModuleName),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			contract, err := sdk.AccAddressFromBech32(args[2])
+			if err != nil {
+				return err
+			}
+
+			grant, err := parseContractGrant(contract, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			var authorization authz.Authorization
+			switch args[1] {
+			case "execution":
+				authorization = types.NewContractExecutionAuthorization(*grant)
+			case "migration":
+				authorization = types.NewContractMigrationAuthorization(*grant)
+			default:
+				return fmt.Errorf("%s authorization type not supported", args[1])
+			}
+			if err := authorization.ValidateBasic(); err != nil {
+				return err
+			}
+
+			exp, err := cmd.Flags().GetInt64(flagExpiration)
+			if err != nil {
+				return err
+			}
+			msg, err := authz.NewMsgGrant(clientCtx.GetFromAddress(), grantee, authorization, time.Unix(exp, 0))
+			if err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().StringSlice(flagAllowedMsgKeys, []string{}, "Allowed msg keys")
+	cmd.Flags().Bool(flagAllowAllMsgs, false, "Allow all messages")
+	cmd.Flags().Uint64(flagMaxCalls, 0, "Maximal number of calls to the contract")
+	cmd.Flags().String(flagMaxFunds, "", "Maximal amount of tokens transferable to the contract")
+	cmd.Flags().Int64(flagExpiration, time.Now().AddDate(1, 0, 0).Unix(), "The Unix timestamp. Default is one year.")
+	return cmd
+}
+
+func parseContractGrant(contract sdk.AccAddress, flags *flag.FlagSet) (*types.ContractGrant, error) {
+	msgKeys, err := flags.GetStringSlice(flagAllowedMsgKeys)
+	if err != nil {
+		return nil, fmt.Errorf("allow msg keys: %s", err)
+	}
+	allowAllMsgs, err := flags.GetBool(flagAllowAllMsgs)
+	if err != nil {
+		return nil, fmt.Errorf("allow all messages: %s", err)
+	}
+	maxCalls, err := flags.GetUint64(flagMaxCalls)
+	if err != nil {
+		return nil, fmt.Errorf("max calls: %s", err)
+	}
+	maxFundsStr, err := flags.GetString(flagMaxFunds)
+	if err != nil {
+		return nil, fmt.Errorf("max funds: %s", err)
+	}
+	maxFunds, err := sdk.ParseCoinsNormalized(maxFundsStr)
+	if err != nil {
+		return nil, fmt.Errorf("max funds: %s", err)
+	}
+
+	var limit types.ContractAuthzLimitX
+	switch {
+	case maxCalls != 0 && !maxFunds.IsZero():
+		limit = types.NewCombinedLimit(maxCalls, maxFunds...)
+	case maxCalls != 0:
+		limit = types.NewMaxCallsLimit(maxCalls)
+	case !maxFunds.IsZero():
+		limit = types.NewMaxFundsLimit(maxFunds...)
+	default:
+		return nil, errors.New("--max-calls or --max-funds is required")
+	}
+
+	var filter types.ContractAuthzFilterX
+	switch {
+	case allowAllMsgs && len(msgKeys) != 0:
+		return nil, errors.New("cannot set both \"allow-all-messages\" and \"allow-msg-keys\"")
+	case allowAllMsgs:
+		filter = types.NewAllowAllMessagesFilter()
+	case len(msgKeys) != 0:
+		filter = types.NewAcceptedMessageKeysFilter(msgKeys...)
+	default:
+		return nil, errors.New("--allow-all-messages or --allow-msg-keys is required")
+	}
+
+	return types.NewContractGrant(contract, limit, filter)
+}
@@ -0,0 +1,69 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/line/lbm-sdk/types"
+	"github.com/line/lbm-sdk/x/wasm/types"
+)
+
+func TestParseContractGrant(t *testing.T) {
+	contract := sdk.AccAddress(make([]byte, types.ContractAddrLen))
+	specs := map[string]struct {
+		args      []string
+		expLimit  types.ContractAuthzLimitX
+		expFilter types.ContractAuthzFilterX
+		expErr    bool
+	}{
+		"max calls with all messages": {
+			args:      []string{"--max-calls=2", "--allow-all-messages"},
+			expLimit:  types.NewMaxCallsLimit(2),
+			expFilter: types.NewAllowAllMessagesFilter(),
+		},
+		"max funds with msg keys": {
+			args:      []string{"--max-funds=100link", "--allow-msg-keys=foo,bar"},
+			expLimit:  types.NewMaxFundsLimit(sdk.NewInt64Coin("link", 100)),
+			expFilter: types.NewAcceptedMessageKeysFilter("foo", "bar"),
+		},
+		"combined": {
+			args:      []string{"--max-calls=1", "--max-funds=100link", "--allow-all-messages"},
+			expLimit:  types.NewCombinedLimit(1, sdk.NewInt64Coin("link", 100)),
+			expFilter: types.NewAllowAllMessagesFilter(),
+		},
+		"no limit": {
+			args:   []string{"--allow-all-messages"},
+			expErr: true,
+		},
+		"no filter": {
+			args:   []string{"--max-calls=1"},
+			expErr: true,
+		},
+		"both filters": {
+			args:   []string{"--max-calls=1", "--allow-all-messages", "--allow-msg-keys=foo"},
+			expErr: true,
+		},
+		"invalid funds": {
+			args:   []string{"--max-funds=invalid", "--allow-all-messages"},
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			cmd := GrantAuthorizationCmd()
+			require.NoError(t, cmd.ParseFlags(spec.args))
+
+			grant, err := parseContractGrant(contract, cmd.Flags())
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, contract.String(), grant.Contract)
+			assert.Equal(t, spec.expLimit, grant.GetLimit())
+			assert.Equal(t, spec.expFilter, grant.GetFilter())
+		})
+	}
+}
@@ -18,4 +18,7 @@ var ProposalHandlers = []govclient.ProposalHandler{
 	govclient.NewProposalHandler(cli.ProposalPinCodesCmd, rest.PinCodeProposalHandler),
 	govclient.NewProposalHandler(cli.ProposalUnpinCodesCmd, rest.UnpinCodeProposalHandler),
 	govclient.NewProposalHandler(cli.ProposalUpdateInstantiateConfigCmd, rest.UpdateInstantiateConfigProposalHandler),
+	govclient.NewProposalHandler(cli.ProposalImportContractCmd, rest.ImportContractProposalHandler),
+	govclient.NewProposalHandler(cli.ProposalRegisterScheduleCmd, rest.RegisterScheduleProposalHandler),
+	govclient.NewProposalHandler(cli.ProposalDeregisterScheduleCmd, rest.DeregisterScheduleProposalHandler),
 }
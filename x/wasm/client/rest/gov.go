@@ -457,6 +457,138 @@ func UpdateInstantiateConfigProposalHandler(cliCtx client.Context) govrest.Propo
 	}
 }
 
+type ImportContractProposalJSONReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+	Title       string               `json:"title" yaml:"title"`
+	Description string               `json:"description" yaml:"description"`
+	Proposer    string               `json:"proposer" yaml:"proposer"`
+	Deposit     sdk.Coins            `json:"deposit" yaml:"deposit"`
+	Contract    types.ContractExport `json:"contract" yaml:"contract"`
+}
+
+func (s ImportContractProposalJSONReq) Content() govtypes.Content {
+	return &types.ImportContractProposal{
+		Title:       s.Title,
+		Description: s.Description,
+		Contract:    s.Contract,
+	}
+}
+func (s ImportContractProposalJSONReq) GetProposer() string {
+	return s.Proposer
+}
+func (s ImportContractProposalJSONReq) GetDeposit() sdk.Coins {
+	return s.Deposit
+}
+func (s ImportContractProposalJSONReq) GetBaseReq() rest.BaseReq {
+	return s.BaseReq
+}
+
+func ImportContractProposalHandler(cliCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "import_contract",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			var req ImportContractProposalJSONReq
+			if !rest.ReadRESTReq(w, r, cliCtx.LegacyAmino, &req) {
+				return
+			}
+			toStdTxResponse(cliCtx, w, req)
+		},
+	}
+}
+
+type RegisterScheduleProposalJSONReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+	Title       string    `json:"title" yaml:"title"`
+	Description string    `json:"description" yaml:"description"`
+	Proposer    string    `json:"proposer" yaml:"proposer"`
+	Deposit     sdk.Coins `json:"deposit" yaml:"deposit"`
+
+	Contract string          `json:"contract" yaml:"contract"`
+	Name     string          `json:"name" yaml:"name"`
+	Msg      json.RawMessage `json:"msg" yaml:"msg"`
+	Interval uint64          `json:"interval" yaml:"interval"`
+	GasLimit uint64          `json:"gas_limit" yaml:"gas_limit"`
+}
+
+func (s RegisterScheduleProposalJSONReq) Content() govtypes.Content {
+	return &types.RegisterScheduleProposal{
+		Title:       s.Title,
+		Description: s.Description,
+		Contract:    s.Contract,
+		Name:        s.Name,
+		Msg:         types.RawContractMessage(s.Msg),
+		Interval:    s.Interval,
+		GasLimit:    s.GasLimit,
+	}
+}
+func (s RegisterScheduleProposalJSONReq) GetProposer() string {
+	return s.Proposer
+}
+func (s RegisterScheduleProposalJSONReq) GetDeposit() sdk.Coins {
+	return s.Deposit
+}
+func (s RegisterScheduleProposalJSONReq) GetBaseReq() rest.BaseReq {
+	return s.BaseReq
+}
+
+func RegisterScheduleProposalHandler(cliCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "register_schedule",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			var req RegisterScheduleProposalJSONReq
+			if !rest.ReadRESTReq(w, r, cliCtx.LegacyAmino, &req) {
+				return
+			}
+			toStdTxResponse(cliCtx, w, req)
+		},
+	}
+}
+
+type DeregisterScheduleProposalJSONReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+	Title       string    `json:"title" yaml:"title"`
+	Description string    `json:"description" yaml:"description"`
+	Proposer    string    `json:"proposer" yaml:"proposer"`
+	Deposit     sdk.Coins `json:"deposit" yaml:"deposit"`
+
+	Contract string `json:"contract" yaml:"contract"`
+	Name     string `json:"name" yaml:"name"`
+}
+
+func (s DeregisterScheduleProposalJSONReq) Content() govtypes.Content {
+	return &types.DeregisterScheduleProposal{
+		Title:       s.Title,
+		Description: s.Description,
+		Contract:    s.Contract,
+		Name:        s.Name,
+	}
+}
+func (s DeregisterScheduleProposalJSONReq) GetProposer() string {
+	return s.Proposer
+}
+func (s DeregisterScheduleProposalJSONReq) GetDeposit() sdk.Coins {
+	return s.Deposit
+}
+func (s DeregisterScheduleProposalJSONReq) GetBaseReq() rest.BaseReq {
+	return s.BaseReq
+}
+
+func DeregisterScheduleProposalHandler(cliCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "deregister_schedule",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			var req DeregisterScheduleProposalJSONReq
+			if !rest.ReadRESTReq(w, r, cliCtx.LegacyAmino, &req) {
+				return
+			}
+			toStdTxResponse(cliCtx, w, req)
+		},
+	}
+}
+
 type wasmProposalData interface {
 	Content() govtypes.Content
 	GetProposer() string
@@ -14,8 +14,8 @@ import (
 )
 
 // NewHandler returns a handler for "wasm" type messages.
-func NewHandler(k types.ContractOpsKeeper) sdk.Handler {
-	msgServer := keeper.NewMsgServerImpl(k)
+func NewHandler(k types.ContractOpsKeeper, fk types.FoundationKeeper) sdk.Handler {
+	msgServer := keeper.NewMsgServerImpl(k, fk)
 
 	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
 		ctx = ctx.WithEventManager(sdk.NewEventManager())
@@ -29,6 +29,8 @@ func NewHandler(k types.ContractOpsKeeper) sdk.Handler {
 			res, err = msgServer.StoreCode(sdk.WrapSDKContext(ctx), msg)
 		case *MsgInstantiateContract:
 			res, err = msgServer.InstantiateContract(sdk.WrapSDKContext(ctx), msg)
+		case *MsgInstantiateContract2:
+			res, err = msgServer.InstantiateContract2(sdk.WrapSDKContext(ctx), msg)
 		case *MsgStoreCodeAndInstantiateContract:
 			res, err = msgServer.StoreCodeAndInstantiateContract(sdk.WrapSDKContext(ctx), msg)
 		case *MsgExecuteContract:
@@ -39,6 +41,16 @@ func NewHandler(k types.ContractOpsKeeper) sdk.Handler {
 			res, err = msgServer.UpdateAdmin(sdk.WrapSDKContext(ctx), msg)
 		case *MsgClearAdmin:
 			res, err = msgServer.ClearAdmin(sdk.WrapSDKContext(ctx), msg)
+		case *MsgUpdateCircuitBreaker:
+			res, err = msgServer.UpdateCircuitBreaker(sdk.WrapSDKContext(ctx), msg)
+		case *MsgUpdateContractQuota:
+			res, err = msgServer.UpdateContractQuota(sdk.WrapSDKContext(ctx), msg)
+		case *MsgRegisterSchedule:
+			res, err = msgServer.RegisterSchedule(sdk.WrapSDKContext(ctx), msg)
+		case *MsgDeregisterSchedule:
+			res, err = msgServer.DeregisterSchedule(sdk.WrapSDKContext(ctx), msg)
+		case *MsgUpdateReceiverHook:
+			res, err = msgServer.UpdateReceiverHook(sdk.WrapSDKContext(ctx), msg)
 		default:
 			errMsg := fmt.Sprintf("unrecognized wasm message type: %T", msg)
 			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, errMsg)
@@ -4,6 +4,7 @@ import (
 	"encoding/binary"
 
 	sdk "github.com/line/lbm-sdk/types"
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
 	"github.com/line/lbm-sdk/x/wasm/types"
 )
 
@@ -93,3 +94,38 @@ func (d LimitSimulationGasDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simu
 	}
 	return next(ctx, tx, simulate)
 }
+
+// ContractLimitsSource is a subset of the keeper to check the circuit breaker and the quota of a contract
+type ContractLimitsSource interface {
+	CheckContractLimits(ctx sdk.Context, contractAddress sdk.AccAddress) error
+}
+
+// CircuitBreakerDecorator ante decorator to reject contract executions early
+type CircuitBreakerDecorator struct {
+	source ContractLimitsSource
+}
+
+// NewCircuitBreakerDecorator constructor
+func NewCircuitBreakerDecorator(source ContractLimitsSource) *CircuitBreakerDecorator {
+	return &CircuitBreakerDecorator{source: source}
+}
+
+// AnteHandle rejects txs that execute contracts which are paused by the circuit breaker or whose quota
+// is used up in the current block, before any wasm gas is spent on them. The keeper enforces the same
+// limits on nested executions.
+func (d CircuitBreakerDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	for _, msg := range tx.GetMsgs() {
+		execMsg, ok := msg.(*types.MsgExecuteContract)
+		if !ok {
+			continue
+		}
+		contractAddr, err := sdk.AccAddressFromBech32(execMsg.Contract)
+		if err != nil {
+			return ctx, sdkerrors.Wrap(err, "contract")
+		}
+		if err := d.source.CheckContractLimits(ctx, contractAddr); err != nil {
+			return ctx, err
+		}
+	}
+	return next(ctx, tx, simulate)
+}
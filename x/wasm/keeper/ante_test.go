@@ -6,6 +6,7 @@ import (
 
 	"github.com/line/lbm-sdk/store"
 	sdk "github.com/line/lbm-sdk/types"
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
 	"github.com/line/lbm-sdk/x/wasm/keeper"
 	"github.com/line/lbm-sdk/x/wasm/types"
 	abci "github.com/line/ostracon/abci/types"
@@ -182,3 +183,69 @@ func consumeGasAnteHandler(gasToConsume sdk.Gas) sdk.AnteHandler {
 		return ctx, nil
 	}
 }
+
+func TestCircuitBreakerDecorator(t *testing.T) {
+	pausedAddr := sdk.AccAddress(make([]byte, types.ContractAddrLen))
+	otherAddr := sdk.AccAddress(append(make([]byte, types.ContractAddrLen-1), 1))
+	source := mockContractLimitsSource(func(ctx sdk.Context, contractAddress sdk.AccAddress) error {
+		if contractAddress.Equals(pausedAddr) {
+			return types.ErrContractPaused
+		}
+		return nil
+	})
+	execMsg := func(contract sdk.AccAddress) sdk.Msg {
+		return &types.MsgExecuteContract{Sender: otherAddr.String(), Contract: contract.String(), Msg: []byte("{}")}
+	}
+
+	specs := map[string]struct {
+		msgs   []sdk.Msg
+		expErr *sdkerrors.Error
+	}{
+		"no contract executions": {
+			msgs: []sdk.Msg{&types.MsgClearAdmin{Sender: otherAddr.String(), Contract: pausedAddr.String()}},
+		},
+		"execute active contract": {
+			msgs: []sdk.Msg{execMsg(otherAddr)},
+		},
+		"execute paused contract": {
+			msgs:   []sdk.Msg{execMsg(otherAddr), execMsg(pausedAddr)},
+			expErr: types.ErrContractPaused,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			var nextCalled bool
+			next := func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+				nextCalled = true
+				return ctx, nil
+			}
+			ante := keeper.NewCircuitBreakerDecorator(source)
+			_, gotErr := ante.AnteHandle(sdk.Context{}, mockTx{msgs: spec.msgs}, false, next)
+			if spec.expErr != nil {
+				assert.True(t, spec.expErr.Is(gotErr), "got %+v", gotErr)
+				assert.False(t, nextCalled)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.True(t, nextCalled)
+		})
+	}
+}
+
+type mockContractLimitsSource func(ctx sdk.Context, contractAddress sdk.AccAddress) error
+
+func (m mockContractLimitsSource) CheckContractLimits(ctx sdk.Context, contractAddress sdk.AccAddress) error {
+	return m(ctx, contractAddress)
+}
+
+type mockTx struct {
+	msgs []sdk.Msg
+}
+
+func (m mockTx) GetMsgs() []sdk.Msg {
+	return m.msgs
+}
+
+func (m mockTx) ValidateBasic() error {
+	return nil
+}
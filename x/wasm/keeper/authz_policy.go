@@ -10,6 +10,7 @@ type AuthorizationPolicy interface {
 	CanInstantiateContract(c types.AccessConfig, actor sdk.AccAddress) bool
 	CanModifyContract(admin, actor sdk.AccAddress) bool
 	CanUpdateContractStatus() bool
+	CanImportContract() bool
 }
 
 type DefaultAuthorizationPolicy struct {
@@ -31,6 +32,10 @@ func (p DefaultAuthorizationPolicy) CanUpdateContractStatus() bool {
 	return false
 }
 
+func (p DefaultAuthorizationPolicy) CanImportContract() bool {
+	return false
+}
+
 // GovAuthorizationPolicy is for the gov handler(proposal_handler.go) authorities
 type GovAuthorizationPolicy struct {
 }
@@ -54,3 +59,8 @@ func (p GovAuthorizationPolicy) CanUpdateContractStatus() bool {
 	// The gov handler can update contract status
 	return true
 }
+
+func (p GovAuthorizationPolicy) CanImportContract() bool {
+	// The gov handler can import an exported contract
+	return true
+}
@@ -0,0 +1,224 @@
+package keeper
+
+import (
+	"strconv"
+
+	sdk "github.com/line/lbm-sdk/types"
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
+	"github.com/line/lbm-sdk/x/wasm/types"
+)
+
+// setContractPaused pauses or resumes the executions of a contract
+func (k Keeper) setContractPaused(ctx sdk.Context, contractAddress sdk.AccAddress, paused bool) error {
+	if !k.HasContractInfo(ctx, contractAddress) {
+		return sdkerrors.Wrap(types.ErrNotFound, "contract")
+	}
+	store := ctx.KVStore(k.storeKey)
+	if paused {
+		// store 1 byte to not run into `nil` debugging issues
+		store.Set(types.GetPausedContractKey(contractAddress), []byte{1})
+	} else {
+		store.Delete(types.GetPausedContractKey(contractAddress))
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeUpdateCircuitBreaker,
+		sdk.NewAttribute(types.AttributeKeyContractAddr, contractAddress.String()),
+		sdk.NewAttribute(types.AttributeKeyPaused, strconv.FormatBool(paused)),
+	))
+	return nil
+}
+
+// setCodePaused pauses or resumes the executions of all contracts of a code
+func (k Keeper) setCodePaused(ctx sdk.Context, codeID uint64, paused bool) error {
+	if k.GetCodeInfo(ctx, codeID) == nil {
+		return sdkerrors.Wrap(types.ErrNotFound, "code info")
+	}
+	store := ctx.KVStore(k.storeKey)
+	if paused {
+		store.Set(types.GetPausedCodeKey(codeID), []byte{1})
+	} else {
+		store.Delete(types.GetPausedCodeKey(codeID))
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeUpdateCircuitBreaker,
+		sdk.NewAttribute(types.AttributeKeyCodeID, strconv.FormatUint(codeID, 10)),
+		sdk.NewAttribute(types.AttributeKeyPaused, strconv.FormatBool(paused)),
+	))
+	return nil
+}
+
+// updateCircuitBreaker pauses or resumes the executions of the given contracts and codes
+func (k Keeper) updateCircuitBreaker(ctx sdk.Context, contracts []sdk.AccAddress, codeIDs []uint64, paused bool) error {
+	for _, contractAddress := range contracts {
+		if err := k.setContractPaused(ctx, contractAddress, paused); err != nil {
+			return sdkerrors.Wrap(err, contractAddress.String())
+		}
+	}
+	for _, codeID := range codeIDs {
+		if err := k.setCodePaused(ctx, codeID, paused); err != nil {
+			return sdkerrors.Wrapf(err, "code %d", codeID)
+		}
+	}
+	return nil
+}
+
+// limitsStore returns the store to read the limits of contract executions with. The reads are done on
+// every execution on behalf of the chain, also when no limits are set, so they are free not to raise the
+// gas cost of all contract calls. They are a fixed number of small reads, so they can not be abused.
+// The usage of a quota is written to the store of the tx instead, charging the gas of the tx.
+func (k Keeper) limitsStore(ctx sdk.Context) sdk.KVStore {
+	return ctx.WithGasMeter(sdk.NewInfiniteGasMeter()).KVStore(k.storeKey)
+}
+
+// IsPausedContract returns true when the contract is paused by the circuit breaker
+func (k Keeper) IsPausedContract(ctx sdk.Context, contractAddress sdk.AccAddress) bool {
+	store := k.limitsStore(ctx)
+	return store.Has(types.GetPausedContractKey(contractAddress))
+}
+
+// IsPausedCode returns true when the code is paused by the circuit breaker
+func (k Keeper) IsPausedCode(ctx sdk.Context, codeID uint64) bool {
+	store := k.limitsStore(ctx)
+	return store.Has(types.GetPausedCodeKey(codeID))
+}
+
+// assertNotPaused returns an error when the contract or its code is paused by the circuit breaker
+func (k Keeper) assertNotPaused(ctx sdk.Context, contractAddress sdk.AccAddress, codeID uint64) error {
+	if k.IsPausedContract(ctx, contractAddress) {
+		return sdkerrors.Wrap(types.ErrContractPaused, contractAddress.String())
+	}
+	if k.IsPausedCode(ctx, codeID) {
+		return sdkerrors.Wrapf(types.ErrContractPaused, "code %d", codeID)
+	}
+	return nil
+}
+
+// setContractQuota sets the per block execution quota of a contract. An empty quota removes it.
+func (k Keeper) setContractQuota(ctx sdk.Context, contractAddress sdk.AccAddress, quota types.ContractQuota) error {
+	if !k.HasContractInfo(ctx, contractAddress) {
+		return sdkerrors.Wrap(types.ErrNotFound, "contract")
+	}
+	store := ctx.KVStore(k.storeKey)
+	if quota.IsEmpty() {
+		store.Delete(types.GetContractQuotaKey(contractAddress))
+		store.Delete(types.GetContractQuotaUsageKey(contractAddress))
+	} else {
+		store.Set(types.GetContractQuotaKey(contractAddress), k.cdc.MustMarshal(&quota))
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeUpdateContractQuota,
+		sdk.NewAttribute(types.AttributeKeyContractAddr, contractAddress.String()),
+		sdk.NewAttribute(types.AttributeKeyMaxExecutions, strconv.FormatUint(quota.MaxExecutionsPerBlock, 10)),
+		sdk.NewAttribute(types.AttributeKeyMaxGas, strconv.FormatUint(quota.MaxGasPerBlock, 10)),
+	))
+	return nil
+}
+
+// GetContractQuota returns the per block execution quota of a contract or nil when it has none
+func (k Keeper) GetContractQuota(ctx sdk.Context, contractAddress sdk.AccAddress) *types.ContractQuota {
+	store := k.limitsStore(ctx)
+	bz := store.Get(types.GetContractQuotaKey(contractAddress))
+	if bz == nil {
+		return nil
+	}
+	var quota types.ContractQuota
+	k.cdc.MustUnmarshal(bz, &quota)
+	return &quota
+}
+
+// GetContractQuotaUsage returns the number of executions and the gas consumed by them for a contract
+// with a quota in the current block.
+func (k Keeper) GetContractQuotaUsage(ctx sdk.Context, contractAddress sdk.AccAddress) (executions uint64, gasUsed sdk.Gas) {
+	store := k.limitsStore(ctx)
+	bz := store.Get(types.GetContractQuotaUsageKey(contractAddress))
+	if bz == nil {
+		return 0, 0
+	}
+	height, executions, gasUsed := decodeQuotaUsage(bz)
+	if height != ctx.BlockHeight() {
+		// the usage of a former block
+		return 0, 0
+	}
+	return executions, gasUsed
+}
+
+// checkContractQuota returns an error when the quota of the contract is used up in the current block
+func (k Keeper) checkContractQuota(ctx sdk.Context, contractAddress sdk.AccAddress) error {
+	quota := k.GetContractQuota(ctx, contractAddress)
+	if quota == nil {
+		return nil
+	}
+	executions, gasUsed := k.GetContractQuotaUsage(ctx, contractAddress)
+	return assertWithinQuota(*quota, executions, gasUsed, true)
+}
+
+// chargeContractQuota counts an execution and the gas it consumed against the quota of the contract.
+// The usage is stored with the current height, so that it does not need to be reset for new blocks.
+// As it is stored in the state it is reverted together with failed executions.
+func (k Keeper) chargeContractQuota(ctx sdk.Context, contractAddress sdk.AccAddress, gas sdk.Gas) error {
+	quota := k.GetContractQuota(ctx, contractAddress)
+	if quota == nil {
+		return nil
+	}
+	executions, gasUsed := k.GetContractQuotaUsage(ctx, contractAddress)
+	executions++
+	gasUsed += gas
+	if err := assertWithinQuota(*quota, executions, gasUsed, false); err != nil {
+		return err
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetContractQuotaUsageKey(contractAddress), encodeQuotaUsage(ctx.BlockHeight(), executions, gasUsed))
+	return nil
+}
+
+// CheckContractLimits returns an error when the contract can not be executed in the current block as it is paused
+// by the circuit breaker or its quota is used up. The quota is not checked in CheckTx as the usage there belongs to
+// the last committed block rather than to the block the tx goes into.
+func (k Keeper) CheckContractLimits(ctx sdk.Context, contractAddress sdk.AccAddress) error {
+	contractInfo := k.GetContractInfo(ctx, contractAddress)
+	if contractInfo == nil {
+		return sdkerrors.Wrap(types.ErrNotFound, "contract")
+	}
+	if err := k.assertNotPaused(ctx, contractAddress, contractInfo.CodeID); err != nil {
+		return err
+	}
+	if ctx.IsCheckTx() {
+		return nil
+	}
+	return k.checkContractQuota(ctx, contractAddress)
+}
+
+// assertWithinQuota checks the usage against the quota. When exhausted is set, reaching a limit is
+// already an error as no further execution fits in.
+func assertWithinQuota(quota types.ContractQuota, executions uint64, gasUsed sdk.Gas, exhausted bool) error {
+	exceeds := func(used, max uint64) bool {
+		if max == 0 {
+			return false
+		}
+		if exhausted {
+			return used >= max
+		}
+		return used > max
+	}
+	if exceeds(executions, quota.MaxExecutionsPerBlock) {
+		return sdkerrors.Wrapf(types.ErrQuotaExceeded, "max %d executions per block", quota.MaxExecutionsPerBlock)
+	}
+	if exceeds(gasUsed, quota.MaxGasPerBlock) {
+		return sdkerrors.Wrapf(types.ErrQuotaExceeded, "max %d gas per block", quota.MaxGasPerBlock)
+	}
+	return nil
+}
+
+func encodeQuotaUsage(height int64, executions uint64, gasUsed sdk.Gas) []byte {
+	r := make([]byte, 0, 24)
+	r = append(r, sdk.Uint64ToBigEndian(uint64(height))...)
+	r = append(r, sdk.Uint64ToBigEndian(executions)...)
+	return append(r, sdk.Uint64ToBigEndian(gasUsed)...)
+}
+
+func decodeQuotaUsage(bz []byte) (int64, uint64, sdk.Gas) {
+	return int64(sdk.BigEndianToUint64(bz[0:8])), sdk.BigEndianToUint64(bz[8:16]), sdk.BigEndianToUint64(bz[16:24])
+}
@@ -0,0 +1,270 @@
+package keeper
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/line/lbm-sdk/types"
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
+	"github.com/line/lbm-sdk/x/foundation"
+	"github.com/line/lbm-sdk/x/wasm/types"
+)
+
+func instantiateReflectContract(t *testing.T, ctx sdk.Context, keepers TestKeepers, creator sdk.AccAddress) (uint64, sdk.AccAddress) {
+	reflectCode, err := ioutil.ReadFile("./testdata/reflect.wasm")
+	require.NoError(t, err)
+	codeID, err := keepers.ContractKeeper.Create(ctx, creator, reflectCode, nil)
+	require.NoError(t, err)
+	contractAddr, _, err := keepers.ContractKeeper.Instantiate(ctx, codeID, creator, nil, []byte("{}"), "reflect", nil)
+	require.NoError(t, err)
+	return codeID, contractAddr
+}
+
+// reflectNoopMsg keeps the owner of the reflect contract
+func reflectNoopMsg(t *testing.T, owner sdk.AccAddress) []byte {
+	bz, err := json.Marshal(ReflectHandleMsg{Change: &ownerPayload{Owner: owner}})
+	require.NoError(t, err)
+	return bz
+}
+
+func TestCircuitBreaker(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, ReflectFeatures, nil, nil)
+	creator := keepers.Faucet.NewFundedAccount(ctx, sdk.NewInt64Coin("denom", 100000))
+	codeID, contractAddr := instantiateReflectContract(t, ctx, keepers, creator)
+	noopMsg := reflectNoopMsg(t, creator)
+	_, _, otherAddr := keyPubAddr()
+
+	specs := map[string]struct {
+		contracts []sdk.AccAddress
+		codeIDs   []uint64
+		expErr    *sdkerrors.Error
+	}{
+		"pause contract": {
+			contracts: []sdk.AccAddress{contractAddr},
+		},
+		"pause code": {
+			codeIDs: []uint64{codeID},
+		},
+		"unknown contract": {
+			contracts: []sdk.AccAddress{otherAddr},
+			expErr:    types.ErrNotFound,
+		},
+		"unknown code": {
+			codeIDs: []uint64{codeID + 1},
+			expErr:  types.ErrNotFound,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			ctx, _ := ctx.CacheContext()
+			err := keepers.ContractKeeper.UpdateCircuitBreaker(ctx, spec.contracts, spec.codeIDs, true)
+			require.True(t, spec.expErr.Is(err), "got %+v", err)
+			if spec.expErr != nil {
+				return
+			}
+
+			_, err = keepers.ContractKeeper.Execute(ctx, contractAddr, creator, noopMsg, nil)
+			assert.True(t, types.ErrContractPaused.Is(err), "got %+v", err)
+			if len(spec.codeIDs) != 0 {
+				_, _, err = keepers.ContractKeeper.Instantiate(ctx, codeID, creator, nil, []byte("{}"), "paused", nil)
+				assert.True(t, types.ErrContractPaused.Is(err), "got %+v", err)
+			}
+
+			// and resume
+			require.NoError(t, keepers.ContractKeeper.UpdateCircuitBreaker(ctx, spec.contracts, spec.codeIDs, false))
+			_, err = keepers.ContractKeeper.Execute(ctx, contractAddr, creator, noopMsg, nil)
+			assert.NoError(t, err)
+			assert.False(t, keepers.WasmKeeper.IsPausedContract(ctx, contractAddr))
+			assert.False(t, keepers.WasmKeeper.IsPausedCode(ctx, codeID))
+		})
+	}
+}
+
+func TestContractQuota(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, ReflectFeatures, nil, nil)
+	creator := keepers.Faucet.NewFundedAccount(ctx, sdk.NewInt64Coin("denom", 100000))
+	_, contractAddr := instantiateReflectContract(t, ctx, keepers, creator)
+	noopMsg := reflectNoopMsg(t, creator)
+
+	// gas charged for a single execution to set the gas quota with
+	gasCtx, _ := ctx.CacheContext()
+	require.NoError(t, keepers.ContractKeeper.SetContractQuota(gasCtx, contractAddr, types.ContractQuota{MaxExecutionsPerBlock: 1}))
+	_, err := keepers.ContractKeeper.Execute(gasCtx, contractAddr, creator, noopMsg, nil)
+	require.NoError(t, err)
+	_, execGas := keepers.WasmKeeper.GetContractQuotaUsage(gasCtx, contractAddr)
+	require.NotZero(t, execGas)
+
+	specs := map[string]struct {
+		quota         types.ContractQuota
+		expExecutions int
+		// the gas of the next execution is not known in advance
+		expCheckPasses bool
+	}{
+		"max executions": {
+			quota:         types.ContractQuota{MaxExecutionsPerBlock: 2},
+			expExecutions: 2,
+		},
+		"max gas": {
+			quota:          types.ContractQuota{MaxGasPerBlock: 3*execGas - 1},
+			expExecutions:  2,
+			expCheckPasses: true,
+		},
+		"both": {
+			quota:         types.ContractQuota{MaxExecutionsPerBlock: 1, MaxGasPerBlock: 10 * execGas},
+			expExecutions: 1,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			ctx, _ := ctx.CacheContext()
+			require.NoError(t, keepers.ContractKeeper.SetContractQuota(ctx, contractAddr, spec.quota))
+			assert.Equal(t, &spec.quota, keepers.WasmKeeper.GetContractQuota(ctx, contractAddr))
+
+			for i := 0; i < spec.expExecutions; i++ {
+				_, err := keepers.ContractKeeper.Execute(ctx, contractAddr, creator, noopMsg, nil)
+				require.NoError(t, err)
+			}
+			executions, gasUsed := keepers.WasmKeeper.GetContractQuotaUsage(ctx, contractAddr)
+			assert.Equal(t, uint64(spec.expExecutions), executions)
+			assert.NotZero(t, gasUsed)
+
+			_, err := keepers.ContractKeeper.Execute(ctx, contractAddr, creator, noopMsg, nil)
+			assert.True(t, types.ErrQuotaExceeded.Is(err), "got %+v", err)
+			err = keepers.WasmKeeper.CheckContractLimits(ctx, contractAddr)
+			if spec.expCheckPasses {
+				assert.NoError(t, err)
+			} else {
+				assert.True(t, types.ErrQuotaExceeded.Is(err), "got %+v", err)
+			}
+
+			// the quota is available again in the next block
+			nextCtx := ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+			executions, _ = keepers.WasmKeeper.GetContractQuotaUsage(nextCtx, contractAddr)
+			assert.Zero(t, executions)
+			_, err = keepers.ContractKeeper.Execute(nextCtx, contractAddr, creator, noopMsg, nil)
+			assert.NoError(t, err)
+
+			// and removed with an empty quota
+			require.NoError(t, keepers.ContractKeeper.SetContractQuota(ctx, contractAddr, types.ContractQuota{}))
+			assert.Nil(t, keepers.WasmKeeper.GetContractQuota(ctx, contractAddr))
+			_, err = keepers.ContractKeeper.Execute(ctx, contractAddr, creator, noopMsg, nil)
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestChargeContractQuotaGas(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, ReflectFeatures, nil, nil)
+	creator := keepers.Faucet.NewFundedAccount(ctx, sdk.NewInt64Coin("denom", 100000))
+	_, contractAddr := instantiateReflectContract(t, ctx, keepers, creator)
+
+	// the limits are read for free
+	gasCtx := ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+	require.NoError(t, keepers.WasmKeeper.chargeContractQuota(gasCtx, contractAddr, 100))
+	assert.Equal(t, sdk.Gas(0), gasCtx.GasMeter().GasConsumed())
+
+	// while the usage of a quota is written with the gas of the tx
+	require.NoError(t, keepers.ContractKeeper.SetContractQuota(ctx, contractAddr, types.ContractQuota{MaxExecutionsPerBlock: 1}))
+	require.NoError(t, keepers.WasmKeeper.chargeContractQuota(gasCtx, contractAddr, 100))
+	assert.NotZero(t, gasCtx.GasMeter().GasConsumed())
+}
+
+func TestCheckContractLimitsInCheckTx(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, ReflectFeatures, nil, nil)
+	creator := keepers.Faucet.NewFundedAccount(ctx, sdk.NewInt64Coin("denom", 100000))
+	_, contractAddr := instantiateReflectContract(t, ctx, keepers, creator)
+	noopMsg := reflectNoopMsg(t, creator)
+
+	require.NoError(t, keepers.ContractKeeper.SetContractQuota(ctx, contractAddr, types.ContractQuota{MaxExecutionsPerBlock: 1}))
+	_, err := keepers.ContractKeeper.Execute(ctx, contractAddr, creator, noopMsg, nil)
+	require.NoError(t, err)
+
+	// the usage of the last committed block is not checked
+	assert.NoError(t, keepers.WasmKeeper.CheckContractLimits(ctx.WithIsCheckTx(true), contractAddr))
+	// but the circuit breaker is
+	require.NoError(t, keepers.ContractKeeper.UpdateCircuitBreaker(ctx, []sdk.AccAddress{contractAddr}, nil, true))
+	err = keepers.WasmKeeper.CheckContractLimits(ctx.WithIsCheckTx(true), contractAddr)
+	assert.True(t, types.ErrContractPaused.Is(err), "got %+v", err)
+}
+
+func TestMsgUpdateCircuitBreaker(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, ReflectFeatures, nil, nil)
+	creator := keepers.Faucet.NewFundedAccount(ctx, sdk.NewInt64Coin("denom", 100000))
+	_, contractAddr := instantiateReflectContract(t, ctx, keepers, creator)
+	_, _, operatorAddr := keyPubAddr()
+
+	specs := map[string]struct {
+		acceptErr error
+		expErr    bool
+	}{
+		"authorized": {},
+		"unauthorized": {
+			acceptErr: sdkerrors.ErrUnauthorized,
+			expErr:    true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			ctx, _ := ctx.CacheContext()
+			var gotGranter string
+			var gotGrantee sdk.AccAddress
+			fk := keepers.FoundationKeeper
+			fk.AcceptFn = func(ctx sdk.Context, granter string, grantee sdk.AccAddress, msg sdk.Msg) error {
+				gotGranter, gotGrantee = granter, grantee
+				return spec.acceptErr
+			}
+			msgServer := NewMsgServerImpl(keepers.ContractKeeper, fk)
+
+			_, err := msgServer.UpdateCircuitBreaker(sdk.WrapSDKContext(ctx), &types.MsgUpdateCircuitBreaker{
+				Operator:          operatorAddr.String(),
+				ContractAddresses: []string{contractAddr.String()},
+				Paused:            true,
+			})
+			assert.Equal(t, foundation.ModuleName, gotGranter)
+			assert.Equal(t, operatorAddr, gotGrantee)
+			if spec.expErr {
+				require.Error(t, err)
+				assert.False(t, keepers.WasmKeeper.IsPausedContract(ctx, contractAddr))
+				return
+			}
+			require.NoError(t, err)
+			assert.True(t, keepers.WasmKeeper.IsPausedContract(ctx, contractAddr))
+
+			_, err = msgServer.UpdateContractQuota(sdk.WrapSDKContext(ctx), &types.MsgUpdateContractQuota{
+				Operator: operatorAddr.String(),
+				Contract: contractAddr.String(),
+				Quota:    types.ContractQuota{MaxExecutionsPerBlock: 5},
+			})
+			require.NoError(t, err)
+			assert.Equal(t, &types.ContractQuota{MaxExecutionsPerBlock: 5}, keepers.WasmKeeper.GetContractQuota(ctx, contractAddr))
+		})
+	}
+}
+
+func TestCircuitBreakerGenesis(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, ReflectFeatures, nil, nil)
+	creator := keepers.Faucet.NewFundedAccount(ctx, sdk.NewInt64Coin("denom", 100000))
+	codeID, contractAddr := instantiateReflectContract(t, ctx, keepers, creator)
+
+	quota := types.ContractQuota{MaxExecutionsPerBlock: 3, MaxGasPerBlock: 1_000_000}
+	require.NoError(t, keepers.ContractKeeper.UpdateCircuitBreaker(ctx, []sdk.AccAddress{contractAddr}, []uint64{codeID}, true))
+	require.NoError(t, keepers.ContractKeeper.SetContractQuota(ctx, contractAddr, quota))
+
+	genState := ExportGenesis(ctx, keepers.WasmKeeper)
+	require.Len(t, genState.Codes, 1)
+	assert.True(t, genState.Codes[0].Paused)
+	require.Len(t, genState.Contracts, 1)
+	assert.True(t, genState.Contracts[0].Paused)
+	assert.Equal(t, &quota, genState.Contracts[0].Quota)
+
+	dstCtx, dstKeepers := CreateTestInput(t, false, ReflectFeatures, nil, nil)
+	_, err := InitGenesis(dstCtx, dstKeepers.WasmKeeper, *genState, dstKeepers.StakingKeeper, TestHandler(dstKeepers.ContractKeeper))
+	require.NoError(t, err)
+	assert.True(t, dstKeepers.WasmKeeper.IsPausedContract(dstCtx, contractAddr))
+	assert.True(t, dstKeepers.WasmKeeper.IsPausedCode(dstCtx, codeID))
+	assert.Equal(t, &quota, dstKeepers.WasmKeeper.GetContractQuota(dstCtx, contractAddr))
+}
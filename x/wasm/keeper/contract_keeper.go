@@ -10,7 +10,8 @@ var _ types.ContractOpsKeeper = PermissionedKeeper{}
 // decoratedKeeper contains a subset of the wasm keeper that are already or can be guarded by an authorization policy in the future
 type decoratedKeeper interface {
 	create(ctx sdk.Context, creator sdk.AccAddress, wasmCode []byte, instantiateAccess *types.AccessConfig, authZ AuthorizationPolicy) (codeID uint64, err error)
-	instantiate(ctx sdk.Context, codeID uint64, creator, admin sdk.AccAddress, initMsg []byte, label string, deposit sdk.Coins, authZ AuthorizationPolicy) (sdk.AccAddress, []byte, error)
+	instantiate(ctx sdk.Context, codeID uint64, creator, admin sdk.AccAddress, initMsg []byte, label string, deposit sdk.Coins, addressGenerator AddressGenerator, authZ AuthorizationPolicy) (sdk.AccAddress, []byte, error)
+	ClassicAddressGenerator() AddressGenerator
 	migrate(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress, newCodeID uint64, msg []byte, authZ AuthorizationPolicy) ([]byte, error)
 	setContractAdmin(ctx sdk.Context, contractAddress, caller, newAdmin sdk.AccAddress, authZ AuthorizationPolicy) error
 	pinCode(ctx sdk.Context, codeID uint64) error
@@ -20,6 +21,12 @@ type decoratedKeeper interface {
 	setContractInfoExtension(ctx sdk.Context, contract sdk.AccAddress, extra types.ContractInfoExtension) error
 	setContractStatus(ctx sdk.Context, contract sdk.AccAddress, caller sdk.AccAddress, status types.ContractStatus, authZ AuthorizationPolicy) error
 	setAccessConfig(ctx sdk.Context, codeID uint64, config types.AccessConfig) error
+	importContractExport(ctx sdk.Context, export types.ContractExport, authZ AuthorizationPolicy) (uint64, error)
+	updateCircuitBreaker(ctx sdk.Context, contracts []sdk.AccAddress, codeIDs []uint64, paused bool) error
+	setContractQuota(ctx sdk.Context, contractAddress sdk.AccAddress, quota types.ContractQuota) error
+	registerSchedule(ctx sdk.Context, contractAddress sdk.AccAddress, name string, msg []byte, interval, gasLimit uint64) error
+	deregisterSchedule(ctx sdk.Context, contractAddress sdk.AccAddress, name string) error
+	setReceiverHook(ctx sdk.Context, contractAddress, caller sdk.AccAddress, enabled bool, authZ AuthorizationPolicy) error
 }
 
 type PermissionedKeeper struct {
@@ -44,7 +51,13 @@ func (p PermissionedKeeper) Create(ctx sdk.Context, creator sdk.AccAddress, wasm
 }
 
 func (p PermissionedKeeper) Instantiate(ctx sdk.Context, codeID uint64, creator, admin sdk.AccAddress, initMsg []byte, label string, deposit sdk.Coins) (sdk.AccAddress, []byte, error) {
-	return p.nested.instantiate(ctx, codeID, creator, admin, initMsg, label, deposit, p.authZPolicy)
+	return p.nested.instantiate(ctx, codeID, creator, admin, initMsg, label, deposit, p.nested.ClassicAddressGenerator(), p.authZPolicy)
+}
+
+// Instantiate2 creates an instance of a WASM contract at an address predictable from the
+// code checksum, the creator, the salt and, if fixMsg is set, the init msg.
+func (p PermissionedKeeper) Instantiate2(ctx sdk.Context, codeID uint64, creator, admin sdk.AccAddress, initMsg []byte, label string, deposit sdk.Coins, salt []byte, fixMsg bool) (sdk.AccAddress, []byte, error) {
+	return p.nested.instantiate(ctx, codeID, creator, admin, initMsg, label, deposit, PredictableAddressGenerator(creator, salt, initMsg, fixMsg), p.authZPolicy)
 }
 
 func (p PermissionedKeeper) Execute(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress, msg []byte, coins sdk.Coins) ([]byte, error) {
@@ -88,3 +101,31 @@ func (p PermissionedKeeper) UpdateContractStatus(ctx sdk.Context, contract sdk.A
 func (p PermissionedKeeper) SetAccessConfig(ctx sdk.Context, codeID uint64, config types.AccessConfig) error {
 	return p.nested.setAccessConfig(ctx, codeID, config)
 }
+
+// ImportContract stores the code of an exported contract under a new code id and restores the contract at its address
+func (p PermissionedKeeper) ImportContract(ctx sdk.Context, export types.ContractExport) (uint64, error) {
+	return p.nested.importContractExport(ctx, export, p.authZPolicy)
+}
+
+// UpdateCircuitBreaker pauses or resumes the executions of the given contracts and codes
+func (p PermissionedKeeper) UpdateCircuitBreaker(ctx sdk.Context, contracts []sdk.AccAddress, codeIDs []uint64, paused bool) error {
+	return p.nested.updateCircuitBreaker(ctx, contracts, codeIDs, paused)
+}
+
+// SetContractQuota sets the per block execution quota of a contract
+func (p PermissionedKeeper) SetContractQuota(ctx sdk.Context, contractAddress sdk.AccAddress, quota types.ContractQuota) error {
+	return p.nested.setContractQuota(ctx, contractAddress, quota)
+}
+
+func (p PermissionedKeeper) RegisterSchedule(ctx sdk.Context, contractAddress sdk.AccAddress, name string, msg []byte, interval, gasLimit uint64) error {
+	return p.nested.registerSchedule(ctx, contractAddress, name, msg, interval, gasLimit)
+}
+
+func (p PermissionedKeeper) DeregisterSchedule(ctx sdk.Context, contractAddress sdk.AccAddress, name string) error {
+	return p.nested.deregisterSchedule(ctx, contractAddress, name)
+}
+
+// UpdateReceiverHook enables or disables the notification of a contract on receiving x/token tokens or x/collection coins
+func (p PermissionedKeeper) UpdateReceiverHook(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress, enabled bool) error {
+	return p.nested.setReceiverHook(ctx, contractAddress, caller, enabled, p.authZPolicy)
+}
@@ -0,0 +1,246 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	wasmvmtypes "github.com/line/wasmvm/types"
+
+	storetypes "github.com/line/lbm-sdk/store/types"
+	sdk "github.com/line/lbm-sdk/types"
+	"github.com/line/lbm-sdk/x/wasm/types"
+)
+
+// gas descriptors of the wasm module that the gas tracer classifies
+const (
+	gasDescWasmContract     = "wasm contract"
+	gasDescContractSubQuery = "contract sub-query"
+)
+
+// gasTraceNode is a step of a traced execution. The nested steps are kept as pointers while the trace is built.
+type gasTraceNode struct {
+	trace    types.GasTrace
+	children []*gasTraceNode
+}
+
+func (n *gasTraceNode) record(amount sdk.Gas, descriptor string) {
+	switch descriptor {
+	case gasDescWasmContract:
+		n.trace.VMGas += amount
+	case gasDescContractSubQuery:
+		n.trace.QueryGas += amount
+	case storetypes.GasReadCostFlatDesc, storetypes.GasReadPerByteDesc, storetypes.GasHasDesc,
+		storetypes.GasIterNextCostFlatDesc, storetypes.GasValuePerByteDesc:
+		n.trace.StoreReadGas += amount
+	case storetypes.GasWriteCostFlatDesc, storetypes.GasWritePerByteDesc, storetypes.GasDeleteDesc:
+		n.trace.StoreWriteGas += amount
+	default:
+		n.trace.OtherGas += amount
+	}
+}
+
+func (n *gasTraceNode) toGasTrace() types.GasTrace {
+	t := n.trace
+	if len(n.children) != 0 {
+		t.Children = make([]types.GasTrace, len(n.children))
+		for i, c := range n.children {
+			t.Children[i] = c.toGasTrace()
+		}
+	}
+	return t
+}
+
+// gasTracer collects the gas consumption of a traced execution as a tree of steps
+type gasTracer struct {
+	root  *gasTraceNode
+	stack []*gasTraceNode
+}
+
+// withGasTracer returns a context that records the gas consumption of all contract calls into the tracer
+func withGasTracer(ctx sdk.Context, tracer *gasTracer) sdk.Context {
+	return ctx.WithContext(context.WithValue(ctx.Context(), contextKeyGasTracer, tracer))
+}
+
+// gasTraceStep is an open step of a gasTracer. All methods are no-ops on a nil step, which is returned when the
+// execution is not traced.
+type gasTraceStep struct {
+	tracer *gasTracer
+	node   *gasTraceNode
+	meter  sdk.GasMeter
+	start  sdk.Gas
+	depth  int
+}
+
+// startGasTrace opens a new step when the execution is traced. The returned context carries a gas meter that
+// records the gas consumed by the step itself. The step must be closed with end or endWithError.
+func startGasTrace(ctx sdk.Context, kind string, contract sdk.AccAddress, label string) (sdk.Context, *gasTraceStep) {
+	if ctx.Context() == nil {
+		return ctx, nil
+	}
+	tracer, ok := ctx.Context().Value(contextKeyGasTracer).(*gasTracer)
+	if !ok {
+		return ctx, nil
+	}
+
+	node := &gasTraceNode{trace: types.GasTrace{Kind: kind, Label: label}}
+	if len(contract) != 0 {
+		node.trace.Contract = contract.String()
+	}
+	depth := len(tracer.stack)
+	if depth == 0 {
+		tracer.root = node
+	} else {
+		parent := tracer.stack[depth-1]
+		parent.children = append(parent.children, node)
+	}
+	tracer.stack = append(tracer.stack, node)
+
+	// record on the underlying meter only, so that the gas is not accounted to the parent step as well
+	meter := ctx.GasMeter()
+	if m, ok := meter.(*tracingGasMeter); ok {
+		meter = m.GasMeter
+	}
+	step := &gasTraceStep{tracer: tracer, node: node, meter: meter, start: meter.GasConsumed(), depth: depth}
+	return ctx.WithGasMeter(&tracingGasMeter{GasMeter: meter, node: node}), step
+}
+
+// setContract sets the contract of the step when it is not known on start
+func (s *gasTraceStep) setContract(contract sdk.AccAddress) {
+	if s == nil {
+		return
+	}
+	s.node.trace.Contract = contract.String()
+}
+
+// end closes the step
+func (s *gasTraceStep) end() {
+	s.endWithError(nil)
+}
+
+// endWithError closes the step and records the error. Steps that were left open by a panic are closed as well.
+func (s *gasTraceStep) endWithError(err error) {
+	if s == nil {
+		return
+	}
+	if consumed := s.meter.GasConsumed(); consumed > s.start {
+		s.node.trace.GasUsed = consumed - s.start
+	}
+	switch {
+	case err != nil:
+		s.node.trace.Error = err.Error()
+	case s.meter.IsOutOfGas() && s.node.trace.Error == "":
+		s.node.trace.Error = "out of gas"
+	}
+	if len(s.tracer.stack) > s.depth {
+		s.tracer.stack = s.tracer.stack[:s.depth]
+	}
+}
+
+// tracingGasMeter records all gas consumed through it into a step of the trace
+type tracingGasMeter struct {
+	sdk.GasMeter
+	node *gasTraceNode
+}
+
+func (m *tracingGasMeter) ConsumeGas(amount sdk.Gas, descriptor string) {
+	m.node.record(amount, descriptor)
+	m.GasMeter.ConsumeGas(amount, descriptor)
+}
+
+// cosmosMsgType returns a short description of the type of the message for the trace
+func cosmosMsgType(msg wasmvmtypes.CosmosMsg) string {
+	switch {
+	case msg.Bank != nil:
+		return "bank"
+	case msg.Custom != nil:
+		return "custom"
+	case msg.Distribution != nil:
+		return "distribution"
+	case msg.Gov != nil:
+		return "gov"
+	case msg.IBC != nil:
+		return "ibc"
+	case msg.Staking != nil:
+		return "staking"
+	case msg.Stargate != nil:
+		return fmt.Sprintf("stargate %s", msg.Stargate.TypeURL)
+	case msg.Wasm != nil:
+		switch {
+		case msg.Wasm.Execute != nil:
+			return "wasm execute"
+		case msg.Wasm.Instantiate != nil:
+			return "wasm instantiate"
+		case msg.Wasm.Migrate != nil:
+			return "wasm migrate"
+		case msg.Wasm.UpdateAdmin != nil:
+			return "wasm update_admin"
+		case msg.Wasm.ClearAdmin != nil:
+			return "wasm clear_admin"
+		}
+		return "wasm"
+	}
+	return "unknown"
+}
+
+// queryRequestType returns a short description of the type of the query for the trace
+func queryRequestType(request wasmvmtypes.QueryRequest) string {
+	switch {
+	case request.Bank != nil:
+		return "bank"
+	case request.Custom != nil:
+		return "custom"
+	case request.IBC != nil:
+		return "ibc"
+	case request.Staking != nil:
+		return "staking"
+	case request.Stargate != nil:
+		return fmt.Sprintf("stargate %s", request.Stargate.Path)
+	case request.Wasm != nil:
+		switch {
+		case request.Wasm.Smart != nil:
+			return "wasm smart"
+		case request.Wasm.Raw != nil:
+			return "wasm raw"
+		case request.Wasm.ContractInfo != nil:
+			return "wasm contract_info"
+		}
+		return "wasm"
+	}
+	return "unknown"
+}
+
+// TraceExecute executes the contract on a branch of the state that is discarded afterwards and returns the gas
+// consumption broken down per nested call, submessage and query. An execution error is reported in the trace.
+func (k Keeper) TraceExecute(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress, msg []byte, coins sdk.Coins) (rsp *types.QueryTraceExecuteContractResponse) {
+	tracer := &gasTracer{}
+	ctx, _ = ctx.CacheContext()
+	ctx = withGasTracer(ctx, tracer)
+	meter := ctx.GasMeter()
+	rsp = &types.QueryTraceExecuteContractResponse{}
+
+	defer func() {
+		if r := recover(); r != nil {
+			oog, ok := r.(sdk.ErrorOutOfGas)
+			if !ok {
+				panic(r)
+			}
+			rsp.Data = nil
+			rsp.Error = fmt.Sprintf("out of gas in location: %v", oog.Descriptor)
+		}
+		rsp.GasUsed = meter.GasConsumed()
+		if tracer.root != nil {
+			rsp.Trace = tracer.root.toGasTrace()
+		}
+	}()
+
+	data, err := k.execute(ctx, contractAddress, caller, msg, coins)
+	if err != nil {
+		rsp.Error = err.Error()
+		if tracer.root != nil {
+			tracer.root.trace.Error = err.Error()
+		}
+		return rsp
+	}
+	rsp.Data = data
+	return rsp
+}
@@ -0,0 +1,156 @@
+package keeper
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+
+	wasmvmtypes "github.com/line/wasmvm/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/line/lbm-sdk/types"
+	"github.com/line/lbm-sdk/x/wasm/types"
+)
+
+func TestTraceExecute(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, ReflectFeatures, nil, nil)
+	keeper := keepers.WasmKeeper
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin("denom", 100000))
+	creator := keepers.Faucet.NewFundedAccount(ctx, deposit...)
+	_, _, beneficiary := keyPubAddr()
+
+	reflectCode, err := ioutil.ReadFile("./testdata/reflect.wasm")
+	require.NoError(t, err)
+	reflectID, err := keepers.ContractKeeper.Create(ctx, creator, reflectCode, nil)
+	require.NoError(t, err)
+	reflectAddr, _, err := keepers.ContractKeeper.Instantiate(ctx, reflectID, creator, nil, []byte("{}"), "reflect", nil)
+	require.NoError(t, err)
+
+	// the hackatom contract can be released by the reflect contract
+	hackatom := StoreHackatomExampleContract(t, ctx, keepers)
+	initMsg := HackatomExampleInitMsg{Verifier: reflectAddr, Beneficiary: beneficiary}.GetBytes(t)
+	hackatomAddr, _, err := keepers.ContractKeeper.Instantiate(ctx, hackatom.CodeID, creator, nil, initMsg, "hackatom", sdk.NewCoins(sdk.NewInt64Coin("denom", 1000)))
+	require.NoError(t, err)
+
+	reflectMsg := func(t *testing.T, msg []byte) []byte {
+		bz, err := json.Marshal(ReflectHandleMsg{
+			ReflectSubMsg: &reflectSubPayload{
+				Msgs: []wasmvmtypes.SubMsg{{
+					ID:      7,
+					Msg:     wasmvmtypes.CosmosMsg{Wasm: &wasmvmtypes.WasmMsg{Execute: &wasmvmtypes.ExecuteMsg{ContractAddr: hackatomAddr.String(), Msg: msg, Funds: []wasmvmtypes.Coin{}}}},
+					ReplyOn: wasmvmtypes.ReplyAlways,
+				}},
+			},
+		})
+		require.NoError(t, err)
+		return bz
+	}
+
+	specs := map[string]struct {
+		msg      []byte
+		gasLimit sdk.Gas
+		expTrace func(t *testing.T, trace types.GasTrace)
+		expErr   bool
+	}{
+		"nested execution": {
+			msg:      reflectMsg(t, []byte(`{"release":{}}`)),
+			gasLimit: 10_000_000,
+			expTrace: func(t *testing.T, trace types.GasTrace) {
+				require.Len(t, trace.Children, 2)
+				subMsg, reply := trace.Children[0], trace.Children[1]
+
+				assert.Equal(t, types.GasTraceKindSubmessage, subMsg.Kind)
+				assert.Equal(t, "wasm execute", subMsg.Label)
+				assert.Empty(t, subMsg.Error)
+				require.Len(t, subMsg.Children, 1)
+				nested := subMsg.Children[0]
+				assert.Equal(t, types.GasTraceKindExecute, nested.Kind)
+				assert.Equal(t, hackatomAddr.String(), nested.Contract)
+				assert.NotZero(t, nested.VMGas)
+				assert.NotZero(t, nested.StoreReadGas)
+				assert.NotZero(t, nested.QueryGas)
+				require.Len(t, nested.Children, 2)
+				query, send := nested.Children[0], nested.Children[1]
+				assert.Equal(t, types.GasTraceKindQuery, query.Kind)
+				assert.Equal(t, "bank", query.Label)
+				assert.Equal(t, nested.QueryGas, query.GasUsed)
+				assert.NotZero(t, query.StoreReadGas)
+				assert.Equal(t, types.GasTraceKindSubmessage, send.Kind)
+				assert.Equal(t, "bank", send.Label)
+				assert.NotZero(t, send.StoreWriteGas)
+
+				assert.Equal(t, types.GasTraceKindReply, reply.Kind)
+				assert.Equal(t, "7", reply.Label)
+				assert.Equal(t, reflectAddr.String(), reply.Contract)
+				assert.NotZero(t, reply.VMGas)
+			},
+		},
+		"failing submessage": {
+			msg:      reflectMsg(t, []byte(`{"unknown":{}}`)),
+			gasLimit: 10_000_000,
+			expTrace: func(t *testing.T, trace types.GasTrace) {
+				require.Len(t, trace.Children, 2)
+				subMsg := trace.Children[0]
+				assert.Equal(t, types.GasTraceKindSubmessage, subMsg.Kind)
+				assert.NotEmpty(t, subMsg.Error)
+				require.Len(t, subMsg.Children, 1)
+				assert.Equal(t, types.GasTraceKindExecute, subMsg.Children[0].Kind)
+			},
+		},
+		"out of gas": {
+			msg:      reflectMsg(t, []byte(`{"release":{}}`)),
+			gasLimit: 100_000,
+			expTrace: func(t *testing.T, trace types.GasTrace) {
+				assert.NotEmpty(t, trace.Error)
+			},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			tracedCtx := ctx.WithGasMeter(sdk.NewGasMeter(spec.gasLimit))
+
+			rsp := keeper.TraceExecute(tracedCtx, reflectAddr, creator, spec.msg, nil)
+			if spec.expErr {
+				assert.NotEmpty(t, rsp.Error)
+			} else {
+				assert.Empty(t, rsp.Error)
+			}
+
+			root := rsp.Trace
+			assert.Equal(t, types.GasTraceKindExecute, root.Kind)
+			assert.Equal(t, reflectAddr.String(), root.Contract)
+			assert.Equal(t, rsp.GasUsed, root.GasUsed)
+			assert.NotZero(t, root.VMGas)
+			// the gas of a step is the sum of its own costs and the costs of its nested steps
+			var childrenGas sdk.Gas
+			for _, c := range root.Children {
+				childrenGas += c.GasUsed
+			}
+			if !spec.expErr {
+				assert.Equal(t, root.GasUsed, root.VMGas+root.StoreReadGas+root.StoreWriteGas+root.OtherGas+childrenGas)
+			}
+			spec.expTrace(t, root)
+
+			// and nothing was committed
+			assert.True(t, keepers.BankKeeper.GetAllBalances(ctx, beneficiary).IsZero())
+		})
+	}
+}
+
+func TestTraceExecuteDisabled(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+	example := InstantiateHackatomExampleContract(t, ctx, keepers)
+
+	// no steps are recorded without a tracer in the context
+	ctx, trace := startGasTrace(ctx, types.GasTraceKindExecute, example.Contract, "")
+	assert.Nil(t, trace)
+	_, isTracing := ctx.GasMeter().(*tracingGasMeter)
+	assert.False(t, isTracing)
+	trace.end()
+
+	_, err := keepers.ContractKeeper.Execute(ctx, example.Contract, example.VerifierAddr, []byte(`{"release":{}}`), nil)
+	require.NoError(t, err)
+}
@@ -33,6 +33,11 @@ func InitGenesis(ctx sdk.Context, keeper *Keeper, data types.GenesisState, staki
 				return nil, sdkerrors.Wrapf(err, "contract number %d", i)
 			}
 		}
+		if code.Paused {
+			if err := keeper.setCodePaused(ctx, code.CodeID, true); err != nil {
+				return nil, sdkerrors.Wrapf(err, "code %d with id: %d", i, code.CodeID)
+			}
+		}
 	}
 
 	var maxContractID int
@@ -45,9 +50,34 @@ func InitGenesis(ctx sdk.Context, keeper *Keeper, data types.GenesisState, staki
 		if err != nil {
 			return nil, sdkerrors.Wrapf(err, "contract number %d", i)
 		}
+		if contract.Paused {
+			if err := keeper.setContractPaused(ctx, contractAddr, true); err != nil {
+				return nil, sdkerrors.Wrapf(err, "contract number %d", i)
+			}
+		}
+		if contract.Quota != nil {
+			if err := keeper.setContractQuota(ctx, contractAddr, *contract.Quota); err != nil {
+				return nil, sdkerrors.Wrapf(err, "contract number %d", i)
+			}
+		}
+		if contract.ReceiverHook {
+			keeper.storeReceiverHook(ctx, contractAddr, true)
+		}
 		maxContractID = i + 1 // not ideal but max(contractID) is not persisted otherwise
 	}
 
+	for i, schedule := range data.Schedules {
+		contractAddr, err := sdk.AccAddressFromBech32(schedule.Contract)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "address in schedule number %d", i)
+		}
+		if !keeper.HasContractInfo(ctx, contractAddr) {
+			return nil, sdkerrors.Wrapf(types.ErrNotFound, "contract of schedule number %d", i)
+		}
+		// schedules overdue at the initial height are called at the end of the first block
+		keeper.storeSchedule(ctx, schedule)
+	}
+
 	for i, seq := range data.Sequences {
 		err := keeper.importAutoIncrementID(ctx, seq.IDKey, seq.Value)
 		if err != nil {
@@ -97,6 +127,7 @@ func ExportGenesis(ctx sdk.Context, keeper *Keeper) *types.GenesisState {
 			CodeInfo:  info,
 			CodeBytes: bytecode,
 			Pinned:    keeper.IsPinnedCode(ctx, codeID),
+			Paused:    keeper.IsPausedCode(ctx, codeID),
 		})
 		return false
 	})
@@ -113,10 +144,18 @@ func ExportGenesis(ctx sdk.Context, keeper *Keeper) *types.GenesisState {
 			ContractAddress: addr.String(),
 			ContractInfo:    contract,
 			ContractState:   state,
+			Paused:          keeper.IsPausedContract(ctx, addr),
+			Quota:           keeper.GetContractQuota(ctx, addr),
+			ReceiverHook:    keeper.HasReceiverHook(ctx, addr),
 		})
 		return false
 	})
 
+	keeper.IterateSchedules(ctx, func(schedule types.Schedule) bool {
+		genState.Schedules = append(genState.Schedules, schedule)
+		return false
+	})
+
 	for _, k := range [][]byte{types.KeyLastCodeID, types.KeyLastInstanceID} {
 		genState.Sequences = append(genState.Sequences, types.Sequence{
 			IDKey: k,
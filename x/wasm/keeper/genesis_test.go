@@ -122,6 +122,8 @@ func TestGenesisExportImport(t *testing.T) {
 		newHistory := x.ResetFromGenesis(dstCtx)
 		wasmKeeper.storeContractInfo(srcCtx, address, x)
 		wasmKeeper.addToContractCodeSecondaryIndex(srcCtx, address, newHistory)
+		wasmKeeper.addToContractCreatorSecondaryIndex(srcCtx, sdk.MustAccAddressFromBech32(x.Creator), newHistory.Updated, address)
+		wasmKeeper.addToContractAdminSecondaryIndex(srcCtx, x.AdminAddr(), address)
 		wasmKeeper.appendToContractHistory(srcCtx, address, newHistory)
 		iter.Close()
 		return false
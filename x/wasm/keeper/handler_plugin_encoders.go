@@ -205,6 +205,9 @@ func EncodeStargateMsg(unpacker codectypes.AnyUnpacker) StargateEncoder {
 	}
 }
 
+// EncodeWasmMsg encodes the WasmMsg variants of the vendored wasmvm. It has no
+// Instantiate2 variant yet, so contracts send MsgInstantiateContract2 as a
+// StargateMsg until wasmvm is upgraded.
 func EncodeWasmMsg(sender sdk.AccAddress, msg *wasmvmtypes.WasmMsg) ([]sdk.Msg, error) {
 	switch {
 	case msg.Execute != nil:
@@ -59,6 +59,19 @@ func TestEncoding(t *testing.T) {
 	proposalMsgBin, err := proto.Marshal(proposalMsg)
 	require.NoError(t, err)
 
+	instantiate2Msg := &types.MsgInstantiateContract2{
+		Sender: addr1.String(),
+		Admin:  addr2.String(),
+		CodeID: 7,
+		Label:  "my instance",
+		Msg:    jsonMsg,
+		Funds:  sdk.NewCoins(sdk.NewInt64Coin("eth", 12)),
+		Salt:   []byte("salt"),
+		FixMsg: true,
+	}
+	instantiate2MsgBin, err := proto.Marshal(instantiate2Msg)
+	require.NoError(t, err)
+
 	cases := map[string]struct {
 		sender             sdk.AccAddress
 		srcMsg             wasmvmtypes.CosmosMsg
@@ -371,6 +384,16 @@ func TestEncoding(t *testing.T) {
 			},
 			output: []sdk.Msg{proposalMsg},
 		},
+		"stargate encoded instantiate2 msg": {
+			sender: addr1,
+			srcMsg: wasmvmtypes.CosmosMsg{
+				Stargate: &wasmvmtypes.StargateMsg{
+					TypeURL: "/cosmwasm.wasm.v1.MsgInstantiateContract2",
+					Value:   instantiate2MsgBin,
+				},
+			},
+			output: []sdk.Msg{instantiate2Msg},
+		},
 		"stargate encoded invalid typeUrl": {
 			sender: addr2,
 			srcMsg: wasmvmtypes.CosmosMsg{
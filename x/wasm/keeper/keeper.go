@@ -23,6 +23,7 @@ import (
 	sdk "github.com/line/lbm-sdk/types"
 	sdkerrors "github.com/line/lbm-sdk/types/errors"
 	authkeeper "github.com/line/lbm-sdk/x/auth/keeper"
+	authtypes "github.com/line/lbm-sdk/x/auth/types"
 	bankpluskeeper "github.com/line/lbm-sdk/x/bankplus/keeper"
 	paramtypes "github.com/line/lbm-sdk/x/params/types"
 	"github.com/line/lbm-sdk/x/wasm/ioutils"
@@ -38,6 +39,10 @@ type contextKey int
 const (
 	// private type creates an interface key for Context that cannot be accessed by any other package
 	contextKeyQueryStackSize contextKey = iota
+	// contextKeyGasTracer holds the gasTracer of a traced execution
+	contextKeyGasTracer
+	// contextKeyReceiverHookDepth holds the number of nested receiver hook calls
+	contextKeyReceiverHookDepth
 )
 
 // Option is an extension point to instantiate keeper with non default values
@@ -88,6 +93,9 @@ type Keeper struct {
 	paramSpace        paramtypes.Subspace
 	gasRegister       WasmGasRegister
 	maxQueryStackSize uint32
+	// receiverHookGasLimit is the max gas a contract can spend on handling a received transfer
+	receiverHookGasLimit uint64
+	maxReceiverHookDepth uint32
 }
 
 // NewKeeper creates a new contract Keeper instance
@@ -123,19 +131,21 @@ func NewKeeper(
 	}
 
 	keeper := &Keeper{
-		storeKey:          storeKey,
-		cdc:               cdc,
-		wasmVM:            wasmer,
-		accountKeeper:     accountKeeper,
-		bank:              NewBankCoinTransferrer(bankKeeper),
-		portKeeper:        portKeeper,
-		capabilityKeeper:  capabilityKeeper,
-		messenger:         NewDefaultMessageHandler(router, channelKeeper, capabilityKeeper, bankKeeper, cdc, portSource, customEncoders),
-		queryGasLimit:     wasmConfig.SmartQueryGasLimit,
-		paramSpace:        paramSpace,
-		metrics:           NopMetrics(),
-		gasRegister:       NewDefaultWasmGasRegister(),
-		maxQueryStackSize: types.DefaultMaxQueryStackSize,
+		storeKey:             storeKey,
+		cdc:                  cdc,
+		wasmVM:               wasmer,
+		accountKeeper:        accountKeeper,
+		bank:                 NewBankCoinTransferrer(bankKeeper),
+		portKeeper:           portKeeper,
+		capabilityKeeper:     capabilityKeeper,
+		messenger:            NewDefaultMessageHandler(router, channelKeeper, capabilityKeeper, bankKeeper, cdc, portSource, customEncoders),
+		queryGasLimit:        wasmConfig.SmartQueryGasLimit,
+		paramSpace:           paramSpace,
+		metrics:              NopMetrics(),
+		gasRegister:          NewDefaultWasmGasRegister(),
+		maxQueryStackSize:    types.DefaultMaxQueryStackSize,
+		receiverHookGasLimit: types.DefaultReceiverHookGasLimit,
+		maxReceiverHookDepth: types.DefaultMaxReceiverHookDepth,
 	}
 	keeper.wasmVMQueryHandler = DefaultQueryPlugins(bankKeeper, stakingKeeper, distKeeper, channelKeeper, queryRouter, keeper).Merge(customPlugins)
 	for _, o := range opts {
@@ -306,17 +316,42 @@ func (k Keeper) importCode(ctx sdk.Context, codeID uint64, codeInfo types.CodeIn
 	return nil
 }
 
-func (k Keeper) instantiate(ctx sdk.Context, codeID uint64, creator, admin sdk.AccAddress, initMsg []byte, label string, deposit sdk.Coins, authZ AuthorizationPolicy) (sdk.AccAddress, []byte, error) {
+func (k Keeper) instantiate(ctx sdk.Context, codeID uint64, creator, admin sdk.AccAddress, initMsg []byte, label string, deposit sdk.Coins, addressGenerator AddressGenerator, authZ AuthorizationPolicy) (sdk.AccAddress, []byte, error) {
 	defer func(begin time.Time) { k.metrics.InstantiateElapsedTimes.Observe(time.Since(begin).Seconds()) }(time.Now())
+	ctx, trace := startGasTrace(ctx, types.GasTraceKindInstantiate, nil, "")
+	defer trace.end()
 
 	instanceCosts := k.newContractInstanceCosts(k.gasRegister, ctx, k.IsPinnedCode(ctx, codeID), len(initMsg))
 	ctx.GasMeter().ConsumeGas(instanceCosts, "Loading CosmWasm module: instantiate")
 
+	// get contact info
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetCodeKey(codeID))
+	if bz == nil {
+		return nil, nil, sdkerrors.Wrap(types.ErrNotFound, "code")
+	}
+	var codeInfo types.CodeInfo
+	k.cdc.MustUnmarshal(bz, &codeInfo)
+
+	if !authZ.CanInstantiateContract(codeInfo.InstantiateConfig, creator) {
+		return nil, nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "can not instantiate")
+	}
+	if k.IsPausedCode(ctx, codeID) {
+		return nil, nil, sdkerrors.Wrapf(types.ErrContractPaused, "code %d", codeID)
+	}
+
 	// create contract address
-	contractAddress := k.generateContractAddress(ctx, codeID)
+	contractAddress := addressGenerator(ctx, codeID, codeInfo.CodeHash)
+	trace.setContract(contractAddress)
 	existingAcct := k.accountKeeper.GetAccount(ctx, contractAddress)
 	if existingAcct != nil {
-		return nil, nil, sdkerrors.Wrap(types.ErrAccountExists, existingAcct.GetAddress().String())
+		// Anyone can send coins to a predictable address before the contract
+		// is instantiated, so an unused base account there is taken over with
+		// its balance instead of blocking the instantiation.
+		_, isBaseAcct := existingAcct.(*authtypes.BaseAccount)
+		if !isBaseAcct || existingAcct.GetSequence() != 0 || existingAcct.GetPubKey() != nil || k.HasContractInfo(ctx, contractAddress) {
+			return nil, nil, sdkerrors.Wrap(types.ErrAccountExists, existingAcct.GetAddress().String())
+		}
 	}
 
 	// deposit initial contract funds
@@ -324,26 +359,13 @@ func (k Keeper) instantiate(ctx sdk.Context, codeID uint64, creator, admin sdk.A
 		if err := k.bank.TransferCoins(ctx, creator, contractAddress, deposit); err != nil {
 			return nil, nil, err
 		}
-	} else {
+	} else if existingAcct == nil {
 		// create an empty account (so we don't have issues later)
 		// TODO: can we remove this?
 		contractAccount := k.accountKeeper.NewAccountWithAddress(ctx, contractAddress)
 		k.accountKeeper.SetAccount(ctx, contractAccount)
 	}
 
-	// get contact info
-	store := ctx.KVStore(k.storeKey)
-	bz := store.Get(types.GetCodeKey(codeID))
-	if bz == nil {
-		return nil, nil, sdkerrors.Wrap(types.ErrNotFound, "code")
-	}
-	var codeInfo types.CodeInfo
-	k.cdc.MustUnmarshal(bz, &codeInfo)
-
-	if !authZ.CanInstantiateContract(codeInfo.InstantiateConfig, creator) {
-		return nil, nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "can not instantiate")
-	}
-
 	// prepare params for contract instantiate call
 	env := types.NewEnv(ctx, contractAddress)
 	info := types.NewInfo(creator, deposit)
@@ -386,6 +408,8 @@ func (k Keeper) instantiate(ctx sdk.Context, codeID uint64, creator, admin sdk.A
 	// store contract before dispatch so that contract could be called back
 	historyEntry := contractInfo.InitialHistory(initMsg)
 	k.addToContractCodeSecondaryIndex(ctx, contractAddress, historyEntry)
+	k.addToContractCreatorSecondaryIndex(ctx, creator, historyEntry.Updated, contractAddress)
+	k.addToContractAdminSecondaryIndex(ctx, admin, contractAddress)
 	k.appendToContractHistory(ctx, contractAddress, historyEntry)
 	k.storeContractInfo(ctx, contractAddress, &contractInfo)
 
@@ -406,6 +430,8 @@ func (k Keeper) instantiate(ctx sdk.Context, codeID uint64, creator, admin sdk.A
 // Execute executes the contract instance
 func (k Keeper) execute(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress, msg []byte, coins sdk.Coins) ([]byte, error) {
 	defer func(begin time.Time) { k.metrics.ExecuteElapsedTimes.Observe(time.Since(begin).Seconds()) }(time.Now())
+	ctx, trace := startGasTrace(ctx, types.GasTraceKindExecute, contractAddress, "")
+	defer trace.end()
 	contractInfo, codeInfo, prefixStore, err := k.contractInstance(ctx, contractAddress)
 	if err != nil {
 		return nil, err
@@ -413,6 +439,13 @@ func (k Keeper) execute(ctx sdk.Context, contractAddress sdk.AccAddress, caller
 	if contractInfo.Status != types.ContractStatusActive {
 		return nil, sdkerrors.Wrap(types.ErrInvalid, "inactive contract")
 	}
+	if err := k.assertNotPaused(ctx, contractAddress, contractInfo.CodeID); err != nil {
+		return nil, err
+	}
+	if err := k.checkContractQuota(ctx, contractAddress); err != nil {
+		return nil, err
+	}
+	gasBefore := ctx.GasMeter().GasConsumed()
 
 	executeCosts := k.instantiateContractCosts(k.gasRegister, ctx, k.IsPinnedCode(ctx, contractInfo.CodeID), len(msg))
 	ctx.GasMeter().ConsumeGas(executeCosts, "Loading CosmWasm module: execute")
@@ -447,11 +480,17 @@ func (k Keeper) execute(ctx sdk.Context, contractAddress sdk.AccAddress, caller
 		return nil, sdkerrors.Wrap(err, "dispatch")
 	}
 
+	// the gas of the execution includes its submessages
+	if err := k.chargeContractQuota(ctx, contractAddress, ctx.GasMeter().GasConsumed()-gasBefore); err != nil {
+		return nil, err
+	}
 	return data, nil
 }
 
 func (k Keeper) migrate(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress, newCodeID uint64, msg []byte, authZ AuthorizationPolicy) ([]byte, error) {
 	defer func(begin time.Time) { k.metrics.MigrateElapsedTimes.Observe(time.Since(begin).Seconds()) }(time.Now())
+	ctx, trace := startGasTrace(ctx, types.GasTraceKindMigrate, contractAddress, "")
+	defer trace.end()
 	migrateSetupCosts := k.instantiateContractCosts(k.gasRegister, ctx, k.IsPinnedCode(ctx, newCodeID), len(msg))
 	ctx.GasMeter().ConsumeGas(migrateSetupCosts, "Loading CosmWasm module: migrate")
 
@@ -470,6 +509,9 @@ func (k Keeper) migrate(ctx sdk.Context, contractAddress sdk.AccAddress, caller
 	if newCodeInfo == nil {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "unknown code")
 	}
+	if k.IsPausedCode(ctx, newCodeID) {
+		return nil, sdkerrors.Wrapf(types.ErrContractPaused, "code %d", newCodeID)
+	}
 
 	// check for IBC flag
 	switch report, err := k.wasmVM.AnalyzeCode(newCodeInfo.CodeHash); {
@@ -529,10 +571,15 @@ func (k Keeper) migrate(ctx sdk.Context, contractAddress sdk.AccAddress, caller
 // place any access controls on it, that is the responsibility or the app developer (who passes the wasm.Keeper in app.go)
 func (k Keeper) Sudo(ctx sdk.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error) {
 	defer func(begin time.Time) { k.metrics.SudoElapsedTimes.Observe(time.Since(begin).Seconds()) }(time.Now())
+	ctx, trace := startGasTrace(ctx, types.GasTraceKindSudo, contractAddress, "")
+	defer trace.end()
 	contractInfo, codeInfo, prefixStore, err := k.contractInstance(ctx, contractAddress)
 	if err != nil {
 		return nil, err
 	}
+	if err := k.assertNotPaused(ctx, contractAddress, contractInfo.CodeID); err != nil {
+		return nil, err
+	}
 
 	sudoSetupCosts := k.instantiateContractCosts(k.gasRegister, ctx, k.IsPinnedCode(ctx, contractInfo.CodeID), len(msg))
 	ctx.GasMeter().ConsumeGas(sudoSetupCosts, "Loading CosmWasm module: sudo")
@@ -564,10 +611,15 @@ func (k Keeper) Sudo(ctx sdk.Context, contractAddress sdk.AccAddress, msg []byte
 
 // reply is only called from keeper internal functions (dispatchSubmessages) after processing the submessage
 func (k Keeper) reply(ctx sdk.Context, contractAddress sdk.AccAddress, reply wasmvmtypes.Reply) ([]byte, error) {
+	ctx, trace := startGasTrace(ctx, types.GasTraceKindReply, contractAddress, strconv.FormatUint(reply.ID, 10))
+	defer trace.end()
 	contractInfo, codeInfo, prefixStore, err := k.contractInstance(ctx, contractAddress)
 	if err != nil {
 		return nil, err
 	}
+	if err := k.assertNotPaused(ctx, contractAddress, contractInfo.CodeID); err != nil {
+		return nil, err
+	}
 
 	// always consider this pinned
 	replyCosts := k.replyCosts(k.gasRegister, ctx, true, reply)
@@ -609,6 +661,57 @@ func (k Keeper) removeFromContractCodeSecondaryIndex(ctx sdk.Context, contractAd
 	ctx.KVStore(k.storeKey).Delete(types.GetContractByCreatedSecondaryIndexKey(contractAddress, entry))
 }
 
+// addToContractCreatorSecondaryIndex adds element to the index for contracts-by-creator queries
+func (k Keeper) addToContractCreatorSecondaryIndex(ctx sdk.Context, creatorAddress sdk.AccAddress, position *types.AbsoluteTxPosition, contractAddress sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetContractByCreatorSecondaryIndexKey(creatorAddress, position, contractAddress), []byte{})
+}
+
+// IterateContractsByCreator iterates over all contracts with given creator address in order of creation time asc.
+func (k Keeper) IterateContractsByCreator(ctx sdk.Context, creator sdk.AccAddress, cb func(address sdk.AccAddress) bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetContractsByCreatorPrefix(creator))
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		key := iter.Key()
+		if cb(key[types.AbsoluteTxPositionLen:]) {
+			return
+		}
+	}
+}
+
+// addToContractAdminSecondaryIndex adds element to the index for contracts-by-admin queries.
+// Contracts without an admin are not indexed.
+func (k Keeper) addToContractAdminSecondaryIndex(ctx sdk.Context, adminAddress, contractAddress sdk.AccAddress) {
+	if adminAddress.Empty() {
+		return
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetContractByAdminSecondaryIndexKey(adminAddress, contractAddress), []byte{})
+}
+
+// removeFromContractAdminSecondaryIndex removes element from the index for contracts-by-admin queries
+func (k Keeper) removeFromContractAdminSecondaryIndex(ctx sdk.Context, adminAddress, contractAddress sdk.AccAddress) {
+	if adminAddress.Empty() {
+		return
+	}
+	ctx.KVStore(k.storeKey).Delete(types.GetContractByAdminSecondaryIndexKey(adminAddress, contractAddress))
+}
+
+// IterateContractsByAdmin iterates over all contracts with given admin address in order of contract address asc.
+func (k Keeper) IterateContractsByAdmin(ctx sdk.Context, admin sdk.AccAddress, cb func(address sdk.AccAddress) bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetContractsByAdminPrefix(admin))
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		if cb(iter.Key()) {
+			return
+		}
+	}
+}
+
 // IterateContractsByCode iterates over all contracts with given codeID ASC on code update time.
 func (k Keeper) IterateContractsByCode(ctx sdk.Context, codeID uint64, cb func(address sdk.AccAddress) bool) {
 	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetContractByCodeIDSecondaryIndexPrefix(codeID))
@@ -657,6 +760,8 @@ func (k Keeper) setContractAdmin(ctx sdk.Context, contractAddress, caller, newAd
 	if !authZ.CanModifyContract(contractInfo.AdminAddr(), caller) {
 		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "can not modify contract")
 	}
+	k.removeFromContractAdminSecondaryIndex(ctx, contractInfo.AdminAddr(), contractAddress)
+	k.addToContractAdminSecondaryIndex(ctx, newAdmin, contractAddress)
 	contractInfo.Admin = newAdmin.String()
 	k.storeContractInfo(ctx, contractAddress, contractInfo)
 	return nil
@@ -713,6 +818,8 @@ func (k Keeper) getLastContractHistoryEntry(ctx sdk.Context, contractAddr sdk.Ac
 // QuerySmart queries the smart contract itself.
 func (k Keeper) QuerySmart(ctx sdk.Context, contractAddr sdk.AccAddress, req []byte) ([]byte, error) {
 	defer func(begin time.Time) { k.metrics.QuerySmartElapsedTimes.Observe(time.Since(begin).Seconds()) }(time.Now())
+	ctx, trace := startGasTrace(ctx, types.GasTraceKindQuerySmart, contractAddr, "")
+	defer trace.end()
 
 	// checks and increase query stack size
 	ctx, err := checkAndIncreaseQueryStackSize(ctx, k.maxQueryStackSize)
@@ -1040,13 +1147,34 @@ func (k Keeper) runtimeGasForContract(ctx sdk.Context) uint64 {
 
 func (k Keeper) consumeRuntimeGas(ctx sdk.Context, gas uint64) {
 	consumed := k.getGasMultiplier(ctx).FromWasmVMGas(gas)
-	ctx.GasMeter().ConsumeGas(consumed, "wasm contract")
+	ctx.GasMeter().ConsumeGas(consumed, gasDescWasmContract)
 	// throw OutOfGas error if we ran out (got exactly to zero due to better limit enforcing)
 	if ctx.GasMeter().IsOutOfGas() {
 		panic(sdk.ErrorOutOfGas{Descriptor: "Wasmer function execution"})
 	}
 }
 
+// AddressGenerator abstracts the address generation of a new contract instance
+type AddressGenerator func(ctx sdk.Context, codeID uint64, checksum []byte) sdk.AccAddress
+
+// ClassicAddressGenerator generates a contract address from codeID + instanceID
+func (k Keeper) ClassicAddressGenerator() AddressGenerator {
+	return func(ctx sdk.Context, codeID uint64, _ []byte) sdk.AccAddress {
+		return k.generateContractAddress(ctx, codeID)
+	}
+}
+
+// PredictableAddressGenerator generates a contract address from the code checksum, the creator,
+// the salt and, if fixMsg is set, the init msg. The address does not depend on the chain state.
+func PredictableAddressGenerator(creator sdk.AccAddress, salt []byte, initMsg []byte, fixMsg bool) AddressGenerator {
+	if !fixMsg { // clear the init msg when it is not part of the address
+		initMsg = []byte{}
+	}
+	return func(ctx sdk.Context, _ uint64, checksum []byte) sdk.AccAddress {
+		return BuildContractAddressPredictable(checksum, creator, salt, initMsg)
+	}
+}
+
 // generates a contract address from codeID + instanceID
 func (k Keeper) generateContractAddress(ctx sdk.Context, codeID uint64) sdk.AccAddress {
 	instanceID := k.autoIncrementID(ctx, types.KeyLastInstanceID)
@@ -1061,6 +1189,18 @@ func BuildContractAddress(codeID, instanceID uint64) sdk.AccAddress {
 	return address.Module(types.ModuleName, contractID)[:types.ContractAddrLen]
 }
 
+// BuildContractAddressPredictable builds a contract address from the code checksum, the creator,
+// the salt and the init msg, each of them prefixed by its length as 8 byte big endian.
+// Pass an empty init msg to leave it out of the address.
+func BuildContractAddressPredictable(checksum []byte, creator sdk.AccAddress, salt, initMsg []byte) sdk.AccAddress {
+	var key []byte
+	for _, part := range [][]byte{checksum, creator, salt, initMsg} {
+		key = append(key, sdk.Uint64ToBigEndian(uint64(len(part)))...)
+		key = append(key, part...)
+	}
+	return address.Module(types.ModuleName, key)[:types.ContractAddrLen]
+}
+
 func (k Keeper) autoIncrementID(ctx sdk.Context, lastIDKey []byte) uint64 {
 	store := ctx.KVStore(k.storeKey)
 	bz := store.Get(lastIDKey)
@@ -1106,9 +1246,57 @@ func (k Keeper) importContract(ctx sdk.Context, contractAddr sdk.AccAddress, c *
 	k.appendToContractHistory(ctx, contractAddr, historyEntry)
 	k.storeContractInfo(ctx, contractAddr, c)
 	k.addToContractCodeSecondaryIndex(ctx, contractAddr, historyEntry)
+	creator, err := sdk.AccAddressFromBech32(c.Creator)
+	if err != nil {
+		return sdkerrors.Wrap(err, "creator")
+	}
+	k.addToContractCreatorSecondaryIndex(ctx, creator, historyEntry.Updated, contractAddr)
+	k.addToContractAdminSecondaryIndex(ctx, c.AdminAddr(), contractAddr)
 	return k.importContractState(ctx, contractAddr, state)
 }
 
+// importContractExport stores the code of the export under a new code id and restores the contract with its
+// history and state at the exported address.
+func (k Keeper) importContractExport(ctx sdk.Context, export types.ContractExport, authZ AuthorizationPolicy) (uint64, error) {
+	if !authZ.CanImportContract() {
+		return 0, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "can not import contract")
+	}
+	if err := export.ValidateBasic(); err != nil {
+		return 0, err
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(export.ContractAddress)
+	if err != nil {
+		return 0, sdkerrors.Wrap(err, "contract address")
+	}
+	if k.HasContractInfo(ctx, contractAddr) {
+		return 0, sdkerrors.Wrapf(types.ErrDuplicate, "contract: %s", contractAddr)
+	}
+
+	ctx.GasMeter().ConsumeGas(k.compileCosts(ctx, len(export.CodeBytes)), "Compiling WASM Bytecode")
+	codeID := k.autoIncrementID(ctx, types.KeyLastCodeID)
+	if err := k.importCode(ctx, codeID, export.CodeInfo, export.CodeBytes); err != nil {
+		return 0, err
+	}
+
+	contractInfo := export.ContractInfo
+	contractInfo.CodeID = codeID
+	k.appendToContractHistory(ctx, contractAddr, export.ContractHistory...)
+	if err := k.importContract(ctx, contractAddr, &contractInfo, export.ContractState); err != nil {
+		return 0, err
+	}
+	if contractInfo.Status == types.ContractStatusInactive {
+		k.bank.AddToInactiveAddr(ctx, contractAddr)
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeImportContract,
+		sdk.NewAttribute(types.AttributeKeyContractAddr, contractAddr.String()),
+		sdk.NewAttribute(types.AttributeKeyCodeID, strconv.FormatUint(codeID, 10)),
+		sdk.NewAttribute(types.AttributeKeyChecksum, export.Checksum.String()),
+	))
+	return codeID, nil
+}
+
 func (k Keeper) newQueryHandler(ctx sdk.Context, contractAddress sdk.AccAddress) QueryHandler {
 	return NewQueryHandler(ctx, k.wasmVMQueryHandler, contractAddress, k.getGasMultiplier(ctx))
 }
@@ -15,6 +15,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/line/lbm-sdk/crypto/keys/secp256k1"
 	stypes "github.com/line/lbm-sdk/store/types"
 	sdk "github.com/line/lbm-sdk/types"
 	sdkerrors "github.com/line/lbm-sdk/types/errors"
@@ -389,7 +390,7 @@ func TestInstantiate(t *testing.T) {
 
 	gasAfter := ctx.GasMeter().GasConsumed()
 	if types.EnableGasVerification {
-		require.Equal(t, uint64(0x18c38), gasAfter-gasBefore)
+		require.Equal(t, uint64(0x19c3c), gasAfter-gasBefore)
 	}
 
 	// ensure it is stored properly
@@ -418,6 +419,91 @@ func TestInstantiate(t *testing.T) {
 	assert.Equal(t, expEvt, em.Events())
 }
 
+func TestInstantiate2(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin("denom", 100000))
+	creator := keepers.Faucet.NewFundedAccount(ctx, deposit...)
+
+	codeID, err := keepers.ContractKeeper.Create(ctx, creator, hackatomWasm, nil)
+	require.NoError(t, err)
+	codeHash := keepers.WasmKeeper.GetCodeInfo(ctx, codeID).CodeHash
+
+	_, _, bob := keyPubAddr()
+	_, _, fred := keyPubAddr()
+	initMsgBz, err := json.Marshal(HackatomExampleInitMsg{Verifier: fred, Beneficiary: bob})
+	require.NoError(t, err)
+	otherInitMsgBz, err := json.Marshal(HackatomExampleInitMsg{Verifier: bob, Beneficiary: fred})
+	require.NoError(t, err)
+
+	salt := []byte("salt")
+	gotAddr, _, err := keepers.ContractKeeper.Instantiate2(ctx, codeID, creator, nil, initMsgBz, "demo contract 1", nil, salt, false)
+	require.NoError(t, err)
+	assert.Equal(t, BuildContractAddressPredictable(codeHash, creator, salt, []byte{}), gotAddr)
+	assert.NotNil(t, keepers.WasmKeeper.GetContractInfo(ctx, gotAddr))
+
+	// the address does not depend on the init msg unless it is fixed
+	_, _, err = keepers.ContractKeeper.Instantiate2(ctx, codeID, creator, nil, otherInitMsgBz, "demo contract 2", nil, salt, false)
+	require.True(t, types.ErrAccountExists.Is(err), err)
+
+	gotAddr, _, err = keepers.ContractKeeper.Instantiate2(ctx, codeID, creator, nil, initMsgBz, "demo contract 3", nil, salt, true)
+	require.NoError(t, err)
+	assert.Equal(t, BuildContractAddressPredictable(codeHash, creator, salt, initMsgBz), gotAddr)
+	_, _, err = keepers.ContractKeeper.Instantiate2(ctx, codeID, creator, nil, otherInitMsgBz, "demo contract 4", nil, salt, true)
+	require.NoError(t, err)
+
+	// an unused base account at the address, funded in advance, is taken over
+	otherSalt := []byte("other salt")
+	addr := BuildContractAddressPredictable(codeHash, creator, otherSalt, []byte{})
+	keepers.Faucet.Fund(ctx, addr, sdk.NewInt64Coin("denom", 1))
+	gotAddr, _, err = keepers.ContractKeeper.Instantiate2(ctx, codeID, creator, nil, initMsgBz, "demo contract 5", sdk.NewCoins(sdk.NewInt64Coin("denom", 10)), otherSalt, false)
+	require.NoError(t, err)
+	assert.Equal(t, addr, gotAddr)
+	assert.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("denom", 11)), keepers.BankKeeper.GetAllBalances(ctx, addr))
+
+	// a used account or an account of another type at the address is rejected
+	usedAcc := func(salt string) authtypes.AccountI {
+		return keepers.AccountKeeper.NewAccountWithAddress(ctx, BuildContractAddressPredictable(codeHash, creator, []byte(salt), []byte{}))
+	}
+	withSequence := usedAcc("sequence")
+	require.NoError(t, withSequence.SetSequence(1))
+	withPubKey := usedAcc("pubkey")
+	require.NoError(t, withPubKey.SetPubKey(secp256k1.GenPrivKey().PubKey()))
+	moduleAcc := authtypes.NewModuleAccount(usedAcc("module").(*authtypes.BaseAccount), "module")
+	for salt, acc := range map[string]authtypes.AccountI{"sequence": withSequence, "pubkey": withPubKey, "module": moduleAcc} {
+		keepers.AccountKeeper.SetAccount(ctx, acc)
+		_, _, err = keepers.ContractKeeper.Instantiate2(ctx, codeID, creator, nil, initMsgBz, "demo contract "+salt, nil, []byte(salt), false)
+		require.True(t, types.ErrAccountExists.Is(err), "%s: %v", salt, err)
+	}
+
+	// the classic addresses are not affected
+	gotAddr, _, err = keepers.ContractKeeper.Instantiate(ctx, codeID, creator, nil, initMsgBz, "demo contract 6", nil)
+	require.NoError(t, err)
+	assert.Equal(t, BuildContractAddress(codeID, 1), gotAddr)
+}
+
+func TestBuildContractAddressPredictable(t *testing.T) {
+	checksum := bytes.Repeat([]byte{0x01}, 32)
+	creator := sdk.AccAddress(bytes.Repeat([]byte{0x02}, 20))
+	salt := []byte{0x03}
+	initMsg := []byte(`{}`)
+
+	addr := BuildContractAddressPredictable(checksum, creator, salt, initMsg)
+	assert.Len(t, addr, types.ContractAddrLen)
+	assert.Equal(t, addr, BuildContractAddressPredictable(checksum, creator, salt, initMsg))
+
+	assert.NotEqual(t, addr, BuildContractAddressPredictable(bytes.Repeat([]byte{0x04}, 32), creator, salt, initMsg))
+	assert.NotEqual(t, addr, BuildContractAddressPredictable(checksum, sdk.AccAddress(bytes.Repeat([]byte{0x04}, 20)), salt, initMsg))
+	assert.NotEqual(t, addr, BuildContractAddressPredictable(checksum, creator, []byte{0x04}, initMsg))
+	assert.NotEqual(t, addr, BuildContractAddressPredictable(checksum, creator, salt, []byte{}))
+
+	// the length prefixes keep the parts apart
+	assert.NotEqual(t,
+		BuildContractAddressPredictable(checksum, creator, []byte{0x03, 0x7b}, []byte(`}`)),
+		BuildContractAddressPredictable(checksum, creator, []byte{0x03}, []byte(`{}`)),
+	)
+}
+
 func TestInstantiateWithDeposit(t *testing.T) {
 	var (
 		bob  = bytes.Repeat([]byte{1}, types.SDKAddrLen)
@@ -1229,6 +1315,49 @@ func TestIterateContractsByCodeWithMigration(t *testing.T) {
 	assert.Equal(t, exp, gotAddr)
 }
 
+func TestIterateContractsByCreatorAndAdmin(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+	k, c := keepers.WasmKeeper, keepers.ContractKeeper
+	example := InstantiateHackatomExampleContract(t, ctx, keepers)
+	creator, otherAdmin := example.CreatorAddr, RandomAccountAddress(t)
+	initMsg := HackatomExampleInitMsg{
+		Verifier:    RandomAccountAddress(t),
+		Beneficiary: RandomAccountAddress(t),
+	}.GetBytes(t)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	withoutAdmin, _, err := c.Instantiate(ctx, example.CodeID, creator, nil, initMsg, "no admin", nil)
+	require.NoError(t, err)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	withOtherAdmin, _, err := c.Instantiate(ctx, example.CodeID, creator, otherAdmin, initMsg, "other admin", nil)
+	require.NoError(t, err)
+
+	collect := func(iterate func(sdk.Context, sdk.AccAddress, func(sdk.AccAddress) bool), addr sdk.AccAddress) []sdk.AccAddress {
+		var gotAddr []sdk.AccAddress
+		iterate(ctx, addr, func(address sdk.AccAddress) bool {
+			gotAddr = append(gotAddr, address)
+			return false
+		})
+		return gotAddr
+	}
+
+	// contracts are returned in creation order
+	assert.Equal(t, []sdk.AccAddress{example.Contract, withoutAdmin, withOtherAdmin}, collect(k.IterateContractsByCreator, creator))
+	assert.Empty(t, collect(k.IterateContractsByCreator, otherAdmin))
+	assert.Equal(t, []sdk.AccAddress{example.Contract}, collect(k.IterateContractsByAdmin, creator))
+	assert.Equal(t, []sdk.AccAddress{withOtherAdmin}, collect(k.IterateContractsByAdmin, otherAdmin))
+
+	// when admin is updated
+	require.NoError(t, c.UpdateContractAdmin(ctx, example.Contract, creator, otherAdmin))
+	assert.Empty(t, collect(k.IterateContractsByAdmin, creator))
+	assert.ElementsMatch(t, []sdk.AccAddress{example.Contract, withOtherAdmin}, collect(k.IterateContractsByAdmin, otherAdmin))
+
+	// when admin is cleared
+	require.NoError(t, c.ClearContractAdmin(ctx, withOtherAdmin, otherAdmin))
+	assert.Equal(t, []sdk.AccAddress{example.Contract}, collect(k.IterateContractsByAdmin, otherAdmin))
+	// creator index is not affected by admin changes
+	assert.Len(t, collect(k.IterateContractsByCreator, creator), 3)
+}
+
 type sudoMsg struct {
 	// This is a tongue-in-check demo command. This is not the intended purpose of Sudo.
 	// Here we show that some priviledged Go module can make a call that should never be exposed
@@ -2,6 +2,8 @@ package keeper
 
 import (
 	sdk "github.com/line/lbm-sdk/types"
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
+	"github.com/line/lbm-sdk/x/wasm/types"
 )
 
 // Migrator is a struct for handling in-place store migrations.
@@ -14,7 +16,27 @@ func NewMigrator(keeper Keeper) Migrator {
 	return Migrator{keeper: keeper}
 }
 
-// Migrate1to2 migrates from version 1 to 2.
+// Migrate1to2 migrates from version 1 to 2. It backfills the contracts-by-creator
+// and contracts-by-admin secondary indexes of the existing contracts.
 func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	type contract struct {
+		address sdk.AccAddress
+		info    types.ContractInfo
+	}
+	// the store must not be written while it is iterated
+	var contracts []contract
+	m.keeper.IterateContractInfo(ctx, func(contractAddr sdk.AccAddress, contractInfo types.ContractInfo) bool {
+		contracts = append(contracts, contract{address: contractAddr, info: contractInfo})
+		return false
+	})
+
+	for _, c := range contracts {
+		creator, err := sdk.AccAddressFromBech32(c.info.Creator)
+		if err != nil {
+			return sdkerrors.Wrapf(err, "creator of contract %s", c.address)
+		}
+		m.keeper.addToContractCreatorSecondaryIndex(ctx, creator, c.info.Created, c.address)
+		m.keeper.addToContractAdminSecondaryIndex(ctx, c.info.AdminAddr(), c.address)
+	}
 	return nil
 }
@@ -0,0 +1,55 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/line/lbm-sdk/types"
+	"github.com/line/lbm-sdk/x/wasm/types"
+)
+
+func TestMigrate1to2(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+	k := keepers.WasmKeeper
+	withAdmin := InstantiateHackatomExampleContract(t, ctx, keepers)
+	initMsg := HackatomExampleInitMsg{
+		Verifier:    RandomAccountAddress(t),
+		Beneficiary: RandomAccountAddress(t),
+	}.GetBytes(t)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	withoutAdmin, _, err := keepers.ContractKeeper.Instantiate(ctx, withAdmin.CodeID, withAdmin.CreatorAddr, nil, initMsg, "no admin", nil)
+	require.NoError(t, err)
+
+	// drop the indexes to simulate a version 1 store
+	store := ctx.KVStore(k.storeKey)
+	for _, p := range [][]byte{types.ContractsByCreatorPrefix, types.ContractsByAdminPrefix} {
+		var keys [][]byte
+		iter := sdk.KVStorePrefixIterator(store, p)
+		for ; iter.Valid(); iter.Next() {
+			keys = append(keys, iter.Key())
+		}
+		iter.Close()
+		require.NotEmpty(t, keys)
+		for _, key := range keys {
+			store.Delete(key)
+		}
+	}
+
+	// when
+	require.NoError(t, NewMigrator(*k).Migrate1to2(ctx))
+
+	// then
+	var byCreator, byAdmin []sdk.AccAddress
+	k.IterateContractsByCreator(ctx, withAdmin.CreatorAddr, func(address sdk.AccAddress) bool {
+		byCreator = append(byCreator, address)
+		return false
+	})
+	k.IterateContractsByAdmin(ctx, withAdmin.CreatorAddr, func(address sdk.AccAddress) bool {
+		byAdmin = append(byAdmin, address)
+		return false
+	})
+	assert.Equal(t, []sdk.AccAddress{withAdmin.Contract, withoutAdmin}, byCreator)
+	assert.Equal(t, []sdk.AccAddress{withAdmin.Contract}, byAdmin)
+}
@@ -36,7 +36,9 @@ func NewMessageDispatcher(messenger Messenger, keeper replyer) *MessageDispatche
 // DispatchMessages sends all messages.
 func (d MessageDispatcher) DispatchMessages(ctx sdk.Context, contractAddr sdk.AccAddress, ibcPort string, msgs []wasmvmtypes.CosmosMsg) error {
 	for _, msg := range msgs {
-		events, _, err := d.messenger.DispatchMsg(ctx, contractAddr, ibcPort, msg)
+		msgCtx, trace := startGasTrace(ctx, types.GasTraceKindMessage, contractAddr, cosmosMsgType(msg))
+		events, _, err := d.messenger.DispatchMsg(msgCtx, contractAddr, ibcPort, msg)
+		trace.endWithError(err)
 		if err != nil {
 			return err
 		}
@@ -87,6 +89,7 @@ func (d MessageDispatcher) DispatchSubmessages(ctx sdk.Context, contractAddr sdk
 		subCtx, commit := ctx.CacheContext()
 		em := sdk.NewEventManager()
 		subCtx = subCtx.WithEventManager(em)
+		subCtx, trace := startGasTrace(subCtx, types.GasTraceKindSubmessage, contractAddr, cosmosMsgType(msg.Msg))
 
 		// check how much gas left locally, optionally wrap the gas meter
 		gasRemaining := ctx.GasMeter().Limit() - ctx.GasMeter().GasConsumed()
@@ -100,6 +103,7 @@ func (d MessageDispatcher) DispatchSubmessages(ctx sdk.Context, contractAddr sdk
 		} else {
 			events, data, err = d.messenger.DispatchMsg(subCtx, contractAddr, ibcPort, msg.Msg)
 		}
+		trace.endWithError(err)
 
 		// if it succeeds, commit state changes from submessage, and pass on events to Event Manager
 		var filteredEvents []sdk.Event
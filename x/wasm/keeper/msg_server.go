@@ -6,6 +6,7 @@ import (
 	sdk "github.com/line/lbm-sdk/types"
 	sdkerrors "github.com/line/lbm-sdk/types/errors"
 
+	"github.com/line/lbm-sdk/x/foundation"
 	"github.com/line/lbm-sdk/x/wasm/types"
 )
 
@@ -13,10 +14,11 @@ var _ types.MsgServer = msgServer{}
 
 type msgServer struct {
 	keeper types.ContractOpsKeeper
+	fk     types.FoundationKeeper
 }
 
-func NewMsgServerImpl(k types.ContractOpsKeeper) types.MsgServer {
-	return &msgServer{keeper: k}
+func NewMsgServerImpl(k types.ContractOpsKeeper, fk types.FoundationKeeper) types.MsgServer {
+	return &msgServer{keeper: k, fk: fk}
 }
 
 func (m msgServer) StoreCode(goCtx context.Context, msg *types.MsgStoreCode) (*types.MsgStoreCodeResponse, error) {
@@ -73,6 +75,37 @@ func (m msgServer) InstantiateContract(goCtx context.Context, msg *types.MsgInst
 	}, nil
 }
 
+func (m msgServer) InstantiateContract2(goCtx context.Context, msg *types.MsgInstantiateContract2) (*types.MsgInstantiateContract2Response, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "sender")
+	}
+	var adminAddr sdk.AccAddress
+	if msg.Admin != "" {
+		if adminAddr, err = sdk.AccAddressFromBech32(msg.Admin); err != nil {
+			return nil, sdkerrors.Wrap(err, "admin")
+		}
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+	))
+
+	contractAddr, data, err := m.keeper.Instantiate2(ctx, msg.CodeID, senderAddr, adminAddr, msg.Msg, msg.Label, msg.Funds, msg.Salt, msg.FixMsg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgInstantiateContract2Response{
+		Address: contractAddr.String(),
+		Data:    data,
+	}, nil
+}
+
 func (m msgServer) StoreCodeAndInstantiateContract(goCtx context.Context,
 	msg *types.MsgStoreCodeAndInstantiateContract) (*types.MsgStoreCodeAndInstantiateContractResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
@@ -217,3 +250,137 @@ func (m msgServer) ClearAdmin(goCtx context.Context, msg *types.MsgClearAdmin) (
 
 	return &types.MsgClearAdminResponse{}, nil
 }
+
+func (m msgServer) UpdateCircuitBreaker(goCtx context.Context, msg *types.MsgUpdateCircuitBreaker) (*types.MsgUpdateCircuitBreakerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	operatorAddr, err := sdk.AccAddressFromBech32(msg.Operator)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "operator")
+	}
+	if err := m.fk.Accept(ctx, foundation.ModuleName, operatorAddr, msg); err != nil {
+		return nil, err
+	}
+	contracts := make([]sdk.AccAddress, len(msg.ContractAddresses))
+	for i, addr := range msg.ContractAddresses {
+		if contracts[i], err = sdk.AccAddressFromBech32(addr); err != nil {
+			return nil, sdkerrors.Wrap(err, "contract")
+		}
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(sdk.AttributeKeySender, msg.Operator),
+	))
+
+	if err := m.keeper.UpdateCircuitBreaker(ctx, contracts, msg.CodeIDs, msg.Paused); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateCircuitBreakerResponse{}, nil
+}
+
+func (m msgServer) UpdateContractQuota(goCtx context.Context, msg *types.MsgUpdateContractQuota) (*types.MsgUpdateContractQuotaResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	operatorAddr, err := sdk.AccAddressFromBech32(msg.Operator)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "operator")
+	}
+	if err := m.fk.Accept(ctx, foundation.ModuleName, operatorAddr, msg); err != nil {
+		return nil, err
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(msg.Contract)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "contract")
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(sdk.AttributeKeySender, msg.Operator),
+	))
+
+	if err := m.keeper.SetContractQuota(ctx, contractAddr, msg.Quota); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateContractQuotaResponse{}, nil
+}
+
+func (m msgServer) RegisterSchedule(goCtx context.Context, msg *types.MsgRegisterSchedule) (*types.MsgRegisterScheduleResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	operatorAddr, err := sdk.AccAddressFromBech32(msg.Operator)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "operator")
+	}
+	if err := m.fk.Accept(ctx, foundation.ModuleName, operatorAddr, msg); err != nil {
+		return nil, err
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(msg.Contract)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "contract")
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(sdk.AttributeKeySender, msg.Operator),
+	))
+
+	if err := m.keeper.RegisterSchedule(ctx, contractAddr, msg.Name, msg.Msg, msg.Interval, msg.GasLimit); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRegisterScheduleResponse{}, nil
+}
+
+func (m msgServer) DeregisterSchedule(goCtx context.Context, msg *types.MsgDeregisterSchedule) (*types.MsgDeregisterScheduleResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	operatorAddr, err := sdk.AccAddressFromBech32(msg.Operator)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "operator")
+	}
+	if err := m.fk.Accept(ctx, foundation.ModuleName, operatorAddr, msg); err != nil {
+		return nil, err
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(msg.Contract)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "contract")
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(sdk.AttributeKeySender, msg.Operator),
+	))
+
+	if err := m.keeper.DeregisterSchedule(ctx, contractAddr, msg.Name); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgDeregisterScheduleResponse{}, nil
+}
+
+func (m msgServer) UpdateReceiverHook(goCtx context.Context, msg *types.MsgUpdateReceiverHook) (*types.MsgUpdateReceiverHookResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "sender")
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(msg.Contract)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "contract")
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+	))
+
+	if err := m.keeper.UpdateReceiverHook(ctx, contractAddr, senderAddr, msg.Enabled); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateReceiverHookResponse{}, nil
+}
@@ -121,3 +121,17 @@ func WithMaxQueryStackSize(m uint32) Option {
 		k.maxQueryStackSize = m
 	})
 }
+
+// WithReceiverHookGasLimit overwrites the default gas limit of a contract handling a received transfer
+func WithReceiverHookGasLimit(limit uint64) Option {
+	return optsFn(func(k *Keeper) {
+		k.receiverHookGasLimit = limit
+	})
+}
+
+// WithMaxReceiverHookDepth overwrites the default limit for nested receiver hook calls
+func WithMaxReceiverHookDepth(m uint32) Option {
+	return optsFn(func(k *Keeper) {
+		k.maxReceiverHookDepth = m
+	})
+}
@@ -50,6 +50,12 @@ func NewWasmProposalHandlerX(k types.ContractOpsKeeper, enabledProposalTypes []t
 			return handleUpdateContractStatusProposal(ctx, k, *c)
 		case *types.UpdateInstantiateConfigProposal:
 			return handleUpdateInstantiateConfigProposal(ctx, k, *c)
+		case *types.ImportContractProposal:
+			return handleImportContractProposal(ctx, k, *c)
+		case *types.RegisterScheduleProposal:
+			return handleRegisterScheduleProposal(ctx, k, *c)
+		case *types.DeregisterScheduleProposal:
+			return handleDeregisterScheduleProposal(ctx, k, *c)
 		default:
 			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized wasm proposal content type: %T", c)
 		}
@@ -255,3 +261,33 @@ func handleUpdateInstantiateConfigProposal(ctx sdk.Context, k types.ContractOpsK
 	}
 	return nil
 }
+
+func handleImportContractProposal(ctx sdk.Context, k types.ContractOpsKeeper, p types.ImportContractProposal) error {
+	if err := p.ValidateBasic(); err != nil {
+		return err
+	}
+	_, err := k.ImportContract(ctx, p.Contract)
+	return err
+}
+
+func handleRegisterScheduleProposal(ctx sdk.Context, k types.ContractOpsKeeper, p types.RegisterScheduleProposal) error {
+	if err := p.ValidateBasic(); err != nil {
+		return err
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(p.Contract)
+	if err != nil {
+		return sdkerrors.Wrap(err, "contract")
+	}
+	return k.RegisterSchedule(ctx, contractAddr, p.Name, p.Msg, p.Interval, p.GasLimit)
+}
+
+func handleDeregisterScheduleProposal(ctx sdk.Context, k types.ContractOpsKeeper, p types.DeregisterScheduleProposal) error {
+	if err := p.ValidateBasic(); err != nil {
+		return err
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(p.Contract)
+	if err != nil {
+		return sdkerrors.Wrap(err, "contract")
+	}
+	return k.DeregisterSchedule(ctx, contractAddr, p.Name)
+}
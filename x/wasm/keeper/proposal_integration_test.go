@@ -5,6 +5,7 @@ import (
 	"encoding/hex"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"io/ioutil"
 	"testing"
 
@@ -919,3 +920,109 @@ func TestUpdateInstantiateConfigProposal(t *testing.T) {
 		})
 	}
 }
+
+func TestImportContractProposal(t *testing.T) {
+	// setup a contract on the source chain
+	srcCtx, srcKeepers := CreateTestInput(t, false, "staking", nil, nil)
+	example := InstantiateHackatomExampleContract(t, srcCtx, srcKeepers)
+	srcKeeper := srcKeepers.WasmKeeper
+
+	codeInfo := srcKeeper.GetCodeInfo(srcCtx, example.CodeID)
+	require.NotNil(t, codeInfo)
+	codeBytes, err := srcKeeper.GetByteCode(srcCtx, example.CodeID)
+	require.NoError(t, err)
+	contractInfo := srcKeeper.GetContractInfo(srcCtx, example.Contract)
+	require.NotNil(t, contractInfo)
+	history := srcKeeper.GetContractHistory(srcCtx, example.Contract)
+	var state []types.Model
+	srcKeeper.IterateContractState(srcCtx, example.Contract, func(key, value []byte) bool {
+		state = append(state, types.Model{Key: key, Value: value})
+		return false
+	})
+	export, err := types.NewContractExport(*codeInfo, codeBytes, example.Contract, *contractInfo, history, state)
+	require.NoError(t, err)
+
+	// and a target chain that already has a code
+	ctx, keepers := CreateTestInput(t, false, "staking", nil, nil)
+	govKeeper, wasmKeeper := keepers.GovKeeper, keepers.WasmKeeper
+	StoreHackatomExampleContract(t, ctx, keepers)
+
+	src := types.ImportContractProposalFixture(func(p *types.ImportContractProposal) {
+		p.Contract = export
+	})
+
+	// when stored
+	storedProposal, err := govKeeper.SubmitProposal(ctx, src)
+	require.NoError(t, err)
+
+	// and proposal execute
+	handler := govKeeper.Router().GetRoute(storedProposal.ProposalRoute())
+	err = handler(ctx, storedProposal.GetContent())
+	require.NoError(t, err)
+
+	// then the code is stored with a new code id
+	const expCodeID = 2
+	gotCodeInfo := wasmKeeper.GetCodeInfo(ctx, expCodeID)
+	require.NotNil(t, gotCodeInfo)
+	assert.Equal(t, codeInfo.CodeHash, gotCodeInfo.CodeHash)
+
+	// and the contract is restored at its address
+	gotContractInfo := wasmKeeper.GetContractInfo(ctx, example.Contract)
+	require.NotNil(t, gotContractInfo)
+	assert.Equal(t, uint64(expCodeID), gotContractInfo.CodeID)
+	assert.Equal(t, contractInfo.Creator, gotContractInfo.Creator)
+	assert.Equal(t, contractInfo.Label, gotContractInfo.Label)
+
+	gotHistory := wasmKeeper.GetContractHistory(ctx, example.Contract)
+	require.Len(t, gotHistory, len(history)+1)
+	assert.Equal(t, history, gotHistory[:len(history)])
+	assert.Equal(t, types.ContractCodeHistoryOperationTypeGenesis, gotHistory[len(history)].Operation)
+	assert.Equal(t, uint64(expCodeID), gotHistory[len(history)].CodeID)
+
+	var gotState []types.Model
+	wasmKeeper.IterateContractState(ctx, example.Contract, func(key, value []byte) bool {
+		gotState = append(gotState, types.Model{Key: key, Value: value})
+		return false
+	})
+	assert.Equal(t, state, gotState)
+
+	var gotContracts []sdk.AccAddress
+	wasmKeeper.IterateContractsByCode(ctx, expCodeID, func(address sdk.AccAddress) bool {
+		gotContracts = append(gotContracts, address)
+		return false
+	})
+	assert.Equal(t, []sdk.AccAddress{example.Contract}, gotContracts)
+
+	// and the contract can be queried
+	res, err := wasmKeeper.QuerySmart(ctx, example.Contract, []byte(`{"verifier":{}}`))
+	require.NoError(t, err)
+	assert.JSONEq(t, fmt.Sprintf(`{"verifier":%q}`, example.VerifierAddr.String()), string(res))
+
+	// and a second import of the same contract fails
+	err = handler(ctx, storedProposal.GetContent())
+	require.ErrorIs(t, err, types.ErrDuplicate)
+}
+
+func TestImportContractProposalRejectsTamperedExport(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, "staking", nil, nil)
+	govKeeper, wasmKeeper := keepers.GovKeeper, keepers.WasmKeeper
+	wasmCode, err := ioutil.ReadFile("./testdata/hackatom.wasm")
+	require.NoError(t, err)
+
+	export, err := types.NewContractExport(
+		types.CodeInfoFixture(types.WithSHA256CodeHash(wasmCode)),
+		wasmCode,
+		BuildContractAddress(1, 1),
+		types.ContractInfoFixture(),
+		nil,
+		[]types.Model{{Key: []byte("anyKey"), Value: []byte("anyValue")}},
+	)
+	require.NoError(t, err)
+	export.ContractState[0].Value = []byte("otherValue")
+
+	handler := govKeeper.Router().GetRoute(types.RouterKey)
+	err = handler(ctx, &types.ImportContractProposal{Title: "Foo", Description: "Bar", Contract: export})
+	require.Error(t, err)
+	assert.Nil(t, wasmKeeper.GetCodeInfo(ctx, 1))
+	assert.False(t, wasmKeeper.HasContractInfo(ctx, BuildContractAddress(1, 1)))
+}
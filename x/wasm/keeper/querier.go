@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 	"encoding/binary"
+	"encoding/hex"
 	"runtime/debug"
 
 	"google.golang.org/grpc/codes"
@@ -311,3 +312,243 @@ func (q GrpcQuerier) PinnedCodes(c context.Context, req *types.QueryPinnedCodesR
 		Pagination: pageRes,
 	}, nil
 }
+
+func (q GrpcQuerier) BuildAddress(c context.Context, req *types.QueryBuildAddressRequest) (*types.QueryBuildAddressResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	codeHash, err := hex.DecodeString(req.CodeHash)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid code hash: %s", err)
+	}
+	creator, err := sdk.AccAddressFromBech32(req.CreatorAddress)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid creator address: %s", err)
+	}
+	salt, err := hex.DecodeString(req.Salt)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid salt: %s", err)
+	}
+	if err := types.ValidateSalt(salt); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid salt: %s", err)
+	}
+	initMsg := types.RawContractMessage(req.InitArgs)
+	if len(initMsg) != 0 {
+		if err := initMsg.ValidateBasic(); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid init args: %s", err)
+		}
+	}
+	return &types.QueryBuildAddressResponse{
+		Address: BuildContractAddressPredictable(codeHash, creator, salt, initMsg).String(),
+	}, nil
+}
+
+func (q GrpcQuerier) ContractsByCreator(c context.Context, req *types.QueryContractsByCreatorRequest) (*types.QueryContractsByCreatorResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	creatorAddr, err := sdk.AccAddressFromBech32(req.CreatorAddress)
+	if err != nil {
+		return nil, err
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	r := make([]string, 0)
+
+	prefixStore := prefix.NewStore(ctx.KVStore(q.storeKey), types.GetContractsByCreatorPrefix(creatorAddr))
+	pageRes, err := query.FilteredPaginate(prefixStore, req.Pagination, func(key []byte, _ []byte, accumulate bool) (bool, error) {
+		if accumulate {
+			var contractAddr sdk.AccAddress = key[types.AbsoluteTxPositionLen:]
+			r = append(r, contractAddr.String())
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryContractsByCreatorResponse{
+		ContractAddresses: r,
+		Pagination:        pageRes,
+	}, nil
+}
+
+func (q GrpcQuerier) ContractsByAdmin(c context.Context, req *types.QueryContractsByAdminRequest) (*types.QueryContractsByAdminResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	adminAddr, err := sdk.AccAddressFromBech32(req.AdminAddress)
+	if err != nil {
+		return nil, err
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	r := make([]string, 0)
+
+	prefixStore := prefix.NewStore(ctx.KVStore(q.storeKey), types.GetContractsByAdminPrefix(adminAddr))
+	pageRes, err := query.FilteredPaginate(prefixStore, req.Pagination, func(key []byte, _ []byte, accumulate bool) (bool, error) {
+		if accumulate {
+			var contractAddr sdk.AccAddress = key
+			r = append(r, contractAddr.String())
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryContractsByAdminResponse{
+		ContractAddresses: r,
+		Pagination:        pageRes,
+	}, nil
+}
+
+func (q GrpcQuerier) TraceExecuteContract(c context.Context, req *types.QueryTraceExecuteContractRequest) (*types.QueryTraceExecuteContractResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if err := req.Msg.ValidateBasic(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid msg")
+	}
+	if !req.Funds.IsValid() {
+		return nil, status.Error(codes.InvalidArgument, "invalid funds")
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(req.Contract)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "contract")
+	}
+	senderAddr, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "sender")
+	}
+	gasLimit := q.queryGasLimit
+	if req.GasLimit != 0 && req.GasLimit < gasLimit {
+		gasLimit = req.GasLimit
+	}
+	ctx := sdk.UnwrapSDKContext(c).WithGasMeter(sdk.NewGasMeter(gasLimit))
+	return q.keeper.TraceExecute(ctx, contractAddr, senderAddr, req.Msg, req.Funds), nil
+}
+
+func (q GrpcQuerier) ContractLimits(c context.Context, req *types.QueryContractLimitsRequest) (*types.QueryContractLimitsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, err
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	contractInfo := q.keeper.GetContractInfo(ctx, contractAddr)
+	if contractInfo == nil {
+		return nil, types.ErrNotFound
+	}
+	executions, gasUsed := q.keeper.GetContractQuotaUsage(ctx, contractAddr)
+	return &types.QueryContractLimitsResponse{
+		Paused:     q.keeper.IsPausedContract(ctx, contractAddr),
+		CodePaused: q.keeper.IsPausedCode(ctx, contractInfo.CodeID),
+		Quota:      q.keeper.GetContractQuota(ctx, contractAddr),
+		Executions: executions,
+		GasUsed:    gasUsed,
+	}, nil
+}
+
+func (q GrpcQuerier) PausedContracts(c context.Context, req *types.QueryPausedContractsRequest) (*types.QueryPausedContractsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	r := make([]string, 0)
+
+	prefixStore := prefix.NewStore(ctx.KVStore(q.storeKey), types.PausedContractPrefix)
+	pageRes, err := query.FilteredPaginate(prefixStore, req.Pagination, func(key []byte, _ []byte, accumulate bool) (bool, error) {
+		if accumulate {
+			var contractAddr sdk.AccAddress = key
+			r = append(r, contractAddr.String())
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryPausedContractsResponse{
+		ContractAddresses: r,
+		Pagination:        pageRes,
+	}, nil
+}
+
+func (q GrpcQuerier) PausedCodes(c context.Context, req *types.QueryPausedCodesRequest) (*types.QueryPausedCodesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	r := make([]uint64, 0)
+
+	prefixStore := prefix.NewStore(ctx.KVStore(q.storeKey), types.PausedCodePrefix)
+	pageRes, err := query.FilteredPaginate(prefixStore, req.Pagination, func(key []byte, _ []byte, accumulate bool) (bool, error) {
+		if accumulate {
+			r = append(r, sdk.BigEndianToUint64(key))
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryPausedCodesResponse{
+		CodeIDs:    r,
+		Pagination: pageRes,
+	}, nil
+}
+
+func (q GrpcQuerier) Schedules(c context.Context, req *types.QuerySchedulesRequest) (*types.QuerySchedulesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	r := make([]types.Schedule, 0)
+
+	prefixStore := prefix.NewStore(ctx.KVStore(q.storeKey), types.SchedulePrefix)
+	pageRes, err := query.FilteredPaginate(prefixStore, req.Pagination, func(_ []byte, value []byte, accumulate bool) (bool, error) {
+		if accumulate {
+			var schedule types.Schedule
+			if err := q.cdc.Unmarshal(value, &schedule); err != nil {
+				return false, err
+			}
+			r = append(r, schedule)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.QuerySchedulesResponse{
+		Schedules:  r,
+		Pagination: pageRes,
+	}, nil
+}
+
+func (q GrpcQuerier) ContractSchedules(c context.Context, req *types.QueryContractSchedulesRequest) (*types.QueryContractSchedulesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, err
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	r := make([]types.Schedule, 0)
+
+	prefixStore := prefix.NewStore(ctx.KVStore(q.storeKey), types.GetSchedulesPrefix(contractAddr))
+	pageRes, err := query.FilteredPaginate(prefixStore, req.Pagination, func(_ []byte, value []byte, accumulate bool) (bool, error) {
+		if accumulate {
+			var schedule types.Schedule
+			if err := q.cdc.Unmarshal(value, &schedule); err != nil {
+				return false, err
+			}
+			r = append(r, schedule)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryContractSchedulesResponse{
+		Schedules:  r,
+		Pagination: pageRes,
+	}, nil
+}
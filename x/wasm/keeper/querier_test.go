@@ -1,7 +1,9 @@
 package keeper
 
 import (
+	"context"
 	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -851,3 +853,372 @@ func fromBase64(s string) []byte {
 	}
 	return r
 }
+
+func TestQueryBuildAddress(t *testing.T) {
+	specs := map[string]struct {
+		src    *types.QueryBuildAddressRequest
+		exp    *types.QueryBuildAddressResponse
+		expErr bool
+	}{
+		"empty request": {
+			src:    nil,
+			expErr: true,
+		},
+		"valid": {
+			src: &types.QueryBuildAddressRequest{
+				CodeHash:       "13a1fc994cc6d1c81b746ee0c0ff6f90043875e0bf1d9be6b7d779fc978dc2a5",
+				CreatorAddress: sdk.AccAddress(make([]byte, 20)).String(),
+				Salt:           "61",
+			},
+			exp: &types.QueryBuildAddressResponse{
+				Address: BuildContractAddressPredictable(
+					mustDecodeHex(t, "13a1fc994cc6d1c81b746ee0c0ff6f90043875e0bf1d9be6b7d779fc978dc2a5"),
+					make([]byte, 20), []byte("a"), []byte{}).String(),
+			},
+		},
+		"valid with init args": {
+			src: &types.QueryBuildAddressRequest{
+				CodeHash:       "13a1fc994cc6d1c81b746ee0c0ff6f90043875e0bf1d9be6b7d779fc978dc2a5",
+				CreatorAddress: sdk.AccAddress(make([]byte, 20)).String(),
+				Salt:           "61",
+				InitArgs:       []byte(`{"foo":"bar"}`),
+			},
+			exp: &types.QueryBuildAddressResponse{
+				Address: BuildContractAddressPredictable(
+					mustDecodeHex(t, "13a1fc994cc6d1c81b746ee0c0ff6f90043875e0bf1d9be6b7d779fc978dc2a5"),
+					make([]byte, 20), []byte("a"), []byte(`{"foo":"bar"}`)).String(),
+			},
+		},
+		"invalid code hash": {
+			src: &types.QueryBuildAddressRequest{
+				CodeHash:       "invalid",
+				CreatorAddress: sdk.AccAddress(make([]byte, 20)).String(),
+				Salt:           "61",
+			},
+			expErr: true,
+		},
+		"invalid creator address": {
+			src: &types.QueryBuildAddressRequest{
+				CodeHash:       "13a1fc994cc6d1c81b746ee0c0ff6f90043875e0bf1d9be6b7d779fc978dc2a5",
+				CreatorAddress: "invalid",
+				Salt:           "61",
+			},
+			expErr: true,
+		},
+		"empty salt": {
+			src: &types.QueryBuildAddressRequest{
+				CodeHash:       "13a1fc994cc6d1c81b746ee0c0ff6f90043875e0bf1d9be6b7d779fc978dc2a5",
+				CreatorAddress: sdk.AccAddress(make([]byte, 20)).String(),
+			},
+			expErr: true,
+		},
+		"invalid init args": {
+			src: &types.QueryBuildAddressRequest{
+				CodeHash:       "13a1fc994cc6d1c81b746ee0c0ff6f90043875e0bf1d9be6b7d779fc978dc2a5",
+				CreatorAddress: sdk.AccAddress(make([]byte, 20)).String(),
+				Salt:           "61",
+				InitArgs:       []byte("invalid"),
+			},
+			expErr: true,
+		},
+	}
+	q := GrpcQuerier{}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			got, gotErr := q.BuildAddress(context.Background(), spec.src)
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.exp, got)
+		})
+	}
+}
+
+func mustDecodeHex(t *testing.T, s string) []byte {
+	bz, err := hex.DecodeString(s)
+	require.NoError(t, err)
+	return bz
+}
+
+func TestQueryContractsByCreatorAndAdmin(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+	example := InstantiateHackatomExampleContract(t, ctx, keepers)
+	creator, admin := example.CreatorAddr, RandomAccountAddress(t)
+	initMsg := HackatomExampleInitMsg{
+		Verifier:    RandomAccountAddress(t),
+		Beneficiary: RandomAccountAddress(t),
+	}.GetBytes(t)
+	contracts := []string{example.Contract.String()}
+	for i := 0; i < 2; i++ {
+		ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+		addr, _, err := keepers.ContractKeeper.Instantiate(ctx, example.CodeID, creator, admin, initMsg, fmt.Sprintf("contract %d", i), nil)
+		require.NoError(t, err)
+		contracts = append(contracts, addr.String())
+	}
+	q := Querier(keepers.WasmKeeper)
+
+	t.Run("by creator", func(t *testing.T) {
+		specs := map[string]struct {
+			src    *types.QueryContractsByCreatorRequest
+			exp    []string
+			expErr bool
+		}{
+			"all": {
+				src: &types.QueryContractsByCreatorRequest{CreatorAddress: creator.String()},
+				exp: contracts,
+			},
+			"with pagination limit": {
+				src: &types.QueryContractsByCreatorRequest{CreatorAddress: creator.String(), Pagination: &query.PageRequest{Limit: 2}},
+				exp: contracts[:2],
+			},
+			"with pagination offset": {
+				src: &types.QueryContractsByCreatorRequest{CreatorAddress: creator.String(), Pagination: &query.PageRequest{Offset: 1}},
+				exp: contracts[1:],
+			},
+			"unknown creator": {
+				src: &types.QueryContractsByCreatorRequest{CreatorAddress: admin.String()},
+				exp: []string{},
+			},
+			"invalid creator": {
+				src:    &types.QueryContractsByCreatorRequest{CreatorAddress: "invalid"},
+				expErr: true,
+			},
+			"nil request": {
+				expErr: true,
+			},
+		}
+		for msg, spec := range specs {
+			t.Run(msg, func(t *testing.T) {
+				got, gotErr := q.ContractsByCreator(sdk.WrapSDKContext(ctx), spec.src)
+				if spec.expErr {
+					require.Error(t, gotErr)
+					return
+				}
+				require.NoError(t, gotErr)
+				assert.Equal(t, spec.exp, got.ContractAddresses)
+			})
+		}
+	})
+	t.Run("by admin", func(t *testing.T) {
+		// the admin index is ordered by contract address
+		specs := map[string]struct {
+			src    *types.QueryContractsByAdminRequest
+			exp    []string
+			expLen int
+			expErr bool
+		}{
+			"creator as admin": {
+				src:    &types.QueryContractsByAdminRequest{AdminAddress: creator.String()},
+				exp:    contracts[:1],
+				expLen: 1,
+			},
+			"all": {
+				src:    &types.QueryContractsByAdminRequest{AdminAddress: admin.String()},
+				exp:    contracts[1:],
+				expLen: 2,
+			},
+			"with pagination limit": {
+				src:    &types.QueryContractsByAdminRequest{AdminAddress: admin.String(), Pagination: &query.PageRequest{Limit: 1}},
+				exp:    contracts[1:],
+				expLen: 1,
+			},
+			"invalid admin": {
+				src:    &types.QueryContractsByAdminRequest{AdminAddress: "invalid"},
+				expErr: true,
+			},
+			"nil request": {
+				expErr: true,
+			},
+		}
+		for msg, spec := range specs {
+			t.Run(msg, func(t *testing.T) {
+				got, gotErr := q.ContractsByAdmin(sdk.WrapSDKContext(ctx), spec.src)
+				if spec.expErr {
+					require.Error(t, gotErr)
+					return
+				}
+				require.NoError(t, gotErr)
+				require.Len(t, got.ContractAddresses, spec.expLen)
+				assert.Subset(t, spec.exp, got.ContractAddresses)
+			})
+		}
+	})
+}
+
+func TestQueryCircuitBreaker(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+	example := InstantiateHackatomExampleContract(t, ctx, keepers)
+	quota := types.ContractQuota{MaxExecutionsPerBlock: 10}
+	require.NoError(t, keepers.ContractKeeper.UpdateCircuitBreaker(ctx, []sdk.AccAddress{example.Contract}, []uint64{example.CodeID}, true))
+	require.NoError(t, keepers.ContractKeeper.SetContractQuota(ctx, example.Contract, quota))
+	q := Querier(keepers.WasmKeeper)
+
+	t.Run("contract limits", func(t *testing.T) {
+		specs := map[string]struct {
+			src    *types.QueryContractLimitsRequest
+			exp    *types.QueryContractLimitsResponse
+			expErr bool
+		}{
+			"paused with quota": {
+				src: &types.QueryContractLimitsRequest{Address: example.Contract.String()},
+				exp: &types.QueryContractLimitsResponse{Paused: true, CodePaused: true, Quota: &quota},
+			},
+			"unknown contract": {
+				src:    &types.QueryContractLimitsRequest{Address: RandomBech32AccountAddress(t)},
+				expErr: true,
+			},
+			"invalid address": {
+				src:    &types.QueryContractLimitsRequest{Address: "invalid"},
+				expErr: true,
+			},
+		}
+		for msg, spec := range specs {
+			t.Run(msg, func(t *testing.T) {
+				got, gotErr := q.ContractLimits(sdk.WrapSDKContext(ctx), spec.src)
+				if spec.expErr {
+					require.Error(t, gotErr)
+					return
+				}
+				require.NoError(t, gotErr)
+				assert.Equal(t, spec.exp, got)
+			})
+		}
+	})
+	t.Run("paused contracts", func(t *testing.T) {
+		got, err := q.PausedContracts(sdk.WrapSDKContext(ctx), &types.QueryPausedContractsRequest{})
+		require.NoError(t, err)
+		assert.Equal(t, []string{example.Contract.String()}, got.ContractAddresses)
+	})
+	t.Run("paused codes", func(t *testing.T) {
+		got, err := q.PausedCodes(sdk.WrapSDKContext(ctx), &types.QueryPausedCodesRequest{})
+		require.NoError(t, err)
+		assert.Equal(t, []uint64{example.CodeID}, got.CodeIDs)
+	})
+}
+
+func TestQuerySchedules(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+	example := InstantiateHackatomExampleContract(t, ctx, keepers)
+	other := InstantiateHackatomExampleContract(t, ctx, keepers)
+	for _, name := range []string{"b", "a"} {
+		require.NoError(t, keepers.ContractKeeper.RegisterSchedule(ctx, example.Contract, name, []byte(`{}`), 10, 100_000))
+	}
+	require.NoError(t, keepers.ContractKeeper.RegisterSchedule(ctx, other.Contract, "c", []byte(`{}`), 10, 100_000))
+	q := Querier(keepers.WasmKeeper)
+
+	t.Run("all schedules", func(t *testing.T) {
+		got, err := q.Schedules(sdk.WrapSDKContext(ctx), &types.QuerySchedulesRequest{})
+		require.NoError(t, err)
+		assert.Len(t, got.Schedules, 3)
+
+		got, err = q.Schedules(sdk.WrapSDKContext(ctx), &types.QuerySchedulesRequest{Pagination: &query.PageRequest{Limit: 2}})
+		require.NoError(t, err)
+		assert.Len(t, got.Schedules, 2)
+		assert.NotEmpty(t, got.Pagination.NextKey)
+	})
+	t.Run("contract schedules", func(t *testing.T) {
+		specs := map[string]struct {
+			src      *types.QueryContractSchedulesRequest
+			expNames []string
+			expErr   bool
+		}{
+			"ordered by name": {
+				src:      &types.QueryContractSchedulesRequest{Address: example.Contract.String()},
+				expNames: []string{"a", "b"},
+			},
+			"other contract": {
+				src:      &types.QueryContractSchedulesRequest{Address: other.Contract.String()},
+				expNames: []string{"c"},
+			},
+			"without schedules": {
+				src:      &types.QueryContractSchedulesRequest{Address: RandomBech32AccountAddress(t)},
+				expNames: []string{},
+			},
+			"invalid address": {
+				src:    &types.QueryContractSchedulesRequest{Address: "invalid"},
+				expErr: true,
+			},
+		}
+		for msg, spec := range specs {
+			t.Run(msg, func(t *testing.T) {
+				got, gotErr := q.ContractSchedules(sdk.WrapSDKContext(ctx), spec.src)
+				if spec.expErr {
+					require.Error(t, gotErr)
+					return
+				}
+				require.NoError(t, gotErr)
+				names := make([]string, 0, len(got.Schedules))
+				for _, s := range got.Schedules {
+					assert.Equal(t, spec.src.Address, s.Contract)
+					names = append(names, s.Name)
+				}
+				assert.Equal(t, spec.expNames, names)
+			})
+		}
+	})
+}
+
+func TestQueryTraceExecuteContract(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+	keeper := keepers.WasmKeeper
+
+	example := InstantiateHackatomExampleContract(t, ctx, keepers)
+	contractAddr := example.Contract.String()
+	verifierAddr := example.VerifierAddr.String()
+
+	q := Querier(keeper)
+	specs := map[string]struct {
+		src          *types.QueryTraceExecuteContractRequest
+		expExecError bool
+		expErr       bool
+	}{
+		"execute": {
+			src: &types.QueryTraceExecuteContractRequest{Sender: verifierAddr, Contract: contractAddr, Msg: []byte(`{"release":{}}`)},
+		},
+		"execution fails": {
+			src:          &types.QueryTraceExecuteContractRequest{Sender: RandomBech32AccountAddress(t), Contract: contractAddr, Msg: []byte(`{"release":{}}`)},
+			expExecError: true,
+		},
+		"gas limit": {
+			src:          &types.QueryTraceExecuteContractRequest{Sender: verifierAddr, Contract: contractAddr, Msg: []byte(`{"release":{}}`), GasLimit: 1000},
+			expExecError: true,
+		},
+		"invalid json": {
+			src:    &types.QueryTraceExecuteContractRequest{Sender: verifierAddr, Contract: contractAddr, Msg: []byte(`not a json string`)},
+			expErr: true,
+		},
+		"invalid sender": {
+			src:    &types.QueryTraceExecuteContractRequest{Sender: "invalid", Contract: contractAddr, Msg: []byte(`{"release":{}}`)},
+			expErr: true,
+		},
+		"invalid contract": {
+			src:    &types.QueryTraceExecuteContractRequest{Sender: verifierAddr, Contract: "invalid", Msg: []byte(`{"release":{}}`)},
+			expErr: true,
+		},
+		"empty request": {
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			got, err := q.TraceExecuteContract(sdk.WrapSDKContext(ctx), spec.src)
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			if spec.expExecError {
+				assert.NotEmpty(t, got.Error)
+				return
+			}
+			assert.Empty(t, got.Error)
+			assert.NotZero(t, got.GasUsed)
+			assert.Equal(t, types.GasTraceKindExecute, got.Trace.Kind)
+			assert.Equal(t, contractAddr, got.Trace.Contract)
+		})
+	}
+	// the contract was not released
+	assert.False(t, keepers.BankKeeper.GetAllBalances(ctx, example.Contract).IsZero())
+}
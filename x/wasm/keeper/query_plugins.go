@@ -3,6 +3,8 @@ package keeper
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
+	"reflect"
 
 	channeltypes "github.com/line/lbm-sdk/x/ibc/core/04-channel/types"
 	"github.com/line/lbm-sdk/x/wasm/types"
@@ -10,6 +12,7 @@ import (
 	abci "github.com/line/ostracon/abci/types"
 	wasmvmtypes "github.com/line/wasmvm/types"
 
+	"github.com/line/lbm-sdk/codec"
 	sdk "github.com/line/lbm-sdk/types"
 	sdkerrors "github.com/line/lbm-sdk/types/errors"
 	distributiontypes "github.com/line/lbm-sdk/x/distribution/types"
@@ -47,13 +50,16 @@ func (q QueryHandler) Query(request wasmvmtypes.QueryRequest, gasLimit uint64) (
 	sdkGas := q.GasMultiplier.FromWasmVMGas(gasLimit)
 	// discard all changes/ events in subCtx by not committing the cached context
 	subCtx, _ := q.Ctx.WithGasMeter(sdk.NewGasMeter(sdkGas)).CacheContext()
+	subCtx, trace := startGasTrace(subCtx, types.GasTraceKindQuery, q.Caller, queryRequestType(request))
+	defer trace.end()
 
 	// make sure we charge the higher level context even on panic
 	defer func() {
-		q.Ctx.GasMeter().ConsumeGas(subCtx.GasMeter().GasConsumed(), "contract sub-query")
+		q.Ctx.GasMeter().ConsumeGas(subCtx.GasMeter().GasConsumed(), gasDescContractSubQuery)
 	}()
 
 	res, err := q.Plugins.HandleQuery(subCtx, q.Caller, request)
+	trace.endWithError(err)
 	if err == nil {
 		// short-circuit, the rest is dealing with handling existing errors
 		return res, nil
@@ -294,6 +300,59 @@ func StargateQuerier(queryRouter GRPCQueryRouter) func(ctx sdk.Context, request
 	}
 }
 
+// AcceptedStargateQueries defines the Stargate queries accepted from contracts
+// as a map of the gRPC query paths to the types of their responses.
+type AcceptedStargateQueries map[string]codec.ProtoMarshaler
+
+// AcceptListStargateQuerier supports the Stargate queries of the accept list
+// only. The responses are returned as the proto3 JSON of the response types.
+//
+// Warning: the queries of the accept list must be deterministic, as their
+// results are part of the state transitions of the contracts.
+//
+// The querier can be set by the WithQueryPlugins option of the keeper:
+// WithQueryPlugins(&QueryPlugins{Stargate: AcceptListStargateQuerier(acceptList, queryRouter, codec)})
+func AcceptListStargateQuerier(acceptList AcceptedStargateQueries, queryRouter GRPCQueryRouter, cdc codec.Codec) func(ctx sdk.Context, request *wasmvmtypes.StargateQuery) ([]byte, error) {
+	return func(ctx sdk.Context, request *wasmvmtypes.StargateQuery) ([]byte, error) {
+		protoResponse, accepted := acceptList[request.Path]
+		if !accepted {
+			return nil, wasmvmtypes.UnsupportedRequest{Kind: fmt.Sprintf("'%s' path is not allowed from the contract", request.Path)}
+		}
+
+		route := queryRouter.Route(request.Path)
+		if route == nil {
+			return nil, wasmvmtypes.UnsupportedRequest{Kind: fmt.Sprintf("No route to query '%s'", request.Path)}
+		}
+
+		res, err := route(ctx, abci.RequestQuery{
+			Data: request.Data,
+			Path: request.Path,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		return ConvertProtoToJSONMarshal(cdc, protoResponse, res.Value)
+	}
+}
+
+// ConvertProtoToJSONMarshal unmarshals the bytes into a new message of the
+// type of protoResponse, and marshals the message into its proto3 JSON. The
+// JSON is stable as the fields are written in the order of their numbers.
+func ConvertProtoToJSONMarshal(cdc codec.Codec, protoResponse codec.ProtoMarshaler, bz []byte) ([]byte, error) {
+	// a new message is used as the accept list is shared by all queries
+	msg := reflect.New(reflect.TypeOf(protoResponse).Elem()).Interface().(codec.ProtoMarshaler)
+	if err := cdc.Unmarshal(bz, msg); err != nil {
+		return nil, sdkerrors.Wrap(err, "to proto")
+	}
+
+	bz, err := cdc.MarshalJSON(msg)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "to json")
+	}
+	return bz, nil
+}
+
 func StakingQuerier(keeper types.StakingKeeper, distKeeper types.DistributionKeeper) func(ctx sdk.Context, request *wasmvmtypes.StakingQuery) ([]byte, error) {
 	return func(ctx sdk.Context, request *wasmvmtypes.StakingQuery) ([]byte, error) {
 		if request.BondedDenom != nil {
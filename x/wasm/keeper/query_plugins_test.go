@@ -4,14 +4,17 @@ import (
 	"encoding/json"
 	"testing"
 
+	abci "github.com/line/ostracon/abci/types"
 	wasmvmtypes "github.com/line/wasmvm/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	dbm "github.com/tendermint/tm-db"
 
+	"github.com/line/lbm-sdk/baseapp"
 	"github.com/line/lbm-sdk/store"
 	sdk "github.com/line/lbm-sdk/types"
 	sdkerrors "github.com/line/lbm-sdk/types/errors"
+	banktypes "github.com/line/lbm-sdk/x/bank/types"
 	channeltypes "github.com/line/lbm-sdk/x/ibc/core/04-channel/types"
 	"github.com/line/lbm-sdk/x/wasm/keeper/wasmtesting"
 	"github.com/line/lbm-sdk/x/wasm/types"
@@ -444,6 +447,94 @@ func TestContractInfoWasmQuerier(t *testing.T) {
 	}
 }
 
+func TestAcceptListStargateQuerier(t *testing.T) {
+	ctx, keepers := CreateDefaultTestInput(t)
+	cdc := keepers.EncodingConfig.Marshaler
+	addr := keepers.Faucet.NewFundedAccount(ctx, sdk.NewInt64Coin("denom", 100))
+
+	queryRouter := baseapp.NewGRPCQueryRouter()
+	queryRouter.SetInterfaceRegistry(keepers.EncodingConfig.InterfaceRegistry)
+	banktypes.RegisterQueryServer(queryRouter, keepers.BankKeeper)
+
+	acceptList := AcceptedStargateQueries{
+		"/cosmos.bank.v1beta1.Query/Balance":   &banktypes.QueryBalanceResponse{},
+		"/cosmos.staking.v1beta1.Query/Params": &banktypes.QueryParamsResponse{},
+	}
+	q := AcceptListStargateQuerier(acceptList, queryRouter, cdc)
+
+	balanceReq, err := cdc.Marshal(&banktypes.QueryBalanceRequest{Address: addr.String(), Denom: "denom"})
+	require.NoError(t, err)
+	expBalance := sdk.NewInt64Coin("denom", 100)
+	expJSON, err := cdc.MarshalJSON(&banktypes.QueryBalanceResponse{Balance: &expBalance})
+	require.NoError(t, err)
+
+	specs := map[string]struct {
+		path      string
+		data      []byte
+		expRes    []byte
+		expErr    error
+		expAnyErr bool
+	}{
+		"accepted": {
+			path:   "/cosmos.bank.v1beta1.Query/Balance",
+			data:   balanceReq,
+			expRes: expJSON,
+		},
+		"not accepted": {
+			path:   "/cosmos.bank.v1beta1.Query/AllBalances",
+			data:   balanceReq,
+			expErr: wasmvmtypes.UnsupportedRequest{Kind: "'/cosmos.bank.v1beta1.Query/AllBalances' path is not allowed from the contract"},
+		},
+		"accepted without route": {
+			path:   "/cosmos.staking.v1beta1.Query/Params",
+			expErr: wasmvmtypes.UnsupportedRequest{Kind: "No route to query '/cosmos.staking.v1beta1.Query/Params'"},
+		},
+		"invalid request": {
+			path:      "/cosmos.bank.v1beta1.Query/Balance",
+			data:      []byte{0xff},
+			expAnyErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			gotRes, gotErr := q(ctx, &wasmvmtypes.StargateQuery{Path: spec.path, Data: spec.data})
+			if spec.expAnyErr {
+				require.Error(t, gotErr)
+				return
+			}
+			if spec.expErr != nil {
+				assert.Equal(t, spec.expErr, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.JSONEq(t, string(spec.expRes), string(gotRes))
+			// the same query gives the same bytes
+			again, err := q(ctx, &wasmvmtypes.StargateQuery{Path: spec.path, Data: spec.data})
+			require.NoError(t, err)
+			assert.Equal(t, gotRes, again)
+		})
+	}
+
+	// the gas of the gRPC query is charged to the querying contract
+	plugins := QueryPlugins{Stargate: q}
+	gasMultiplier := NewGasMultiplier(types.DefaultGasMultiplier)
+	handler := NewQueryHandler(ctx.WithGasMeter(sdk.NewInfiniteGasMeter()), plugins, addr, gasMultiplier)
+	_, err = handler.Query(wasmvmtypes.QueryRequest{Stargate: &wasmvmtypes.StargateQuery{Path: "/cosmos.bank.v1beta1.Query/Balance", Data: balanceReq}}, gasMultiplier.ToWasmVMGas(1_000_000))
+	require.NoError(t, err)
+
+	directCtx := ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+	_, err = queryRouter.Route("/cosmos.bank.v1beta1.Query/Balance")(directCtx, abci.RequestQuery{Data: balanceReq})
+	require.NoError(t, err)
+	require.NotZero(t, directCtx.GasMeter().GasConsumed())
+	assert.Equal(t, directCtx.GasMeter().GasConsumed(), handler.GasConsumed())
+
+	// the query fails when running out of the gas given by the contract
+	handler = NewQueryHandler(ctx.WithGasMeter(sdk.NewInfiniteGasMeter()), plugins, addr, gasMultiplier)
+	assert.Panics(t, func() {
+		_, _ = handler.Query(wasmvmtypes.QueryRequest{Stargate: &wasmvmtypes.StargateQuery{Path: "/cosmos.bank.v1beta1.Query/Balance", Data: balanceReq}}, 1)
+	})
+}
+
 func TestQueryErrors(t *testing.T) {
 	specs := map[string]struct {
 		src    error
@@ -0,0 +1,146 @@
+package keeper
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	sdk "github.com/line/lbm-sdk/types"
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
+	"github.com/line/lbm-sdk/x/collection"
+	"github.com/line/lbm-sdk/x/token"
+	"github.com/line/lbm-sdk/x/wasm/types"
+)
+
+var (
+	_ token.ReceiverHooks      = ReceiverHooks{}
+	_ collection.ReceiverHooks = ReceiverHooks{}
+)
+
+// ReceiverHooks notifies the contracts opted in to the receiver hook about received x/token tokens and
+// x/collection coins. A contract rejects a transfer by returning an error from its sudo entry point.
+type ReceiverHooks struct {
+	k Keeper
+}
+
+// ReceiverHooks returns the hooks to be set on the x/token and x/collection keepers
+func (k Keeper) ReceiverHooks() ReceiverHooks {
+	return ReceiverHooks{k: k}
+}
+
+// OnTokenReceived implements token.ReceiverHooks
+func (h ReceiverHooks) OnTokenReceived(ctx sdk.Context, contractID string, operator, from, to sdk.AccAddress, amount sdk.Int) error {
+	return h.k.callReceiverHook(ctx, to, types.ReceiverHookMsg{
+		TokenReceived: &types.TokenReceivedMsg{
+			ContractID: contractID,
+			Operator:   operator.String(),
+			From:       from.String(),
+			Amount:     amount.String(),
+		},
+	})
+}
+
+// OnCollectionReceived implements collection.ReceiverHooks
+func (h ReceiverHooks) OnCollectionReceived(ctx sdk.Context, contractID string, operator, from, to sdk.AccAddress, amount []collection.Coin) error {
+	coins := make([]types.CollectionCoin, len(amount))
+	for i, coin := range amount {
+		coins[i] = types.CollectionCoin{TokenID: coin.TokenId, Amount: coin.Amount.String()}
+	}
+	return h.k.callReceiverHook(ctx, to, types.ReceiverHookMsg{
+		CollectionReceived: &types.CollectionReceivedMsg{
+			ContractID: contractID,
+			Operator:   operator.String(),
+			From:       from.String(),
+			Amount:     coins,
+		},
+	})
+}
+
+// callReceiverHook passes the msg to the sudo entry point of the recipient, when it is a contract opted in to
+// the receiver hook. Otherwise, it is a no-op.
+func (k Keeper) callReceiverHook(ctx sdk.Context, recipient sdk.AccAddress, msg types.ReceiverHookMsg) error {
+	if !k.HasReceiverHook(ctx, recipient) {
+		return nil
+	}
+	// a contract can trigger a transfer to another contract from its hook
+	ctx, err := checkAndIncreaseReceiverHookDepth(ctx, k.maxReceiverHookDepth)
+	if err != nil {
+		return err
+	}
+	bz, err := json.Marshal(msg)
+	if err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	if err := k.sudoWithReceiverHookGasLimit(ctx, recipient, bz); err != nil {
+		return sdkerrors.Wrap(err, "receiver hook")
+	}
+	return nil
+}
+
+// sudoWithReceiverHookGasLimit calls the sudo entry point of the contract with the receiver hook gas limit.
+// The gas used is charged to the parent context.
+func (k Keeper) sudoWithReceiverHookGasLimit(ctx sdk.Context, contractAddress sdk.AccAddress, msg []byte) (err error) {
+	subCtx := ctx.WithGasMeter(sdk.NewGasMeter(k.receiverHookGasLimit))
+
+	// catch out of gas panic of the limited gas meter only
+	defer func() {
+		r := recover()
+		ctx.GasMeter().ConsumeGas(subCtx.GasMeter().GasConsumedToLimit(), "receiver hook")
+		if r == nil {
+			return
+		}
+		if _, ok := r.(sdk.ErrorOutOfGas); !ok {
+			panic(r)
+		}
+		err = sdkerrors.Wrap(sdkerrors.ErrOutOfGas, "receiver hook hit gas limit")
+	}()
+
+	_, err = k.Sudo(subCtx, contractAddress, msg)
+	return err
+}
+
+func checkAndIncreaseReceiverHookDepth(ctx sdk.Context, maxDepth uint32) (sdk.Context, error) {
+	var depth uint32
+	if d := ctx.Context().Value(contextKeyReceiverHookDepth); d != nil {
+		depth = d.(uint32)
+	}
+	depth++
+	if depth > maxDepth {
+		return ctx, types.ErrExceedMaxReceiverHookDepth
+	}
+	return ctx.WithContext(context.WithValue(ctx.Context(), contextKeyReceiverHookDepth, depth)), nil
+}
+
+// setReceiverHook opts a contract in to or out of the receiver hook. The contract itself or its admin can do so.
+func (k Keeper) setReceiverHook(ctx sdk.Context, contractAddress, caller sdk.AccAddress, enabled bool, authZ AuthorizationPolicy) error {
+	contractInfo := k.GetContractInfo(ctx, contractAddress)
+	if contractInfo == nil {
+		return sdkerrors.Wrap(types.ErrNotFound, "contract")
+	}
+	if !caller.Equals(contractAddress) && !authZ.CanModifyContract(contractInfo.AdminAddr(), caller) {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "can not modify contract")
+	}
+	k.storeReceiverHook(ctx, contractAddress, enabled)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeUpdateReceiverHook,
+		sdk.NewAttribute(types.AttributeKeyContractAddr, contractAddress.String()),
+		sdk.NewAttribute(types.AttributeKeyEnabled, strconv.FormatBool(enabled)),
+	))
+	return nil
+}
+
+func (k Keeper) storeReceiverHook(ctx sdk.Context, contractAddress sdk.AccAddress, enabled bool) {
+	store := ctx.KVStore(k.storeKey)
+	if enabled {
+		// store 1 byte to not run into `nil` debugging issues
+		store.Set(types.GetReceiverHookKey(contractAddress), []byte{1})
+		return
+	}
+	store.Delete(types.GetReceiverHookKey(contractAddress))
+}
+
+// HasReceiverHook returns true when the contract opted in to the receiver hook
+func (k Keeper) HasReceiverHook(ctx sdk.Context, contractAddress sdk.AccAddress) bool {
+	return ctx.KVStore(k.storeKey).Has(types.GetReceiverHookKey(contractAddress))
+}
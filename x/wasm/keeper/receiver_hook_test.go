@@ -0,0 +1,225 @@
+package keeper
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	wasmvm "github.com/line/wasmvm"
+	wasmvmtypes "github.com/line/wasmvm/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/line/lbm-sdk/types"
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
+	"github.com/line/lbm-sdk/x/collection"
+	"github.com/line/lbm-sdk/x/wasm/keeper/wasmtesting"
+	"github.com/line/lbm-sdk/x/wasm/types"
+)
+
+func TestReceiverHooks(t *testing.T) {
+	var (
+		gotMsg      []byte
+		gotGasLimit uint64
+		sudoErr     error
+		sudoGasUsed uint64
+	)
+	mock := wasmtesting.MockWasmer{SudoFn: func(codeID wasmvm.Checksum, env wasmvmtypes.Env, sudoMsg []byte, store wasmvm.KVStore, goapi wasmvm.GoAPI, querier wasmvm.Querier, gasMeter wasmvm.GasMeter, gasLimit uint64, deserCost wasmvmtypes.UFraction) (*wasmvmtypes.Response, uint64, error) {
+		gotMsg, gotGasLimit = sudoMsg, gasLimit
+		return &wasmvmtypes.Response{}, sudoGasUsed, sudoErr
+	}}
+	wasmtesting.MakeInstantiable(&mock)
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil, WithWasmEngine(&mock), WithReceiverHookGasLimit(100_000))
+	example := SeedNewContractInstance(t, ctx, keepers, &mock)
+	_, _, operator := keyPubAddr()
+	_, _, from := keyPubAddr()
+	hooks := keepers.WasmKeeper.ReceiverHooks()
+
+	callToken := func(ctx sdk.Context, to sdk.AccAddress) error {
+		return hooks.OnTokenReceived(ctx, "f00dbabe", operator, from, to, sdk.NewInt(100))
+	}
+	callCollection := func(ctx sdk.Context, to sdk.AccAddress) error {
+		return hooks.OnCollectionReceived(ctx, "deadbeef", operator, from, to, []collection.Coin{
+			{TokenId: "0000000100000000", Amount: sdk.NewInt(5)},
+			{TokenId: "1000000100000001", Amount: sdk.OneInt()},
+		})
+	}
+
+	specs := map[string]struct {
+		call        func(sdk.Context, sdk.AccAddress) error
+		to          sdk.AccAddress
+		disabled    bool
+		sudoErr     error
+		sudoGasUsed uint64
+		expMsg      *types.ReceiverHookMsg
+		expErr      *sdkerrors.Error
+	}{
+		"token received": {
+			call: callToken,
+			to:   example.Contract,
+			expMsg: &types.ReceiverHookMsg{TokenReceived: &types.TokenReceivedMsg{
+				ContractID: "f00dbabe",
+				Operator:   operator.String(),
+				From:       from.String(),
+				Amount:     "100",
+			}},
+		},
+		"collection received": {
+			call: callCollection,
+			to:   example.Contract,
+			expMsg: &types.ReceiverHookMsg{CollectionReceived: &types.CollectionReceivedMsg{
+				ContractID: "deadbeef",
+				Operator:   operator.String(),
+				From:       from.String(),
+				Amount: []types.CollectionCoin{
+					{TokenID: "0000000100000000", Amount: "5"},
+					{TokenID: "1000000100000001", Amount: "1"},
+				},
+			}},
+		},
+		"not opted in": {
+			call:     callToken,
+			to:       example.Contract,
+			disabled: true,
+		},
+		"not a contract": {
+			call: callToken,
+			to:   from,
+		},
+		"rejected by contract": {
+			call:    callCollection,
+			to:      example.Contract,
+			sudoErr: errors.New("unexpected token"),
+			expErr:  types.ErrExecuteFailed,
+		},
+		"gas limit exceeded": {
+			call:        callToken,
+			to:          example.Contract,
+			sudoGasUsed: 1 << 60,
+			expErr:      sdkerrors.ErrOutOfGas,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := ctx.CacheContext()
+			gotMsg, sudoErr, sudoGasUsed = nil, spec.sudoErr, spec.sudoGasUsed
+			if !spec.disabled {
+				require.NoError(t, keepers.ContractKeeper.UpdateReceiverHook(ctx, example.Contract, example.CreatorAddr, true))
+			}
+			gasBefore := ctx.GasMeter().GasConsumed()
+
+			err := spec.call(ctx, spec.to)
+			require.True(t, spec.expErr.Is(err), "got %+v", err)
+			if spec.expErr != nil {
+				return
+			}
+			if spec.expMsg == nil {
+				assert.Nil(t, gotMsg)
+				return
+			}
+			var msg types.ReceiverHookMsg
+			require.NoError(t, json.Unmarshal(gotMsg, &msg))
+			assert.Equal(t, *spec.expMsg, msg)
+			assert.Less(t, gotGasLimit, keepers.WasmKeeper.getGasMultiplier(ctx).ToWasmVMGas(100_000))
+			assert.Greater(t, ctx.GasMeter().GasConsumed(), gasBefore)
+		})
+	}
+}
+
+func TestReceiverHookDepth(t *testing.T) {
+	mock := wasmtesting.MockWasmer{SudoFn: func(codeID wasmvm.Checksum, env wasmvmtypes.Env, sudoMsg []byte, store wasmvm.KVStore, goapi wasmvm.GoAPI, querier wasmvm.Querier, gasMeter wasmvm.GasMeter, gasLimit uint64, deserCost wasmvmtypes.UFraction) (*wasmvmtypes.Response, uint64, error) {
+		return &wasmvmtypes.Response{}, 0, nil
+	}}
+	wasmtesting.MakeInstantiable(&mock)
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil, WithWasmEngine(&mock), WithMaxReceiverHookDepth(2))
+	example := SeedNewContractInstance(t, ctx, keepers, &mock)
+	require.NoError(t, keepers.ContractKeeper.UpdateReceiverHook(ctx, example.Contract, example.CreatorAddr, true))
+	_, _, from := keyPubAddr()
+	hooks := keepers.WasmKeeper.ReceiverHooks()
+
+	specs := map[string]struct {
+		depth  uint32
+		expErr bool
+	}{
+		"top level":       {},
+		"nested in limit": {depth: 1},
+		"nested too deep": {depth: 2, expErr: true},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx := ctx
+			if spec.depth != 0 {
+				ctx = ctx.WithContext(context.WithValue(ctx.Context(), contextKeyReceiverHookDepth, spec.depth))
+			}
+			err := hooks.OnTokenReceived(ctx, "f00dbabe", from, from, example.Contract, sdk.OneInt())
+			if spec.expErr {
+				assert.True(t, types.ErrExceedMaxReceiverHookDepth.Is(err), "got %+v", err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestUpdateReceiverHook(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+	example := InstantiateHackatomExampleContract(t, ctx, keepers)
+	_, _, otherAddr := keyPubAddr()
+
+	specs := map[string]struct {
+		contract sdk.AccAddress
+		caller   sdk.AccAddress
+		expErr   *sdkerrors.Error
+	}{
+		"by admin": {
+			contract: example.Contract,
+			caller:   example.CreatorAddr,
+		},
+		"by contract": {
+			contract: example.Contract,
+			caller:   example.Contract,
+		},
+		"by other": {
+			contract: example.Contract,
+			caller:   otherAddr,
+			expErr:   sdkerrors.ErrUnauthorized,
+		},
+		"unknown contract": {
+			contract: otherAddr,
+			caller:   otherAddr,
+			expErr:   types.ErrNotFound,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := ctx.CacheContext()
+			err := keepers.ContractKeeper.UpdateReceiverHook(ctx, spec.contract, spec.caller, true)
+			require.True(t, spec.expErr.Is(err), "got %+v", err)
+			if spec.expErr != nil {
+				assert.False(t, keepers.WasmKeeper.HasReceiverHook(ctx, spec.contract))
+				return
+			}
+			assert.True(t, keepers.WasmKeeper.HasReceiverHook(ctx, spec.contract))
+
+			// and disable again
+			require.NoError(t, keepers.ContractKeeper.UpdateReceiverHook(ctx, spec.contract, spec.caller, false))
+			assert.False(t, keepers.WasmKeeper.HasReceiverHook(ctx, spec.contract))
+		})
+	}
+}
+
+func TestReceiverHookGenesis(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+	example := InstantiateHackatomExampleContract(t, ctx, keepers)
+	require.NoError(t, keepers.ContractKeeper.UpdateReceiverHook(ctx, example.Contract, example.CreatorAddr, true))
+
+	genState := ExportGenesis(ctx, keepers.WasmKeeper)
+	require.Len(t, genState.Contracts, 1)
+	assert.True(t, genState.Contracts[0].ReceiverHook)
+
+	dstCtx, dstKeepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+	_, err := InitGenesis(dstCtx, dstKeepers.WasmKeeper, *genState, dstKeepers.StakingKeeper, TestHandler(dstKeepers.ContractKeeper))
+	require.NoError(t, err)
+	assert.True(t, dstKeepers.WasmKeeper.HasReceiverHook(dstCtx, example.Contract))
+}
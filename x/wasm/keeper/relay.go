@@ -127,6 +127,9 @@ func (k Keeper) OnRecvPacket(
 	if err != nil {
 		return nil, err
 	}
+	if err := k.assertNotPaused(ctx, contractAddr, contractInfo.CodeID); err != nil {
+		return nil, err
+	}
 
 	env := types.NewEnv(ctx, contractAddr)
 	querier := NewQueryHandler(ctx, k.wasmVMQueryHandler, contractAddr, k.getGasMultiplier(ctx))
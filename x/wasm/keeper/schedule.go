@@ -0,0 +1,170 @@
+package keeper
+
+import (
+	"strconv"
+
+	"github.com/line/lbm-sdk/store/prefix"
+	sdk "github.com/line/lbm-sdk/types"
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
+	"github.com/line/lbm-sdk/x/wasm/types"
+)
+
+// maxScheduleErrorLen limits the size of the error recorded on a schedule as it is kept in the state
+const maxScheduleErrorLen = 256
+
+// registerSchedule registers a periodic call of the sudo entry point of a contract. An existing schedule
+// of the same name is replaced and starts over with the current height.
+func (k Keeper) registerSchedule(ctx sdk.Context, contractAddress sdk.AccAddress, name string, msg []byte, interval, gasLimit uint64) error {
+	if !k.HasContractInfo(ctx, contractAddress) {
+		return sdkerrors.Wrap(types.ErrNotFound, "contract")
+	}
+	if existing := k.GetSchedule(ctx, contractAddress, name); existing != nil {
+		ctx.KVStore(k.storeKey).Delete(types.GetScheduleQueueKey(existing.NextHeight, contractAddress, name))
+	}
+	schedule := types.NewSchedule(contractAddress, name, msg, interval, gasLimit, ctx.BlockHeight()+int64(interval))
+	if err := schedule.ValidateBasic(); err != nil {
+		return err
+	}
+	k.storeSchedule(ctx, schedule)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeRegisterSchedule,
+		sdk.NewAttribute(types.AttributeKeyContractAddr, contractAddress.String()),
+		sdk.NewAttribute(types.AttributeKeyScheduleName, name),
+		sdk.NewAttribute(types.AttributeKeyInterval, strconv.FormatUint(interval, 10)),
+		sdk.NewAttribute(types.AttributeKeyGasLimit, strconv.FormatUint(gasLimit, 10)),
+	))
+	return nil
+}
+
+// deregisterSchedule removes a schedule of a contract
+func (k Keeper) deregisterSchedule(ctx sdk.Context, contractAddress sdk.AccAddress, name string) error {
+	schedule := k.GetSchedule(ctx, contractAddress, name)
+	if schedule == nil {
+		return sdkerrors.Wrap(types.ErrNotFound, "schedule")
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetScheduleKey(contractAddress, name))
+	store.Delete(types.GetScheduleQueueKey(schedule.NextHeight, contractAddress, name))
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeDeregisterSchedule,
+		sdk.NewAttribute(types.AttributeKeyContractAddr, contractAddress.String()),
+		sdk.NewAttribute(types.AttributeKeyScheduleName, name),
+	))
+	return nil
+}
+
+// storeSchedule persists the schedule and queues it for its next height
+func (k Keeper) storeSchedule(ctx sdk.Context, schedule types.Schedule) {
+	contractAddress := sdk.MustAccAddressFromBech32(schedule.Contract)
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetScheduleKey(contractAddress, schedule.Name), k.cdc.MustMarshal(&schedule))
+	// store 1 byte to not run into `nil` debugging issues
+	store.Set(types.GetScheduleQueueKey(schedule.NextHeight, contractAddress, schedule.Name), []byte{1})
+}
+
+// GetSchedule returns the schedule of a contract or nil when it does not exist
+func (k Keeper) GetSchedule(ctx sdk.Context, contractAddress sdk.AccAddress, name string) *types.Schedule {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetScheduleKey(contractAddress, name))
+	if bz == nil {
+		return nil
+	}
+	var schedule types.Schedule
+	k.cdc.MustUnmarshal(bz, &schedule)
+	return &schedule
+}
+
+// IterateSchedules iterates over all schedules ordered by contract address and name.
+// When the callback returns true, the loop is aborted early.
+func (k Keeper) IterateSchedules(ctx sdk.Context, cb func(types.Schedule) bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.SchedulePrefix)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var schedule types.Schedule
+		k.cdc.MustUnmarshal(iter.Value(), &schedule)
+		if cb(schedule) {
+			return
+		}
+	}
+}
+
+// ExecuteSchedules calls the sudo entry point of the contracts whose schedules are due at the current height.
+// Each call runs with the gas limit of its schedule in a sandbox. A failed call is reverted and recorded on
+// the schedule, it neither affects the other calls nor the block.
+func (k Keeper) ExecuteSchedules(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	// collect first, as the store must not be modified while iterating
+	var dueKeys [][]byte
+	iter := store.Iterator(types.ScheduleQueuePrefix, types.GetScheduleQueuePrefix(ctx.BlockHeight()+1))
+	for ; iter.Valid(); iter.Next() {
+		dueKeys = append(dueKeys, iter.Key())
+	}
+	iter.Close()
+
+	queuePrefixLen := len(types.GetScheduleQueuePrefix(0))
+	for _, key := range dueKeys {
+		store.Delete(key)
+		contractAddress, name := types.ParseScheduleQueueKey(key[queuePrefixLen:])
+		schedule := k.GetSchedule(ctx, contractAddress, name)
+		if schedule == nil { // should never happen as the queue is kept in sync
+			continue
+		}
+		k.executeSchedule(ctx, contractAddress, *schedule)
+	}
+}
+
+func (k Keeper) executeSchedule(ctx sdk.Context, contractAddress sdk.AccAddress, schedule types.Schedule) {
+	gasUsed, err := k.sudoWithGasLimit(ctx, contractAddress, schedule.Msg, schedule.GasLimit)
+
+	schedule.LastHeight = ctx.BlockHeight()
+	schedule.NextHeight = ctx.BlockHeight() + int64(schedule.Interval)
+	schedule.LastError = ""
+	attrs := []sdk.Attribute{
+		sdk.NewAttribute(types.AttributeKeyContractAddr, schedule.Contract),
+		sdk.NewAttribute(types.AttributeKeyScheduleName, schedule.Name),
+		sdk.NewAttribute(types.AttributeKeyGasUsed, strconv.FormatUint(gasUsed, 10)),
+	}
+	if err != nil {
+		schedule.LastError = err.Error()
+		if len(schedule.LastError) > maxScheduleErrorLen {
+			schedule.LastError = schedule.LastError[:maxScheduleErrorLen]
+		}
+		attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyError, schedule.LastError))
+		moduleLogger(ctx).Info("scheduled contract call failed", "contract", schedule.Contract, "schedule", schedule.Name, "error", err)
+	}
+	k.storeSchedule(ctx, schedule)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeScheduleExecution, attrs...))
+}
+
+// sudoWithGasLimit calls the sudo entry point of the contract in a sandbox. The state and the events are
+// only kept when the call succeeds.
+func (k Keeper) sudoWithGasLimit(ctx sdk.Context, contractAddress sdk.AccAddress, msg []byte, gasLimit uint64) (gasUsed sdk.Gas, err error) {
+	cacheCtx, commit := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithGasMeter(sdk.NewGasMeter(gasLimit))
+
+	// catch any panic, so that a failing contract can not halt the chain
+	defer func() {
+		if r := recover(); r != nil {
+			gasUsed = cacheCtx.GasMeter().GasConsumed()
+			if _, ok := r.(sdk.ErrorOutOfGas); ok {
+				err = sdkerrors.Wrap(sdkerrors.ErrOutOfGas, "schedule hit gas limit")
+				return
+			}
+			err = sdkerrors.Wrapf(types.ErrExecuteFailed, "panic: %v", r)
+		}
+	}()
+
+	_, err = k.Sudo(cacheCtx, contractAddress, msg)
+	gasUsed = cacheCtx.GasMeter().GasConsumed()
+	if err != nil {
+		return gasUsed, err
+	}
+	commit()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	return gasUsed, nil
+}
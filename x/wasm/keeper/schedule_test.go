@@ -0,0 +1,259 @@
+package keeper
+
+import (
+	"encoding/json"
+	"testing"
+
+	wasmvmtypes "github.com/line/wasmvm/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/line/lbm-sdk/types"
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
+	"github.com/line/lbm-sdk/x/foundation"
+	"github.com/line/lbm-sdk/x/wasm/types"
+)
+
+// stealFundsSudoMsg pays the given amount from the hackatom contract to the recipient
+func stealFundsSudoMsg(t *testing.T, recipient sdk.AccAddress, amount int64) []byte {
+	bz, err := json.Marshal(sudoMsg{
+		StealFunds: stealFundsMsg{
+			Recipient: recipient.String(),
+			Amount:    wasmvmtypes.Coins{wasmvmtypes.NewCoin(uint64(amount), "denom")},
+		},
+	})
+	require.NoError(t, err)
+	return bz
+}
+
+func TestExecuteSchedules(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+	example := InstantiateHackatomExampleContract(t, ctx, keepers)
+	_, _, community := keyPubAddr()
+	const interval = 3
+
+	require.NoError(t, keepers.ContractKeeper.RegisterSchedule(ctx, example.Contract, "payout", stealFundsSudoMsg(t, community, 40), interval, 500_000))
+	schedule := keepers.WasmKeeper.GetSchedule(ctx, example.Contract, "payout")
+	require.NotNil(t, schedule)
+	assert.Equal(t, ctx.BlockHeight()+interval, schedule.NextHeight)
+
+	// not due yet
+	keepers.WasmKeeper.ExecuteSchedules(ctx.WithBlockHeight(schedule.NextHeight - 1))
+	assert.True(t, keepers.BankKeeper.GetBalance(ctx, community, "denom").IsZero())
+
+	specs := []struct {
+		expBalance int64
+		expErr     bool
+	}{
+		{expBalance: 40},
+		{expBalance: 80},
+		{expBalance: 80, expErr: true}, // contract holds only 20 denom left
+	}
+	for i, spec := range specs {
+		height := schedule.NextHeight
+		em := sdk.NewEventManager()
+		keepers.WasmKeeper.ExecuteSchedules(ctx.WithBlockHeight(height).WithEventManager(em))
+
+		assert.Equal(t, sdk.NewInt64Coin("denom", spec.expBalance), keepers.BankKeeper.GetBalance(ctx, community, "denom"), "run %d", i)
+		schedule = keepers.WasmKeeper.GetSchedule(ctx, example.Contract, "payout")
+		require.NotNil(t, schedule)
+		assert.Equal(t, height, schedule.LastHeight)
+		assert.Equal(t, height+interval, schedule.NextHeight)
+		assert.Equal(t, spec.expErr, schedule.LastError != "", "run %d: %q", i, schedule.LastError)
+		lastEvent := em.Events()[len(em.Events())-1]
+		assert.Equal(t, types.EventTypeScheduleExecution, lastEvent.Type)
+	}
+
+	// and remove
+	require.NoError(t, keepers.ContractKeeper.DeregisterSchedule(ctx, example.Contract, "payout"))
+	assert.Nil(t, keepers.WasmKeeper.GetSchedule(ctx, example.Contract, "payout"))
+	keepers.WasmKeeper.ExecuteSchedules(ctx.WithBlockHeight(schedule.NextHeight))
+	err := keepers.ContractKeeper.DeregisterSchedule(ctx, example.Contract, "payout")
+	assert.True(t, types.ErrNotFound.Is(err), "got %+v", err)
+}
+
+func TestExecuteSchedulesGasLimit(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+	example := InstantiateHackatomExampleContract(t, ctx, keepers)
+	_, _, community := keyPubAddr()
+
+	require.NoError(t, keepers.ContractKeeper.RegisterSchedule(ctx, example.Contract, "expensive", stealFundsSudoMsg(t, community, 1), 1, 1_000))
+	require.NoError(t, keepers.ContractKeeper.RegisterSchedule(ctx, example.Contract, "cheap", stealFundsSudoMsg(t, community, 2), 1, 500_000))
+
+	keepers.WasmKeeper.ExecuteSchedules(ctx.WithBlockHeight(ctx.BlockHeight() + 1))
+
+	// the failed call is reverted and does not affect the other one
+	assert.Equal(t, sdk.NewInt64Coin("denom", 2), keepers.BankKeeper.GetBalance(ctx, community, "denom"))
+	expensive := keepers.WasmKeeper.GetSchedule(ctx, example.Contract, "expensive")
+	require.NotNil(t, expensive)
+	assert.Contains(t, expensive.LastError, "schedule hit gas limit")
+	cheap := keepers.WasmKeeper.GetSchedule(ctx, example.Contract, "cheap")
+	require.NotNil(t, cheap)
+	assert.Empty(t, cheap.LastError)
+}
+
+func TestRegisterSchedule(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+	example := InstantiateHackatomExampleContract(t, ctx, keepers)
+	_, _, otherAddr := keyPubAddr()
+	msg := []byte(`{"foo":"bar"}`)
+
+	specs := map[string]struct {
+		contract sdk.AccAddress
+		name     string
+		msg      []byte
+		interval uint64
+		gasLimit uint64
+		expErr   *sdkerrors.Error
+	}{
+		"all good": {
+			contract: example.Contract,
+			name:     "job",
+			msg:      msg,
+			interval: 10,
+			gasLimit: 100_000,
+		},
+		"unknown contract": {
+			contract: otherAddr,
+			name:     "job",
+			msg:      msg,
+			interval: 10,
+			gasLimit: 100_000,
+			expErr:   types.ErrNotFound,
+		},
+		"empty name": {
+			contract: example.Contract,
+			msg:      msg,
+			interval: 10,
+			gasLimit: 100_000,
+			expErr:   types.ErrEmpty,
+		},
+		"invalid msg": {
+			contract: example.Contract,
+			name:     "job",
+			msg:      []byte("not json"),
+			interval: 10,
+			gasLimit: 100_000,
+			expErr:   types.ErrInvalid,
+		},
+		"zero interval": {
+			contract: example.Contract,
+			name:     "job",
+			msg:      msg,
+			gasLimit: 100_000,
+			expErr:   types.ErrEmpty,
+		},
+		"zero gas limit": {
+			contract: example.Contract,
+			name:     "job",
+			msg:      msg,
+			interval: 10,
+			expErr:   types.ErrEmpty,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := ctx.CacheContext()
+			err := keepers.ContractKeeper.RegisterSchedule(ctx, spec.contract, spec.name, spec.msg, spec.interval, spec.gasLimit)
+			require.True(t, spec.expErr.Is(err), "got %+v", err)
+			if spec.expErr != nil {
+				return
+			}
+			exp := types.NewSchedule(spec.contract, spec.name, spec.msg, spec.interval, spec.gasLimit, ctx.BlockHeight()+int64(spec.interval))
+			assert.Equal(t, &exp, keepers.WasmKeeper.GetSchedule(ctx, spec.contract, spec.name))
+		})
+	}
+}
+
+func TestRegisterScheduleReplaces(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+	example := InstantiateHackatomExampleContract(t, ctx, keepers)
+	_, _, community := keyPubAddr()
+
+	require.NoError(t, keepers.ContractKeeper.RegisterSchedule(ctx, example.Contract, "payout", stealFundsSudoMsg(t, community, 1), 1, 500_000))
+	require.NoError(t, keepers.ContractKeeper.RegisterSchedule(ctx, example.Contract, "payout", stealFundsSudoMsg(t, community, 1), 5, 500_000))
+
+	// the queue entry of the replaced schedule is gone
+	keepers.WasmKeeper.ExecuteSchedules(ctx.WithBlockHeight(ctx.BlockHeight() + 1))
+	assert.True(t, keepers.BankKeeper.GetBalance(ctx, community, "denom").IsZero())
+	keepers.WasmKeeper.ExecuteSchedules(ctx.WithBlockHeight(ctx.BlockHeight() + 5))
+	assert.Equal(t, sdk.NewInt64Coin("denom", 1), keepers.BankKeeper.GetBalance(ctx, community, "denom"))
+}
+
+func TestMsgRegisterSchedule(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+	example := InstantiateHackatomExampleContract(t, ctx, keepers)
+	_, _, operatorAddr := keyPubAddr()
+
+	specs := map[string]struct {
+		acceptErr error
+		expErr    bool
+	}{
+		"authorized": {},
+		"unauthorized": {
+			acceptErr: sdkerrors.ErrUnauthorized,
+			expErr:    true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			ctx, _ := ctx.CacheContext()
+			var gotGranter string
+			var gotGrantee sdk.AccAddress
+			fk := keepers.FoundationKeeper
+			fk.AcceptFn = func(ctx sdk.Context, granter string, grantee sdk.AccAddress, msg sdk.Msg) error {
+				gotGranter, gotGrantee = granter, grantee
+				return spec.acceptErr
+			}
+			msgServer := NewMsgServerImpl(keepers.ContractKeeper, fk)
+
+			_, err := msgServer.RegisterSchedule(sdk.WrapSDKContext(ctx), &types.MsgRegisterSchedule{
+				Operator: operatorAddr.String(),
+				Contract: example.Contract.String(),
+				Name:     "job",
+				Msg:      []byte(`{}`),
+				Interval: 10,
+				GasLimit: 100_000,
+			})
+			assert.Equal(t, foundation.ModuleName, gotGranter)
+			assert.Equal(t, operatorAddr, gotGrantee)
+			if spec.expErr {
+				require.Error(t, err)
+				assert.Nil(t, keepers.WasmKeeper.GetSchedule(ctx, example.Contract, "job"))
+				return
+			}
+			require.NoError(t, err)
+			assert.NotNil(t, keepers.WasmKeeper.GetSchedule(ctx, example.Contract, "job"))
+
+			_, err = msgServer.DeregisterSchedule(sdk.WrapSDKContext(ctx), &types.MsgDeregisterSchedule{
+				Operator: operatorAddr.String(),
+				Contract: example.Contract.String(),
+				Name:     "job",
+			})
+			require.NoError(t, err)
+			assert.Nil(t, keepers.WasmKeeper.GetSchedule(ctx, example.Contract, "job"))
+		})
+	}
+}
+
+func TestScheduleGenesis(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+	example := InstantiateHackatomExampleContract(t, ctx, keepers)
+	require.NoError(t, keepers.ContractKeeper.RegisterSchedule(ctx, example.Contract, "payout", []byte(`{}`), 2, 500_000))
+	schedule := keepers.WasmKeeper.GetSchedule(ctx, example.Contract, "payout")
+	require.NotNil(t, schedule)
+
+	genState := ExportGenesis(ctx, keepers.WasmKeeper)
+	require.Equal(t, []types.Schedule{*schedule}, genState.Schedules)
+
+	dstCtx, dstKeepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+	_, err := InitGenesis(dstCtx, dstKeepers.WasmKeeper, *genState, dstKeepers.StakingKeeper, TestHandler(dstKeepers.ContractKeeper))
+	require.NoError(t, err)
+	assert.Equal(t, schedule, dstKeepers.WasmKeeper.GetSchedule(dstCtx, example.Contract, "payout"))
+
+	// and queued for its next height
+	dstKeepers.WasmKeeper.ExecuteSchedules(dstCtx.WithBlockHeight(schedule.NextHeight))
+	got := dstKeepers.WasmKeeper.GetSchedule(dstCtx, example.Contract, "payout")
+	require.NotNil(t, got)
+	assert.Equal(t, schedule.NextHeight, got.LastHeight)
+}
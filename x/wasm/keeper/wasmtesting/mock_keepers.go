@@ -109,3 +109,16 @@ func (m MockIBCTransferKeeper) GetPort(ctx sdk.Context) string {
 	}
 	return m.GetPortFn(ctx)
 }
+
+var _ types.FoundationKeeper = &MockFoundationKeeper{}
+
+type MockFoundationKeeper struct {
+	AcceptFn func(ctx sdk.Context, granter string, grantee sdk.AccAddress, msg sdk.Msg) error
+}
+
+func (m MockFoundationKeeper) Accept(ctx sdk.Context, granter string, grantee sdk.AccAddress, msg sdk.Msg) error {
+	if m.AcceptFn == nil {
+		panic("not expected to be called")
+	}
+	return m.AcceptFn(ctx, granter, grantee, msg)
+}
@@ -0,0 +1,581 @@
+package types
+
+import (
+	"strings"
+
+	"github.com/gogo/protobuf/proto"
+
+	cdctypes "github.com/line/lbm-sdk/codec/types"
+	sdk "github.com/line/lbm-sdk/types"
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
+	"github.com/line/lbm-sdk/x/authz"
+	"github.com/line/lbm-sdk/x/foundation"
+)
+
+const gasDeserializationCostPerByte = uint64(1)
+
+var (
+	_ authz.Authorization              = &ContractExecutionAuthorization{}
+	_ authz.Authorization              = &ContractMigrationAuthorization{}
+	_ cdctypes.UnpackInterfacesMessage = &ContractExecutionAuthorization{}
+	_ cdctypes.UnpackInterfacesMessage = &ContractMigrationAuthorization{}
+	_ cdctypes.UnpackInterfacesMessage = &ContractGrant{}
+)
+
+// AuthzableWasmMsg is abstract wasm tx message that is supported in authz
+type AuthzableWasmMsg interface {
+	GetFunds() sdk.Coins
+	GetMsg() RawContractMessage
+	GetContract() string
+	ValidateBasic() error
+}
+
+// ContractAuthzFactory factory to create an updated Authorization object
+type ContractAuthzFactory interface {
+	NewAuthz([]ContractGrant) authz.Authorization
+}
+
+// NewContractExecutionAuthorization constructor
+func NewContractExecutionAuthorization(grants ...ContractGrant) *ContractExecutionAuthorization {
+	return &ContractExecutionAuthorization{
+		Grants: grants,
+	}
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (a ContractExecutionAuthorization) MsgTypeURL() string {
+	return sdk.MsgTypeURL(&MsgExecuteContract{})
+}
+
+// NewAuthz factory method to create an Authorization with updated grants
+func (a ContractExecutionAuthorization) NewAuthz(g []ContractGrant) authz.Authorization {
+	return NewContractExecutionAuthorization(g...)
+}
+
+// Accept implements Authorization.Accept.
+func (a ContractExecutionAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (authz.AcceptResponse, error) {
+	if _, ok := msg.(*MsgExecuteContract); !ok {
+		return authz.AcceptResponse{}, sdkerrors.ErrInvalidType.Wrap("type mismatch")
+	}
+	return AcceptGrantedMessage(ctx, a.Grants, msg, a)
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a ContractExecutionAuthorization) ValidateBasic() error {
+	return validateGrants(a.Grants)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (a ContractExecutionAuthorization) UnpackInterfaces(unpacker cdctypes.AnyUnpacker) error {
+	for _, g := range a.Grants {
+		if err := g.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// NewContractMigrationAuthorization constructor
+func NewContractMigrationAuthorization(grants ...ContractGrant) *ContractMigrationAuthorization {
+	return &ContractMigrationAuthorization{
+		Grants: grants,
+	}
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (a ContractMigrationAuthorization) MsgTypeURL() string {
+	return sdk.MsgTypeURL(&MsgMigrateContract{})
+}
+
+// NewAuthz factory method to create an Authorization with updated grants
+func (a ContractMigrationAuthorization) NewAuthz(g []ContractGrant) authz.Authorization {
+	return NewContractMigrationAuthorization(g...)
+}
+
+// Accept implements Authorization.Accept.
+func (a ContractMigrationAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (authz.AcceptResponse, error) {
+	if _, ok := msg.(*MsgMigrateContract); !ok {
+		return authz.AcceptResponse{}, sdkerrors.ErrInvalidType.Wrap("type mismatch")
+	}
+	return AcceptGrantedMessage(ctx, a.Grants, msg, a)
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a ContractMigrationAuthorization) ValidateBasic() error {
+	return validateGrants(a.Grants)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (a ContractMigrationAuthorization) UnpackInterfaces(unpacker cdctypes.AnyUnpacker) error {
+	for _, g := range a.Grants {
+		if err := g.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateGrants(g []ContractGrant) error {
+	if len(g) == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "grants")
+	}
+	for i, v := range g {
+		if err := v.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "position %d", i)
+		}
+	}
+	return nil
+}
+
+// AcceptGrantedMessage determines whether this grant permits the provided sdk.Msg to be performed,
+// and if so provides an upgraded authorization instance. The first grant of the contract whose
+// limit and filter accept the message is applied.
+func AcceptGrantedMessage(ctx sdk.Context, grants []ContractGrant, msg sdk.Msg, factory ContractAuthzFactory) (authz.AcceptResponse, error) {
+	exec, ok := msg.(AuthzableWasmMsg)
+	if !ok {
+		return authz.AcceptResponse{}, sdkerrors.ErrInvalidType.Wrap("type mismatch")
+	}
+	if err := exec.ValidateBasic(); err != nil {
+		return authz.AcceptResponse{}, err
+	}
+
+	for i, g := range grants {
+		if g.Contract != exec.GetContract() {
+			continue
+		}
+
+		// first check limits
+		result, err := g.GetLimit().Accept(ctx, exec)
+		switch {
+		case err != nil:
+			return authz.AcceptResponse{}, sdkerrors.Wrap(err, "limit")
+		case result == nil: // sanity check
+			return authz.AcceptResponse{}, sdkerrors.ErrInvalidType.Wrap("limit result must not be nil")
+		case !result.Accepted:
+			// not applicable, continue with next grant
+			continue
+		}
+
+		// then check the message payload
+		ok, err := g.GetFilter().Accept(ctx, exec.GetMsg())
+		switch {
+		case err != nil:
+			return authz.AcceptResponse{}, sdkerrors.Wrap(err, "filter")
+		case !ok:
+			// no limit update and continue with next grant
+			continue
+		}
+
+		// finally apply the limit state updates of the result
+		var updated []ContractGrant
+		switch {
+		case result.DeleteLimit:
+			updated = make([]ContractGrant, 0, len(grants)-1)
+			updated = append(updated, grants[:i]...)
+			updated = append(updated, grants[i+1:]...)
+			if len(updated) == 0 { // remove when empty
+				return authz.AcceptResponse{Accept: true, Delete: true}, nil
+			}
+		case result.UpdateLimit != nil:
+			obj, err := g.WithNewLimits(result.UpdateLimit)
+			if err != nil {
+				return authz.AcceptResponse{}, err
+			}
+			updated = make([]ContractGrant, len(grants))
+			copy(updated, grants)
+			updated[i] = *obj
+		default: // accepted without a limit state update
+			return authz.AcceptResponse{Accept: true}, nil
+		}
+
+		newAuthz := factory.NewAuthz(updated)
+		if err := newAuthz.ValidateBasic(); err != nil { // sanity check
+			return authz.AcceptResponse{}, sdkerrors.Wrapf(ErrInvalid, "new grant state: %s", err)
+		}
+		return authz.AcceptResponse{Accept: true, Updated: newAuthz}, nil
+	}
+	return authz.AcceptResponse{Accept: false}, nil
+}
+
+// ContractAuthzLimitX define execution limits that are enforced and updated when the grant
+// is applied. When the limit lapsed the grant is removed.
+type ContractAuthzLimitX interface {
+	Accept(ctx sdk.Context, msg AuthzableWasmMsg) (*ContractAuthzLimitAcceptResult, error)
+	ValidateBasic() error
+}
+
+// ContractAuthzLimitAcceptResult result of the ContractAuthzLimitX.Accept method
+type ContractAuthzLimitAcceptResult struct {
+	// Accepted is true when limit applies
+	Accepted bool
+	// DeleteLimit when set it is the end of life for this limit. Grant is removed from persistent store
+	DeleteLimit bool
+	// UpdateLimit update persistent state with new value
+	UpdateLimit ContractAuthzLimitX
+}
+
+// ContractAuthzFilterX define more fine-grained control on the message payload passed
+// to the contract in the operation. When no filter applies on execution, the
+// operation is prohibited.
+type ContractAuthzFilterX interface {
+	// Accept returns applicable or error
+	Accept(ctx sdk.Context, msg RawContractMessage) (bool, error)
+	ValidateBasic() error
+}
+
+// NewContractGrant constructor
+func NewContractGrant(contract sdk.AccAddress, limit ContractAuthzLimitX, filter ContractAuthzFilterX) (*ContractGrant, error) {
+	pFilter, ok := filter.(proto.Message)
+	if !ok {
+		return nil, sdkerrors.ErrInvalidType.Wrap("filter is not a proto type")
+	}
+	anyFilter, err := cdctypes.NewAnyWithValue(pFilter)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "filter")
+	}
+	return ContractGrant{
+		Contract: contract.String(),
+		Filter:   anyFilter,
+	}.WithNewLimits(limit)
+}
+
+// WithNewLimits factory method to create a new grant with given limit
+func (g ContractGrant) WithNewLimits(limit ContractAuthzLimitX) (*ContractGrant, error) {
+	pLimit, ok := limit.(proto.Message)
+	if !ok {
+		return nil, sdkerrors.ErrInvalidType.Wrap("limit is not a proto type")
+	}
+	anyLimit, err := cdctypes.NewAnyWithValue(pLimit)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "limit")
+	}
+
+	return &ContractGrant{
+		Contract: g.Contract,
+		Limit:    anyLimit,
+		Filter:   g.Filter,
+	}, nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (g ContractGrant) UnpackInterfaces(unpacker cdctypes.AnyUnpacker) error {
+	var f ContractAuthzFilterX
+	if err := unpacker.UnpackAny(g.Filter, &f); err != nil {
+		return sdkerrors.Wrap(err, "filter")
+	}
+	var l ContractAuthzLimitX
+	if err := unpacker.UnpackAny(g.Limit, &l); err != nil {
+		return sdkerrors.Wrap(err, "limit")
+	}
+	return nil
+}
+
+// GetLimit returns the cached value from the ContractGrant.Limit if present.
+func (g ContractGrant) GetLimit() ContractAuthzLimitX {
+	if g.Limit == nil {
+		return &UndefinedLimit{}
+	}
+	a, ok := g.Limit.GetCachedValue().(ContractAuthzLimitX)
+	if !ok {
+		return &UndefinedLimit{}
+	}
+	return a
+}
+
+// GetFilter returns the cached value from the ContractGrant.Filter if present.
+func (g ContractGrant) GetFilter() ContractAuthzFilterX {
+	if g.Filter == nil {
+		return &UndefinedFilter{}
+	}
+	a, ok := g.Filter.GetCachedValue().(ContractAuthzFilterX)
+	if !ok {
+		return &UndefinedFilter{}
+	}
+	return a
+}
+
+// ValidateBasic validates the grant
+func (g ContractGrant) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(g.Contract); err != nil {
+		return sdkerrors.Wrap(err, "contract")
+	}
+	// execution limits
+	if err := g.GetLimit().ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(err, "limit")
+	}
+	// filter
+	if err := g.GetFilter().ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(err, "filter")
+	}
+	return nil
+}
+
+// UndefinedFilter null object that is always rejected in execution
+type UndefinedFilter struct{}
+
+// Accept always returns error
+func (f *UndefinedFilter) Accept(ctx sdk.Context, msg RawContractMessage) (bool, error) {
+	return false, sdkerrors.ErrNotFound.Wrapf("undefined filter")
+}
+
+// ValidateBasic always returns error
+func (f UndefinedFilter) ValidateBasic() error {
+	return sdkerrors.ErrInvalidType.Wrapf("undefined filter")
+}
+
+// NewAllowAllMessagesFilter constructor
+func NewAllowAllMessagesFilter() *AllowAllMessagesFilter {
+	return &AllowAllMessagesFilter{}
+}
+
+// Accept accepts any valid json message content.
+func (f *AllowAllMessagesFilter) Accept(ctx sdk.Context, msg RawContractMessage) (bool, error) {
+	return true, msg.ValidateBasic()
+}
+
+// ValidateBasic returns always nil
+func (f AllowAllMessagesFilter) ValidateBasic() error {
+	return nil
+}
+
+// NewAcceptedMessageKeysFilter constructor
+func NewAcceptedMessageKeysFilter(acceptedKeys ...string) *AcceptedMessageKeysFilter {
+	return &AcceptedMessageKeysFilter{Keys: acceptedKeys}
+}
+
+// Accept only payload messages which contain one of the accepted key names in the json object.
+func (f *AcceptedMessageKeysFilter) Accept(ctx sdk.Context, msg RawContractMessage) (bool, error) {
+	gasForDeserialization := gasDeserializationCostPerByte * uint64(len(msg))
+	ctx.GasMeter().ConsumeGas(gasForDeserialization, "contract authorization")
+
+	err := IsJSONObjectWithTopLevelKey(msg, f.Keys)
+	switch {
+	case err == nil:
+		return true, nil
+	case ErrTopKevelKeyNotAllowed.Is(err):
+		return false, nil
+	default:
+		return false, sdkerrors.ErrUnauthorized.Wrapf("not an allowed msg: %s", err)
+	}
+}
+
+// ValidateBasic validates the filter
+func (f AcceptedMessageKeysFilter) ValidateBasic() error {
+	if len(f.Keys) == 0 {
+		return ErrEmpty.Wrap("keys")
+	}
+	idx := make(map[string]struct{}, len(f.Keys))
+	for _, m := range f.Keys {
+		if m == "" {
+			return ErrEmpty.Wrap("key")
+		}
+		if m != strings.TrimSpace(m) {
+			return ErrInvalid.Wrapf("key %q contains whitespaces", m)
+		}
+		if _, exists := idx[m]; exists {
+			return ErrDuplicate.Wrapf("key %q", m)
+		}
+		idx[m] = struct{}{}
+	}
+	return nil
+}
+
+var (
+	_ ContractAuthzLimitX = &UndefinedLimit{}
+	_ ContractAuthzLimitX = &MaxCallsLimit{}
+	_ ContractAuthzLimitX = &MaxFundsLimit{}
+	_ ContractAuthzLimitX = &CombinedLimit{}
+)
+
+// UndefinedLimit null object that is always rejected in execution
+type UndefinedLimit struct{}
+
+// ValidateBasic always returns error
+func (u UndefinedLimit) ValidateBasic() error {
+	return sdkerrors.ErrInvalidType.Wrapf("undefined limit")
+}
+
+// Accept always returns error
+func (u UndefinedLimit) Accept(ctx sdk.Context, msg AuthzableWasmMsg) (*ContractAuthzLimitAcceptResult, error) {
+	return nil, sdkerrors.ErrNotFound.Wrapf("undefined limit")
+}
+
+// NewMaxCallsLimit constructor
+func NewMaxCallsLimit(number uint64) *MaxCallsLimit {
+	return &MaxCallsLimit{Remaining: number}
+}
+
+// Accept only the defined number of message calls. No token transfers to the contract allowed.
+func (m MaxCallsLimit) Accept(_ sdk.Context, msg AuthzableWasmMsg) (*ContractAuthzLimitAcceptResult, error) {
+	if !msg.GetFunds().Empty() {
+		return &ContractAuthzLimitAcceptResult{Accepted: false}, nil
+	}
+	switch n := m.Remaining; n {
+	case 0: // sanity check
+		return nil, sdkerrors.ErrUnauthorized.Wrap("no calls left")
+	case 1:
+		return &ContractAuthzLimitAcceptResult{Accepted: true, DeleteLimit: true}, nil
+	default:
+		return &ContractAuthzLimitAcceptResult{Accepted: true, UpdateLimit: &MaxCallsLimit{Remaining: n - 1}}, nil
+	}
+}
+
+// ValidateBasic validates the limit
+func (m MaxCallsLimit) ValidateBasic() error {
+	if m.Remaining == 0 {
+		return ErrEmpty.Wrap("remaining calls")
+	}
+	return nil
+}
+
+// NewMaxFundsLimit constructor
+func NewMaxFundsLimit(max ...sdk.Coin) *MaxFundsLimit {
+	return &MaxFundsLimit{Amounts: max}
+}
+
+// Accept until the defined budget for token transfers to the contract is spent
+func (m MaxFundsLimit) Accept(_ sdk.Context, msg AuthzableWasmMsg) (*ContractAuthzLimitAcceptResult, error) {
+	if msg.GetFunds().Empty() { // no state changes required
+		return &ContractAuthzLimitAcceptResult{Accepted: true}, nil
+	}
+	if !msg.GetFunds().IsAllLTE(m.Amounts) {
+		return &ContractAuthzLimitAcceptResult{Accepted: false}, nil
+	}
+	newAmounts := m.Amounts.Sub(msg.GetFunds())
+	if newAmounts.IsZero() {
+		return &ContractAuthzLimitAcceptResult{Accepted: true, DeleteLimit: true}, nil
+	}
+	return &ContractAuthzLimitAcceptResult{Accepted: true, UpdateLimit: &MaxFundsLimit{Amounts: newAmounts}}, nil
+}
+
+// ValidateBasic validates the limit
+func (m MaxFundsLimit) ValidateBasic() error {
+	if err := m.Amounts.Validate(); err != nil {
+		return err
+	}
+	if m.Amounts.IsZero() {
+		return ErrEmpty.Wrap("amounts")
+	}
+	return nil
+}
+
+// NewCombinedLimit constructor
+func NewCombinedLimit(maxCalls uint64, maxAmounts ...sdk.Coin) *CombinedLimit {
+	return &CombinedLimit{CallsRemaining: maxCalls, Amounts: maxAmounts}
+}
+
+// Accept until the max calls is reached or the token budget is spent.
+func (l CombinedLimit) Accept(_ sdk.Context, msg AuthzableWasmMsg) (*ContractAuthzLimitAcceptResult, error) {
+	transferFunds := msg.GetFunds()
+	if !transferFunds.IsAllLTE(l.Amounts) {
+		return &ContractAuthzLimitAcceptResult{Accepted: false}, nil // does not apply
+	}
+	switch n := l.CallsRemaining; n {
+	case 0: // sanity check
+		return nil, sdkerrors.ErrUnauthorized.Wrap("no calls left")
+	case 1:
+		return &ContractAuthzLimitAcceptResult{Accepted: true, DeleteLimit: true}, nil
+	default:
+		remainingAmounts := l.Amounts.Sub(transferFunds)
+		if remainingAmounts.IsZero() {
+			return &ContractAuthzLimitAcceptResult{Accepted: true, DeleteLimit: true}, nil
+		}
+		return &ContractAuthzLimitAcceptResult{
+			Accepted:    true,
+			UpdateLimit: NewCombinedLimit(n-1, remainingAmounts...),
+		}, nil
+	}
+}
+
+// ValidateBasic validates the limit
+func (l CombinedLimit) ValidateBasic() error {
+	if l.CallsRemaining == 0 {
+		return ErrEmpty.Wrap("remaining calls")
+	}
+	if l.Amounts.IsZero() {
+		return ErrEmpty.Wrap("amounts")
+	}
+	if err := l.Amounts.Validate(); err != nil {
+		return sdkerrors.Wrap(err, "amounts")
+	}
+	return nil
+}
+
+var (
+	_ foundation.Authorization = &CircuitBreakerAuthorization{}
+	_ foundation.Authorization = &ContractQuotaAuthorization{}
+	_ foundation.Authorization = &RegisterScheduleAuthorization{}
+	_ foundation.Authorization = &DeregisterScheduleAuthorization{}
+)
+
+// MsgTypeURL implements foundation.Authorization.MsgTypeURL.
+func (a CircuitBreakerAuthorization) MsgTypeURL() string {
+	return sdk.MsgTypeURL(&MsgUpdateCircuitBreaker{})
+}
+
+// Accept implements foundation.Authorization.Accept.
+func (a CircuitBreakerAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (foundation.AcceptResponse, error) {
+	if _, ok := msg.(*MsgUpdateCircuitBreaker); !ok {
+		return foundation.AcceptResponse{}, sdkerrors.ErrInvalidType.Wrap("type mismatch")
+	}
+	return foundation.AcceptResponse{Accept: true}, nil
+}
+
+// ValidateBasic implements foundation.Authorization.ValidateBasic.
+func (a CircuitBreakerAuthorization) ValidateBasic() error {
+	return nil
+}
+
+// MsgTypeURL implements foundation.Authorization.MsgTypeURL.
+func (a ContractQuotaAuthorization) MsgTypeURL() string {
+	return sdk.MsgTypeURL(&MsgUpdateContractQuota{})
+}
+
+// Accept implements foundation.Authorization.Accept.
+func (a ContractQuotaAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (foundation.AcceptResponse, error) {
+	if _, ok := msg.(*MsgUpdateContractQuota); !ok {
+		return foundation.AcceptResponse{}, sdkerrors.ErrInvalidType.Wrap("type mismatch")
+	}
+	return foundation.AcceptResponse{Accept: true}, nil
+}
+
+// ValidateBasic implements foundation.Authorization.ValidateBasic.
+func (a ContractQuotaAuthorization) ValidateBasic() error {
+	return nil
+}
+
+// MsgTypeURL implements foundation.Authorization.MsgTypeURL.
+func (a RegisterScheduleAuthorization) MsgTypeURL() string {
+	return sdk.MsgTypeURL(&MsgRegisterSchedule{})
+}
+
+// Accept implements foundation.Authorization.Accept.
+func (a RegisterScheduleAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (foundation.AcceptResponse, error) {
+	if _, ok := msg.(*MsgRegisterSchedule); !ok {
+		return foundation.AcceptResponse{}, sdkerrors.ErrInvalidType.Wrap("type mismatch")
+	}
+	return foundation.AcceptResponse{Accept: true}, nil
+}
+
+// ValidateBasic implements foundation.Authorization.ValidateBasic.
+func (a RegisterScheduleAuthorization) ValidateBasic() error {
+	return nil
+}
+
+// MsgTypeURL implements foundation.Authorization.MsgTypeURL.
+func (a DeregisterScheduleAuthorization) MsgTypeURL() string {
+	return sdk.MsgTypeURL(&MsgDeregisterSchedule{})
+}
+
+// Accept implements foundation.Authorization.Accept.
+func (a DeregisterScheduleAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (foundation.AcceptResponse, error) {
+	if _, ok := msg.(*MsgDeregisterSchedule); !ok {
+		return foundation.AcceptResponse{}, sdkerrors.ErrInvalidType.Wrap("type mismatch")
+	}
+	return foundation.AcceptResponse{Accept: true}, nil
+}
+
+// ValidateBasic implements foundation.Authorization.ValidateBasic.
+func (a DeregisterScheduleAuthorization) ValidateBasic() error {
+	return nil
+}
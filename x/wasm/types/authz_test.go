@@ -0,0 +1,563 @@
+package types
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/line/lbm-sdk/codec"
+	cdctypes "github.com/line/lbm-sdk/codec/types"
+	sdk "github.com/line/lbm-sdk/types"
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
+	"github.com/line/lbm-sdk/x/authz"
+)
+
+func TestContractAuthzFilterValidate(t *testing.T) {
+	specs := map[string]struct {
+		src    ContractAuthzFilterX
+		expErr bool
+	}{
+		"allow all": {
+			src: &AllowAllMessagesFilter{},
+		},
+		"allow keys - single": {
+			src: NewAcceptedMessageKeysFilter("foo"),
+		},
+		"allow keys - multi": {
+			src: NewAcceptedMessageKeysFilter("foo", "bar"),
+		},
+		"allow keys - empty": {
+			src:    NewAcceptedMessageKeysFilter(),
+			expErr: true,
+		},
+		"allow keys - duplicates": {
+			src:    NewAcceptedMessageKeysFilter("foo", "foo"),
+			expErr: true,
+		},
+		"allow keys - whitespaces": {
+			src:    NewAcceptedMessageKeysFilter(" foo"),
+			expErr: true,
+		},
+		"allow keys - empty key": {
+			src:    NewAcceptedMessageKeysFilter("", "bar"),
+			expErr: true,
+		},
+		"undefined": {
+			src:    &UndefinedFilter{},
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			gotErr := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+		})
+	}
+}
+
+func TestContractAuthzFilterAccept(t *testing.T) {
+	specs := map[string]struct {
+		filter         ContractAuthzFilterX
+		src            RawContractMessage
+		exp            bool
+		expGasConsumed sdk.Gas
+		expErr         bool
+	}{
+		"allow all - accepts json obj": {
+			filter: &AllowAllMessagesFilter{},
+			src:    []byte(`{}`),
+			exp:    true,
+		},
+		"allow all - rejects non json msg": {
+			filter: &AllowAllMessagesFilter{},
+			src:    []byte(``),
+			expErr: true,
+		},
+		"allowed key - single": {
+			filter:         NewAcceptedMessageKeysFilter("foo"),
+			src:            []byte(`{"foo": "bar"}`),
+			exp:            true,
+			expGasConsumed: sdk.Gas(len(`{"foo": "bar"}`)),
+		},
+		"allowed key - multiple": {
+			filter:         NewAcceptedMessageKeysFilter("foo", "other"),
+			src:            []byte(`{"other": "value"}`),
+			exp:            true,
+			expGasConsumed: sdk.Gas(len(`{"other": "value"}`)),
+		},
+		"allowed key - non accepted key": {
+			filter:         NewAcceptedMessageKeysFilter("foo"),
+			src:            []byte(`{"bar": "value"}`),
+			exp:            false,
+			expGasConsumed: sdk.Gas(len(`{"bar": "value"}`)),
+		},
+		"allowed key - unsupported array msg": {
+			filter:         NewAcceptedMessageKeysFilter("foo", "other"),
+			src:            []byte(`[{"foo":"bar"}]`),
+			expErr:         true,
+			expGasConsumed: sdk.Gas(len(`[{"foo":"bar"}]`)),
+		},
+		"allowed key - multiple keys in msg": {
+			filter:         NewAcceptedMessageKeysFilter("foo", "other"),
+			src:            []byte(`{"foo":"bar", "other": "value"}`),
+			expErr:         true,
+			expGasConsumed: sdk.Gas(len(`{"foo":"bar", "other": "value"}`)),
+		},
+		"undefined filter": {
+			filter: &UndefinedFilter{},
+			src:    []byte(`{"foo":"bar"}`),
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			gm := sdk.NewGasMeter(1_000_000)
+			allowed, gotErr := spec.filter.Accept(sdk.Context{}.WithGasMeter(gm), spec.src)
+
+			// then
+			assert.Equal(t, spec.expGasConsumed, gm.GasConsumed())
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.exp, allowed)
+		})
+	}
+}
+
+func TestContractAuthzLimitValidate(t *testing.T) {
+	oneToken := sdk.NewCoin(sdk.DefaultBondDenom, sdk.OneInt())
+	specs := map[string]struct {
+		src    ContractAuthzLimitX
+		expErr bool
+	}{
+		"max calls": {
+			src: NewMaxCallsLimit(1),
+		},
+		"max calls - max uint64": {
+			src: NewMaxCallsLimit(math.MaxUint64),
+		},
+		"max calls - empty": {
+			src:    NewMaxCallsLimit(0),
+			expErr: true,
+		},
+		"max funds": {
+			src: NewMaxFundsLimit(oneToken),
+		},
+		"max funds - empty coins": {
+			src:    NewMaxFundsLimit(),
+			expErr: true,
+		},
+		"max funds - duplicates": {
+			src:    &MaxFundsLimit{Amounts: sdk.Coins{oneToken, oneToken}},
+			expErr: true,
+		},
+		"max funds - contains empty value": {
+			src:    &MaxFundsLimit{Amounts: sdk.Coins{oneToken, sdk.NewCoin("other", sdk.ZeroInt())}.Sort()},
+			expErr: true,
+		},
+		"max funds - unsorted": {
+			src:    &MaxFundsLimit{Amounts: sdk.Coins{oneToken, sdk.NewCoin("other", sdk.OneInt())}},
+			expErr: true,
+		},
+		"combined": {
+			src: NewCombinedLimit(1, oneToken),
+		},
+		"combined - empty calls": {
+			src:    NewCombinedLimit(0, oneToken),
+			expErr: true,
+		},
+		"combined - empty amounts": {
+			src:    NewCombinedLimit(1),
+			expErr: true,
+		},
+		"combined - invalid amounts": {
+			src:    &CombinedLimit{CallsRemaining: 1, Amounts: sdk.Coins{oneToken, oneToken}},
+			expErr: true,
+		},
+		"undefined": {
+			src:    &UndefinedLimit{},
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			gotErr := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+		})
+	}
+}
+
+func TestContractAuthzLimitAccept(t *testing.T) {
+	oneToken := sdk.NewCoin(sdk.DefaultBondDenom, sdk.OneInt())
+	otherToken := sdk.NewCoin("other", sdk.OneInt())
+	specs := map[string]struct {
+		limit  ContractAuthzLimitX
+		src    AuthzableWasmMsg
+		exp    *ContractAuthzLimitAcceptResult
+		expErr bool
+	}{
+		"max calls - updated": {
+			limit: NewMaxCallsLimit(2),
+			src:   &MsgExecuteContract{},
+			exp:   &ContractAuthzLimitAcceptResult{Accepted: true, UpdateLimit: NewMaxCallsLimit(1)},
+		},
+		"max calls - removed": {
+			limit: NewMaxCallsLimit(1),
+			src:   &MsgExecuteContract{},
+			exp:   &ContractAuthzLimitAcceptResult{Accepted: true, DeleteLimit: true},
+		},
+		"max calls - no funds accepted": {
+			limit: NewMaxCallsLimit(2),
+			src:   &MsgExecuteContract{Funds: sdk.NewCoins(oneToken)},
+			exp:   &ContractAuthzLimitAcceptResult{Accepted: false},
+		},
+		"max calls - accepted with zero fund set": {
+			limit: NewMaxCallsLimit(1),
+			src:   &MsgExecuteContract{Funds: sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.ZeroInt()))},
+			exp:   &ContractAuthzLimitAcceptResult{Accepted: true, DeleteLimit: true},
+		},
+		"max calls - invalid state": {
+			limit:  NewMaxCallsLimit(0),
+			src:    &MsgExecuteContract{},
+			expErr: true,
+		},
+		"max funds - single updated": {
+			limit: NewMaxFundsLimit(oneToken.Add(oneToken)),
+			src:   &MsgExecuteContract{Funds: sdk.NewCoins(oneToken)},
+			exp:   &ContractAuthzLimitAcceptResult{Accepted: true, UpdateLimit: NewMaxFundsLimit(oneToken)},
+		},
+		"max funds - multi with other left": {
+			limit: NewMaxFundsLimit(sdk.NewCoins(oneToken, otherToken)...),
+			src:   &MsgExecuteContract{Funds: sdk.NewCoins(oneToken)},
+			exp:   &ContractAuthzLimitAcceptResult{Accepted: true, UpdateLimit: NewMaxFundsLimit(otherToken)},
+		},
+		"max funds - multi consumed": {
+			limit: NewMaxFundsLimit(sdk.NewCoins(oneToken, otherToken)...),
+			src:   &MsgExecuteContract{Funds: sdk.NewCoins(oneToken, otherToken)},
+			exp:   &ContractAuthzLimitAcceptResult{Accepted: true, DeleteLimit: true},
+		},
+		"max funds - no funds": {
+			limit: NewMaxFundsLimit(oneToken),
+			src:   &MsgExecuteContract{},
+			exp:   &ContractAuthzLimitAcceptResult{Accepted: true},
+		},
+		"max funds - exceeded": {
+			limit: NewMaxFundsLimit(oneToken),
+			src:   &MsgExecuteContract{Funds: sdk.NewCoins(oneToken.Add(oneToken))},
+			exp:   &ContractAuthzLimitAcceptResult{Accepted: false},
+		},
+		"max funds - unknown denom": {
+			limit: NewMaxFundsLimit(oneToken),
+			src:   &MsgExecuteContract{Funds: sdk.NewCoins(otherToken)},
+			exp:   &ContractAuthzLimitAcceptResult{Accepted: false},
+		},
+		"combined - updated": {
+			limit: NewCombinedLimit(2, oneToken.Add(oneToken)),
+			src:   &MsgExecuteContract{Funds: sdk.NewCoins(oneToken)},
+			exp:   &ContractAuthzLimitAcceptResult{Accepted: true, UpdateLimit: NewCombinedLimit(1, oneToken)},
+		},
+		"combined - no funds": {
+			limit: NewCombinedLimit(2, oneToken),
+			src:   &MsgExecuteContract{},
+			exp:   &ContractAuthzLimitAcceptResult{Accepted: true, UpdateLimit: NewCombinedLimit(1, oneToken)},
+		},
+		"combined - last call": {
+			limit: NewCombinedLimit(1, oneToken.Add(oneToken)),
+			src:   &MsgExecuteContract{Funds: sdk.NewCoins(oneToken)},
+			exp:   &ContractAuthzLimitAcceptResult{Accepted: true, DeleteLimit: true},
+		},
+		"combined - funds consumed": {
+			limit: NewCombinedLimit(2, oneToken),
+			src:   &MsgExecuteContract{Funds: sdk.NewCoins(oneToken)},
+			exp:   &ContractAuthzLimitAcceptResult{Accepted: true, DeleteLimit: true},
+		},
+		"combined - funds exceeded": {
+			limit: NewCombinedLimit(2, oneToken),
+			src:   &MsgExecuteContract{Funds: sdk.NewCoins(oneToken.Add(oneToken))},
+			exp:   &ContractAuthzLimitAcceptResult{Accepted: false},
+		},
+		"combined - invalid state": {
+			limit:  NewCombinedLimit(0, oneToken),
+			src:    &MsgExecuteContract{},
+			expErr: true,
+		},
+		"undefined": {
+			limit:  &UndefinedLimit{},
+			src:    &MsgExecuteContract{},
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			gotResult, gotErr := spec.limit.Accept(sdk.Context{}, spec.src)
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.exp, gotResult)
+		})
+	}
+}
+
+func TestAcceptGrantedMessage(t *testing.T) {
+	myContractAddr := sdk.AccAddress(randBytes(SDKAddrLen))
+	otherContractAddr := sdk.AccAddress(randBytes(SDKAddrLen))
+	specs := map[string]struct {
+		auth      authz.Authorization
+		msg       sdk.Msg
+		expResult authz.AcceptResponse
+		expErr    *sdkerrors.Error
+	}{
+		"accepted and updated - contract execution": {
+			auth: NewContractExecutionAuthorization(mustGrant(myContractAddr, NewMaxCallsLimit(2), NewAllowAllMessagesFilter())),
+			msg: &MsgExecuteContract{
+				Sender:   sdk.AccAddress(randBytes(SDKAddrLen)).String(),
+				Contract: myContractAddr.String(),
+				Msg:      []byte(`{"foo":"bar"}`),
+			},
+			expResult: authz.AcceptResponse{
+				Accept:  true,
+				Updated: NewContractExecutionAuthorization(mustGrant(myContractAddr, NewMaxCallsLimit(1), NewAllowAllMessagesFilter())),
+			},
+		},
+		"accepted and updated - multiple grants": {
+			auth: NewContractExecutionAuthorization(
+				mustGrant(myContractAddr, NewMaxCallsLimit(2), NewAllowAllMessagesFilter()),
+				mustGrant(otherContractAddr, NewMaxCallsLimit(1), NewAllowAllMessagesFilter()),
+			),
+			msg: &MsgExecuteContract{
+				Sender:   sdk.AccAddress(randBytes(SDKAddrLen)).String(),
+				Contract: myContractAddr.String(),
+				Msg:      []byte(`{"foo":"bar"}`),
+			},
+			expResult: authz.AcceptResponse{
+				Accept: true,
+				Updated: NewContractExecutionAuthorization(
+					mustGrant(myContractAddr, NewMaxCallsLimit(1), NewAllowAllMessagesFilter()),
+					mustGrant(otherContractAddr, NewMaxCallsLimit(1), NewAllowAllMessagesFilter()),
+				),
+			},
+		},
+		"accepted and not updated - limit not touched": {
+			auth: NewContractExecutionAuthorization(mustGrant(myContractAddr, NewMaxFundsLimit(sdk.NewCoin("link", sdk.OneInt())), NewAllowAllMessagesFilter())),
+			msg: &MsgExecuteContract{
+				Sender:   sdk.AccAddress(randBytes(SDKAddrLen)).String(),
+				Contract: myContractAddr.String(),
+				Msg:      []byte(`{"foo":"bar"}`),
+			},
+			expResult: authz.AcceptResponse{Accept: true},
+		},
+		"accepted and removed - single": {
+			auth: NewContractExecutionAuthorization(mustGrant(myContractAddr, NewMaxCallsLimit(1), NewAllowAllMessagesFilter())),
+			msg: &MsgExecuteContract{
+				Sender:   sdk.AccAddress(randBytes(SDKAddrLen)).String(),
+				Contract: myContractAddr.String(),
+				Msg:      []byte(`{"foo":"bar"}`),
+			},
+			expResult: authz.AcceptResponse{Accept: true, Delete: true},
+		},
+		"accepted and updated - multiple grants, one removed": {
+			auth: NewContractExecutionAuthorization(
+				mustGrant(myContractAddr, NewMaxCallsLimit(1), NewAllowAllMessagesFilter()),
+				mustGrant(otherContractAddr, NewMaxCallsLimit(1), NewAllowAllMessagesFilter()),
+			),
+			msg: &MsgExecuteContract{
+				Sender:   sdk.AccAddress(randBytes(SDKAddrLen)).String(),
+				Contract: myContractAddr.String(),
+				Msg:      []byte(`{"foo":"bar"}`),
+			},
+			expResult: authz.AcceptResponse{
+				Accept:  true,
+				Updated: NewContractExecutionAuthorization(mustGrant(otherContractAddr, NewMaxCallsLimit(1), NewAllowAllMessagesFilter())),
+			},
+		},
+		"accepted with second grant - first filter not applicable": {
+			auth: NewContractExecutionAuthorization(
+				mustGrant(myContractAddr, NewMaxCallsLimit(1), NewAcceptedMessageKeysFilter("other")),
+				mustGrant(myContractAddr, NewMaxCallsLimit(2), NewAcceptedMessageKeysFilter("foo")),
+			),
+			msg: &MsgExecuteContract{
+				Sender:   sdk.AccAddress(randBytes(SDKAddrLen)).String(),
+				Contract: myContractAddr.String(),
+				Msg:      []byte(`{"foo":"bar"}`),
+			},
+			expResult: authz.AcceptResponse{
+				Accept: true,
+				Updated: NewContractExecutionAuthorization(
+					mustGrant(myContractAddr, NewMaxCallsLimit(1), NewAcceptedMessageKeysFilter("other")),
+					mustGrant(myContractAddr, NewMaxCallsLimit(1), NewAcceptedMessageKeysFilter("foo")),
+				),
+			},
+		},
+		"not accepted - no matching contract address": {
+			auth: NewContractExecutionAuthorization(mustGrant(myContractAddr, NewMaxCallsLimit(1), NewAllowAllMessagesFilter())),
+			msg: &MsgExecuteContract{
+				Sender:   sdk.AccAddress(randBytes(SDKAddrLen)).String(),
+				Contract: otherContractAddr.String(),
+				Msg:      []byte(`{"foo":"bar"}`),
+			},
+			expResult: authz.AcceptResponse{Accept: false},
+		},
+		"not accepted - max calls but tokens": {
+			auth: NewContractExecutionAuthorization(mustGrant(myContractAddr, NewMaxCallsLimit(1), NewAllowAllMessagesFilter())),
+			msg: &MsgExecuteContract{
+				Sender:   sdk.AccAddress(randBytes(SDKAddrLen)).String(),
+				Contract: myContractAddr.String(),
+				Msg:      []byte(`{"foo":"bar"}`),
+				Funds:    sdk.NewCoins(sdk.NewCoin("link", sdk.OneInt())),
+			},
+			expResult: authz.AcceptResponse{Accept: false},
+		},
+		"not accepted - funds exceeds limit": {
+			auth: NewContractExecutionAuthorization(mustGrant(myContractAddr, NewMaxFundsLimit(sdk.NewCoin("link", sdk.OneInt())), NewAllowAllMessagesFilter())),
+			msg: &MsgExecuteContract{
+				Sender:   sdk.AccAddress(randBytes(SDKAddrLen)).String(),
+				Contract: myContractAddr.String(),
+				Msg:      []byte(`{"foo":"bar"}`),
+				Funds:    sdk.NewCoins(sdk.NewCoin("link", sdk.NewInt(2))),
+			},
+			expResult: authz.AcceptResponse{Accept: false},
+		},
+		"not accepted - no matching filter": {
+			auth: NewContractExecutionAuthorization(mustGrant(myContractAddr, NewMaxCallsLimit(1), NewAcceptedMessageKeysFilter("other"))),
+			msg: &MsgExecuteContract{
+				Sender:   sdk.AccAddress(randBytes(SDKAddrLen)).String(),
+				Contract: myContractAddr.String(),
+				Msg:      []byte(`{"foo":"bar"}`),
+			},
+			expResult: authz.AcceptResponse{Accept: false},
+		},
+		"invalid msg": {
+			auth: NewContractExecutionAuthorization(mustGrant(myContractAddr, NewMaxCallsLimit(1), NewAllowAllMessagesFilter())),
+			msg: &MsgExecuteContract{
+				Sender:   sdk.AccAddress(randBytes(SDKAddrLen)).String(),
+				Contract: myContractAddr.String(),
+			},
+			expErr: ErrInvalid,
+		},
+		"wrong msg type": {
+			auth: NewContractExecutionAuthorization(mustGrant(myContractAddr, NewMaxCallsLimit(1), NewAllowAllMessagesFilter())),
+			msg: &MsgMigrateContract{
+				Sender:   sdk.AccAddress(randBytes(SDKAddrLen)).String(),
+				Contract: myContractAddr.String(),
+				CodeID:   1,
+				Msg:      []byte(`{"foo":"bar"}`),
+			},
+			expErr: sdkerrors.ErrInvalidType,
+		},
+		"accepted and updated - contract migration": {
+			auth: NewContractMigrationAuthorization(mustGrant(myContractAddr, NewMaxCallsLimit(2), NewAllowAllMessagesFilter())),
+			msg: &MsgMigrateContract{
+				Sender:   sdk.AccAddress(randBytes(SDKAddrLen)).String(),
+				Contract: myContractAddr.String(),
+				CodeID:   1,
+				Msg:      []byte(`{"foo":"bar"}`),
+			},
+			expResult: authz.AcceptResponse{
+				Accept:  true,
+				Updated: NewContractMigrationAuthorization(mustGrant(myContractAddr, NewMaxCallsLimit(1), NewAllowAllMessagesFilter())),
+			},
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx := sdk.Context{}.WithGasMeter(sdk.NewInfiniteGasMeter())
+			gotResult, gotErr := spec.auth.Accept(ctx, spec.msg)
+			if spec.expErr != nil {
+				require.ErrorIs(t, gotErr, spec.expErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.expResult, gotResult)
+		})
+	}
+}
+
+func TestContractAuthorizationValidate(t *testing.T) {
+	validGrant := mustGrant(sdk.AccAddress(randBytes(SDKAddrLen)), NewMaxCallsLimit(1), NewAllowAllMessagesFilter())
+	specs := map[string]struct {
+		src    authz.Authorization
+		expErr bool
+	}{
+		"execution": {
+			src: NewContractExecutionAuthorization(validGrant),
+		},
+		"migration": {
+			src: NewContractMigrationAuthorization(validGrant),
+		},
+		"empty grants": {
+			src:    NewContractExecutionAuthorization(),
+			expErr: true,
+		},
+		"invalid contract address": {
+			src:    NewContractExecutionAuthorization(ContractGrant{Contract: "invalid", Limit: validGrant.Limit, Filter: validGrant.Filter}),
+			expErr: true,
+		},
+		"missing limit": {
+			src:    NewContractExecutionAuthorization(ContractGrant{Contract: validGrant.Contract, Filter: validGrant.Filter}),
+			expErr: true,
+		},
+		"missing filter": {
+			src:    NewContractExecutionAuthorization(ContractGrant{Contract: validGrant.Contract, Limit: validGrant.Limit}),
+			expErr: true,
+		},
+		"invalid limit": {
+			src:    NewContractExecutionAuthorization(mustGrant(sdk.AccAddress(randBytes(SDKAddrLen)), NewMaxCallsLimit(0), NewAllowAllMessagesFilter())),
+			expErr: true,
+		},
+		"invalid filter": {
+			src:    NewContractMigrationAuthorization(mustGrant(sdk.AccAddress(randBytes(SDKAddrLen)), NewMaxCallsLimit(1), NewAcceptedMessageKeysFilter())),
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			gotErr := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+		})
+	}
+}
+
+func TestContractAuthorizationProtoRoundtrip(t *testing.T) {
+	registry := cdctypes.NewInterfaceRegistry()
+	authz.RegisterInterfaces(registry)
+	RegisterInterfaces(registry)
+	cdc := codec.NewProtoCodec(registry)
+
+	src := NewContractExecutionAuthorization(mustGrant(sdk.AccAddress(randBytes(SDKAddrLen)),
+		NewCombinedLimit(1, sdk.NewCoin("link", sdk.OneInt())), NewAcceptedMessageKeysFilter("foo")))
+	bz, err := cdc.MarshalInterface(authz.Authorization(src))
+	require.NoError(t, err)
+
+	var got authz.Authorization
+	require.NoError(t, cdc.UnmarshalInterface(bz, &got))
+	require.NoError(t, got.ValidateBasic())
+	gotGrant := got.(*ContractExecutionAuthorization).Grants[0]
+	assert.Equal(t, NewCombinedLimit(1, sdk.NewCoin("link", sdk.OneInt())), gotGrant.GetLimit())
+	assert.Equal(t, NewAcceptedMessageKeysFilter("foo"), gotGrant.GetFilter())
+}
+
+func mustGrant(contract sdk.AccAddress, limit ContractAuthzLimitX, filter ContractAuthzFilterX) ContractGrant {
+	g, err := NewContractGrant(contract, limit, filter)
+	if err != nil {
+		panic(err)
+	}
+	return *g
+}
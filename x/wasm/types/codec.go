@@ -6,6 +6,8 @@ import (
 	cryptocodec "github.com/line/lbm-sdk/crypto/codec"
 	sdk "github.com/line/lbm-sdk/types"
 	"github.com/line/lbm-sdk/types/msgservice"
+	"github.com/line/lbm-sdk/x/authz"
+	"github.com/line/lbm-sdk/x/foundation"
 	govtypes "github.com/line/lbm-sdk/x/gov/types"
 )
 
@@ -13,11 +15,17 @@ import (
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) { //nolint:staticcheck
 	cdc.RegisterConcrete(&MsgStoreCode{}, "wasm/MsgStoreCode", nil)
 	cdc.RegisterConcrete(&MsgInstantiateContract{}, "wasm/MsgInstantiateContract", nil)
+	cdc.RegisterConcrete(&MsgInstantiateContract2{}, "wasm/MsgInstantiateContract2", nil)
 	cdc.RegisterConcrete(&MsgStoreCodeAndInstantiateContract{}, "wasm/StoreCodeAndInstantiateContract", nil)
 	cdc.RegisterConcrete(&MsgExecuteContract{}, "wasm/MsgExecuteContract", nil)
 	cdc.RegisterConcrete(&MsgMigrateContract{}, "wasm/MsgMigrateContract", nil)
 	cdc.RegisterConcrete(&MsgUpdateAdmin{}, "wasm/MsgUpdateAdmin", nil)
 	cdc.RegisterConcrete(&MsgClearAdmin{}, "wasm/MsgClearAdmin", nil)
+	cdc.RegisterConcrete(&MsgUpdateCircuitBreaker{}, "wasm/MsgUpdateCircuitBreaker", nil)
+	cdc.RegisterConcrete(&MsgUpdateContractQuota{}, "wasm/MsgUpdateContractQuota", nil)
+	cdc.RegisterConcrete(&MsgRegisterSchedule{}, "wasm/MsgRegisterSchedule", nil)
+	cdc.RegisterConcrete(&MsgDeregisterSchedule{}, "wasm/MsgDeregisterSchedule", nil)
+	cdc.RegisterConcrete(&MsgUpdateReceiverHook{}, "wasm/MsgUpdateReceiverHook", nil)
 
 	cdc.RegisterConcrete(&PinCodesProposal{}, "wasm/PinCodesProposal", nil)
 	cdc.RegisterConcrete(&UnpinCodesProposal{}, "wasm/UnpinCodesProposal", nil)
@@ -30,6 +38,25 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) { //nolint:staticcheck
 	cdc.RegisterConcrete(&ClearAdminProposal{}, "wasm/ClearAdminProposal", nil)
 	cdc.RegisterConcrete(&UpdateContractStatusProposal{}, "wasm/UpdateContractStatusProposal", nil)
 	cdc.RegisterConcrete(&UpdateInstantiateConfigProposal{}, "wasm/UpdateInstantiateConfigProposal", nil)
+	cdc.RegisterConcrete(&ImportContractProposal{}, "wasm/ImportContractProposal", nil)
+	cdc.RegisterConcrete(&RegisterScheduleProposal{}, "wasm/RegisterScheduleProposal", nil)
+	cdc.RegisterConcrete(&DeregisterScheduleProposal{}, "wasm/DeregisterScheduleProposal", nil)
+
+	cdc.RegisterInterface((*ContractAuthzFilterX)(nil), nil)
+	cdc.RegisterConcrete(&AllowAllMessagesFilter{}, "wasm/AllowAllMessagesFilter", nil)
+	cdc.RegisterConcrete(&AcceptedMessageKeysFilter{}, "wasm/AcceptedMessageKeysFilter", nil)
+
+	cdc.RegisterInterface((*ContractAuthzLimitX)(nil), nil)
+	cdc.RegisterConcrete(&MaxCallsLimit{}, "wasm/MaxCallsLimit", nil)
+	cdc.RegisterConcrete(&MaxFundsLimit{}, "wasm/MaxFundsLimit", nil)
+	cdc.RegisterConcrete(&CombinedLimit{}, "wasm/CombinedLimit", nil)
+
+	cdc.RegisterConcrete(&ContractExecutionAuthorization{}, "wasm/ContractExecutionAuthorization", nil)
+	cdc.RegisterConcrete(&ContractMigrationAuthorization{}, "wasm/ContractMigrationAuthorization", nil)
+	cdc.RegisterConcrete(&CircuitBreakerAuthorization{}, "wasm/CircuitBreakerAuthorization", nil)
+	cdc.RegisterConcrete(&ContractQuotaAuthorization{}, "wasm/ContractQuotaAuthorization", nil)
+	cdc.RegisterConcrete(&RegisterScheduleAuthorization{}, "wasm/RegisterScheduleAuthorization", nil)
+	cdc.RegisterConcrete(&DeregisterScheduleAuthorization{}, "wasm/DeregisterScheduleAuthorization", nil)
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
@@ -37,11 +64,17 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		(*sdk.Msg)(nil),
 		&MsgStoreCode{},
 		&MsgInstantiateContract{},
+		&MsgInstantiateContract2{},
 		&MsgStoreCodeAndInstantiateContract{},
 		&MsgExecuteContract{},
 		&MsgMigrateContract{},
 		&MsgUpdateAdmin{},
 		&MsgClearAdmin{},
+		&MsgUpdateCircuitBreaker{},
+		&MsgUpdateContractQuota{},
+		&MsgRegisterSchedule{},
+		&MsgDeregisterSchedule{},
+		&MsgUpdateReceiverHook{},
 		&MsgIBCCloseChannel{},
 		&MsgIBCSend{},
 	)
@@ -58,10 +91,41 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&UnpinCodesProposal{},
 		&UpdateContractStatusProposal{},
 		&UpdateInstantiateConfigProposal{},
+		&ImportContractProposal{},
+		&RegisterScheduleProposal{},
+		&DeregisterScheduleProposal{},
 	)
 
 	registry.RegisterInterface("ContractInfoExtension", (*ContractInfoExtension)(nil))
 
+	registry.RegisterInterface("ContractAuthzFilterX", (*ContractAuthzFilterX)(nil))
+	registry.RegisterImplementations(
+		(*ContractAuthzFilterX)(nil),
+		&AllowAllMessagesFilter{},
+		&AcceptedMessageKeysFilter{},
+	)
+
+	registry.RegisterInterface("ContractAuthzLimitX", (*ContractAuthzLimitX)(nil))
+	registry.RegisterImplementations(
+		(*ContractAuthzLimitX)(nil),
+		&MaxCallsLimit{},
+		&MaxFundsLimit{},
+		&CombinedLimit{},
+	)
+
+	registry.RegisterImplementations(
+		(*authz.Authorization)(nil),
+		&ContractExecutionAuthorization{},
+		&ContractMigrationAuthorization{},
+	)
+	registry.RegisterImplementations(
+		(*foundation.Authorization)(nil),
+		&CircuitBreakerAuthorization{},
+		&ContractQuotaAuthorization{},
+		&RegisterScheduleAuthorization{},
+		&DeregisterScheduleAuthorization{},
+	)
+
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
 
@@ -87,6 +87,15 @@ var (
 
 	// ErrExceedMaxQueryStackSize error if max query stack size is exceeded
 	ErrExceedMaxQueryStackSize = sdkErrors.Register(DefaultCodespace, 27, "max query stack size exceeded")
+
+	// ErrContractPaused error if the contract or its code is paused by the circuit breaker
+	ErrContractPaused = sdkErrors.Register(DefaultCodespace, 28, "contract paused")
+
+	// ErrQuotaExceeded error if the per block execution quota of a contract is exceeded
+	ErrQuotaExceeded = sdkErrors.Register(DefaultCodespace, 29, "contract quota exceeded")
+
+	// ErrExceedMaxReceiverHookDepth error if receiver hooks of contracts transferring to other contracts nest too deep
+	ErrExceedMaxReceiverHookDepth = sdkErrors.Register(DefaultCodespace, 30, "max receiver hook depth exceeded")
 )
 
 type ErrNoSuchContract struct {
@@ -16,6 +16,13 @@ const (
 	EventTypeSudo                 = "sudo"
 	EventTypeReply                = "reply"
 	EventTypeGovContractResult    = "gov_contract_result"
+	EventTypeImportContract       = "import_contract"
+	EventTypeUpdateCircuitBreaker = "update_circuit_breaker"
+	EventTypeUpdateContractQuota  = "update_contract_quota"
+	EventTypeRegisterSchedule     = "register_schedule"
+	EventTypeDeregisterSchedule   = "deregister_schedule"
+	EventTypeScheduleExecution    = "schedule_execution"
+	EventTypeUpdateReceiverHook   = "update_receiver_hook"
 )
 
 // event attributes returned from contract execution
@@ -28,4 +35,14 @@ const (
 	AttributeKeyResultDataHex  = "result"
 	AttributeKeyFeature        = "feature"
 	AttributeKeyContractStatus = "contract_status"
+	AttributeKeyChecksum       = "checksum"
+	AttributeKeyPaused         = "paused"
+	AttributeKeyMaxExecutions  = "max_executions_per_block"
+	AttributeKeyMaxGas         = "max_gas_per_block"
+	AttributeKeyScheduleName   = "schedule_name"
+	AttributeKeyInterval       = "interval"
+	AttributeKeyGasLimit       = "gas_limit"
+	AttributeKeyGasUsed        = "gas_used"
+	AttributeKeyError          = "error"
+	AttributeKeyEnabled        = "enabled"
 )
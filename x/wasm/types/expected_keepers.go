@@ -102,3 +102,8 @@ type CapabilityKeeper interface {
 type ICS20TransferPortSource interface {
 	GetPort(ctx sdk.Context) string
 }
+
+// FoundationKeeper defines the expected foundation keeper to authorize the circuit breaker operators
+type FoundationKeeper interface {
+	Accept(ctx sdk.Context, granter string, grantee sdk.AccAddress, msg sdk.Msg) error
+}
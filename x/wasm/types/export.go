@@ -0,0 +1,80 @@
+package types
+
+import (
+	"bytes"
+	"crypto/sha256"
+
+	sdk "github.com/line/lbm-sdk/types"
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
+)
+
+// NewContractExport bundles the given contract data and seals it with its checksum
+func NewContractExport(
+	codeInfo CodeInfo,
+	codeBytes []byte,
+	contractAddr sdk.AccAddress,
+	contractInfo ContractInfo,
+	history []ContractCodeHistoryEntry,
+	state []Model,
+) (ContractExport, error) {
+	e := ContractExport{
+		CodeInfo:        codeInfo,
+		CodeBytes:       codeBytes,
+		ContractAddress: contractAddr.String(),
+		ContractInfo:    contractInfo,
+		ContractHistory: history,
+		ContractState:   state,
+	}
+	checksum, err := e.ComputeChecksum()
+	if err != nil {
+		return ContractExport{}, err
+	}
+	e.Checksum = checksum
+	return e, nil
+}
+
+// ComputeChecksum returns the sha256 hash of the binary encoded export with an empty checksum field
+func (e ContractExport) ComputeChecksum() ([]byte, error) {
+	e.Checksum = nil
+	bz, err := e.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	hash := sha256.Sum256(bz)
+	return hash[:], nil
+}
+
+// ValidateBasic does syntax checks on the export and verifies the code hash and the checksum
+func (e ContractExport) ValidateBasic() error {
+	if err := e.CodeInfo.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(err, "code info")
+	}
+	if err := validateWasmCode(e.CodeBytes); err != nil {
+		return sdkerrors.Wrap(err, "code bytes")
+	}
+	if codeHash := sha256.Sum256(e.CodeBytes); !bytes.Equal(codeHash[:], e.CodeInfo.CodeHash) {
+		return sdkerrors.Wrap(ErrInvalid, "code hash does not match code bytes")
+	}
+	if _, err := sdk.AccAddressFromBech32(e.ContractAddress); err != nil {
+		return sdkerrors.Wrap(err, "contract address")
+	}
+	if err := e.ContractInfo.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(err, "contract info")
+	}
+	for i, m := range e.ContractState {
+		if err := m.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "contract state %d", i)
+		}
+	}
+	if len(e.Checksum) == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "checksum")
+	}
+	checksum, err := e.ComputeChecksum()
+	if err != nil {
+		return sdkerrors.Wrap(err, "checksum")
+	}
+	if !bytes.Equal(checksum, e.Checksum) {
+		return sdkerrors.Wrap(ErrInvalid, "checksum does not match contract export")
+	}
+	return nil
+}
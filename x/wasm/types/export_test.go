@@ -0,0 +1,92 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestContractExportValidateBasic(t *testing.T) {
+	specs := map[string]struct {
+		src    ContractExport
+		expErr bool
+	}{
+		"all good": {
+			src: ContractExportFixture(),
+		},
+		"checksum missing": {
+			src: ContractExportFixture(func(e *ContractExport) {
+				e.Checksum = nil
+			}),
+			expErr: true,
+		},
+		"checksum mismatch": {
+			src: ContractExportFixture(func(e *ContractExport) {
+				e.ContractState[0].Value = []byte("otherValue")
+			}),
+			expErr: true,
+		},
+		"code bytes do not match code hash": {
+			src: ContractExportFixture(func(e *ContractExport) {
+				e.CodeBytes = []byte("other code")
+			}),
+			expErr: true,
+		},
+		"code bytes missing": {
+			src: ContractExportFixture(func(e *ContractExport) {
+				e.CodeBytes = nil
+			}),
+			expErr: true,
+		},
+		"invalid code info": {
+			src: ContractExportFixture(func(e *ContractExport) {
+				e.CodeInfo.Creator = "invalid"
+			}),
+			expErr: true,
+		},
+		"invalid contract address": {
+			src: ContractExportFixture(func(e *ContractExport) {
+				e.ContractAddress = "invalid"
+			}),
+			expErr: true,
+		},
+		"invalid contract info": {
+			src: ContractExportFixture(func(e *ContractExport) {
+				e.ContractInfo.Label = ""
+			}),
+			expErr: true,
+		},
+		"invalid contract state": {
+			src: ContractExportFixture(func(e *ContractExport) {
+				e.ContractState = []Model{{Value: []byte("anyValue")}}
+			}),
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestContractExportChecksum(t *testing.T) {
+	src := ContractExportFixture()
+
+	checksum, err := src.ComputeChecksum()
+	require.NoError(t, err)
+	assert.Equal(t, []byte(src.Checksum), checksum)
+	assert.Len(t, checksum, 32)
+
+	// the checksum does not depend on the checksum field itself
+	src.Checksum = []byte("any")
+	otherChecksum, err := src.ComputeChecksum()
+	require.NoError(t, err)
+	assert.Equal(t, checksum, otherChecksum)
+}
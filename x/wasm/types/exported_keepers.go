@@ -21,6 +21,15 @@ type ViewKeeper interface {
 	IterateCodeInfos(ctx sdk.Context, cb func(uint64, CodeInfo) bool)
 	GetByteCode(ctx sdk.Context, codeID uint64) ([]byte, error)
 	IsPinnedCode(ctx sdk.Context, codeID uint64) bool
+	IsPausedContract(ctx sdk.Context, contractAddress sdk.AccAddress) bool
+	IsPausedCode(ctx sdk.Context, codeID uint64) bool
+	GetContractQuota(ctx sdk.Context, contractAddress sdk.AccAddress) *ContractQuota
+	GetContractQuotaUsage(ctx sdk.Context, contractAddress sdk.AccAddress) (executions uint64, gasUsed sdk.Gas)
+	GetSchedule(ctx sdk.Context, contractAddress sdk.AccAddress, name string) *Schedule
+	IterateSchedules(ctx sdk.Context, cb func(Schedule) bool)
+	HasReceiverHook(ctx sdk.Context, contractAddress sdk.AccAddress) bool
+	// TraceExecute simulates a contract execution on a discarded branch of the state and returns its gas trace
+	TraceExecute(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress, msg []byte, coins sdk.Coins) *QueryTraceExecuteContractResponse
 }
 
 // ContractOpsKeeper contains mutable operations on a contract.
@@ -31,6 +40,9 @@ type ContractOpsKeeper interface {
 	// Instantiate creates an instance of a WASM contract
 	Instantiate(ctx sdk.Context, codeID uint64, creator, admin sdk.AccAddress, initMsg []byte, label string, deposit sdk.Coins) (sdk.AccAddress, []byte, error)
 
+	// Instantiate2 creates an instance of a WASM contract at a predictable address
+	Instantiate2(ctx sdk.Context, codeID uint64, creator, admin sdk.AccAddress, initMsg []byte, label string, deposit sdk.Coins, salt []byte, fixMsg bool) (sdk.AccAddress, []byte, error)
+
 	// Execute executes the contract instance
 	Execute(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress, msg []byte, coins sdk.Coins) ([]byte, error)
 
@@ -60,6 +72,24 @@ type ContractOpsKeeper interface {
 
 	// SetAccessConfig updates the access config of a code id.
 	SetAccessConfig(ctx sdk.Context, codeID uint64, config AccessConfig) error
+
+	// ImportContract restores an exported contract with its code, history and state. It returns the new code id.
+	ImportContract(ctx sdk.Context, export ContractExport) (codeID uint64, err error)
+
+	// UpdateCircuitBreaker pauses or resumes the executions of the given contracts and of all contracts of the given codes.
+	UpdateCircuitBreaker(ctx sdk.Context, contracts []sdk.AccAddress, codeIDs []uint64, paused bool) error
+
+	// SetContractQuota sets the per block execution quota of a contract. An empty quota removes it.
+	SetContractQuota(ctx sdk.Context, contractAddress sdk.AccAddress, quota ContractQuota) error
+
+	// RegisterSchedule registers a periodic call of the sudo entry point of a contract. An existing schedule of the same name is replaced.
+	RegisterSchedule(ctx sdk.Context, contractAddress sdk.AccAddress, name string, msg []byte, interval, gasLimit uint64) error
+
+	// DeregisterSchedule removes a schedule of a contract.
+	DeregisterSchedule(ctx sdk.Context, contractAddress sdk.AccAddress, name string) error
+
+	// UpdateReceiverHook enables or disables the notification of a contract on receiving x/token tokens or x/collection coins.
+	UpdateReceiverHook(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress, enabled bool) error
 }
 
 // IBCContractKeeper IBC lifecycle event handler
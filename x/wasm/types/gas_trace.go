@@ -0,0 +1,14 @@
+package types
+
+// kinds of the steps of a GasTrace
+const (
+	GasTraceKindInstantiate = "instantiate"
+	GasTraceKindExecute     = "execute"
+	GasTraceKindMigrate     = "migrate"
+	GasTraceKindSudo        = "sudo"
+	GasTraceKindReply       = "reply"
+	GasTraceKindQuerySmart  = "query_smart"
+	GasTraceKindMessage     = "message"
+	GasTraceKindSubmessage  = "submessage"
+	GasTraceKindQuery       = "query"
+)
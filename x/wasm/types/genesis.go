@@ -37,6 +37,17 @@ func (s GenesisState) ValidateBasic() error {
 			return sdkerrors.Wrapf(err, "gen message: %d", i)
 		}
 	}
+	uniqueSchedules := make(map[string]struct{}, len(s.Schedules))
+	for i := range s.Schedules {
+		if err := s.Schedules[i].ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "schedule: %d", i)
+		}
+		key := s.Schedules[i].Contract + "/" + s.Schedules[i].Name
+		if _, exists := uniqueSchedules[key]; exists {
+			return sdkerrors.Wrapf(ErrDuplicate, "schedule: %d", i)
+		}
+		uniqueSchedules[key] = struct{}{}
+	}
 	return nil
 }
 
@@ -30,6 +30,7 @@ type GenesisState struct {
 	Contracts []Contract             `protobuf:"bytes,3,rep,name=contracts,proto3" json:"contracts,omitempty"`
 	Sequences []Sequence             `protobuf:"bytes,4,rep,name=sequences,proto3" json:"sequences,omitempty"`
 	GenMsgs   []GenesisState_GenMsgs `protobuf:"bytes,5,rep,name=gen_msgs,json=genMsgs,proto3" json:"gen_msgs,omitempty"`
+	Schedules []Schedule             `protobuf:"bytes,6,rep,name=schedules,proto3" json:"schedules,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -100,6 +101,13 @@ func (m *GenesisState) GetGenMsgs() []GenesisState_GenMsgs {
 	return nil
 }
 
+func (m *GenesisState) GetSchedules() []Schedule {
+	if m != nil {
+		return m.Schedules
+	}
+	return nil
+}
+
 // GenMsgs define the messages that can be executed during genesis phase in order.
 // The intention is to have more human readable data that is auditable.
 type GenesisState_GenMsgs struct {
@@ -209,6 +217,8 @@ type Code struct {
 	CodeBytes []byte   `protobuf:"bytes,3,opt,name=code_bytes,json=codeBytes,proto3" json:"code_bytes,omitempty"`
 	// Pinned to wasmvm cache
 	Pinned bool `protobuf:"varint,4,opt,name=pinned,proto3" json:"pinned,omitempty"`
+	// Paused by the circuit breaker
+	Paused bool `protobuf:"varint,5,opt,name=paused,proto3" json:"paused,omitempty"`
 }
 
 func (m *Code) Reset()         { *m = Code{} }
@@ -272,11 +282,24 @@ func (m *Code) GetPinned() bool {
 	return false
 }
 
+func (m *Code) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
 // Contract struct encompasses ContractAddress, ContractInfo, and ContractState
 type Contract struct {
 	ContractAddress string       `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
 	ContractInfo    ContractInfo `protobuf:"bytes,2,opt,name=contract_info,json=contractInfo,proto3" json:"contract_info"`
 	ContractState   []Model      `protobuf:"bytes,3,rep,name=contract_state,json=contractState,proto3" json:"contract_state"`
+	// Paused by the circuit breaker
+	Paused bool `protobuf:"varint,4,opt,name=paused,proto3" json:"paused,omitempty"`
+	// Quota is the per block execution quota of the contract, if any
+	Quota *ContractQuota `protobuf:"bytes,5,opt,name=quota,proto3" json:"quota,omitempty"`
+	// ReceiverHook is set when the contract opted in to the receiver hook
+	ReceiverHook bool `protobuf:"varint,6,opt,name=receiver_hook,json=receiverHook,proto3" json:"receiver_hook,omitempty"`
 }
 
 func (m *Contract) Reset()         { *m = Contract{} }
@@ -333,6 +356,27 @@ func (m *Contract) GetContractState() []Model {
 	return nil
 }
 
+func (m *Contract) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
+func (m *Contract) GetQuota() *ContractQuota {
+	if m != nil {
+		return m.Quota
+	}
+	return nil
+}
+
+func (m *Contract) GetReceiverHook() bool {
+	if m != nil {
+		return m.ReceiverHook
+	}
+	return false
+}
+
 // Sequence key and value of an id generation counter
 type Sequence struct {
 	IDKey []byte `protobuf:"bytes,1,opt,name=id_key,json=idKey,proto3" json:"id_key,omitempty"`
@@ -397,48 +441,53 @@ func init() {
 func init() { proto.RegisterFile("cosmwasm/wasm/v1/genesis.proto", fileDescriptor_2ab3f539b23472a6) }
 
 var fileDescriptor_2ab3f539b23472a6 = []byte{
-	// 648 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x94, 0xcf, 0x6e, 0xd3, 0x4e,
-	0x10, 0xc7, 0xe3, 0x26, 0x4e, 0x93, 0x69, 0x7e, 0xbf, 0x56, 0xdb, 0xaa, 0x35, 0x06, 0x9c, 0x28,
-	0x54, 0x28, 0x48, 0x90, 0xa8, 0x45, 0xe2, 0x06, 0x08, 0xd3, 0x8a, 0x46, 0x55, 0x25, 0x70, 0xc5,
-	0x05, 0xa9, 0x8a, 0x1c, 0x7b, 0x6a, 0xac, 0xc6, 0xde, 0x90, 0xdd, 0x94, 0xe6, 0xcc, 0x0b, 0xf0,
-	0x08, 0xf0, 0x32, 0xa8, 0xc7, 0x1e, 0x39, 0x45, 0x28, 0xbd, 0xf1, 0x14, 0x68, 0xd7, 0x6b, 0xd7,
-	0x90, 0xf4, 0x62, 0x65, 0x67, 0xbe, 0xf3, 0x99, 0x3f, 0x99, 0x5d, 0xb0, 0x3c, 0xca, 0xa2, 0xcf,
-	0x2e, 0x8b, 0x3a, 0xf2, 0x73, 0xbe, 0xd3, 0x09, 0x30, 0x46, 0x16, 0xb2, 0xf6, 0x70, 0x44, 0x39,
-	0x25, 0x6b, 0xa9, 0xbf, 0x2d, 0x3f, 0xe7, 0x3b, 0xe6, 0x46, 0x40, 0x03, 0x2a, 0x9d, 0x1d, 0xf1,
-	0x2b, 0xd1, 0x99, 0xf7, 0xe6, 0x38, 0x7c, 0x32, 0x44, 0x45, 0x31, 0xef, 0xcc, 0x7b, 0x2f, 0x12,
-	0x57, 0xf3, 0x9b, 0x0e, 0xb5, 0x37, 0x49, 0xca, 0x63, 0xee, 0x72, 0x24, 0xcf, 0xa0, 0x3c, 0x74,
-	0x47, 0x6e, 0xc4, 0x0c, 0xad, 0xa1, 0xb5, 0x56, 0x76, 0x8d, 0xf6, 0xbf, 0x25, 0xb4, 0xdf, 0x4a,
-	0xbf, 0x5d, 0xba, 0x9c, 0xd6, 0x0b, 0x8e, 0x52, 0x93, 0x7d, 0xd0, 0x3d, 0xea, 0x23, 0x33, 0x96,
-	0x1a, 0xc5, 0xd6, 0xca, 0xee, 0xe6, 0x7c, 0xd8, 0x6b, 0xea, 0xa3, 0xbd, 0x25, 0x82, 0x7e, 0x4f,
-	0xeb, 0xab, 0x52, 0xfc, 0x98, 0x46, 0x21, 0xc7, 0x68, 0xc8, 0x27, 0x4e, 0x12, 0x4d, 0xde, 0x43,
-	0xd5, 0xa3, 0x31, 0x1f, 0xb9, 0x1e, 0x67, 0x46, 0x51, 0xa2, 0xcc, 0x45, 0xa8, 0x44, 0x62, 0xdf,
-	0x55, 0xb8, 0xf5, 0x2c, 0x28, 0x87, 0xbc, 0x21, 0x09, 0x2c, 0xc3, 0x4f, 0x63, 0x8c, 0x3d, 0x64,
-	0x46, 0xe9, 0x36, 0xec, 0xb1, 0x92, 0xdc, 0x60, 0xb3, 0xa0, 0x3c, 0x36, 0x33, 0x92, 0x13, 0xa8,
-	0x04, 0x18, 0xf7, 0x22, 0x16, 0x30, 0x43, 0x97, 0xd4, 0x87, 0xf3, 0xd4, 0xfc, 0x78, 0xc5, 0xe1,
-	0x88, 0x05, 0xcc, 0x36, 0x55, 0x06, 0x92, 0xc6, 0xe7, 0x12, 0x2c, 0x07, 0x89, 0xc8, 0xfc, 0xb2,
-	0x04, 0xcb, 0x2a, 0x80, 0xbc, 0x04, 0x60, 0x9c, 0x8e, 0xb0, 0x27, 0xe6, 0xa4, 0xfe, 0x1b, 0x6b,
-	0x3e, 0xd9, 0x11, 0x0b, 0x8e, 0x85, 0x4c, 0x0c, 0xfb, 0xa0, 0xe0, 0x54, 0x59, 0x7a, 0x20, 0x27,
-	0xb0, 0x11, 0xc6, 0x8c, 0xbb, 0x31, 0x0f, 0x5d, 0x2e, 0x30, 0xc9, 0x6c, 0x8c, 0x25, 0x89, 0x6a,
-	0x2d, 0x44, 0x75, 0x6f, 0x02, 0xd2, 0x91, 0x1f, 0x14, 0x9c, 0xf5, 0x70, 0xde, 0x4c, 0xde, 0xc1,
-	0x1a, 0x5e, 0xa0, 0x37, 0xce, 0xa3, 0x8b, 0x12, 0xbd, 0xbd, 0x10, 0xbd, 0x9f, 0x88, 0x73, 0xd8,
-	0x55, 0xfc, 0xdb, 0x64, 0xeb, 0x50, 0x64, 0xe3, 0xa8, 0xf9, 0x5d, 0x83, 0x92, 0xec, 0xe0, 0x01,
-	0x2c, 0x8b, 0xe6, 0x7b, 0xa1, 0x2f, 0xfb, 0x2f, 0xd9, 0x30, 0x9b, 0xd6, 0xcb, 0xc2, 0xd5, 0xdd,
-	0x73, 0xca, 0xc2, 0xd5, 0xf5, 0xc9, 0x73, 0xb1, 0x40, 0x42, 0x14, 0x9f, 0x52, 0xd5, 0x9b, 0xb9,
-	0x78, 0x17, 0xbb, 0xf1, 0x29, 0x55, 0x4b, 0x5c, 0xf1, 0xd4, 0x99, 0xdc, 0x07, 0x90, 0xe1, 0xfd,
-	0x09, 0x47, 0x26, 0x1b, 0xa8, 0x39, 0x12, 0x68, 0x0b, 0x03, 0xd9, 0x84, 0xf2, 0x30, 0x8c, 0x63,
-	0xf4, 0x8d, 0x52, 0x43, 0x6b, 0x55, 0x1c, 0x75, 0x6a, 0xfe, 0xd0, 0xa0, 0x92, 0x8d, 0xe2, 0x11,
-	0xac, 0xa5, 0x23, 0xe8, 0xb9, 0xbe, 0x3f, 0x42, 0x96, 0x5c, 0xa6, 0xaa, 0xb3, 0x9a, 0xda, 0x5f,
-	0x25, 0x66, 0xd2, 0x85, 0xff, 0x32, 0x69, 0xae, 0x62, 0xeb, 0xf6, 0x95, 0xcf, 0x55, 0x5d, 0xf3,
-	0x72, 0x36, 0xb2, 0x07, 0xff, 0x67, 0x28, 0x26, 0x76, 0x4d, 0x5d, 0x9f, 0xad, 0x05, 0xe3, 0xa7,
-	0x3e, 0x0e, 0x14, 0x24, 0xcb, 0x2f, 0xf7, 0xb3, 0x69, 0x43, 0x25, 0xbd, 0x05, 0xa4, 0x01, 0xe5,
-	0xd0, 0xef, 0x9d, 0xe1, 0x44, 0x56, 0x5f, 0xb3, 0xab, 0xb3, 0x69, 0x5d, 0xef, 0xee, 0x1d, 0xe2,
-	0xc4, 0xd1, 0x43, 0xff, 0x10, 0x27, 0x64, 0x03, 0xf4, 0x73, 0x77, 0x30, 0x46, 0x59, 0x76, 0xc9,
-	0x49, 0x0e, 0xf6, 0x8b, 0xcb, 0x99, 0xa5, 0x5d, 0xcd, 0x2c, 0xed, 0xd7, 0xcc, 0xd2, 0xbe, 0x5e,
-	0x5b, 0x85, 0xab, 0x6b, 0xab, 0xf0, 0xf3, 0xda, 0x2a, 0x7c, 0xd8, 0x0e, 0x42, 0xfe, 0x71, 0xdc,
-	0x6f, 0x7b, 0x34, 0xea, 0x0c, 0xc2, 0x18, 0x3b, 0x83, 0x7e, 0xf4, 0x84, 0xf9, 0x67, 0x9d, 0x8b,
-	0xe4, 0x65, 0x92, 0x8f, 0x56, 0xbf, 0x2c, 0x9f, 0xa6, 0xa7, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff,
-	0x24, 0xa7, 0xe9, 0x38, 0x1d, 0x05, 0x00, 0x00,
+	// 725 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x94, 0xcf, 0x4e, 0xdb, 0x4a,
+	0x14, 0xc6, 0xf3, 0xcf, 0x26, 0x19, 0xc2, 0x05, 0x0d, 0x08, 0x7c, 0x73, 0x6f, 0x9d, 0x28, 0xa0,
+	0x2a, 0x95, 0xda, 0x44, 0x50, 0xb5, 0xbb, 0xb6, 0xaa, 0x0b, 0x2a, 0x11, 0x42, 0x2a, 0x46, 0xdd,
+	0x54, 0x42, 0x91, 0x63, 0x1f, 0x8c, 0x95, 0xd8, 0x13, 0x32, 0xe3, 0x94, 0xac, 0xfb, 0x02, 0x7d,
+	0x9d, 0xf6, 0x09, 0x58, 0xb2, 0xec, 0x2a, 0xaa, 0xc2, 0xae, 0x2f, 0xd0, 0x6d, 0xe5, 0x99, 0xb1,
+	0x63, 0x9a, 0x64, 0x13, 0x65, 0xbe, 0xf3, 0x9d, 0xdf, 0x9c, 0x73, 0xc6, 0x33, 0x48, 0xb7, 0x09,
+	0xf5, 0x3f, 0x5b, 0xd4, 0x6f, 0xf1, 0x9f, 0xd1, 0x7e, 0xcb, 0x85, 0x00, 0xa8, 0x47, 0x9b, 0x83,
+	0x21, 0x61, 0x04, 0x6f, 0xc4, 0xf1, 0x26, 0xff, 0x19, 0xed, 0x57, 0xb6, 0x5c, 0xe2, 0x12, 0x1e,
+	0x6c, 0x45, 0xff, 0x84, 0xaf, 0xf2, 0xff, 0x1c, 0x87, 0x8d, 0x07, 0x20, 0x29, 0x95, 0x7f, 0xe7,
+	0xa3, 0x37, 0x22, 0x54, 0xff, 0xad, 0xa0, 0xf2, 0x7b, 0xb1, 0xe5, 0x39, 0xb3, 0x18, 0xe0, 0x97,
+	0x48, 0x1d, 0x58, 0x43, 0xcb, 0xa7, 0x5a, 0xb6, 0x96, 0x6d, 0xac, 0x1e, 0x68, 0xcd, 0xbf, 0x4b,
+	0x68, 0x7e, 0xe0, 0x71, 0xa3, 0x70, 0x3b, 0xa9, 0x66, 0x4c, 0xe9, 0xc6, 0x47, 0x48, 0xb1, 0x89,
+	0x03, 0x54, 0xcb, 0xd5, 0xf2, 0x8d, 0xd5, 0x83, 0xed, 0xf9, 0xb4, 0x77, 0xc4, 0x01, 0x63, 0x27,
+	0x4a, 0xfa, 0x35, 0xa9, 0xae, 0x73, 0xf3, 0x53, 0xe2, 0x7b, 0x0c, 0xfc, 0x01, 0x1b, 0x9b, 0x22,
+	0x1b, 0x7f, 0x44, 0x25, 0x9b, 0x04, 0x6c, 0x68, 0xd9, 0x8c, 0x6a, 0x79, 0x8e, 0xaa, 0x2c, 0x42,
+	0x09, 0x8b, 0xf1, 0x9f, 0xc4, 0x6d, 0x26, 0x49, 0x29, 0xe4, 0x8c, 0x14, 0x61, 0x29, 0x5c, 0x87,
+	0x10, 0xd8, 0x40, 0xb5, 0xc2, 0x32, 0xec, 0xb9, 0xb4, 0xcc, 0xb0, 0x49, 0x52, 0x1a, 0x9b, 0x88,
+	0xf8, 0x02, 0x15, 0x5d, 0x08, 0x3a, 0x3e, 0x75, 0xa9, 0xa6, 0x70, 0xea, 0xe3, 0x79, 0x6a, 0x7a,
+	0xbc, 0xd1, 0xe2, 0x94, 0xba, 0xd4, 0xa8, 0xc8, 0x1d, 0x70, 0x9c, 0x9f, 0xda, 0x60, 0xc5, 0x15,
+	0x26, 0x5e, 0xb5, 0x7d, 0x05, 0x4e, 0xd8, 0x07, 0xaa, 0xa9, 0x4b, 0xab, 0x96, 0x96, 0x54, 0xd5,
+	0x71, 0xd2, 0x83, 0xaa, 0x63, 0xb1, 0xf2, 0x25, 0x87, 0x56, 0x64, 0x1d, 0xf8, 0x0d, 0x42, 0x94,
+	0x91, 0x21, 0x74, 0xa2, 0xf1, 0xcb, 0x23, 0xd7, 0xe7, 0xf7, 0x38, 0xa5, 0xee, 0x79, 0x64, 0x8b,
+	0xce, 0xf0, 0x38, 0x63, 0x96, 0x68, 0xbc, 0xc0, 0x17, 0x68, 0xcb, 0x0b, 0x28, 0xb3, 0x02, 0xe6,
+	0x59, 0x0c, 0x3a, 0xf1, 0xc8, 0xb5, 0x1c, 0x47, 0x35, 0x16, 0xa2, 0xda, 0xb3, 0x84, 0xf8, 0x24,
+	0x8f, 0x33, 0xe6, 0xa6, 0x37, 0x2f, 0xe3, 0x33, 0xb4, 0x01, 0x37, 0x60, 0x87, 0x69, 0x74, 0x9e,
+	0xa3, 0xf7, 0x16, 0xa2, 0x8f, 0x84, 0x39, 0x85, 0x5d, 0x87, 0x87, 0x92, 0xa1, 0xa0, 0x3c, 0x0d,
+	0xfd, 0xfa, 0xb7, 0x2c, 0x2a, 0xf0, 0x0e, 0x76, 0xd1, 0x4a, 0xd4, 0x7c, 0xc7, 0x73, 0x78, 0xff,
+	0x05, 0x03, 0x4d, 0x27, 0x55, 0x35, 0x0a, 0xb5, 0x0f, 0x4d, 0x35, 0x0a, 0xb5, 0x1d, 0xfc, 0x0a,
+	0x95, 0x84, 0x29, 0xb8, 0x24, 0xb2, 0xb7, 0xca, 0xe2, 0x4f, 0xbc, 0x1d, 0x5c, 0x12, 0x79, 0x37,
+	0x8a, 0xb6, 0x5c, 0xe3, 0x47, 0x08, 0xf1, 0xf4, 0xee, 0x98, 0x01, 0xe5, 0x0d, 0x94, 0x4d, 0x0e,
+	0x34, 0x22, 0x01, 0x6f, 0x23, 0x75, 0xe0, 0x05, 0x01, 0x38, 0x5a, 0xa1, 0x96, 0x6d, 0x14, 0x4d,
+	0xb9, 0xe2, 0xba, 0x15, 0x52, 0x70, 0x34, 0x45, 0xea, 0x7c, 0x55, 0xff, 0x9e, 0x43, 0xc5, 0x64,
+	0x44, 0x4f, 0xd0, 0x46, 0x3c, 0x9a, 0x8e, 0xe5, 0x38, 0x43, 0xa0, 0xe2, 0xee, 0x96, 0xcc, 0xf5,
+	0x58, 0x7f, 0x2b, 0x64, 0xdc, 0x46, 0x6b, 0x89, 0x35, 0xd5, 0x89, 0xbe, 0xfc, 0x86, 0xa5, 0xba,
+	0x29, 0xdb, 0x29, 0x0d, 0x1f, 0xa2, 0x7f, 0x12, 0x14, 0x8d, 0x3e, 0x6d, 0x79, 0x5b, 0x77, 0x16,
+	0x1c, 0x0b, 0x71, 0xa0, 0x2f, 0x21, 0xc9, 0xfe, 0xe2, 0xb5, 0x99, 0x35, 0x58, 0x48, 0x37, 0x88,
+	0x5f, 0x20, 0xe5, 0x3a, 0x24, 0xcc, 0xe2, 0x7d, 0xaf, 0x1e, 0x54, 0x97, 0x17, 0x78, 0x16, 0xd9,
+	0x4c, 0xe1, 0xc6, 0xbb, 0x68, 0x6d, 0x08, 0x36, 0x78, 0x23, 0x18, 0x76, 0xae, 0x08, 0xe9, 0x69,
+	0x2a, 0xa7, 0x96, 0x63, 0xf1, 0x98, 0x90, 0x5e, 0xdd, 0x40, 0xc5, 0xf8, 0xa2, 0xe3, 0x1a, 0x52,
+	0x3d, 0xa7, 0xd3, 0x83, 0x31, 0x9f, 0x58, 0xd9, 0x28, 0x4d, 0x27, 0x55, 0xa5, 0x7d, 0x78, 0x02,
+	0x63, 0x53, 0xf1, 0x9c, 0x13, 0x18, 0xe3, 0x2d, 0xa4, 0x8c, 0xac, 0x7e, 0x08, 0x7c, 0x54, 0x05,
+	0x53, 0x2c, 0x8c, 0xd7, 0xb7, 0x53, 0x3d, 0x7b, 0x37, 0xd5, 0xb3, 0x3f, 0xa7, 0x7a, 0xf6, 0xeb,
+	0xbd, 0x9e, 0xb9, 0xbb, 0xd7, 0x33, 0x3f, 0xee, 0xf5, 0xcc, 0xa7, 0x3d, 0xd7, 0x63, 0x57, 0x61,
+	0xb7, 0x69, 0x13, 0xbf, 0xd5, 0xf7, 0x02, 0x68, 0xf5, 0xbb, 0xfe, 0x33, 0xea, 0xf4, 0x5a, 0x37,
+	0xe2, 0xf1, 0xe5, 0xef, 0x72, 0x57, 0xe5, 0xaf, 0xef, 0xf3, 0x3f, 0x03, 0x00, 0x9e, 0x4f, 0x6d,
+	0x64, 0x00, 0x06, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -461,6 +510,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Schedules) > 0 {
+		for iNdEx := len(m.Schedules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Schedules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.GenMsgs) > 0 {
 		for iNdEx := len(m.GenMsgs) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -645,6 +708,16 @@ func (m *Code) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
 	if m.Pinned {
 		i--
 		if m.Pinned {
@@ -700,6 +773,38 @@ func (m *Contract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ReceiverHook {
+		i--
+		if m.ReceiverHook {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Quota != nil {
+		{
+			size, err := m.Quota.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.ContractState) > 0 {
 		for iNdEx := len(m.ContractState) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -812,6 +917,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.Schedules) > 0 {
+		for _, e := range m.Schedules {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -881,6 +992,9 @@ func (m *Code) Size() (n int) {
 	if m.Pinned {
 		n += 2
 	}
+	if m.Paused {
+		n += 2
+	}
 	return n
 }
 
@@ -902,6 +1016,16 @@ func (m *Contract) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if m.Paused {
+		n += 2
+	}
+	if m.Quota != nil {
+		l = m.Quota.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.ReceiverHook {
+		n += 2
+	}
 	return n
 }
 
@@ -1125,6 +1249,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Schedules = append(m.Schedules, Schedule{})
+			if err := m.Schedules[len(m.Schedules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1436,6 +1594,26 @@ func (m *Code) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Pinned = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1585,6 +1763,82 @@ func (m *Contract) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quota", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Quota == nil {
+				m.Quota = &ContractQuota{}
+			}
+			if err := m.Quota.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiverHook", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ReceiverHook = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
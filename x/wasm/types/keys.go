@@ -31,6 +31,15 @@ var (
 	ContractByCodeIDAndCreatedSecondaryIndexPrefix = []byte{0x06}
 	PinnedCodeIndexPrefix                          = []byte{0x07}
 	TXCounterPrefix                                = []byte{0x08}
+	ContractsByCreatorPrefix                       = []byte{0x09}
+	ContractsByAdminPrefix                         = []byte{0x0a}
+	PausedContractPrefix                           = []byte{0x0b}
+	PausedCodePrefix                               = []byte{0x0c}
+	ContractQuotaPrefix                            = []byte{0x0d}
+	ContractQuotaUsagePrefix                       = []byte{0x0e}
+	SchedulePrefix                                 = []byte{0x0f}
+	ScheduleQueuePrefix                            = []byte{0x10}
+	ReceiverHookPrefix                             = []byte{0x11}
 
 	KeyLastCodeID     = append(SequenceKeyPrefix, []byte("lastCodeId")...)
 	KeyLastInstanceID = append(SequenceKeyPrefix, []byte("lastContractId")...)
@@ -75,6 +84,52 @@ func GetContractByCodeIDSecondaryIndexPrefix(codeID uint64) []byte {
 	return r
 }
 
+// GetContractsByCreatorPrefix returns the contracts by creator prefix for the WASM contract instance:
+// `<prefix><len(creatorAddr)><creatorAddr>`
+func GetContractsByCreatorPrefix(creatorAddr sdk.AccAddress) []byte {
+	return lengthPrefixedKey(ContractsByCreatorPrefix, creatorAddr)
+}
+
+// GetContractByCreatorSecondaryIndexKey returns the key for the secondary index:
+// `<prefix><len(creatorAddr)><creatorAddr><created><contractAddr>`
+func GetContractByCreatorSecondaryIndexKey(creatorAddr sdk.AccAddress, created *AbsoluteTxPosition, contractAddr sdk.AccAddress) []byte {
+	prefix := GetContractsByCreatorPrefix(creatorAddr)
+	prefixLen := len(prefix)
+	r := make([]byte, prefixLen+AbsoluteTxPositionLen+len(contractAddr))
+	copy(r[0:], prefix)
+	copy(r[prefixLen:], created.Bytes())
+	copy(r[prefixLen+AbsoluteTxPositionLen:], contractAddr)
+	return r
+}
+
+// GetContractsByAdminPrefix returns the contracts by admin prefix for the WASM contract instance:
+// `<prefix><len(adminAddr)><adminAddr>`
+func GetContractsByAdminPrefix(adminAddr sdk.AccAddress) []byte {
+	return lengthPrefixedKey(ContractsByAdminPrefix, adminAddr)
+}
+
+// GetContractByAdminSecondaryIndexKey returns the key for the secondary index:
+// `<prefix><len(adminAddr)><adminAddr><contractAddr>`
+func GetContractByAdminSecondaryIndexKey(adminAddr sdk.AccAddress, contractAddr sdk.AccAddress) []byte {
+	prefix := GetContractsByAdminPrefix(adminAddr)
+	r := make([]byte, len(prefix)+len(contractAddr))
+	copy(r[0:], prefix)
+	copy(r[len(prefix):], contractAddr)
+	return r
+}
+
+func lengthPrefixedKey(prefix []byte, addr sdk.AccAddress) []byte {
+	if len(addr) > 255 {
+		panic("address length must not exceed 255 bytes")
+	}
+	prefixLen := len(prefix)
+	r := make([]byte, prefixLen+1+len(addr))
+	copy(r[0:], prefix)
+	r[prefixLen] = byte(len(addr))
+	copy(r[prefixLen+1:], addr)
+	return r
+}
+
 // GetContractCodeHistoryElementKey returns the key a contract code history entry: `<prefix><contractAddr><position>`
 func GetContractCodeHistoryElementKey(contractAddr sdk.AccAddress, pos uint64) []byte {
 	prefix := GetContractCodeHistoryElementPrefix(contractAddr)
@@ -101,6 +156,60 @@ func GetPinnedCodeIndexPrefix(codeID uint64) []byte {
 	return r
 }
 
+// GetPausedContractKey returns the key of a contract paused by the circuit breaker: `<prefix><contractAddr>`
+func GetPausedContractKey(contractAddr sdk.AccAddress) []byte {
+	return append(sdk.CopyBytes(PausedContractPrefix), contractAddr...)
+}
+
+// GetPausedCodeKey returns the key of a code paused by the circuit breaker: `<prefix><codeID>`
+func GetPausedCodeKey(codeID uint64) []byte {
+	return append(sdk.CopyBytes(PausedCodePrefix), sdk.Uint64ToBigEndian(codeID)...)
+}
+
+// GetContractQuotaKey returns the key of the per block execution quota of a contract: `<prefix><contractAddr>`
+func GetContractQuotaKey(contractAddr sdk.AccAddress) []byte {
+	return append(sdk.CopyBytes(ContractQuotaPrefix), contractAddr...)
+}
+
+// GetContractQuotaUsageKey returns the key of the quota usage of a contract in the last block it was executed:
+// `<prefix><contractAddr>`
+func GetContractQuotaUsageKey(contractAddr sdk.AccAddress) []byte {
+	return append(sdk.CopyBytes(ContractQuotaUsagePrefix), contractAddr...)
+}
+
+// GetReceiverHookKey returns the key of a contract opted in to the receiver hook: `<prefix><contractAddr>`
+func GetReceiverHookKey(contractAddr sdk.AccAddress) []byte {
+	return append(sdk.CopyBytes(ReceiverHookPrefix), contractAddr...)
+}
+
+// GetSchedulesPrefix returns the key prefix of the schedules of a contract: `<prefix><len(contractAddr)><contractAddr>`
+func GetSchedulesPrefix(contractAddr sdk.AccAddress) []byte {
+	return lengthPrefixedKey(SchedulePrefix, contractAddr)
+}
+
+// GetScheduleKey returns the key of a schedule of a contract: `<prefix><len(contractAddr)><contractAddr><name>`
+func GetScheduleKey(contractAddr sdk.AccAddress, name string) []byte {
+	return append(GetSchedulesPrefix(contractAddr), name...)
+}
+
+// GetScheduleQueuePrefix returns the key prefix of the schedules due at a height: `<prefix><height>`
+func GetScheduleQueuePrefix(height int64) []byte {
+	return append(sdk.CopyBytes(ScheduleQueuePrefix), sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// GetScheduleQueueKey returns the key of a schedule in the queue of due schedules:
+// `<prefix><height><len(contractAddr)><contractAddr><name>`
+func GetScheduleQueueKey(height int64, contractAddr sdk.AccAddress, name string) []byte {
+	return append(lengthPrefixedKey(GetScheduleQueuePrefix(height), contractAddr), name...)
+}
+
+// ParseScheduleQueueKey returns the contract address and the name of the schedule from a key
+// of the schedule queue without the prefix and the height.
+func ParseScheduleQueueKey(key []byte) (sdk.AccAddress, string) {
+	addrLen := int(key[0])
+	return sdk.AccAddress(key[1 : 1+addrLen]), string(key[1+addrLen:])
+}
+
 // ParsePinnedCodeIndex converts the serialized code ID back.
 func ParsePinnedCodeIndex(s []byte) uint64 {
 	return sdk.BigEndianToUint64(s)
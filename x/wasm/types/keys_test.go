@@ -70,3 +70,37 @@ func TestGetContractByCreatedSecondaryIndexKey(t *testing.T) {
 	}
 	assert.Equal(t, exp, got)
 }
+
+func TestGetContractByCreatorSecondaryIndexKey(t *testing.T) {
+	creatorAddr := bytes.Repeat([]byte{4}, 20)
+	contractAddr := bytes.Repeat([]byte{5}, ContractAddrLen)
+	got := GetContractByCreatorSecondaryIndexKey(creatorAddr, &AbsoluteTxPosition{1, 2}, contractAddr)
+	exp := []byte{9, // prefix
+		20,                           // creator address length
+		4, 4, 4, 4, 4, 4, 4, 4, 4, 4, // creator address 20 bytes
+		4, 4, 4, 4, 4, 4, 4, 4, 4, 4,
+		0, 0, 0, 0, 0, 0, 0, 1, // block height
+		0, 0, 0, 0, 0, 0, 0, 2, // tx index
+		5, 5, 5, 5, 5, 5, 5, 5, 5, 5, // contract address 32 bytes
+		5, 5, 5, 5, 5, 5, 5, 5, 5, 5,
+		5, 5, 5, 5, 5, 5, 5, 5, 5, 5,
+		5, 5,
+	}
+	assert.Equal(t, exp, got)
+}
+
+func TestGetContractByAdminSecondaryIndexKey(t *testing.T) {
+	adminAddr := bytes.Repeat([]byte{4}, ContractAddrLen)
+	contractAddr := bytes.Repeat([]byte{5}, 20)
+	got := GetContractByAdminSecondaryIndexKey(adminAddr, contractAddr)
+	exp := []byte{10, // prefix
+		32,                           // admin address length
+		4, 4, 4, 4, 4, 4, 4, 4, 4, 4, // admin address 32 bytes
+		4, 4, 4, 4, 4, 4, 4, 4, 4, 4,
+		4, 4, 4, 4, 4, 4, 4, 4, 4, 4,
+		4, 4,
+		5, 5, 5, 5, 5, 5, 5, 5, 5, 5, // contract address 20 bytes
+		5, 5, 5, 5, 5, 5, 5, 5, 5, 5,
+	}
+	assert.Equal(t, exp, got)
+}
@@ -24,6 +24,9 @@ const (
 	ProposalTypeUnpinCodes              ProposalType = "UnpinCodes"
 	ProposalTypeUpdateContractStatus    ProposalType = "UpdateContractStatus"
 	ProposalTypeUpdateInstantiateConfig ProposalType = "UpdateInstantiateConfig"
+	ProposalTypeImportContract          ProposalType = "ImportContract"
+	ProposalTypeRegisterSchedule        ProposalType = "RegisterSchedule"
+	ProposalTypeDeregisterSchedule      ProposalType = "DeregisterSchedule"
 )
 
 // DisableAllProposals contains no wasm gov types.
@@ -42,6 +45,9 @@ var EnableAllProposals = []ProposalType{
 	ProposalTypeUnpinCodes,
 	ProposalTypeUpdateContractStatus,
 	ProposalTypeUpdateInstantiateConfig,
+	ProposalTypeImportContract,
+	ProposalTypeRegisterSchedule,
+	ProposalTypeDeregisterSchedule,
 }
 
 // ConvertToProposals maps each key to a ProposalType and returns a typed list.
@@ -73,6 +79,9 @@ func init() { // register new content types with the sdk
 	govtypes.RegisterProposalType(string(ProposalTypePinCodes))
 	govtypes.RegisterProposalType(string(ProposalTypeUnpinCodes))
 	govtypes.RegisterProposalType(string(ProposalTypeUpdateInstantiateConfig))
+	govtypes.RegisterProposalType(string(ProposalTypeImportContract))
+	govtypes.RegisterProposalType(string(ProposalTypeRegisterSchedule))
+	govtypes.RegisterProposalType(string(ProposalTypeDeregisterSchedule))
 	govtypes.RegisterProposalTypeCodec(&StoreCodeProposal{}, "wasm/StoreCodeProposal")
 	govtypes.RegisterProposalTypeCodec(&InstantiateContractProposal{}, "wasm/InstantiateContractProposal")
 	govtypes.RegisterProposalTypeCodec(&MigrateContractProposal{}, "wasm/MigrateContractProposal")
@@ -84,6 +93,9 @@ func init() { // register new content types with the sdk
 	govtypes.RegisterProposalTypeCodec(&UnpinCodesProposal{}, "wasm/UnpinCodesProposal")
 	govtypes.RegisterProposalTypeCodec(UpdateContractStatusProposal{}, "wasm/UpdateContractStatusProposal")
 	govtypes.RegisterProposalTypeCodec(&UpdateInstantiateConfigProposal{}, "wasm/UpdateInstantiateConfigProposal")
+	govtypes.RegisterProposalTypeCodec(&ImportContractProposal{}, "wasm/ImportContractProposal")
+	govtypes.RegisterProposalTypeCodec(&RegisterScheduleProposal{}, "wasm/RegisterScheduleProposal")
+	govtypes.RegisterProposalTypeCodec(&DeregisterScheduleProposal{}, "wasm/DeregisterScheduleProposal")
 }
 
 // ProposalRoute returns the routing key of a parameter change proposal.
@@ -649,3 +661,149 @@ func (c AccessConfigUpdate) String() string {
   AccessConfig: %v
 `, c.CodeID, c.InstantiatePermission)
 }
+
+// ProposalRoute returns the routing key of a parameter change proposal.
+func (p ImportContractProposal) ProposalRoute() string { return RouterKey }
+
+// GetTitle returns the title of the proposal
+func (p *ImportContractProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the human readable description of the proposal
+func (p ImportContractProposal) GetDescription() string { return p.Description }
+
+// ProposalType returns the type
+func (p ImportContractProposal) ProposalType() string { return string(ProposalTypeImportContract) }
+
+// ValidateBasic validates the proposal
+func (p ImportContractProposal) ValidateBasic() error {
+	if err := validateProposalCommons(p.Title, p.Description); err != nil {
+		return err
+	}
+	if err := p.Contract.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(err, "contract")
+	}
+	return nil
+}
+
+// String implements the Stringer interface.
+func (p ImportContractProposal) String() string {
+	return fmt.Sprintf(`Import Contract Proposal:
+  Title:       %s
+  Description: %s
+  Contract:    %s
+  CodeHash:    %X
+  Checksum:    %X
+`, p.Title, p.Description, p.Contract.ContractAddress, p.Contract.CodeInfo.CodeHash, p.Contract.Checksum)
+}
+
+// MarshalYAML pretty prints the exported contract
+func (p ImportContractProposal) MarshalYAML() (interface{}, error) {
+	return struct {
+		Title           string `yaml:"title"`
+		Description     string `yaml:"description"`
+		ContractAddress string `yaml:"contract_address"`
+		CodeHash        string `yaml:"code_hash"`
+		Checksum        string `yaml:"checksum"`
+	}{
+		Title:           p.Title,
+		Description:     p.Description,
+		ContractAddress: p.Contract.ContractAddress,
+		CodeHash:        fmt.Sprintf("%X", p.Contract.CodeInfo.CodeHash),
+		Checksum:        p.Contract.Checksum.String(),
+	}, nil
+}
+
+// ProposalRoute returns the routing key of a parameter change proposal.
+func (p RegisterScheduleProposal) ProposalRoute() string { return RouterKey }
+
+// GetTitle returns the title of the proposal
+func (p *RegisterScheduleProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the human readable description of the proposal
+func (p RegisterScheduleProposal) GetDescription() string { return p.Description }
+
+// ProposalType returns the type
+func (p RegisterScheduleProposal) ProposalType() string { return string(ProposalTypeRegisterSchedule) }
+
+// ValidateBasic validates the proposal
+func (p RegisterScheduleProposal) ValidateBasic() error {
+	if err := validateProposalCommons(p.Title, p.Description); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(p.Contract); err != nil {
+		return sdkerrors.Wrap(err, "contract")
+	}
+	return validateSchedule(p.Name, p.Msg, p.Interval, p.GasLimit)
+}
+
+// String implements the Stringer interface.
+func (p RegisterScheduleProposal) String() string {
+	return fmt.Sprintf(`Register Schedule Proposal:
+  Title:       %s
+  Description: %s
+  Contract:    %s
+  Name:        %s
+  Msg:         %q
+  Interval:    %d
+  GasLimit:    %d
+`, p.Title, p.Description, p.Contract, p.Name, p.Msg, p.Interval, p.GasLimit)
+}
+
+// MarshalYAML pretty prints the sudo message
+func (p RegisterScheduleProposal) MarshalYAML() (interface{}, error) {
+	return struct {
+		Title       string `yaml:"title"`
+		Description string `yaml:"description"`
+		Contract    string `yaml:"contract"`
+		Name        string `yaml:"name"`
+		Msg         string `yaml:"msg"`
+		Interval    uint64 `yaml:"interval"`
+		GasLimit    uint64 `yaml:"gas_limit"`
+	}{
+		Title:       p.Title,
+		Description: p.Description,
+		Contract:    p.Contract,
+		Name:        p.Name,
+		Msg:         string(p.Msg),
+		Interval:    p.Interval,
+		GasLimit:    p.GasLimit,
+	}, nil
+}
+
+// ProposalRoute returns the routing key of a parameter change proposal.
+func (p DeregisterScheduleProposal) ProposalRoute() string { return RouterKey }
+
+// GetTitle returns the title of the proposal
+func (p *DeregisterScheduleProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the human readable description of the proposal
+func (p DeregisterScheduleProposal) GetDescription() string { return p.Description }
+
+// ProposalType returns the type
+func (p DeregisterScheduleProposal) ProposalType() string {
+	return string(ProposalTypeDeregisterSchedule)
+}
+
+// ValidateBasic validates the proposal
+func (p DeregisterScheduleProposal) ValidateBasic() error {
+	if err := validateProposalCommons(p.Title, p.Description); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(p.Contract); err != nil {
+		return sdkerrors.Wrap(err, "contract")
+	}
+	if err := validateScheduleName(p.Name); err != nil {
+		return sdkerrors.Wrap(err, "name")
+	}
+	return nil
+}
+
+// String implements the Stringer interface.
+func (p DeregisterScheduleProposal) String() string {
+	return fmt.Sprintf(`Deregister Schedule Proposal:
+  Title:       %s
+  Description: %s
+  Contract:    %s
+  Name:        %s
+`, p.Title, p.Description, p.Contract, p.Name)
+}
@@ -562,6 +562,146 @@ func (m *UpdateInstantiateConfigProposal) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_UpdateInstantiateConfigProposal proto.InternalMessageInfo
 
+// ImportContractProposal gov proposal content type to import a contract
+// exported from another chain. The code is stored under a new code id and the
+// contract keeps its address.
+type ImportContractProposal struct {
+	// Title is a short summary
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty" yaml:"title"`
+	// Description is a human readable text
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty" yaml:"description"`
+	// Contract is the export of the contract
+	Contract ContractExport `protobuf:"bytes,3,opt,name=contract,proto3" json:"contract"`
+}
+
+func (m *ImportContractProposal) Reset()      { *m = ImportContractProposal{} }
+func (*ImportContractProposal) ProtoMessage() {}
+func (*ImportContractProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_be6422d717c730cb, []int{12}
+}
+func (m *ImportContractProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ImportContractProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ImportContractProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ImportContractProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportContractProposal.Merge(m, src)
+}
+func (m *ImportContractProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *ImportContractProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportContractProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportContractProposal proto.InternalMessageInfo
+
+// RegisterScheduleProposal gov proposal content type to register a periodic
+// call of the sudo entry point of a contract.
+type RegisterScheduleProposal struct {
+	// Title is a short summary
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty" yaml:"title"`
+	// Description is a human readable text
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty" yaml:"description"`
+	// Contract is the address of the smart contract
+	Contract string `protobuf:"bytes,3,opt,name=contract,proto3" json:"contract,omitempty" yaml:"contract"`
+	// Name identifies the schedule among the schedules of the contract
+	Name string `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty" yaml:"name"`
+	// Msg json encoded message to be passed to the sudo entry point
+	Msg RawContractMessage `protobuf:"bytes,5,opt,name=msg,proto3,casttype=RawContractMessage" json:"msg,omitempty"`
+	// Interval is the number of blocks between two calls
+	Interval uint64 `protobuf:"varint,6,opt,name=interval,proto3" json:"interval,omitempty" yaml:"interval"`
+	// GasLimit is the max gas a call may consume
+	GasLimit uint64 `protobuf:"varint,7,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty" yaml:"gas_limit"`
+}
+
+func (m *RegisterScheduleProposal) Reset()      { *m = RegisterScheduleProposal{} }
+func (*RegisterScheduleProposal) ProtoMessage() {}
+func (*RegisterScheduleProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_be6422d717c730cb, []int{13}
+}
+func (m *RegisterScheduleProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RegisterScheduleProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RegisterScheduleProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RegisterScheduleProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegisterScheduleProposal.Merge(m, src)
+}
+func (m *RegisterScheduleProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *RegisterScheduleProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegisterScheduleProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RegisterScheduleProposal proto.InternalMessageInfo
+
+// DeregisterScheduleProposal gov proposal content type to remove a schedule of
+// a contract.
+type DeregisterScheduleProposal struct {
+	// Title is a short summary
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty" yaml:"title"`
+	// Description is a human readable text
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty" yaml:"description"`
+	// Contract is the address of the smart contract
+	Contract string `protobuf:"bytes,3,opt,name=contract,proto3" json:"contract,omitempty" yaml:"contract"`
+	// Name identifies the schedule among the schedules of the contract
+	Name string `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty" yaml:"name"`
+}
+
+func (m *DeregisterScheduleProposal) Reset()      { *m = DeregisterScheduleProposal{} }
+func (*DeregisterScheduleProposal) ProtoMessage() {}
+func (*DeregisterScheduleProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_be6422d717c730cb, []int{14}
+}
+func (m *DeregisterScheduleProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeregisterScheduleProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeregisterScheduleProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeregisterScheduleProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeregisterScheduleProposal.Merge(m, src)
+}
+func (m *DeregisterScheduleProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeregisterScheduleProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeregisterScheduleProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeregisterScheduleProposal proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*StoreCodeProposal)(nil), "cosmwasm.wasm.v1.StoreCodeProposal")
 	proto.RegisterType((*InstantiateContractProposal)(nil), "cosmwasm.wasm.v1.InstantiateContractProposal")
@@ -575,65 +715,77 @@ func init() {
 	proto.RegisterType((*UpdateContractStatusProposal)(nil), "cosmwasm.wasm.v1.UpdateContractStatusProposal")
 	proto.RegisterType((*AccessConfigUpdate)(nil), "cosmwasm.wasm.v1.AccessConfigUpdate")
 	proto.RegisterType((*UpdateInstantiateConfigProposal)(nil), "cosmwasm.wasm.v1.UpdateInstantiateConfigProposal")
+	proto.RegisterType((*ImportContractProposal)(nil), "cosmwasm.wasm.v1.ImportContractProposal")
+	proto.RegisterType((*RegisterScheduleProposal)(nil), "cosmwasm.wasm.v1.RegisterScheduleProposal")
+	proto.RegisterType((*DeregisterScheduleProposal)(nil), "cosmwasm.wasm.v1.DeregisterScheduleProposal")
 }
 
 func init() { proto.RegisterFile("cosmwasm/wasm/v1/proposal.proto", fileDescriptor_be6422d717c730cb) }
 
 var fileDescriptor_be6422d717c730cb = []byte{
-	// 847 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x56, 0x41, 0x6b, 0xe3, 0x46,
-	0x14, 0xf6, 0xc4, 0xb6, 0xec, 0x4c, 0xcc, 0xd6, 0xd5, 0x3a, 0x59, 0x37, 0x5d, 0x24, 0xa3, 0x5d,
-	0x8a, 0xa1, 0x54, 0xc6, 0x29, 0x94, 0x6d, 0x6f, 0x91, 0xdb, 0x43, 0x02, 0x81, 0xa0, 0x10, 0x0a,
-	0x6d, 0xa9, 0x19, 0x4b, 0x13, 0xed, 0x50, 0x69, 0x46, 0x68, 0x46, 0xc9, 0xe6, 0x3f, 0xf4, 0xd0,
-	0x43, 0xe9, 0xa9, 0x3f, 0xa0, 0x94, 0x42, 0xe9, 0xbd, 0xd7, 0x42, 0x8e, 0x7b, 0xdc, 0x93, 0xda,
-	0x75, 0xfe, 0x41, 0x8e, 0x85, 0x42, 0x99, 0x19, 0x39, 0x75, 0x36, 0x8d, 0x77, 0x4b, 0xe3, 0x42,
-	0x2f, 0x42, 0xa3, 0xf7, 0x66, 0xbe, 0x6f, 0x3e, 0xbe, 0xf7, 0x9e, 0xa0, 0x1d, 0x30, 0x9e, 0x9c,
-	0x20, 0x9e, 0x0c, 0xd4, 0xe3, 0x78, 0x38, 0x48, 0x33, 0x96, 0x32, 0x8e, 0x62, 0x37, 0xcd, 0x98,
-	0x60, 0x66, 0x7b, 0x96, 0xe0, 0xaa, 0xc7, 0xf1, 0x70, 0xb3, 0x13, 0xb1, 0x88, 0xa9, 0xe0, 0x40,
-	0xbe, 0xe9, 0xbc, 0x4d, 0x4b, 0xe6, 0x31, 0x3e, 0x98, 0x20, 0x8e, 0x07, 0xc7, 0xc3, 0x09, 0x16,
-	0x68, 0x38, 0x08, 0x18, 0xa1, 0x65, 0xfc, 0xfe, 0x35, 0x20, 0x71, 0x9a, 0x62, 0xae, 0xa3, 0xce,
-	0x1f, 0x00, 0xbe, 0x7e, 0x20, 0x58, 0x86, 0x47, 0x2c, 0xc4, 0xfb, 0x25, 0x03, 0xb3, 0x03, 0xeb,
-	0x82, 0x88, 0x18, 0x77, 0x41, 0x0f, 0xf4, 0x57, 0x7d, 0xbd, 0x30, 0x7b, 0x70, 0x2d, 0xc4, 0x3c,
-	0xc8, 0x48, 0x2a, 0x08, 0xa3, 0xdd, 0x15, 0x15, 0x9b, 0xff, 0x64, 0xae, 0x43, 0x23, 0xcb, 0xe9,
-	0x18, 0xf1, 0x6e, 0x55, 0x6f, 0xcc, 0x72, 0xba, 0xcd, 0xcd, 0xf7, 0xe0, 0x1d, 0x89, 0x3d, 0x9e,
-	0x9c, 0x0a, 0x3c, 0x0e, 0x58, 0x88, 0xbb, 0xb5, 0x1e, 0xe8, 0xb7, 0xbc, 0xf6, 0xb4, 0xb0, 0x5b,
-	0x1f, 0x6f, 0x1f, 0xec, 0x79, 0xa7, 0x42, 0x11, 0xf0, 0x5b, 0x32, 0x6f, 0xb6, 0x32, 0x0f, 0xe1,
-	0x06, 0xa1, 0x5c, 0x20, 0x2a, 0x08, 0x12, 0x78, 0x9c, 0xe2, 0x2c, 0x21, 0x9c, 0x4b, 0xec, 0x46,
-	0x0f, 0xf4, 0xd7, 0xb6, 0x2c, 0xf7, 0x45, 0x8d, 0xdc, 0xed, 0x20, 0xc0, 0x9c, 0x8f, 0x18, 0x3d,
-	0x22, 0x91, 0xbf, 0x3e, 0xb7, 0x7b, 0xff, 0x72, 0xf3, 0x6e, 0xad, 0x59, 0x6f, 0x1b, 0xbb, 0xb5,
-	0xa6, 0xd1, 0x6e, 0x38, 0xbf, 0xac, 0xc0, 0x37, 0x77, 0xfe, 0xca, 0x1a, 0x31, 0x2a, 0x32, 0x14,
-	0x88, 0x65, 0x29, 0xd1, 0x81, 0x75, 0x14, 0x26, 0x84, 0x2a, 0x01, 0x56, 0x7d, 0xbd, 0x30, 0x1f,
-	0xc0, 0x86, 0x54, 0x65, 0x4c, 0xc2, 0x6e, 0xbd, 0x07, 0xfa, 0x35, 0x0f, 0x4e, 0x0b, 0xdb, 0x90,
-	0x12, 0xec, 0x7c, 0xe8, 0x1b, 0x32, 0xb4, 0x13, 0xca, 0xad, 0x31, 0x9a, 0xe0, 0xb8, 0x6b, 0xe8,
-	0xad, 0x6a, 0x61, 0xf6, 0x61, 0x35, 0xe1, 0x91, 0xd2, 0xa3, 0xe5, 0x6d, 0xfc, 0x5e, 0xd8, 0xa6,
-	0x8f, 0x4e, 0x66, 0xb7, 0xd8, 0xc3, 0x9c, 0xa3, 0x08, 0xfb, 0x32, 0xc5, 0xfc, 0x0c, 0xd6, 0x8f,
-	0x72, 0x1a, 0xf2, 0x6e, 0xb3, 0x57, 0xed, 0xaf, 0x6d, 0xbd, 0xe1, 0x6a, 0xdf, 0xb8, 0xd2, 0x37,
-	0x6e, 0xe9, 0x1b, 0x77, 0xc4, 0x08, 0xf5, 0xde, 0x3e, 0x2b, 0xec, 0xca, 0xf7, 0xbf, 0xda, 0x0f,
-	0x22, 0x22, 0x1e, 0xe7, 0x13, 0x37, 0x60, 0xc9, 0x20, 0x26, 0x14, 0x0f, 0xe2, 0x49, 0xf2, 0x0e,
-	0x0f, 0xbf, 0x28, 0x0d, 0x24, 0x73, 0xb9, 0xaf, 0x0f, 0x75, 0x7e, 0x06, 0xf0, 0xde, 0x1e, 0x89,
-	0xb2, 0xdb, 0xd4, 0x70, 0x13, 0x36, 0x83, 0xf2, 0xac, 0x52, 0xaf, 0xcb, 0xf5, 0xab, 0x49, 0x56,
-	0x8a, 0x63, 0xbc, 0x54, 0x1c, 0xe7, 0x6b, 0x00, 0x3b, 0x07, 0x79, 0xc8, 0x96, 0xc2, 0xbd, 0xfa,
-	0x02, 0xf7, 0x92, 0x56, 0xed, 0xe5, 0xb4, 0xbe, 0x5c, 0x81, 0xf7, 0x3e, 0x7a, 0x82, 0x83, 0x7c,
-	0xf9, 0xce, 0x5c, 0x24, 0x76, 0x49, 0xb8, 0xfe, 0x0f, 0x4c, 0x66, 0x2c, 0xc3, 0x64, 0xdf, 0x02,
-	0x78, 0xf7, 0x30, 0x0d, 0x91, 0xc0, 0xdb, 0xb2, 0x6e, 0xfe, 0xb5, 0x14, 0x43, 0xb8, 0x4a, 0xf1,
-	0xc9, 0x58, 0x57, 0xa4, 0x52, 0xc3, 0xeb, 0x5c, 0x14, 0x76, 0xfb, 0x14, 0x25, 0xf1, 0x07, 0xce,
-	0x65, 0xc8, 0xf1, 0x9b, 0x14, 0x9f, 0x28, 0xc8, 0x45, 0x32, 0x39, 0x8f, 0xa1, 0x39, 0x8a, 0x31,
-	0xca, 0x6e, 0x87, 0xdc, 0x02, 0x07, 0x39, 0x3f, 0x02, 0xd8, 0xde, 0x27, 0x54, 0xda, 0x9d, 0x5f,
-	0x02, 0xbd, 0x75, 0x05, 0xc8, 0x6b, 0x5f, 0x14, 0x76, 0x4b, 0xdf, 0x44, 0x7d, 0x76, 0x66, 0xd0,
-	0x8f, 0xfe, 0x06, 0xda, 0xdb, 0xb8, 0x28, 0x6c, 0x53, 0x67, 0xcf, 0x05, 0x9d, 0xab, 0x94, 0xde,
-	0x97, 0x94, 0x54, 0xd1, 0x49, 0xf3, 0x54, 0xfb, 0x35, 0xcf, 0x9a, 0x16, 0x76, 0x43, 0x57, 0x1d,
-	0xbf, 0x28, 0xec, 0xd7, 0xf4, 0x09, 0xb3, 0x24, 0xc7, 0x6f, 0xe8, 0x4a, 0xe4, 0xce, 0x4f, 0x00,
-	0x9a, 0x87, 0x34, 0xfd, 0x5f, 0x71, 0xfe, 0x01, 0xc0, 0xfb, 0xda, 0x6e, 0x33, 0xaf, 0x1f, 0x08,
-	0x24, 0x72, 0xbe, 0xd4, 0xe6, 0xf0, 0x08, 0x1a, 0x5c, 0xa1, 0x28, 0x7b, 0xdd, 0xd9, 0xea, 0x5d,
-	0x9f, 0x71, 0x57, 0xd9, 0xf8, 0x65, 0xbe, 0xf3, 0x0d, 0x80, 0xe6, 0xfc, 0xf8, 0xd3, 0xd4, 0xe7,
-	0x3b, 0x25, 0xb8, 0xb1, 0x53, 0x7e, 0x7a, 0xe3, 0xa4, 0x5d, 0x79, 0x95, 0x49, 0xeb, 0xd5, 0x64,
-	0x35, 0xdf, 0x30, 0x6f, 0x9d, 0x73, 0x00, 0x6d, 0x4d, 0xe6, 0xea, 0xa4, 0x3d, 0x22, 0xd1, 0x7f,
-	0x68, 0x84, 0xcf, 0xe1, 0x3a, 0x52, 0x94, 0xc7, 0x81, 0x82, 0x1e, 0xe7, 0x8a, 0x92, 0x76, 0xc5,
-	0xda, 0xd6, 0xc3, 0xc5, 0x37, 0xd4, 0xfc, 0xcb, 0x7b, 0xde, 0x45, 0xd7, 0x22, 0xdc, 0xdb, 0x3d,
-	0x7b, 0x6e, 0x55, 0x9e, 0x3d, 0xb7, 0x2a, 0xdf, 0x4d, 0x2d, 0x70, 0x36, 0xb5, 0xc0, 0xd3, 0xa9,
-	0x05, 0x7e, 0x9b, 0x5a, 0xe0, 0xab, 0x73, 0xab, 0xf2, 0xf4, 0xdc, 0xaa, 0x3c, 0x3b, 0xb7, 0x2a,
-	0x9f, 0x3c, 0xbc, 0xa9, 0xdd, 0x3d, 0xd1, 0xbf, 0x67, 0xaa, 0xeb, 0x4d, 0x0c, 0xf5, 0x73, 0xf6,
-	0xee, 0x9f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x06, 0xd3, 0xfe, 0x41, 0x25, 0x0a, 0x00, 0x00,
+	// 981 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x57, 0x4f, 0x6b, 0x1b, 0xc7,
+	0x1b, 0xd6, 0x48, 0xab, 0x95, 0x3c, 0x12, 0x89, 0x7e, 0x6b, 0xd9, 0xd1, 0xcf, 0x0d, 0xbb, 0x62,
+	0x13, 0x8a, 0xa0, 0x54, 0x8b, 0x5c, 0x28, 0x69, 0x6f, 0x5e, 0x25, 0x07, 0x9b, 0x1a, 0xcc, 0x0a,
+	0x53, 0x68, 0x4b, 0xc5, 0x68, 0x77, 0xbc, 0x1e, 0xba, 0x7f, 0xc4, 0xce, 0xc8, 0x7f, 0xbe, 0x43,
+	0x0f, 0x3d, 0x94, 0x9e, 0xfa, 0x01, 0x4a, 0x29, 0x94, 0xde, 0x4b, 0x6f, 0x05, 0x1f, 0x73, 0xe8,
+	0x21, 0xa7, 0x6d, 0x22, 0x7f, 0x03, 0x1d, 0x0b, 0x85, 0x32, 0x33, 0x2b, 0x45, 0xb2, 0x6b, 0x25,
+	0xa1, 0x51, 0xa0, 0xbd, 0x08, 0xcd, 0xbe, 0xcf, 0xec, 0xfb, 0xbc, 0x0f, 0xcf, 0x3b, 0xef, 0x2c,
+	0x34, 0xdc, 0x98, 0x86, 0xa7, 0x88, 0x86, 0x96, 0xf8, 0x39, 0xe9, 0x58, 0xc3, 0x24, 0x1e, 0xc6,
+	0x14, 0x05, 0xed, 0x61, 0x12, 0xb3, 0x58, 0xab, 0x4d, 0x01, 0x6d, 0xf1, 0x73, 0xd2, 0xd9, 0xaa,
+	0xfb, 0xb1, 0x1f, 0x8b, 0xa0, 0xc5, 0xff, 0x49, 0xdc, 0x96, 0xce, 0x71, 0x31, 0xb5, 0x06, 0x88,
+	0x62, 0xeb, 0xa4, 0x33, 0xc0, 0x0c, 0x75, 0x2c, 0x37, 0x26, 0x51, 0x16, 0xbf, 0x7b, 0x2d, 0x11,
+	0x3b, 0x1f, 0x62, 0x2a, 0xa3, 0xe6, 0x9f, 0x00, 0xfe, 0xaf, 0xc7, 0xe2, 0x04, 0x77, 0x63, 0x0f,
+	0x1f, 0x64, 0x0c, 0xb4, 0x3a, 0x2c, 0x32, 0xc2, 0x02, 0xdc, 0x00, 0x4d, 0xd0, 0x5a, 0x73, 0xe4,
+	0x42, 0x6b, 0xc2, 0x8a, 0x87, 0xa9, 0x9b, 0x90, 0x21, 0x23, 0x71, 0xd4, 0xc8, 0x8b, 0xd8, 0xfc,
+	0x23, 0x6d, 0x03, 0xaa, 0xc9, 0x28, 0xea, 0x23, 0xda, 0x28, 0xc8, 0x8d, 0xc9, 0x28, 0xda, 0xa1,
+	0xda, 0xfb, 0xf0, 0x16, 0xcf, 0xdd, 0x1f, 0x9c, 0x33, 0xdc, 0x77, 0x63, 0x0f, 0x37, 0x94, 0x26,
+	0x68, 0x55, 0xed, 0xda, 0x38, 0x35, 0xaa, 0x1f, 0xef, 0xf4, 0xf6, 0xed, 0x73, 0x26, 0x08, 0x38,
+	0x55, 0x8e, 0x9b, 0xae, 0xb4, 0x43, 0xb8, 0x49, 0x22, 0xca, 0x50, 0xc4, 0x08, 0x62, 0xb8, 0x3f,
+	0xc4, 0x49, 0x48, 0x28, 0xe5, 0xb9, 0x4b, 0x4d, 0xd0, 0xaa, 0x6c, 0xeb, 0xed, 0xab, 0x1a, 0xb5,
+	0x77, 0x5c, 0x17, 0x53, 0xda, 0x8d, 0xa3, 0x23, 0xe2, 0x3b, 0x1b, 0x73, 0xbb, 0x0f, 0x66, 0x9b,
+	0xf7, 0x94, 0x72, 0xb1, 0xa6, 0xee, 0x29, 0x65, 0xb5, 0x56, 0x32, 0x7f, 0xcd, 0xc3, 0xb7, 0x76,
+	0x9f, 0xa3, 0xba, 0x71, 0xc4, 0x12, 0xe4, 0xb2, 0x55, 0x29, 0x51, 0x87, 0x45, 0xe4, 0x85, 0x24,
+	0x12, 0x02, 0xac, 0x39, 0x72, 0xa1, 0xdd, 0x83, 0x25, 0xae, 0x4a, 0x9f, 0x78, 0x8d, 0x62, 0x13,
+	0xb4, 0x14, 0x1b, 0x8e, 0x53, 0x43, 0xe5, 0x12, 0xec, 0x3e, 0x74, 0x54, 0x1e, 0xda, 0xf5, 0xf8,
+	0xd6, 0x00, 0x0d, 0x70, 0xd0, 0x50, 0xe5, 0x56, 0xb1, 0xd0, 0x5a, 0xb0, 0x10, 0x52, 0x5f, 0xe8,
+	0x51, 0xb5, 0x37, 0xff, 0x48, 0x0d, 0xcd, 0x41, 0xa7, 0xd3, 0x2a, 0xf6, 0x31, 0xa5, 0xc8, 0xc7,
+	0x0e, 0x87, 0x68, 0x9f, 0xc1, 0xe2, 0xd1, 0x28, 0xf2, 0x68, 0xa3, 0xdc, 0x2c, 0xb4, 0x2a, 0xdb,
+	0xff, 0x6f, 0x4b, 0xdf, 0xb4, 0xb9, 0x6f, 0xda, 0x99, 0x6f, 0xda, 0xdd, 0x98, 0x44, 0xf6, 0x3b,
+	0x17, 0xa9, 0x91, 0xfb, 0xfe, 0x77, 0xe3, 0x9e, 0x4f, 0xd8, 0xf1, 0x68, 0xd0, 0x76, 0xe3, 0xd0,
+	0x0a, 0x48, 0x84, 0xad, 0x60, 0x10, 0xbe, 0x4b, 0xbd, 0x2f, 0x32, 0x03, 0x71, 0x2c, 0x75, 0xe4,
+	0x4b, 0xcd, 0x9f, 0x01, 0xbc, 0xb3, 0x4f, 0xfc, 0xe4, 0x75, 0x6a, 0xb8, 0x05, 0xcb, 0x6e, 0xf6,
+	0xae, 0x4c, 0xaf, 0xd9, 0xfa, 0xe5, 0x24, 0xcb, 0xc4, 0x51, 0x5f, 0x28, 0x8e, 0xf9, 0x35, 0x80,
+	0xf5, 0xde, 0xc8, 0x8b, 0x57, 0xc2, 0xbd, 0x70, 0x85, 0x7b, 0x46, 0x4b, 0x79, 0x31, 0xad, 0x2f,
+	0xf3, 0xf0, 0xce, 0xa3, 0x33, 0xec, 0x8e, 0x56, 0xef, 0xcc, 0x65, 0x62, 0x67, 0x84, 0x8b, 0xaf,
+	0x60, 0x32, 0x75, 0x15, 0x26, 0xfb, 0x16, 0xc0, 0xf5, 0xc3, 0xa1, 0x87, 0x18, 0xde, 0xe1, 0x7d,
+	0xf3, 0x8f, 0xa5, 0xe8, 0xc0, 0xb5, 0x08, 0x9f, 0xf6, 0x65, 0x47, 0x0a, 0x35, 0xec, 0xfa, 0x24,
+	0x35, 0x6a, 0xe7, 0x28, 0x0c, 0x3e, 0x34, 0x67, 0x21, 0xd3, 0x29, 0x47, 0xf8, 0x54, 0xa4, 0x5c,
+	0x26, 0x93, 0x79, 0x0c, 0xb5, 0x6e, 0x80, 0x51, 0xf2, 0x7a, 0xc8, 0x2d, 0x71, 0x90, 0xf9, 0x23,
+	0x80, 0xb5, 0x03, 0x12, 0x71, 0xbb, 0xd3, 0x59, 0xa2, 0xb7, 0x17, 0x12, 0xd9, 0xb5, 0x49, 0x6a,
+	0x54, 0x65, 0x25, 0xe2, 0xb1, 0x39, 0x4d, 0xfd, 0xe0, 0x6f, 0x52, 0xdb, 0x9b, 0x93, 0xd4, 0xd0,
+	0x24, 0x7a, 0x2e, 0x68, 0x2e, 0x52, 0xfa, 0x00, 0x96, 0xb3, 0xa6, 0xe3, 0xe6, 0x29, 0xb4, 0x14,
+	0x5b, 0x1f, 0xa7, 0x46, 0x49, 0x76, 0x1d, 0x9d, 0xa4, 0xc6, 0x6d, 0xf9, 0x86, 0x29, 0xc8, 0x74,
+	0x4a, 0xb2, 0x13, 0xa9, 0xf9, 0x13, 0x80, 0xda, 0x61, 0x34, 0xfc, 0x57, 0x71, 0xfe, 0x01, 0xc0,
+	0xbb, 0xd2, 0x6e, 0x53, 0xaf, 0xf7, 0x18, 0x62, 0x23, 0xba, 0xd2, 0xc3, 0xe1, 0x01, 0x54, 0xa9,
+	0xc8, 0x22, 0xec, 0x75, 0x6b, 0xbb, 0x79, 0x7d, 0xc6, 0x2d, 0xb2, 0x71, 0x32, 0xbc, 0xf9, 0x0d,
+	0x80, 0xda, 0xfc, 0xf8, 0x93, 0xd4, 0xe7, 0x4f, 0x4a, 0x70, 0xe3, 0x49, 0xf9, 0xe9, 0x8d, 0x93,
+	0x36, 0xff, 0x32, 0x93, 0xd6, 0x56, 0x78, 0x37, 0xdf, 0x30, 0x6f, 0xcd, 0x4b, 0x00, 0x0d, 0x49,
+	0x66, 0x71, 0xd2, 0x1e, 0x11, 0xff, 0x0d, 0x1a, 0xe1, 0x73, 0xb8, 0x81, 0x04, 0xe5, 0xbe, 0x2b,
+	0x52, 0xf7, 0x47, 0x82, 0x92, 0x74, 0x45, 0x65, 0xfb, 0xfe, 0xf2, 0x0a, 0x25, 0xff, 0xac, 0xce,
+	0x75, 0x74, 0x2d, 0x42, 0xcd, 0x5f, 0x00, 0xdc, 0xdc, 0x0d, 0x87, 0x71, 0xc2, 0xae, 0x1d, 0xd5,
+	0xab, 0x2f, 0xce, 0xbe, 0xe2, 0xa8, 0xca, 0x32, 0xdf, 0x3c, 0x3a, 0xe3, 0x2c, 0xb3, 0x5a, 0x9e,
+	0x1f, 0x2a, 0x4f, 0xf3, 0xb0, 0xe1, 0x60, 0x9f, 0x50, 0x86, 0x93, 0x9e, 0x7b, 0x8c, 0xbd, 0x51,
+	0x80, 0xdf, 0x60, 0x09, 0xd6, 0xd5, 0xa6, 0xb0, 0xd7, 0xe7, 0xbb, 0x33, 0x23, 0xb9, 0x70, 0x05,
+	0x50, 0x22, 0x14, 0xca, 0xbb, 0xe4, 0x9a, 0x7d, 0x7b, 0x92, 0x1a, 0x15, 0x09, 0xe6, 0x4f, 0x4d,
+	0x47, 0x04, 0x5f, 0x61, 0x74, 0x59, 0xb0, 0x4c, 0x22, 0x86, 0x93, 0x13, 0x24, 0xaf, 0x58, 0xca,
+	0x7c, 0xfe, 0x69, 0xc4, 0x74, 0x66, 0x20, 0x3e, 0x3d, 0x7c, 0x44, 0xfb, 0x01, 0x09, 0x09, 0x13,
+	0x17, 0x30, 0x65, 0x7e, 0x7a, 0xcc, 0x42, 0xa6, 0x53, 0xf6, 0x11, 0xfd, 0x48, 0xfc, 0xfd, 0x0d,
+	0xc0, 0xad, 0x87, 0x38, 0xf9, 0x8f, 0x89, 0x6c, 0xef, 0x5d, 0x3c, 0xd3, 0x73, 0x4f, 0x9e, 0xe9,
+	0xb9, 0xef, 0xc6, 0x3a, 0xb8, 0x18, 0xeb, 0xe0, 0xf1, 0x58, 0x07, 0x4f, 0xc7, 0x3a, 0xf8, 0xea,
+	0x52, 0xcf, 0x3d, 0xbe, 0xd4, 0x73, 0x4f, 0x2e, 0xf5, 0xdc, 0x27, 0xf7, 0x6f, 0x9a, 0xf4, 0x67,
+	0xf2, 0xcb, 0x44, 0x0c, 0xfc, 0x81, 0x2a, 0xbe, 0x4b, 0xde, 0xfb, 0x6b, 0x00, 0xd4, 0x44, 0x78,
+	0xd8, 0x20, 0x0d, 0x00, 0x00,
 }
 
 func (this *StoreCodeProposal) Equal(that interface{}) bool {
@@ -1063,6 +1215,111 @@ func (this *UpdateInstantiateConfigProposal) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *ImportContractProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ImportContractProposal)
+	if !ok {
+		that2, ok := that.(ImportContractProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if !this.Contract.Equal(&that1.Contract) {
+		return false
+	}
+	return true
+}
+func (this *RegisterScheduleProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RegisterScheduleProposal)
+	if !ok {
+		that2, ok := that.(RegisterScheduleProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if this.Contract != that1.Contract {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if !bytes.Equal(this.Msg, that1.Msg) {
+		return false
+	}
+	if this.Interval != that1.Interval {
+		return false
+	}
+	if this.GasLimit != that1.GasLimit {
+		return false
+	}
+	return true
+}
+func (this *DeregisterScheduleProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DeregisterScheduleProposal)
+	if !ok {
+		that2, ok := that.(DeregisterScheduleProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if this.Contract != that1.Contract {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	return true
+}
 func (m *StoreCodeProposal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1732,58 +1989,224 @@ func (m *UpdateInstantiateConfigProposal) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintProposal(dAtA []byte, offset int, v uint64) int {
-	offset -= sovProposal(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *ImportContractProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *StoreCodeProposal) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *ImportContractProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ImportContractProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovProposal(uint64(l))
-	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovProposal(uint64(l))
-	}
-	l = len(m.RunAs)
-	if l > 0 {
-		n += 1 + l + sovProposal(uint64(l))
+	{
+		size, err := m.Contract.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProposal(dAtA, i, uint64(size))
 	}
-	l = len(m.WASMByteCode)
-	if l > 0 {
-		n += 1 + l + sovProposal(uint64(l))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.InstantiatePermission != nil {
-		l = m.InstantiatePermission.Size()
-		n += 1 + l + sovProposal(uint64(l))
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *InstantiateContractProposal) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RegisterScheduleProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RegisterScheduleProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RegisterScheduleProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovProposal(uint64(l))
+	if m.GasLimit != 0 {
+		i = encodeVarintProposal(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x38
 	}
-	l = len(m.Description)
-	if l > 0 {
+	if m.Interval != 0 {
+		i = encodeVarintProposal(dAtA, i, uint64(m.Interval))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeregisterScheduleProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeregisterScheduleProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeregisterScheduleProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintProposal(dAtA []byte, offset int, v uint64) int {
+	offset -= sovProposal(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *StoreCodeProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.RunAs)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.WASMByteCode)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	if m.InstantiatePermission != nil {
+		l = m.InstantiatePermission.Size()
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	return n
+}
+
+func (m *InstantiateContractProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
 		n += 1 + l + sovProposal(uint64(l))
 	}
 	l = len(m.RunAs)
@@ -2057,6 +2480,85 @@ func (m *UpdateInstantiateConfigProposal) Size() (n int) {
 	return n
 }
 
+func (m *ImportContractProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = m.Contract.Size()
+	n += 1 + l + sovProposal(uint64(l))
+	return n
+}
+
+func (m *RegisterScheduleProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	if m.Interval != 0 {
+		n += 1 + sovProposal(uint64(m.Interval))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovProposal(uint64(m.GasLimit))
+	}
+	return n
+}
+
+func (m *DeregisterScheduleProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	return n
+}
+
 func sovProposal(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -4320,6 +4822,581 @@ func (m *UpdateInstantiateConfigProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ImportContractProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ImportContractProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ImportContractProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Contract.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RegisterScheduleProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RegisterScheduleProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RegisterScheduleProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msg = append(m.Msg[:0], dAtA[iNdEx:postIndex]...)
+			if m.Msg == nil {
+				m.Msg = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			m.Interval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Interval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeregisterScheduleProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeregisterScheduleProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeregisterScheduleProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipProposal(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -3,6 +3,7 @@ package types
 import (
 	"bytes"
 	"encoding/json"
+	"math"
 	"strings"
 	"testing"
 
@@ -587,6 +588,114 @@ func TestValidateUpdateContractStatusProposal(t *testing.T) {
 	}
 }
 
+func TestValidateImportContractProposal(t *testing.T) {
+	specs := map[string]struct {
+		src    *ImportContractProposal
+		expErr bool
+	}{
+		"all good": {
+			src: ImportContractProposalFixture(),
+		},
+		"base data missing": {
+			src: ImportContractProposalFixture(func(p *ImportContractProposal) {
+				p.Title = ""
+			}),
+			expErr: true,
+		},
+		"contract invalid": {
+			src: ImportContractProposalFixture(func(p *ImportContractProposal) {
+				p.Contract.ContractAddress = "invalid"
+			}),
+			expErr: true,
+		},
+		"checksum mismatch": {
+			src: ImportContractProposalFixture(func(p *ImportContractProposal) {
+				p.Contract.ContractInfo.Label = "other"
+			}),
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateRegisterScheduleProposal(t *testing.T) {
+	specs := map[string]struct {
+		src    *RegisterScheduleProposal
+		expErr bool
+	}{
+		"all good": {
+			src: RegisterScheduleProposalFixture(),
+		},
+		"base data missing": {
+			src: RegisterScheduleProposalFixture(func(p *RegisterScheduleProposal) {
+				p.Title = ""
+			}),
+			expErr: true,
+		},
+		"contract invalid": {
+			src: RegisterScheduleProposalFixture(func(p *RegisterScheduleProposal) {
+				p.Contract = "invalid address"
+			}),
+			expErr: true,
+		},
+		"name missing": {
+			src: RegisterScheduleProposalFixture(func(p *RegisterScheduleProposal) {
+				p.Name = ""
+			}),
+			expErr: true,
+		},
+		"name too long": {
+			src: RegisterScheduleProposalFixture(func(p *RegisterScheduleProposal) {
+				p.Name = strings.Repeat("a", MaxScheduleNameSize+1)
+			}),
+			expErr: true,
+		},
+		"msg with invalid json": {
+			src: RegisterScheduleProposalFixture(func(p *RegisterScheduleProposal) {
+				p.Msg = []byte("not a json message")
+			}),
+			expErr: true,
+		},
+		"interval zero": {
+			src: RegisterScheduleProposalFixture(func(p *RegisterScheduleProposal) {
+				p.Interval = 0
+			}),
+			expErr: true,
+		},
+		"interval too large": {
+			src: RegisterScheduleProposalFixture(func(p *RegisterScheduleProposal) {
+				p.Interval = math.MaxUint32 + 1
+			}),
+			expErr: true,
+		},
+		"gas limit zero": {
+			src: RegisterScheduleProposalFixture(func(p *RegisterScheduleProposal) {
+				p.GasLimit = 0
+			}),
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestProposalStrings(t *testing.T) {
 	specs := map[string]struct {
 		src govtypes.Content
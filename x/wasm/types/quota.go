@@ -0,0 +1,6 @@
+package types
+
+// IsEmpty returns true when the quota does not limit the executions
+func (q ContractQuota) IsEmpty() bool {
+	return q.MaxExecutionsPerBlock == 0 && q.MaxGasPerBlock == 0
+}
@@ -0,0 +1,46 @@
+package types
+
+const (
+	// DefaultReceiverHookGasLimit is the max gas a contract can spend on handling a received transfer
+	DefaultReceiverHookGasLimit uint64 = 1_000_000
+	// DefaultMaxReceiverHookDepth is the max depth of receiver hooks triggering transfers to other contracts
+	DefaultMaxReceiverHookDepth uint32 = 5
+)
+
+// ReceiverHookMsg is passed to the sudo entry point of a contract opted in to the receiver hook,
+// after it received x/token tokens or x/collection coins. The contract rejects the transfer by
+// returning an error.
+type ReceiverHookMsg struct {
+	TokenReceived      *TokenReceivedMsg      `json:"token_received,omitempty"`
+	CollectionReceived *CollectionReceivedMsg `json:"collection_received,omitempty"`
+}
+
+// TokenReceivedMsg notifies about x/token tokens received by the contract
+type TokenReceivedMsg struct {
+	// ContractID is the id of the token class
+	ContractID string `json:"contract_id"`
+	// Operator is the address which sent the tokens, either the holder or a proxy
+	Operator string `json:"operator"`
+	// From is the address of the previous holder
+	From string `json:"from"`
+	// Amount is the number of tokens received
+	Amount string `json:"amount"`
+}
+
+// CollectionReceivedMsg notifies about x/collection coins received by the contract
+type CollectionReceivedMsg struct {
+	// ContractID is the id of the collection
+	ContractID string `json:"contract_id"`
+	// Operator is the address which sent the coins, either the holder or a proxy
+	Operator string `json:"operator"`
+	// From is the address of the previous holder
+	From string `json:"from"`
+	// Amount is the list of received fungible tokens and non-fungible tokens
+	Amount []CollectionCoin `json:"amount"`
+}
+
+// CollectionCoin is the amount of a token of a collection
+type CollectionCoin struct {
+	TokenID string `json:"token_id"`
+	Amount  string `json:"amount"`
+}
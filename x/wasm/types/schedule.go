@@ -0,0 +1,66 @@
+package types
+
+import (
+	"math"
+
+	sdk "github.com/line/lbm-sdk/types"
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
+)
+
+// NewSchedule constructor
+func NewSchedule(contract sdk.AccAddress, name string, msg RawContractMessage, interval, gasLimit uint64, nextHeight int64) Schedule {
+	return Schedule{
+		Contract:   contract.String(),
+		Name:       name,
+		Msg:        msg,
+		Interval:   interval,
+		GasLimit:   gasLimit,
+		NextHeight: nextHeight,
+	}
+}
+
+// ValidateBasic performs stateless validation of the schedule
+func (s Schedule) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(s.Contract); err != nil {
+		return sdkerrors.Wrap(err, "contract")
+	}
+	if err := validateSchedule(s.Name, s.Msg, s.Interval, s.GasLimit); err != nil {
+		return err
+	}
+	if s.NextHeight <= 0 {
+		return sdkerrors.Wrap(ErrInvalid, "next height")
+	}
+	if s.LastHeight < 0 {
+		return sdkerrors.Wrap(ErrInvalid, "last height")
+	}
+	return nil
+}
+
+func validateSchedule(name string, msg RawContractMessage, interval, gasLimit uint64) error {
+	if err := validateScheduleName(name); err != nil {
+		return sdkerrors.Wrap(err, "name")
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(err, "payload msg")
+	}
+	if interval == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "interval")
+	}
+	if interval > math.MaxUint32 {
+		return sdkerrors.Wrapf(ErrLimit, "interval cannot be greater than %d", uint64(math.MaxUint32))
+	}
+	if gasLimit == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "gas limit")
+	}
+	return nil
+}
+
+func validateScheduleName(name string) error {
+	if name == "" {
+		return sdkerrors.Wrap(ErrEmpty, "is required")
+	}
+	if len(name) > MaxScheduleNameSize {
+		return sdkerrors.Wrapf(ErrLimit, "cannot be longer than %d characters", MaxScheduleNameSize)
+	}
+	return nil
+}
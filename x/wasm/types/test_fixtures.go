@@ -276,6 +276,27 @@ func SudoContractProposalFixture(mutators ...func(p *SudoContractProposal)) *Sud
 	return p
 }
 
+func RegisterScheduleProposalFixture(mutators ...func(p *RegisterScheduleProposal)) *RegisterScheduleProposal {
+	const (
+		contractAddr = "link1hcttwju93d5m39467gjcq63p5kc4fdcn30dgd8"
+	)
+
+	p := &RegisterScheduleProposal{
+		Title:       "Foo",
+		Description: "Bar",
+		Contract:    contractAddr,
+		Name:        "accrue",
+		Msg:         []byte(`{"do":"something"}`),
+		Interval:    100,
+		GasLimit:    1_000_000,
+	}
+
+	for _, m := range mutators {
+		m(p)
+	}
+	return p
+}
+
 func ExecuteContractProposalFixture(mutators ...func(p *ExecuteContractProposal)) *ExecuteContractProposal {
 	const (
 		contractAddr = "link1hcttwju93d5m39467gjcq63p5kc4fdcn30dgd8"
@@ -345,3 +366,44 @@ func UpdateContractStatusProposalFixture(mutators ...func(p *UpdateContractStatu
 	}
 	return p
 }
+
+func ContractExportFixture(mutators ...func(*ContractExport)) ContractExport {
+	const anyAddress = "link1qyqszqgpqyqszqgpqyqszqgpqyqszqgpqyqszqgpqyqszqgpqyqsh9tp23"
+	wasmCode := bytes.Repeat([]byte{0x1}, 10)
+
+	fixture := ContractExport{
+		CodeInfo:        CodeInfoFixture(WithSHA256CodeHash(wasmCode)),
+		CodeBytes:       wasmCode,
+		ContractAddress: anyAddress,
+		ContractInfo:    ContractInfoFixture(),
+		ContractHistory: []ContractCodeHistoryEntry{{
+			Operation: ContractCodeHistoryOperationTypeInit,
+			CodeID:    1,
+			Updated:   &AbsoluteTxPosition{BlockHeight: 1, TxIndex: 1},
+			Msg:       []byte(`{}`),
+		}},
+		ContractState: []Model{{Key: []byte("anyKey"), Value: []byte("anyValue")}},
+	}
+	checksum, err := fixture.ComputeChecksum()
+	if err != nil {
+		panic(err)
+	}
+	fixture.Checksum = checksum
+
+	for _, m := range mutators {
+		m(&fixture)
+	}
+	return fixture
+}
+
+func ImportContractProposalFixture(mutators ...func(p *ImportContractProposal)) *ImportContractProposal {
+	p := &ImportContractProposal{
+		Title:       "Foo",
+		Description: "Bar",
+		Contract:    ContractExportFixture(),
+	}
+	for _, m := range mutators {
+		m(p)
+	}
+	return p
+}
@@ -127,6 +127,57 @@ func (msg MsgInstantiateContract) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{senderAddr}
 }
 
+func (msg MsgInstantiateContract2) Route() string {
+	return RouterKey
+}
+
+func (msg MsgInstantiateContract2) Type() string {
+	return "instantiate2"
+}
+
+func (msg MsgInstantiateContract2) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrap(err, "sender")
+	}
+
+	if msg.CodeID == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "code id is required")
+	}
+
+	if err := validateLabel(msg.Label); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "label is required")
+	}
+
+	if !msg.Funds.IsValid() {
+		return sdkerrors.ErrInvalidCoins
+	}
+
+	if len(msg.Admin) != 0 {
+		if _, err := sdk.AccAddressFromBech32(msg.Admin); err != nil {
+			return sdkerrors.Wrap(err, "admin")
+		}
+	}
+	if err := msg.Msg.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(err, "payload msg")
+	}
+	if err := ValidateSalt(msg.Salt); err != nil {
+		return sdkerrors.Wrap(err, "salt")
+	}
+	return nil
+}
+
+func (msg MsgInstantiateContract2) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgInstantiateContract2) GetSigners() []sdk.AccAddress {
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil { // should never happen as valid basic rejects invalid addresses
+		panic(err.Error())
+	}
+	return []sdk.AccAddress{senderAddr}
+}
+
 func (msg MsgStoreCodeAndInstantiateContract) Route() string {
 	return RouterKey
 }
@@ -219,6 +270,21 @@ func (msg MsgExecuteContract) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{senderAddr}
 }
 
+// GetMsg returns the payload message send to the contract
+func (msg MsgExecuteContract) GetMsg() RawContractMessage {
+	return msg.Msg
+}
+
+// GetFunds returns tokens send to the contract
+func (msg MsgExecuteContract) GetFunds() sdk.Coins {
+	return msg.Funds
+}
+
+// GetContract returns the bech32 address of the contract
+func (msg MsgExecuteContract) GetContract() string {
+	return msg.Contract
+}
+
 func (msg MsgMigrateContract) Route() string {
 	return RouterKey
 }
@@ -257,6 +323,21 @@ func (msg MsgMigrateContract) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{senderAddr}
 }
 
+// GetMsg returns the payload message send to the contract
+func (msg MsgMigrateContract) GetMsg() RawContractMessage {
+	return msg.Msg
+}
+
+// GetFunds returns tokens send to the contract
+func (msg MsgMigrateContract) GetFunds() sdk.Coins {
+	return sdk.NewCoins()
+}
+
+// GetContract returns the bech32 address of the contract
+func (msg MsgMigrateContract) GetContract() string {
+	return msg.Contract
+}
+
 func (msg MsgUpdateAdmin) Route() string {
 	return RouterKey
 }
@@ -362,3 +443,176 @@ func (msg MsgIBCCloseChannel) GetSignBytes() []byte {
 func (msg MsgIBCCloseChannel) GetSigners() []sdk.AccAddress {
 	return nil
 }
+
+func (msg MsgUpdateCircuitBreaker) Route() string {
+	return RouterKey
+}
+
+func (msg MsgUpdateCircuitBreaker) Type() string {
+	return "update-circuit-breaker"
+}
+
+func (msg MsgUpdateCircuitBreaker) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Operator); err != nil {
+		return sdkerrors.Wrap(err, "operator")
+	}
+	if len(msg.ContractAddresses) == 0 && len(msg.CodeIDs) == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "contract addresses and code ids")
+	}
+	contracts := make(map[string]bool, len(msg.ContractAddresses))
+	for i, addr := range msg.ContractAddresses {
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return sdkerrors.Wrapf(err, "contract address %d", i)
+		}
+		if contracts[addr] {
+			return sdkerrors.Wrapf(ErrDuplicate, "duplicate contract: %s", addr)
+		}
+		contracts[addr] = true
+	}
+	codeIDs := make(map[uint64]bool, len(msg.CodeIDs))
+	for _, codeID := range msg.CodeIDs {
+		if codeID == 0 {
+			return sdkerrors.Wrap(ErrEmpty, "code id")
+		}
+		if codeIDs[codeID] {
+			return sdkerrors.Wrapf(ErrDuplicate, "duplicate code: %d", codeID)
+		}
+		codeIDs[codeID] = true
+	}
+	return nil
+}
+
+func (msg MsgUpdateCircuitBreaker) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgUpdateCircuitBreaker) GetSigners() []sdk.AccAddress {
+	operatorAddr, err := sdk.AccAddressFromBech32(msg.Operator)
+	if err != nil { // should never happen as valid basic rejects invalid addresses
+		panic(err.Error())
+	}
+	return []sdk.AccAddress{operatorAddr}
+}
+
+func (msg MsgUpdateContractQuota) Route() string {
+	return RouterKey
+}
+
+func (msg MsgUpdateContractQuota) Type() string {
+	return "update-contract-quota"
+}
+
+func (msg MsgUpdateContractQuota) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Operator); err != nil {
+		return sdkerrors.Wrap(err, "operator")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Contract); err != nil {
+		return sdkerrors.Wrap(err, "contract")
+	}
+	return nil
+}
+
+func (msg MsgUpdateContractQuota) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgUpdateContractQuota) GetSigners() []sdk.AccAddress {
+	operatorAddr, err := sdk.AccAddressFromBech32(msg.Operator)
+	if err != nil { // should never happen as valid basic rejects invalid addresses
+		panic(err.Error())
+	}
+	return []sdk.AccAddress{operatorAddr}
+}
+
+func (msg MsgRegisterSchedule) Route() string {
+	return RouterKey
+}
+
+func (msg MsgRegisterSchedule) Type() string {
+	return "register-schedule"
+}
+
+func (msg MsgRegisterSchedule) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Operator); err != nil {
+		return sdkerrors.Wrap(err, "operator")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Contract); err != nil {
+		return sdkerrors.Wrap(err, "contract")
+	}
+	return validateSchedule(msg.Name, msg.Msg, msg.Interval, msg.GasLimit)
+}
+
+func (msg MsgRegisterSchedule) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgRegisterSchedule) GetSigners() []sdk.AccAddress {
+	operatorAddr, err := sdk.AccAddressFromBech32(msg.Operator)
+	if err != nil { // should never happen as valid basic rejects invalid addresses
+		panic(err.Error())
+	}
+	return []sdk.AccAddress{operatorAddr}
+}
+
+func (msg MsgDeregisterSchedule) Route() string {
+	return RouterKey
+}
+
+func (msg MsgDeregisterSchedule) Type() string {
+	return "deregister-schedule"
+}
+
+func (msg MsgDeregisterSchedule) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Operator); err != nil {
+		return sdkerrors.Wrap(err, "operator")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Contract); err != nil {
+		return sdkerrors.Wrap(err, "contract")
+	}
+	if err := validateScheduleName(msg.Name); err != nil {
+		return sdkerrors.Wrap(err, "name")
+	}
+	return nil
+}
+
+func (msg MsgDeregisterSchedule) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgDeregisterSchedule) GetSigners() []sdk.AccAddress {
+	operatorAddr, err := sdk.AccAddressFromBech32(msg.Operator)
+	if err != nil { // should never happen as valid basic rejects invalid addresses
+		panic(err.Error())
+	}
+	return []sdk.AccAddress{operatorAddr}
+}
+
+func (msg MsgUpdateReceiverHook) Route() string {
+	return RouterKey
+}
+
+func (msg MsgUpdateReceiverHook) Type() string {
+	return "update-receiver-hook"
+}
+
+func (msg MsgUpdateReceiverHook) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrap(err, "sender")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Contract); err != nil {
+		return sdkerrors.Wrap(err, "contract")
+	}
+	return nil
+}
+
+func (msg MsgUpdateReceiverHook) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgUpdateReceiverHook) GetSigners() []sdk.AccAddress {
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil { // should never happen as valid basic rejects invalid addresses
+		panic(err.Error())
+	}
+	return []sdk.AccAddress{senderAddr}
+}
@@ -185,6 +185,126 @@ func TestInstantiateContractValidation(t *testing.T) {
 	}
 }
 
+func TestInstantiateContract2Validation(t *testing.T) {
+	bad, err := sdk.AccAddressFromHex("012345")
+	require.NoError(t, err)
+	badAddress := bad.String()
+	// proper address size
+	goodAddress := sdk.AccAddress(make([]byte, 20)).String()
+
+	cases := map[string]struct {
+		msg   MsgInstantiateContract2
+		valid bool
+	}{
+		"empty": {
+			msg:   MsgInstantiateContract2{},
+			valid: false,
+		},
+		"correct minimal": {
+			msg: MsgInstantiateContract2{
+				Sender: goodAddress,
+				CodeID: firstCodeID,
+				Label:  "foo",
+				Msg:    []byte("{}"),
+				Salt:   []byte{0},
+			},
+			valid: true,
+		},
+		"correct maximal": {
+			msg: MsgInstantiateContract2{
+				Sender: goodAddress,
+				Admin:  goodAddress,
+				CodeID: firstCodeID,
+				Label:  "foo",
+				Msg:    []byte(`{"some": "data"}`),
+				Funds:  sdk.Coins{sdk.Coin{Denom: "foobar", Amount: sdk.NewInt(200)}},
+				Salt:   bytes.Repeat([]byte{0}, MaxSaltSize),
+				FixMsg: true,
+			},
+			valid: true,
+		},
+		"bad sender minimal": {
+			msg: MsgInstantiateContract2{
+				Sender: badAddress,
+				CodeID: firstCodeID,
+				Label:  "foo",
+				Msg:    []byte("{}"),
+				Salt:   []byte{0},
+			},
+			valid: false,
+		},
+		"bad admin": {
+			msg: MsgInstantiateContract2{
+				Sender: goodAddress,
+				Admin:  "invalid",
+				CodeID: firstCodeID,
+				Label:  "foo",
+				Msg:    []byte("{}"),
+				Salt:   []byte{0},
+			},
+			valid: false,
+		},
+		"missing code": {
+			msg: MsgInstantiateContract2{
+				Sender: goodAddress,
+				Label:  "foo",
+				Msg:    []byte("{}"),
+				Salt:   []byte{0},
+			},
+			valid: false,
+		},
+		"missing label": {
+			msg: MsgInstantiateContract2{
+				Sender: goodAddress,
+				CodeID: firstCodeID,
+				Msg:    []byte("{}"),
+				Salt:   []byte{0},
+			},
+			valid: false,
+		},
+		"non json init msg": {
+			msg: MsgInstantiateContract2{
+				Sender: goodAddress,
+				CodeID: firstCodeID,
+				Label:  "foo",
+				Msg:    []byte("invalid-json"),
+				Salt:   []byte{0},
+			},
+			valid: false,
+		},
+		"empty salt": {
+			msg: MsgInstantiateContract2{
+				Sender: goodAddress,
+				CodeID: firstCodeID,
+				Label:  "foo",
+				Msg:    []byte("{}"),
+			},
+			valid: false,
+		},
+		"salt too long": {
+			msg: MsgInstantiateContract2{
+				Sender: goodAddress,
+				CodeID: firstCodeID,
+				Label:  "foo",
+				Msg:    []byte("{}"),
+				Salt:   bytes.Repeat([]byte{0}, MaxSaltSize+1),
+			},
+			valid: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
 func TestStoreCodeAndInstantiateContractValidation(t *testing.T) {
 	bad, err := sdk.AccAddressFromHex("012345")
 	require.NoError(t, err)
@@ -529,6 +649,67 @@ func TestMsgClearAdministrator(t *testing.T) {
 	}
 }
 
+func TestMsgUpdateReceiverHook(t *testing.T) {
+	bad, err := sdk.AccAddressFromHex("012345")
+	require.NoError(t, err)
+	badAddress := bad.String()
+	// proper address size
+	goodAddress := sdk.AccAddress(make([]byte, 20)).String()
+	anotherGoodAddress := sdk.AccAddress(bytes.Repeat([]byte{0x2}, 20)).String()
+
+	specs := map[string]struct {
+		src    MsgUpdateReceiverHook
+		expErr bool
+	}{
+		"enable": {
+			src: MsgUpdateReceiverHook{
+				Sender:   goodAddress,
+				Contract: anotherGoodAddress,
+				Enabled:  true,
+			},
+		},
+		"disable": {
+			src: MsgUpdateReceiverHook{
+				Sender:   goodAddress,
+				Contract: anotherGoodAddress,
+			},
+		},
+		"bad sender": {
+			src: MsgUpdateReceiverHook{
+				Sender:   badAddress,
+				Contract: anotherGoodAddress,
+				Enabled:  true,
+			},
+			expErr: true,
+		},
+		"bad contract addr": {
+			src: MsgUpdateReceiverHook{
+				Sender:   goodAddress,
+				Contract: badAddress,
+				Enabled:  true,
+			},
+			expErr: true,
+		},
+		"contract missing": {
+			src: MsgUpdateReceiverHook{
+				Sender:  goodAddress,
+				Enabled: true,
+			},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
 func TestMsgMigrateContract(t *testing.T) {
 	bad, err := sdk.AccAddressFromHex("012345")
 	require.NoError(t, err)
@@ -616,6 +797,236 @@ func TestMsgMigrateContract(t *testing.T) {
 	}
 }
 
+func TestMsgUpdateCircuitBreaker(t *testing.T) {
+	bad, err := sdk.AccAddressFromHex("012345")
+	require.NoError(t, err)
+	badAddress := bad.String()
+	// proper address size
+	goodAddress := sdk.AccAddress(make([]byte, 20)).String()
+	contractAddress := sdk.AccAddress(bytes.Repeat([]byte{0x1}, ContractAddrLen)).String()
+
+	specs := map[string]struct {
+		src    MsgUpdateCircuitBreaker
+		expErr bool
+	}{
+		"contracts and codes": {
+			src: MsgUpdateCircuitBreaker{
+				Operator:          goodAddress,
+				ContractAddresses: []string{contractAddress},
+				CodeIDs:           []uint64{1, 2},
+				Paused:            true,
+			},
+		},
+		"codes only": {
+			src: MsgUpdateCircuitBreaker{
+				Operator: goodAddress,
+				CodeIDs:  []uint64{1},
+			},
+		},
+		"nothing to update": {
+			src: MsgUpdateCircuitBreaker{
+				Operator: goodAddress,
+				Paused:   true,
+			},
+			expErr: true,
+		},
+		"bad operator": {
+			src: MsgUpdateCircuitBreaker{
+				Operator: badAddress,
+				CodeIDs:  []uint64{1},
+			},
+			expErr: true,
+		},
+		"bad contract addr": {
+			src: MsgUpdateCircuitBreaker{
+				Operator:          goodAddress,
+				ContractAddresses: []string{badAddress},
+			},
+			expErr: true,
+		},
+		"duplicate contracts": {
+			src: MsgUpdateCircuitBreaker{
+				Operator:          goodAddress,
+				ContractAddresses: []string{contractAddress, contractAddress},
+			},
+			expErr: true,
+		},
+		"duplicate codes": {
+			src: MsgUpdateCircuitBreaker{
+				Operator: goodAddress,
+				CodeIDs:  []uint64{1, 1},
+			},
+			expErr: true,
+		},
+		"empty code id": {
+			src: MsgUpdateCircuitBreaker{
+				Operator: goodAddress,
+				CodeIDs:  []uint64{0},
+			},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgUpdateContractQuota(t *testing.T) {
+	bad, err := sdk.AccAddressFromHex("012345")
+	require.NoError(t, err)
+	badAddress := bad.String()
+	// proper address size
+	goodAddress := sdk.AccAddress(make([]byte, 20)).String()
+	contractAddress := sdk.AccAddress(bytes.Repeat([]byte{0x1}, ContractAddrLen)).String()
+
+	specs := map[string]struct {
+		src    MsgUpdateContractQuota
+		expErr bool
+	}{
+		"all good": {
+			src: MsgUpdateContractQuota{
+				Operator: goodAddress,
+				Contract: contractAddress,
+				Quota:    ContractQuota{MaxExecutionsPerBlock: 1, MaxGasPerBlock: 1_000_000},
+			},
+		},
+		"empty quota": {
+			src: MsgUpdateContractQuota{
+				Operator: goodAddress,
+				Contract: contractAddress,
+			},
+		},
+		"bad operator": {
+			src: MsgUpdateContractQuota{
+				Operator: badAddress,
+				Contract: contractAddress,
+			},
+			expErr: true,
+		},
+		"bad contract addr": {
+			src: MsgUpdateContractQuota{
+				Operator: goodAddress,
+				Contract: badAddress,
+			},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgRegisterSchedule(t *testing.T) {
+	bad, err := sdk.AccAddressFromHex("012345")
+	require.NoError(t, err)
+	badAddress := bad.String()
+	// proper address size
+	goodAddress := sdk.AccAddress(make([]byte, 20)).String()
+	contractAddress := sdk.AccAddress(bytes.Repeat([]byte{0x1}, ContractAddrLen)).String()
+
+	specs := map[string]struct {
+		src    MsgRegisterSchedule
+		expErr bool
+	}{
+		"all good": {
+			src: MsgRegisterSchedule{
+				Operator: goodAddress,
+				Contract: contractAddress,
+				Name:     "accrue",
+				Msg:      []byte(`{}`),
+				Interval: 10,
+				GasLimit: 100_000,
+			},
+		},
+		"bad operator": {
+			src: MsgRegisterSchedule{
+				Operator: badAddress,
+				Contract: contractAddress,
+				Name:     "accrue",
+				Msg:      []byte(`{}`),
+				Interval: 10,
+				GasLimit: 100_000,
+			},
+			expErr: true,
+		},
+		"bad contract addr": {
+			src: MsgRegisterSchedule{
+				Operator: goodAddress,
+				Contract: badAddress,
+				Name:     "accrue",
+				Msg:      []byte(`{}`),
+				Interval: 10,
+				GasLimit: 100_000,
+			},
+			expErr: true,
+		},
+		"empty name": {
+			src: MsgRegisterSchedule{
+				Operator: goodAddress,
+				Contract: contractAddress,
+				Msg:      []byte(`{}`),
+				Interval: 10,
+				GasLimit: 100_000,
+			},
+			expErr: true,
+		},
+		"empty msg": {
+			src: MsgRegisterSchedule{
+				Operator: goodAddress,
+				Contract: contractAddress,
+				Name:     "accrue",
+				Interval: 10,
+				GasLimit: 100_000,
+			},
+			expErr: true,
+		},
+		"zero interval": {
+			src: MsgRegisterSchedule{
+				Operator: goodAddress,
+				Contract: contractAddress,
+				Name:     "accrue",
+				Msg:      []byte(`{}`),
+				GasLimit: 100_000,
+			},
+			expErr: true,
+		},
+		"zero gas limit": {
+			src: MsgRegisterSchedule{
+				Operator: goodAddress,
+				Contract: contractAddress,
+				Name:     "accrue",
+				Msg:      []byte(`{}`),
+				Interval: 10,
+			},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
 func TestMsgJsonSignBytes(t *testing.T) {
 	const myInnerMsg = `{"foo":"bar"}`
 	specs := map[string]struct {
@@ -466,6 +466,159 @@ func (m *Model) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Model proto.InternalMessageInfo
 
+// ContractExport is the portable export of a single contract, encompassing its
+// code, info, history and storage.
+type ContractExport struct {
+	// CodeInfo is the info of the code of the contract
+	CodeInfo CodeInfo `protobuf:"bytes,1,opt,name=code_info,json=codeInfo,proto3" json:"code_info"`
+	// CodeBytes is the WASM code of the contract
+	CodeBytes []byte `protobuf:"bytes,2,opt,name=code_bytes,json=codeBytes,proto3" json:"code_bytes,omitempty"`
+	// ContractAddress is the bech32 address of the contract
+	ContractAddress string `protobuf:"bytes,3,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// ContractInfo is the info of the contract
+	ContractInfo ContractInfo `protobuf:"bytes,4,opt,name=contract_info,json=contractInfo,proto3" json:"contract_info"`
+	// ContractHistory is the code history of the contract. The code ids refer
+	// to the exporting chain.
+	ContractHistory []ContractCodeHistoryEntry `protobuf:"bytes,5,rep,name=contract_history,json=contractHistory,proto3" json:"contract_history"`
+	// ContractState is the raw storage of the contract
+	ContractState []Model `protobuf:"bytes,6,rep,name=contract_state,json=contractState,proto3" json:"contract_state"`
+	// Checksum is the sha256 hash of the binary encoded export with an empty
+	// checksum
+	Checksum github_com_line_ostracon_libs_bytes.HexBytes `protobuf:"bytes,7,opt,name=checksum,proto3,casttype=github.com/line/ostracon/libs/bytes.HexBytes" json:"checksum,omitempty"`
+}
+
+func (m *ContractExport) Reset()         { *m = ContractExport{} }
+func (m *ContractExport) String() string { return proto.CompactTextString(m) }
+func (*ContractExport) ProtoMessage()    {}
+func (*ContractExport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6155d98fa173e02, []int{8}
+}
+func (m *ContractExport) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContractExport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractExport.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ContractExport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractExport.Merge(m, src)
+}
+func (m *ContractExport) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContractExport) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractExport.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractExport proto.InternalMessageInfo
+
+// ContractQuota defines the per block limits of the executions of a contract.
+// A zero value means no limit.
+type ContractQuota struct {
+	// MaxExecutionsPerBlock is the max number of executions of the contract
+	// within a block
+	MaxExecutionsPerBlock uint64 `protobuf:"varint,1,opt,name=max_executions_per_block,json=maxExecutionsPerBlock,proto3" json:"max_executions_per_block,omitempty"`
+	// MaxGasPerBlock is the max gas the executions of the contract may consume
+	// within a block
+	MaxGasPerBlock uint64 `protobuf:"varint,2,opt,name=max_gas_per_block,json=maxGasPerBlock,proto3" json:"max_gas_per_block,omitempty"`
+}
+
+func (m *ContractQuota) Reset()         { *m = ContractQuota{} }
+func (m *ContractQuota) String() string { return proto.CompactTextString(m) }
+func (*ContractQuota) ProtoMessage()    {}
+func (*ContractQuota) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6155d98fa173e02, []int{9}
+}
+func (m *ContractQuota) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContractQuota) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractQuota.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ContractQuota) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractQuota.Merge(m, src)
+}
+func (m *ContractQuota) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContractQuota) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractQuota.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractQuota proto.InternalMessageInfo
+
+// Schedule defines a periodic call of the sudo entry point of a contract by
+// the chain.
+type Schedule struct {
+	// Contract is the address of the contract to call
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	// Name identifies the schedule among the schedules of the contract
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Msg json encoded message to be passed to the sudo entry point
+	Msg RawContractMessage `protobuf:"bytes,3,opt,name=msg,proto3,casttype=RawContractMessage" json:"msg,omitempty"`
+	// Interval is the number of blocks between two calls
+	Interval uint64 `protobuf:"varint,4,opt,name=interval,proto3" json:"interval,omitempty"`
+	// GasLimit is the max gas a call may consume
+	GasLimit uint64 `protobuf:"varint,5,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+	// NextHeight is the height of the block at the end of which the contract is
+	// called next
+	NextHeight int64 `protobuf:"varint,6,opt,name=next_height,json=nextHeight,proto3" json:"next_height,omitempty"`
+	// LastHeight is the height of the last call, zero when not called yet
+	LastHeight int64 `protobuf:"varint,7,opt,name=last_height,json=lastHeight,proto3" json:"last_height,omitempty"`
+	// LastError is the error of the last call, empty when it succeeded
+	LastError string `protobuf:"bytes,8,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+}
+
+func (m *Schedule) Reset()         { *m = Schedule{} }
+func (m *Schedule) String() string { return proto.CompactTextString(m) }
+func (*Schedule) ProtoMessage()    {}
+func (*Schedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6155d98fa173e02, []int{10}
+}
+func (m *Schedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Schedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Schedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Schedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Schedule.Merge(m, src)
+}
+func (m *Schedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *Schedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_Schedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Schedule proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterEnum("cosmwasm.wasm.v1.AccessType", AccessType_name, AccessType_value)
 	proto.RegisterEnum("cosmwasm.wasm.v1.ContractStatus", ContractStatus_name, ContractStatus_value)
@@ -478,95 +631,118 @@ func init() {
 	proto.RegisterType((*ContractCodeHistoryEntry)(nil), "cosmwasm.wasm.v1.ContractCodeHistoryEntry")
 	proto.RegisterType((*AbsoluteTxPosition)(nil), "cosmwasm.wasm.v1.AbsoluteTxPosition")
 	proto.RegisterType((*Model)(nil), "cosmwasm.wasm.v1.Model")
+	proto.RegisterType((*ContractExport)(nil), "cosmwasm.wasm.v1.ContractExport")
+	proto.RegisterType((*ContractQuota)(nil), "cosmwasm.wasm.v1.ContractQuota")
+	proto.RegisterType((*Schedule)(nil), "cosmwasm.wasm.v1.Schedule")
 }
 
 func init() { proto.RegisterFile("cosmwasm/wasm/v1/types.proto", fileDescriptor_e6155d98fa173e02) }
 
 var fileDescriptor_e6155d98fa173e02 = []byte{
-	// 1315 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0x4d, 0x6f, 0x1a, 0xc7,
-	0x1b, 0x67, 0x0d, 0xb6, 0x61, 0x4c, 0xfc, 0x27, 0x13, 0x3b, 0x01, 0x92, 0x3f, 0x6c, 0xb6, 0xa9,
-	0xea, 0x24, 0x0e, 0x24, 0x6e, 0xd5, 0xa6, 0x96, 0x1a, 0x89, 0x97, 0x4d, 0xbc, 0x51, 0x0d, 0x68,
-	0xc0, 0xa9, 0x5c, 0x29, 0x5a, 0x0d, 0xbb, 0x63, 0xbc, 0xca, 0xb2, 0x83, 0x76, 0x06, 0x07, 0xbe,
-	0x41, 0x85, 0x54, 0xa9, 0xb7, 0xf6, 0x82, 0x54, 0xb5, 0x55, 0x95, 0x0f, 0xd0, 0xaf, 0x50, 0x29,
-	0xca, 0x29, 0xc7, 0x9e, 0x50, 0xeb, 0x5c, 0xda, 0x2b, 0x97, 0x4a, 0xe9, 0xa5, 0xda, 0x59, 0x08,
-	0x6b, 0xe7, 0xc5, 0xee, 0x65, 0xb5, 0xcf, 0xcb, 0xef, 0xf7, 0xbc, 0xcd, 0x33, 0xbb, 0xe0, 0x92,
-	0x41, 0x59, 0xfb, 0x31, 0x66, 0xed, 0xbc, 0x78, 0x1c, 0xdc, 0xca, 0xf3, 0x7e, 0x87, 0xb0, 0x5c,
-	0xc7, 0xa5, 0x9c, 0xc2, 0xc4, 0xd4, 0x9a, 0x13, 0x8f, 0x83, 0x5b, 0xe9, 0x94, 0xa7, 0xa1, 0x4c,
-	0x17, 0xf6, 0xbc, 0x2f, 0xf8, 0xce, 0xe9, 0x95, 0x16, 0x6d, 0x51, 0x5f, 0xef, 0xbd, 0x4d, 0xb4,
-	0xa9, 0x16, 0xa5, 0x2d, 0x9b, 0xe4, 0x85, 0xd4, 0xec, 0xee, 0xe5, 0xb1, 0xd3, 0xf7, 0x4d, 0xca,
-	0x43, 0xf0, 0xbf, 0x82, 0x61, 0x10, 0xc6, 0x1a, 0xfd, 0x0e, 0xa9, 0x61, 0x17, 0xb7, 0x61, 0x19,
-	0xcc, 0x1f, 0x60, 0xbb, 0x4b, 0x92, 0x92, 0x2c, 0xad, 0x2d, 0x6f, 0x5c, 0xca, 0x1d, 0x4f, 0x20,
-	0x37, 0x43, 0x14, 0x13, 0xe3, 0x51, 0x36, 0xde, 0xc7, 0x6d, 0x7b, 0x53, 0x11, 0x20, 0x05, 0xf9,
-	0xe0, 0xcd, 0xc8, 0x77, 0xdf, 0x67, 0x25, 0xe5, 0x5b, 0x09, 0xc4, 0x7d, 0xef, 0x12, 0x75, 0xf6,
-	0xac, 0x16, 0xac, 0x03, 0xd0, 0x21, 0x6e, 0xdb, 0x62, 0xcc, 0xa2, 0xce, 0xa9, 0x22, 0xac, 0x8e,
-	0x47, 0xd9, 0xb3, 0x7e, 0x84, 0x19, 0x52, 0x41, 0x01, 0x1a, 0xb8, 0x0e, 0x16, 0xb1, 0x69, 0xba,
-	0x84, 0xb1, 0xe4, 0x9c, 0x2c, 0xad, 0xc5, 0x8a, 0x70, 0x3c, 0xca, 0x2e, 0xfb, 0x98, 0x89, 0x41,
-	0x41, 0x53, 0x97, 0x49, 0x66, 0xbf, 0x86, 0xc1, 0x82, 0xa8, 0x97, 0x41, 0x0a, 0xa0, 0x41, 0x4d,
-	0xa2, 0x77, 0x3b, 0x36, 0xc5, 0xa6, 0x8e, 0x45, 0x6c, 0x91, 0xdb, 0xd2, 0x46, 0xe6, 0x6d, 0xb9,
-	0xf9, 0xf5, 0x14, 0x2f, 0x3f, 0x1d, 0x65, 0x43, 0xe3, 0x51, 0x36, 0xe5, 0x47, 0x7b, 0x9d, 0x47,
-	0x41, 0x09, 0x4f, 0xb9, 0x23, 0x74, 0x3e, 0x14, 0x7e, 0x2d, 0x81, 0x8c, 0xe5, 0x30, 0x8e, 0x1d,
-	0x6e, 0x61, 0x4e, 0x74, 0x93, 0xec, 0xe1, 0xae, 0xcd, 0xf5, 0x40, 0x67, 0xe6, 0x4e, 0xd1, 0x99,
-	0xab, 0xe3, 0x51, 0xf6, 0x7d, 0x3f, 0xee, 0xbb, 0xd9, 0x14, 0x74, 0x29, 0xe0, 0x50, 0xf6, 0xed,
-	0xb5, 0x59, 0xff, 0x3e, 0x05, 0xcb, 0x2d, 0xcc, 0xf4, 0x76, 0xd7, 0xe6, 0x56, 0xc7, 0xb6, 0x88,
-	0x9b, 0x0c, 0xcb, 0xd2, 0x5a, 0x24, 0xd8, 0xc6, 0x36, 0xee, 0xe9, 0x2d, 0xcc, 0x14, 0x74, 0xa6,
-	0x85, 0xd9, 0xf6, 0x2b, 0x47, 0xf8, 0x19, 0x38, 0xe3, 0x53, 0x1b, 0x44, 0x37, 0x28, 0xe3, 0xc9,
-	0x88, 0x40, 0x26, 0xc7, 0xa3, 0xec, 0x4a, 0x30, 0xb5, 0x89, 0x59, 0x41, 0xf1, 0xa9, 0x5c, 0xa2,
-	0x8c, 0xc3, 0x4d, 0x10, 0x37, 0x68, 0xbb, 0x63, 0xd9, 0x13, 0xf4, 0xbc, 0x40, 0x5f, 0x18, 0x8f,
-	0xb2, 0xe7, 0xa6, 0x0d, 0x9d, 0x59, 0x15, 0xb4, 0x34, 0x11, 0x3d, 0xac, 0x98, 0x63, 0x48, 0xf9,
-	0x41, 0x02, 0xd1, 0x12, 0x35, 0x89, 0xe6, 0xec, 0x51, 0x78, 0x11, 0xc4, 0xc4, 0x04, 0xf6, 0x31,
-	0xdb, 0x17, 0x03, 0x8c, 0xa3, 0xa8, 0xa7, 0xd8, 0xc2, 0x6c, 0x1f, 0x26, 0xc1, 0xa2, 0xe1, 0x12,
-	0xcc, 0xa9, 0xeb, 0x9f, 0x12, 0x34, 0x15, 0x61, 0x1d, 0xc0, 0x60, 0x03, 0x0d, 0x31, 0x5a, 0x91,
-	0xcb, 0xc9, 0x07, 0x20, 0xe2, 0x1d, 0x00, 0x74, 0x36, 0x80, 0xf7, 0x0d, 0xf7, 0x23, 0xd1, 0x70,
-	0x22, 0x72, 0x3f, 0x12, 0x8d, 0x24, 0xe6, 0x95, 0xbf, 0xe7, 0x40, 0xbc, 0x44, 0x1d, 0xee, 0x62,
-	0x83, 0x8b, 0x44, 0xdf, 0x03, 0x8b, 0x22, 0x51, 0xcb, 0x14, 0x69, 0x46, 0x8a, 0xe0, 0x70, 0x94,
-	0x5d, 0x10, 0x75, 0x94, 0xd1, 0x82, 0x67, 0xd2, 0xcc, 0x77, 0x24, 0xbc, 0x02, 0xe6, 0xb1, 0xd9,
-	0xb6, 0x1c, 0x31, 0xa7, 0x18, 0xf2, 0x05, 0x4f, 0x6b, 0xe3, 0x26, 0xb1, 0xc5, 0x0c, 0x62, 0xc8,
-	0x17, 0xe0, 0x9d, 0x09, 0x0b, 0x31, 0x27, 0x15, 0x5d, 0x79, 0x43, 0x45, 0x4d, 0x46, 0xed, 0x2e,
-	0x27, 0x8d, 0x5e, 0x8d, 0x32, 0x8b, 0x5b, 0xd4, 0x41, 0x53, 0x10, 0xbc, 0x01, 0x96, 0xac, 0xa6,
-	0xa1, 0x77, 0xa8, 0xcb, 0xbd, 0x74, 0x17, 0xc4, 0x82, 0x9d, 0x39, 0x1c, 0x65, 0x63, 0x5a, 0xb1,
-	0x54, 0xa3, 0x2e, 0xd7, 0xca, 0x28, 0x66, 0x35, 0x0d, 0xf1, 0x6a, 0xc2, 0x6d, 0x10, 0x23, 0x3d,
-	0x4e, 0x1c, 0x71, 0x8a, 0x17, 0x45, 0xc0, 0x95, 0x9c, 0x7f, 0xff, 0xe4, 0xa6, 0xf7, 0x4f, 0xae,
-	0xe0, 0xf4, 0x8b, 0xa9, 0x67, 0xbf, 0xdc, 0x58, 0x0d, 0x36, 0x45, 0x9d, 0xc2, 0xd0, 0x8c, 0x01,
-	0xde, 0x06, 0x0b, 0x8c, 0x63, 0xde, 0x65, 0xc9, 0xa8, 0xd8, 0x08, 0xf9, 0xf5, 0xe4, 0xa7, 0x1c,
-	0x75, 0xe1, 0x87, 0x26, 0xfe, 0x9b, 0x91, 0x3f, 0xbd, 0x35, 0xff, 0x47, 0x02, 0xc9, 0xa9, 0x83,
-	0xd7, 0xde, 0x2d, 0x8b, 0x71, 0xea, 0xf6, 0x55, 0x87, 0xbb, 0x7d, 0x58, 0x03, 0x31, 0xda, 0x21,
-	0x2e, 0xe6, 0xb3, 0xbb, 0x68, 0xe3, 0xed, 0xfc, 0x01, 0x78, 0x75, 0x8a, 0xf2, 0xf6, 0x10, 0xcd,
-	0x48, 0x82, 0x73, 0x9d, 0x7b, 0xeb, 0x5c, 0xef, 0x80, 0xc5, 0x6e, 0xc7, 0x14, 0x13, 0x09, 0xff,
-	0x97, 0x89, 0x4c, 0x40, 0x70, 0x0d, 0x84, 0xdb, 0xac, 0x25, 0xa6, 0x1c, 0x2f, 0x9e, 0x7f, 0x39,
-	0xca, 0x42, 0x84, 0x1f, 0x4f, 0xb3, 0xdc, 0x26, 0x8c, 0xe1, 0x16, 0x41, 0x9e, 0x8b, 0x82, 0x00,
-	0x7c, 0x9d, 0x08, 0x5e, 0x06, 0xf1, 0xa6, 0x4d, 0x8d, 0x47, 0xfa, 0x3e, 0xb1, 0x5a, 0xfb, 0xdc,
-	0x3f, 0x81, 0x68, 0x49, 0xe8, 0xb6, 0x84, 0x0a, 0xa6, 0x40, 0x94, 0xf7, 0x74, 0xcb, 0x31, 0x49,
-	0xcf, 0x2f, 0x04, 0x2d, 0xf2, 0x9e, 0xe6, 0x89, 0x0a, 0x06, 0xf3, 0xdb, 0xd4, 0x24, 0x36, 0x2c,
-	0x82, 0xf0, 0x23, 0xd2, 0xf7, 0xd7, 0xac, 0x78, 0xf3, 0xe5, 0x28, 0xbb, 0xde, 0xb2, 0xf8, 0x7e,
-	0xb7, 0x99, 0x33, 0x68, 0x3b, 0x6f, 0x5b, 0x0e, 0xc9, 0x53, 0xe6, 0xa5, 0x44, 0x9d, 0xbc, 0x6d,
-	0x35, 0x59, 0xbe, 0xd9, 0xe7, 0x84, 0xe5, 0xb6, 0x48, 0xaf, 0xe8, 0xbd, 0x20, 0x0f, 0xec, 0x1d,
-	0x59, 0xff, 0x5b, 0x33, 0x27, 0x96, 0xd5, 0x17, 0xae, 0xfd, 0x25, 0x01, 0x30, 0xbb, 0xe7, 0xe0,
-	0xc7, 0xe0, 0x42, 0xa1, 0x54, 0x52, 0xeb, 0x75, 0xbd, 0xb1, 0x5b, 0x53, 0xf5, 0x9d, 0x4a, 0xbd,
-	0xa6, 0x96, 0xb4, 0xbb, 0x9a, 0x5a, 0x4e, 0x84, 0xd2, 0xa9, 0xc1, 0x50, 0x5e, 0x9d, 0x39, 0xef,
-	0x38, 0xac, 0x43, 0x0c, 0x6b, 0xcf, 0x22, 0x26, 0x5c, 0x07, 0x30, 0x88, 0xab, 0x54, 0x8b, 0xd5,
-	0xf2, 0x6e, 0x42, 0x4a, 0xaf, 0x0c, 0x86, 0x72, 0x62, 0x06, 0xa9, 0xd0, 0x26, 0x35, 0xfb, 0xf0,
-	0x13, 0x90, 0x0c, 0x7a, 0x57, 0x2b, 0x9f, 0xef, 0xea, 0x85, 0x72, 0x19, 0xa9, 0xf5, 0x7a, 0x62,
-	0xee, 0x78, 0x98, 0xaa, 0x63, 0xf7, 0x0b, 0xfe, 0xf7, 0x04, 0x6e, 0x80, 0xd5, 0x20, 0x50, 0x7d,
-	0xa0, 0xa2, 0x5d, 0x11, 0x29, 0x9c, 0xbe, 0x30, 0x18, 0xca, 0xe7, 0x66, 0x28, 0xf5, 0x80, 0xb8,
-	0x7d, 0x2f, 0x58, 0x3a, 0xfa, 0xd5, 0x8f, 0x99, 0xd0, 0x93, 0x9f, 0x32, 0xa1, 0x6b, 0xcf, 0x24,
-	0xb0, 0x7c, 0xf4, 0x04, 0xc3, 0x3b, 0xe0, 0x62, 0xa9, 0x5a, 0x69, 0xa0, 0x42, 0xa9, 0xa1, 0xd7,
-	0x1b, 0x85, 0xc6, 0x4e, 0xfd, 0x58, 0xcd, 0xff, 0x1f, 0x0c, 0xe5, 0xd4, 0x51, 0x50, 0xb0, 0xee,
-	0x8f, 0xc0, 0xf9, 0xe3, 0xf8, 0x42, 0xa9, 0xa1, 0x3d, 0x50, 0x13, 0x52, 0x3a, 0x39, 0x18, 0xca,
-	0x2b, 0x47, 0xa1, 0x05, 0x83, 0x5b, 0x07, 0x04, 0xde, 0x06, 0xc9, 0xe3, 0x28, 0xad, 0x32, 0xc1,
-	0xcd, 0xa5, 0xd3, 0x83, 0xa1, 0x7c, 0xfe, 0x28, 0x4e, 0x73, 0xb0, 0x40, 0x06, 0x8a, 0xf9, 0x39,
-	0x0c, 0xe4, 0x93, 0xd6, 0x05, 0x12, 0x70, 0xf3, 0x55, 0xa0, 0x52, 0xb5, 0xac, 0xea, 0x5b, 0x5a,
-	0xbd, 0x51, 0x45, 0xbb, 0x7a, 0xb5, 0xa6, 0xa2, 0x42, 0x43, 0xab, 0x56, 0xde, 0x34, 0xe7, 0xfc,
-	0x60, 0x28, 0x5f, 0x3f, 0x89, 0x3b, 0xd8, 0x85, 0x2f, 0xc0, 0xd5, 0x53, 0x85, 0xd1, 0x2a, 0x5a,
-	0x23, 0x21, 0xa5, 0xd7, 0x06, 0x43, 0xf9, 0xca, 0x49, 0xfc, 0x9a, 0x63, 0x71, 0xf8, 0x10, 0xac,
-	0x9f, 0x8a, 0x78, 0x5b, 0xbb, 0x87, 0x0a, 0x0d, 0xaf, 0x79, 0xd7, 0x07, 0x43, 0xf9, 0x83, 0x93,
-	0xb8, 0xb7, 0xad, 0x96, 0x8b, 0x39, 0x39, 0x35, 0xfd, 0x3d, 0xb5, 0xa2, 0xd6, 0xb5, 0x7a, 0x22,
-	0x7c, 0x3a, 0xfa, 0x7b, 0xc4, 0x21, 0xcc, 0x62, 0xe9, 0x88, 0x37, 0xac, 0xe2, 0xdd, 0xa7, 0x7f,
-	0x64, 0x42, 0x4f, 0x0e, 0x33, 0xd2, 0xd3, 0xc3, 0x8c, 0xf4, 0xfc, 0x30, 0x23, 0xfd, 0x7e, 0x98,
-	0x91, 0xbe, 0x79, 0x91, 0x09, 0x3d, 0x7f, 0x91, 0x09, 0xfd, 0xf6, 0x22, 0x13, 0xfa, 0xf2, 0xca,
-	0xf1, 0x65, 0xb6, 0x9b, 0xed, 0x1b, 0xcc, 0x7c, 0x94, 0xef, 0xf9, 0x7f, 0xa9, 0xe2, 0x17, 0xb5,
-	0xb9, 0x20, 0xae, 0xf4, 0x0f, 0xff, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x81, 0x13, 0x00, 0x98, 0xc3,
-	0x0a, 0x00, 0x00,
+	// 1633 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x57, 0x4f, 0x6f, 0x1b, 0xc7,
+	0x15, 0xe7, 0x8a, 0x94, 0x44, 0x8e, 0x28, 0x85, 0x99, 0x48, 0x36, 0xc5, 0xd8, 0x24, 0xb3, 0x75,
+	0x51, 0xd9, 0xb1, 0xc9, 0x44, 0x2d, 0xda, 0xd4, 0x40, 0x0c, 0xf0, 0xcf, 0xc6, 0xda, 0xc0, 0x22,
+	0xd9, 0x21, 0x9d, 0x42, 0x2d, 0x82, 0xc5, 0x70, 0x77, 0x44, 0x2e, 0xbc, 0xbb, 0x43, 0xec, 0x2c,
+	0x15, 0xf2, 0x1b, 0x14, 0x04, 0x0a, 0xf4, 0xd6, 0x5e, 0x08, 0x14, 0x6d, 0x51, 0xe4, 0x03, 0xf4,
+	0x2b, 0x14, 0x30, 0x72, 0xca, 0xa5, 0x40, 0x4f, 0x44, 0x2b, 0x5f, 0xda, 0xab, 0x2e, 0x05, 0x52,
+	0xa0, 0x28, 0x66, 0x66, 0x97, 0x5c, 0xcb, 0x76, 0xa4, 0xf6, 0x22, 0xec, 0x7b, 0xf3, 0x7e, 0xbf,
+	0xf7, 0xde, 0xbc, 0x3f, 0x23, 0x82, 0x5b, 0x26, 0x65, 0xee, 0x17, 0x98, 0xb9, 0x55, 0xf1, 0xe7,
+	0xec, 0xc3, 0x6a, 0x30, 0x1d, 0x11, 0x56, 0x19, 0xf9, 0x34, 0xa0, 0x30, 0x17, 0x9d, 0x56, 0xc4,
+	0x9f, 0xb3, 0x0f, 0x0b, 0xfb, 0x5c, 0x43, 0x99, 0x21, 0xce, 0xab, 0x52, 0x90, 0xc6, 0x85, 0xdd,
+	0x01, 0x1d, 0x50, 0xa9, 0xe7, 0x5f, 0xa1, 0x76, 0x7f, 0x40, 0xe9, 0xc0, 0x21, 0x55, 0x21, 0xf5,
+	0xc7, 0xa7, 0x55, 0xec, 0x4d, 0xe5, 0x91, 0xfa, 0x39, 0x78, 0xab, 0x66, 0x9a, 0x84, 0xb1, 0xde,
+	0x74, 0x44, 0x3a, 0xd8, 0xc7, 0x2e, 0x6c, 0x82, 0xf5, 0x33, 0xec, 0x8c, 0x49, 0x5e, 0x29, 0x2b,
+	0x07, 0x3b, 0x87, 0xb7, 0x2a, 0x97, 0x03, 0xa8, 0xac, 0x10, 0xf5, 0xdc, 0xc5, 0xa2, 0x94, 0x9d,
+	0x62, 0xd7, 0x79, 0xa8, 0x0a, 0x90, 0x8a, 0x24, 0xf8, 0x61, 0xea, 0x37, 0xbf, 0x2d, 0x29, 0xea,
+	0xaf, 0x15, 0x90, 0x95, 0xd6, 0x0d, 0xea, 0x9d, 0xda, 0x03, 0xd8, 0x05, 0x60, 0x44, 0x7c, 0xd7,
+	0x66, 0xcc, 0xa6, 0xde, 0xb5, 0x3c, 0xec, 0x5d, 0x2c, 0x4a, 0x6f, 0x4b, 0x0f, 0x2b, 0xa4, 0x8a,
+	0x62, 0x34, 0xf0, 0x3e, 0xd8, 0xc4, 0x96, 0xe5, 0x13, 0xc6, 0xf2, 0x6b, 0x65, 0xe5, 0x20, 0x53,
+	0x87, 0x17, 0x8b, 0xd2, 0x8e, 0xc4, 0x84, 0x07, 0x2a, 0x8a, 0x4c, 0xc2, 0xc8, 0xfe, 0x9c, 0x04,
+	0x1b, 0x22, 0x5f, 0x06, 0x29, 0x80, 0x26, 0xb5, 0x88, 0x31, 0x1e, 0x39, 0x14, 0x5b, 0x06, 0x16,
+	0xbe, 0x45, 0x6c, 0x5b, 0x87, 0xc5, 0x37, 0xc5, 0x26, 0xf3, 0xa9, 0xbf, 0xf7, 0x7c, 0x51, 0x4a,
+	0x5c, 0x2c, 0x4a, 0xfb, 0xd2, 0xdb, 0xab, 0x3c, 0x2a, 0xca, 0x71, 0xe5, 0x53, 0xa1, 0x93, 0x50,
+	0xf8, 0x4b, 0x05, 0x14, 0x6d, 0x8f, 0x05, 0xd8, 0x0b, 0x6c, 0x1c, 0x10, 0xc3, 0x22, 0xa7, 0x78,
+	0xec, 0x04, 0x46, 0xec, 0x66, 0xd6, 0xae, 0x71, 0x33, 0x77, 0x2f, 0x16, 0xa5, 0xef, 0x4a, 0xbf,
+	0xdf, 0xce, 0xa6, 0xa2, 0x5b, 0x31, 0x83, 0xa6, 0x3c, 0xef, 0xac, 0xee, 0xef, 0xc7, 0x60, 0x67,
+	0x80, 0x99, 0xe1, 0x8e, 0x9d, 0xc0, 0x1e, 0x39, 0x36, 0xf1, 0xf3, 0xc9, 0xb2, 0x72, 0x90, 0x8a,
+	0x5f, 0xa3, 0x8b, 0x27, 0xc6, 0x00, 0x33, 0x15, 0x6d, 0x0f, 0x30, 0x3b, 0x5e, 0x1a, 0xc2, 0x8f,
+	0xc1, 0xb6, 0xa4, 0x36, 0x89, 0x61, 0x52, 0x16, 0xe4, 0x53, 0x02, 0x99, 0xbf, 0x58, 0x94, 0x76,
+	0xe3, 0xa1, 0x85, 0xc7, 0x2a, 0xca, 0x46, 0x72, 0x83, 0xb2, 0x00, 0x3e, 0x04, 0x59, 0x93, 0xba,
+	0x23, 0xdb, 0x09, 0xd1, 0xeb, 0x02, 0x7d, 0xf3, 0x62, 0x51, 0x7a, 0x27, 0xba, 0xd0, 0xd5, 0xa9,
+	0x8a, 0xb6, 0x42, 0x91, 0x63, 0x45, 0x1d, 0x13, 0xea, 0xef, 0x14, 0x90, 0x6e, 0x50, 0x8b, 0xe8,
+	0xde, 0x29, 0x85, 0xef, 0x82, 0x8c, 0xa8, 0xc0, 0x10, 0xb3, 0xa1, 0x28, 0x60, 0x16, 0xa5, 0xb9,
+	0xe2, 0x08, 0xb3, 0x21, 0xcc, 0x83, 0x4d, 0xd3, 0x27, 0x38, 0xa0, 0xbe, 0xec, 0x12, 0x14, 0x89,
+	0xb0, 0x0b, 0x60, 0xfc, 0x02, 0x4d, 0x51, 0xda, 0xfc, 0xfa, 0xb5, 0x1a, 0x20, 0xc5, 0x1b, 0x00,
+	0xbd, 0x1d, 0xc3, 0xcb, 0x83, 0x4f, 0x53, 0xe9, 0x64, 0x2e, 0xf5, 0x69, 0x2a, 0x9d, 0xca, 0xad,
+	0xab, 0xff, 0x5a, 0x03, 0xd9, 0x06, 0xf5, 0x02, 0x1f, 0x9b, 0x81, 0x08, 0xf4, 0x3b, 0x60, 0x53,
+	0x04, 0x6a, 0x5b, 0x22, 0xcc, 0x54, 0x1d, 0x9c, 0x2f, 0x4a, 0x1b, 0x22, 0x8f, 0x26, 0xda, 0xe0,
+	0x47, 0xba, 0xf5, 0x2d, 0x01, 0xef, 0x82, 0x75, 0x6c, 0xb9, 0xb6, 0x27, 0xea, 0x94, 0x41, 0x52,
+	0xe0, 0x5a, 0x07, 0xf7, 0x89, 0x23, 0x6a, 0x90, 0x41, 0x52, 0x80, 0x8f, 0x42, 0x16, 0x62, 0x85,
+	0x19, 0xdd, 0x79, 0x4d, 0x46, 0x7d, 0x46, 0x9d, 0x71, 0x40, 0x7a, 0x93, 0x0e, 0x65, 0x76, 0x60,
+	0x53, 0x0f, 0x45, 0x20, 0xf8, 0x00, 0x6c, 0xd9, 0x7d, 0xd3, 0x18, 0x51, 0x3f, 0xe0, 0xe1, 0x6e,
+	0x88, 0x01, 0xdb, 0x3e, 0x5f, 0x94, 0x32, 0x7a, 0xbd, 0xd1, 0xa1, 0x7e, 0xa0, 0x37, 0x51, 0xc6,
+	0xee, 0x9b, 0xe2, 0xd3, 0x82, 0xc7, 0x20, 0x43, 0x26, 0x01, 0xf1, 0x44, 0x17, 0x6f, 0x0a, 0x87,
+	0xbb, 0x15, 0xb9, 0x7f, 0x2a, 0xd1, 0xfe, 0xa9, 0xd4, 0xbc, 0x69, 0x7d, 0xff, 0xab, 0x3f, 0x3d,
+	0xd8, 0x8b, 0x5f, 0x8a, 0x16, 0xc1, 0xd0, 0x8a, 0x01, 0x7e, 0x04, 0x36, 0x58, 0x80, 0x83, 0x31,
+	0xcb, 0xa7, 0xc5, 0x44, 0x94, 0x5f, 0x0d, 0x3e, 0xe2, 0xe8, 0x0a, 0x3b, 0x14, 0xda, 0x3f, 0x4c,
+	0xfd, 0x83, 0x8f, 0xf9, 0xbf, 0x15, 0x90, 0x8f, 0x0c, 0xf8, 0xf5, 0x1e, 0xd9, 0x2c, 0xa0, 0xfe,
+	0x54, 0xf3, 0x02, 0x7f, 0x0a, 0x3b, 0x20, 0x43, 0x47, 0xc4, 0xc7, 0xc1, 0x6a, 0x17, 0x1d, 0xbe,
+	0x99, 0x3f, 0x06, 0x6f, 0x47, 0x28, 0x3e, 0x87, 0x68, 0x45, 0x12, 0xaf, 0xeb, 0xda, 0x1b, 0xeb,
+	0xfa, 0x08, 0x6c, 0x8e, 0x47, 0x96, 0xa8, 0x48, 0xf2, 0x7f, 0xa9, 0x48, 0x08, 0x82, 0x07, 0x20,
+	0xe9, 0xb2, 0x81, 0xa8, 0x72, 0xb6, 0x7e, 0xe3, 0x9b, 0x45, 0x09, 0x22, 0xfc, 0x45, 0x14, 0xe5,
+	0x31, 0x61, 0x0c, 0x0f, 0x08, 0xe2, 0x26, 0x2a, 0x02, 0xf0, 0x55, 0x22, 0xf8, 0x1e, 0xc8, 0xf6,
+	0x1d, 0x6a, 0x3e, 0x33, 0x86, 0xc4, 0x1e, 0x0c, 0x03, 0xd9, 0x81, 0x68, 0x4b, 0xe8, 0x8e, 0x84,
+	0x0a, 0xee, 0x83, 0x74, 0x30, 0x31, 0x6c, 0xcf, 0x22, 0x13, 0x99, 0x08, 0xda, 0x0c, 0x26, 0x3a,
+	0x17, 0x55, 0x0c, 0xd6, 0x8f, 0xa9, 0x45, 0x1c, 0x58, 0x07, 0xc9, 0x67, 0x64, 0x2a, 0xc7, 0xac,
+	0xfe, 0xc1, 0x37, 0x8b, 0xd2, 0xfd, 0x81, 0x1d, 0x0c, 0xc7, 0xfd, 0x8a, 0x49, 0xdd, 0xaa, 0x63,
+	0x7b, 0xa4, 0x4a, 0x19, 0x0f, 0x89, 0x7a, 0x55, 0xc7, 0xee, 0xb3, 0x6a, 0x7f, 0x1a, 0x10, 0x56,
+	0x39, 0x22, 0x93, 0x3a, 0xff, 0x40, 0x1c, 0xcc, 0x5b, 0x56, 0xbe, 0x35, 0x6b, 0x62, 0x58, 0xa5,
+	0xa0, 0xfe, 0x25, 0x09, 0x76, 0xa2, 0x7c, 0xb4, 0x09, 0xef, 0x3c, 0xf8, 0x71, 0x38, 0xd9, 0xb6,
+	0x77, 0x4a, 0xc3, 0xd5, 0x5c, 0x78, 0x5d, 0xa9, 0xe4, 0x22, 0x08, 0xa7, 0x32, 0x6d, 0x86, 0x32,
+	0xbc, 0x0d, 0x80, 0x80, 0x8b, 0x18, 0x42, 0x67, 0x82, 0x50, 0xc4, 0x02, 0xef, 0x82, 0x9c, 0x19,
+	0xfa, 0x33, 0xa2, 0x97, 0x44, 0x8e, 0xd6, 0x5b, 0x91, 0xbe, 0x26, 0xd5, 0x50, 0x07, 0xdb, 0x4b,
+	0x53, 0x11, 0x4c, 0xea, 0x4d, 0x6b, 0x22, 0xde, 0xdb, 0x61, 0x40, 0x59, 0x33, 0xa6, 0x83, 0x3f,
+	0x8f, 0x79, 0x1d, 0xca, 0xc6, 0xca, 0xaf, 0x97, 0x93, 0x07, 0x5b, 0x87, 0xf7, 0xae, 0xd5, 0x85,
+	0xa2, 0x89, 0x43, 0xe6, 0x65, 0x9c, 0xe1, 0x19, 0x6c, 0x82, 0x9d, 0x25, 0x39, 0x9f, 0x08, 0x92,
+	0xdf, 0x10, 0xd4, 0x37, 0x5f, 0xa5, 0x16, 0xe5, 0x0c, 0x79, 0xb6, 0xcd, 0xd8, 0x34, 0x11, 0xf8,
+	0x04, 0xa4, 0xcd, 0x21, 0x31, 0x9f, 0xb1, 0xb1, 0x9b, 0xdf, 0xfc, 0x3f, 0x0b, 0xbd, 0x64, 0x50,
+	0x19, 0xd8, 0x8e, 0xd2, 0xf8, 0xc9, 0x98, 0x06, 0x18, 0xfe, 0x08, 0xe4, 0xf9, 0xc3, 0x42, 0x26,
+	0xc4, 0x1c, 0xf3, 0xd6, 0x64, 0xfc, 0xd1, 0x32, 0x44, 0x23, 0x86, 0x5d, 0xb9, 0xe7, 0xe2, 0x89,
+	0xb6, 0x3c, 0xee, 0x10, 0xbf, 0xce, 0x0f, 0xe1, 0x5d, 0xf0, 0x76, 0xf8, 0x22, 0xc5, 0x10, 0xb2,
+	0x51, 0x77, 0x5c, 0x3c, 0x79, 0x8c, 0x97, 0xa6, 0xea, 0x7f, 0x14, 0x90, 0xee, 0x9a, 0x43, 0x62,
+	0x8d, 0x1d, 0x02, 0x0b, 0x20, 0x1d, 0x25, 0x28, 0x1c, 0x64, 0xd0, 0x52, 0x86, 0x10, 0xa4, 0x3c,
+	0xec, 0x92, 0x70, 0xd7, 0x8a, 0xef, 0x68, 0xd4, 0x92, 0x57, 0x8e, 0x1a, 0x67, 0xb6, 0xbd, 0x80,
+	0xf8, 0x67, 0x58, 0xee, 0xdf, 0x14, 0x5a, 0xca, 0xfc, 0x59, 0xe2, 0x91, 0x3a, 0xb6, 0x6b, 0x87,
+	0x4f, 0x1c, 0x4a, 0x0f, 0x30, 0x7b, 0xc2, 0x65, 0x58, 0x02, 0x5b, 0x1e, 0x99, 0x04, 0xd1, 0x30,
+	0xf2, 0xfd, 0x9a, 0x44, 0x80, 0xab, 0xc2, 0x59, 0x2c, 0x81, 0x2d, 0x07, 0xb3, 0xa5, 0xc1, 0xa6,
+	0x34, 0xe0, 0xaa, 0xd0, 0xe0, 0x36, 0x10, 0x92, 0x41, 0x7c, 0x9f, 0xfa, 0x62, 0x4f, 0x66, 0x50,
+	0x86, 0x6b, 0x34, 0xae, 0xb8, 0xf7, 0x4f, 0x05, 0x80, 0xd5, 0x7f, 0x0d, 0xf0, 0x87, 0xe0, 0x66,
+	0xad, 0xd1, 0xd0, 0xba, 0x5d, 0xa3, 0x77, 0xd2, 0xd1, 0x8c, 0xa7, 0xad, 0x6e, 0x47, 0x6b, 0xe8,
+	0x9f, 0xe8, 0x5a, 0x33, 0x97, 0x28, 0xec, 0xcf, 0xe6, 0xe5, 0xbd, 0x95, 0xf1, 0x53, 0x8f, 0x8d,
+	0x88, 0x69, 0x9f, 0xda, 0xc4, 0x82, 0xf7, 0x01, 0x8c, 0xe3, 0x5a, 0xed, 0x7a, 0xbb, 0x79, 0x92,
+	0x53, 0x0a, 0xbb, 0xb3, 0x79, 0x39, 0xb7, 0x82, 0xb4, 0x68, 0x9f, 0x5a, 0x53, 0x5e, 0xd9, 0xb8,
+	0x75, 0xbb, 0xf5, 0xe4, 0xc4, 0xa8, 0x35, 0x9b, 0x48, 0xeb, 0x76, 0x73, 0x6b, 0x97, 0xdd, 0xb4,
+	0x3d, 0x67, 0x1a, 0xcd, 0xd7, 0x21, 0xd8, 0x8b, 0x03, 0xb5, 0xcf, 0x34, 0x74, 0x22, 0x3c, 0x25,
+	0x0b, 0x37, 0x67, 0xf3, 0xf2, 0x3b, 0x2b, 0x94, 0x76, 0x46, 0xfc, 0x29, 0x77, 0x56, 0x48, 0xff,
+	0xe2, 0xf7, 0xc5, 0xc4, 0x97, 0x7f, 0x28, 0x26, 0xee, 0x7d, 0xa5, 0xac, 0x36, 0x87, 0x7c, 0x0f,
+	0xe0, 0x23, 0xf0, 0x6e, 0xa3, 0xdd, 0xea, 0xa1, 0x5a, 0xa3, 0x67, 0x74, 0x7b, 0xb5, 0xde, 0xd3,
+	0xee, 0xa5, 0x9c, 0x6f, 0xcf, 0xe6, 0xe5, 0xfd, 0x97, 0x41, 0xf1, 0xbc, 0x7f, 0x00, 0x6e, 0x5c,
+	0xc6, 0xd7, 0x1a, 0x3d, 0xfd, 0x33, 0x2d, 0xa7, 0x14, 0xf2, 0xb3, 0x79, 0x79, 0xf7, 0x65, 0x68,
+	0xcd, 0x0c, 0xec, 0x33, 0x02, 0x3f, 0x02, 0xf9, 0xcb, 0x28, 0xbd, 0x15, 0xe2, 0xd6, 0x0a, 0x85,
+	0xd9, 0xbc, 0x7c, 0xe3, 0x65, 0x9c, 0xee, 0x61, 0x81, 0x8c, 0x25, 0xf3, 0xc7, 0x24, 0x28, 0x5f,
+	0xf5, 0xf8, 0x40, 0x02, 0x3e, 0x58, 0x3a, 0x6a, 0xb4, 0x9b, 0x9a, 0x71, 0xa4, 0x77, 0x7b, 0x6d,
+	0x74, 0x62, 0xb4, 0x3b, 0x1a, 0xaa, 0xf5, 0xf4, 0x76, 0xeb, 0x75, 0x75, 0xae, 0xce, 0xe6, 0xe5,
+	0xf7, 0xaf, 0xe2, 0x8e, 0xdf, 0xc2, 0x4f, 0xc1, 0xdd, 0x6b, 0xb9, 0xd1, 0x5b, 0x7a, 0x2f, 0xa7,
+	0x14, 0x0e, 0x66, 0xf3, 0xf2, 0x9d, 0xab, 0xf8, 0x75, 0xcf, 0x0e, 0xe0, 0xe7, 0xe0, 0xfe, 0xb5,
+	0x88, 0x8f, 0xf5, 0xc7, 0xa8, 0xd6, 0xe3, 0x97, 0xf7, 0xfe, 0x6c, 0x5e, 0xfe, 0xde, 0x55, 0xdc,
+	0xc7, 0xf6, 0xc0, 0xe7, 0x0b, 0xec, 0xba, 0xf4, 0x8f, 0xb5, 0x96, 0xd6, 0xd5, 0xbb, 0xb9, 0xe4,
+	0xf5, 0xe8, 0x1f, 0x13, 0x8f, 0x30, 0x9b, 0x15, 0x52, 0xbc, 0x58, 0xf5, 0x4f, 0x9e, 0xff, 0xbd,
+	0x98, 0xf8, 0xf2, 0xbc, 0xa8, 0x3c, 0x3f, 0x2f, 0x2a, 0x5f, 0x9f, 0x17, 0x95, 0xbf, 0x9d, 0x17,
+	0x95, 0x5f, 0xbd, 0x28, 0x26, 0xbe, 0x7e, 0x51, 0x4c, 0xfc, 0xf5, 0x45, 0x31, 0xf1, 0xb3, 0x3b,
+	0x97, 0x37, 0xa6, 0xd3, 0x77, 0x1f, 0x30, 0xeb, 0x59, 0x75, 0x22, 0x7f, 0xf3, 0x89, 0x1f, 0x7c,
+	0xfd, 0x0d, 0xf1, 0x0f, 0xd2, 0xf7, 0xff, 0x3b, 0x00, 0x6e, 0x55, 0x9d, 0xc3, 0x11, 0x0e, 0x00,
+	0x00,
 }
 
 func (this *AccessTypeParam) Equal(that interface{}) bool {
@@ -818,6 +994,130 @@ func (this *Model) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *ContractExport) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ContractExport)
+	if !ok {
+		that2, ok := that.(ContractExport)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.CodeInfo.Equal(&that1.CodeInfo) {
+		return false
+	}
+	if !bytes.Equal(this.CodeBytes, that1.CodeBytes) {
+		return false
+	}
+	if this.ContractAddress != that1.ContractAddress {
+		return false
+	}
+	if !this.ContractInfo.Equal(&that1.ContractInfo) {
+		return false
+	}
+	if len(this.ContractHistory) != len(that1.ContractHistory) {
+		return false
+	}
+	for i := range this.ContractHistory {
+		if !this.ContractHistory[i].Equal(&that1.ContractHistory[i]) {
+			return false
+		}
+	}
+	if len(this.ContractState) != len(that1.ContractState) {
+		return false
+	}
+	for i := range this.ContractState {
+		if !this.ContractState[i].Equal(&that1.ContractState[i]) {
+			return false
+		}
+	}
+	if !bytes.Equal(this.Checksum, that1.Checksum) {
+		return false
+	}
+	return true
+}
+func (this *ContractQuota) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ContractQuota)
+	if !ok {
+		that2, ok := that.(ContractQuota)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MaxExecutionsPerBlock != that1.MaxExecutionsPerBlock {
+		return false
+	}
+	if this.MaxGasPerBlock != that1.MaxGasPerBlock {
+		return false
+	}
+	return true
+}
+func (this *Schedule) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Schedule)
+	if !ok {
+		that2, ok := that.(Schedule)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Contract != that1.Contract {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if !bytes.Equal(this.Msg, that1.Msg) {
+		return false
+	}
+	if this.Interval != that1.Interval {
+		return false
+	}
+	if this.GasLimit != that1.GasLimit {
+		return false
+	}
+	if this.NextHeight != that1.NextHeight {
+		return false
+	}
+	if this.LastHeight != that1.LastHeight {
+		return false
+	}
+	if this.LastError != that1.LastError {
+		return false
+	}
+	return true
+}
 func (m *AccessTypeParam) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1188,78 +1488,274 @@ func (m *Model) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTypes(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *ContractExport) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *AccessTypeParam) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Value != 0 {
-		n += 1 + sovTypes(uint64(m.Value))
-	}
-	return n
+
+func (m *ContractExport) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AccessConfig) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ContractExport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Permission != 0 {
-		n += 1 + sovTypes(uint64(m.Permission))
+	if len(m.Checksum) > 0 {
+		i -= len(m.Checksum)
+		copy(dAtA[i:], m.Checksum)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Checksum)))
+		i--
+		dAtA[i] = 0x3a
 	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if len(m.ContractState) > 0 {
+		for iNdEx := len(m.ContractState) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ContractState[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
 	}
-	return n
-}
-
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.ContractHistory) > 0 {
+		for iNdEx := len(m.ContractHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ContractHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
 	}
-	var l int
-	_ = l
-	l = m.CodeUploadAccess.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	if m.InstantiateDefaultPermission != 0 {
-		n += 1 + sovTypes(uint64(m.InstantiateDefaultPermission))
+	{
+		size, err := m.ContractInfo.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
 	}
-	if m.GasMultiplier != 0 {
-		n += 1 + sovTypes(uint64(m.GasMultiplier))
+	i--
+	dAtA[i] = 0x22
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.InstanceCost != 0 {
-		n += 1 + sovTypes(uint64(m.InstanceCost))
+	if len(m.CodeBytes) > 0 {
+		i -= len(m.CodeBytes)
+		copy(dAtA[i:], m.CodeBytes)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.CodeBytes)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.CompileCost != 0 {
-		n += 1 + sovTypes(uint64(m.CompileCost))
+	{
+		size, err := m.CodeInfo.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *CodeInfo) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ContractQuota) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractQuota) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractQuota) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.CodeHash)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
+	if m.MaxGasPerBlock != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxGasPerBlock))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MaxExecutionsPerBlock != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxExecutionsPerBlock))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Schedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Schedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Schedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LastError) > 0 {
+		i -= len(m.LastError)
+		copy(dAtA[i:], m.LastError)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.LastError)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.LastHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.LastHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.NextHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.NextHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.GasLimit != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Interval != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Interval))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTypes(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *AccessTypeParam) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Value != 0 {
+		n += 1 + sovTypes(uint64(m.Value))
+	}
+	return n
+}
+
+func (m *AccessConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Permission != 0 {
+		n += 1 + sovTypes(uint64(m.Permission))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CodeUploadAccess.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.InstantiateDefaultPermission != 0 {
+		n += 1 + sovTypes(uint64(m.InstantiateDefaultPermission))
+	}
+	if m.GasMultiplier != 0 {
+		n += 1 + sovTypes(uint64(m.GasMultiplier))
+	}
+	if m.InstanceCost != 0 {
+		n += 1 + sovTypes(uint64(m.InstanceCost))
+	}
+	if m.CompileCost != 0 {
+		n += 1 + sovTypes(uint64(m.CompileCost))
+	}
+	return n
+}
+
+func (m *CodeInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CodeHash)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
 	l = len(m.Creator)
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
@@ -1363,6 +1859,95 @@ func (m *Model) Size() (n int) {
 	return n
 }
 
+func (m *ContractExport) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CodeInfo.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = len(m.CodeBytes)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.ContractInfo.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if len(m.ContractHistory) > 0 {
+		for _, e := range m.ContractHistory {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.ContractState) > 0 {
+		for _, e := range m.ContractState {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	l = len(m.Checksum)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *ContractQuota) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxExecutionsPerBlock != 0 {
+		n += 1 + sovTypes(uint64(m.MaxExecutionsPerBlock))
+	}
+	if m.MaxGasPerBlock != 0 {
+		n += 1 + sovTypes(uint64(m.MaxGasPerBlock))
+	}
+	return n
+}
+
+func (m *Schedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Interval != 0 {
+		n += 1 + sovTypes(uint64(m.Interval))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovTypes(uint64(m.GasLimit))
+	}
+	if m.NextHeight != 0 {
+		n += 1 + sovTypes(uint64(m.NextHeight))
+	}
+	if m.LastHeight != 0 {
+		n += 1 + sovTypes(uint64(m.LastHeight))
+	}
+	l = len(m.LastError)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
 func sovTypes(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2499,6 +3084,634 @@ func (m *Model) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ContractExport) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractExport: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractExport: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CodeInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeBytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CodeBytes = append(m.CodeBytes[:0], dAtA[iNdEx:postIndex]...)
+			if m.CodeBytes == nil {
+				m.CodeBytes = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ContractInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractHistory = append(m.ContractHistory, ContractCodeHistoryEntry{})
+			if err := m.ContractHistory[len(m.ContractHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractState = append(m.ContractState, Model{})
+			if err := m.ContractState[len(m.ContractState)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checksum", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Checksum = append(m.Checksum[:0], dAtA[iNdEx:postIndex]...)
+			if m.Checksum == nil {
+				m.Checksum = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContractQuota) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractQuota: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractQuota: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxExecutionsPerBlock", wireType)
+			}
+			m.MaxExecutionsPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxExecutionsPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGasPerBlock", wireType)
+			}
+			m.MaxGasPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxGasPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Schedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Schedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Schedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msg = append(m.Msg[:0], dAtA[iNdEx:postIndex]...)
+			if m.Msg == nil {
+				m.Msg = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			m.Interval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Interval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextHeight", wireType)
+			}
+			m.NextHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastHeight", wireType)
+			}
+			m.LastHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastError", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastError = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTypes(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
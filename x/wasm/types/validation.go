@@ -10,6 +10,12 @@ var (
 
 	// MaxWasmSize is the largest a compiled contract code can be when storing code on chain
 	MaxWasmSize = 800 * 1024 // extension point for chains to customize via compile flag.
+
+	// MaxSaltSize is the longest salt that can be used when instantiating a contract with a predictable address
+	MaxSaltSize = 64
+
+	// MaxScheduleNameSize is the longest name that can be used for a schedule of a contract
+	MaxScheduleNameSize = 64
 )
 
 func validateWasmCode(s []byte) error {
@@ -31,3 +37,14 @@ func validateLabel(label string) error {
 	}
 	return nil
 }
+
+// ValidateSalt ensures the length constraints of the salt of a predictable address
+func ValidateSalt(salt []byte) error {
+	switch n := len(salt); {
+	case n == 0:
+		return sdkerrors.Wrap(ErrEmpty, "is required")
+	case n > MaxSaltSize:
+		return sdkerrors.Wrapf(ErrLimit, "cannot be longer than %d bytes", MaxSaltSize)
+	}
+	return nil
+}